@@ -0,0 +1,70 @@
+package oracle
+
+import (
+	"math/big"
+
+	cometabci "github.com/cometbft/cometbft/abci/types"
+	cometproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
+	oracletypes "github.com/zoguxprotocol/slinky/x/oracle/types"
+)
+
+// onChainPrices returns the prices currently stored on-chain, before the prices of the block are applied. The
+// prices are only read if the oracle keeper tracks the performance of validators, otherwise nil is returned.
+func (h *PreBlockHandler) onChainPrices(ctx sdk.Context) map[slinkytypes.CurrencyPair]*big.Int {
+	if h.performanceKeeper == nil || !h.performanceKeeper.IsPerformanceTrackingEnabled(ctx) {
+		return nil
+	}
+
+	prices := make(map[slinkytypes.CurrencyPair]*big.Int)
+	for _, cp := range h.keeper.GetAllCurrencyPairs(ctx) {
+		qp, err := h.performanceKeeper.GetPriceForCurrencyPair(ctx, cp)
+		if err != nil {
+			// the currency-pair has no price yet
+			continue
+		}
+
+		prices[cp] = qp.Price.BigInt()
+	}
+
+	return prices
+}
+
+// trackValidatorPerformance takes the commit decided for this block, and records the prices reported by each
+// validator in the commit against the aggregated prices of the block. This is a no-op if the oracle keeper does
+// not track the performance of validators. The tracking, including the performance hooks it calls, is run in a
+// cache context that is only written if it succeeds; otherwise, the error is logged and the changes are discarded.
+func (h *PreBlockHandler) trackValidatorPerformance(
+	ctx sdk.Context,
+	decidedCommit cometabci.CommitInfo,
+	previous, aggregated map[slinkytypes.CurrencyPair]*big.Int,
+) {
+	if h.performanceKeeper == nil || !h.performanceKeeper.IsPerformanceTrackingEnabled(ctx) {
+		return
+	}
+
+	reports := make([]oracletypes.ValidatorReport, len(decidedCommit.Votes))
+	for i, vote := range decidedCommit.Votes {
+		validator := sdk.ConsAddress(vote.Validator.Address)
+		reports[i] = oracletypes.ValidatorReport{
+			Validator: validator,
+			Absent:    vote.BlockIdFlag != cometproto.BlockIDFlagCommit,
+			Prices:    h.pa.GetPricesForValidator(validator),
+		}
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := h.performanceKeeper.TrackValidatorPerformance(cacheCtx, reports, previous, aggregated); err != nil {
+		h.logger.Error(
+			"failed to track validator performance",
+			"height", ctx.BlockHeight(),
+			"error", err,
+		)
+
+		return
+	}
+
+	write()
+}
@@ -14,6 +14,7 @@ import (
 	abciaggregator "github.com/zoguxprotocol/slinky/abci/strategies/aggregator"
 	"github.com/zoguxprotocol/slinky/abci/strategies/codec"
 	"github.com/zoguxprotocol/slinky/abci/strategies/currencypair"
+	"github.com/zoguxprotocol/slinky/abci/strategies/registry"
 	slinkyabcitypes "github.com/zoguxprotocol/slinky/abci/types"
 	"github.com/zoguxprotocol/slinky/abci/ve"
 	"github.com/zoguxprotocol/slinky/aggregator"
@@ -37,6 +38,9 @@ type PreBlockHandler struct { //golint:ignore
 
 	// pa is the price applier that is used to decode vote-extensions, aggregate price reports, and write prices to state.
 	pa abciaggregator.PriceApplier
+
+	// performanceKeeper is the oracle keeper, if it tracks the oracle performance of validators.
+	performanceKeeper slinkyabcitypes.ValidatorPerformanceKeeper
 }
 
 // NewOraclePreBlockHandler returns a new PreBlockHandler. The handler
@@ -63,11 +67,47 @@ func NewOraclePreBlockHandler(
 		logger,
 	)
 
+	return newOraclePreBlockHandler(logger, oracleKeeper, metrics, pa)
+}
+
+// NewVersionedOraclePreBlockHandler returns a new PreBlockHandler that decodes the oracle data included in
+// vote extensions with the codecs and the currency pair strategy registered for their height.
+func NewVersionedOraclePreBlockHandler(
+	logger log.Logger,
+	aggregateFn aggregator.AggregateFnFromContext[string, map[slinkytypes.CurrencyPair]*big.Int],
+	oracleKeeper slinkyabcitypes.OracleKeeper,
+	metrics servicemetrics.Metrics,
+	versions *registry.Registry,
+) *PreBlockHandler {
+	va := abciaggregator.NewVersionedVoteAggregator(
+		logger,
+		aggregateFn,
+		versions,
+	)
+	pa := abciaggregator.NewVersionedOraclePriceApplier(
+		va,
+		oracleKeeper,
+		versions,
+		logger,
+	)
+
+	return newOraclePreBlockHandler(logger, oracleKeeper, metrics, pa)
+}
+
+func newOraclePreBlockHandler(
+	logger log.Logger,
+	oracleKeeper slinkyabcitypes.OracleKeeper,
+	metrics servicemetrics.Metrics,
+	pa abciaggregator.PriceApplier,
+) *PreBlockHandler {
+	performanceKeeper, _ := oracleKeeper.(slinkyabcitypes.ValidatorPerformanceKeeper)
+
 	return &PreBlockHandler{
-		logger:  logger,
-		keeper:  oracleKeeper,
-		metrics: metrics,
-		pa:      pa,
+		logger:            logger,
+		keeper:            oracleKeeper,
+		metrics:           metrics,
+		pa:                pa,
+		performanceKeeper: performanceKeeper,
 	}
 }
 
@@ -131,6 +171,9 @@ func (h *PreBlockHandler) WrappedPreBlocker(mm *module.Manager) sdk.PreBlocker {
 			"height", req.Height,
 		)
 
+		// snapshot the on-chain prices before they are updated, so that stale reports can be detected
+		previous := h.onChainPrices(ctx)
+
 		// decode vote-extensions + apply prices to state
 		prices, err = h.pa.ApplyPricesFromVoteExtensions(ctx, req)
 		if err != nil {
@@ -143,6 +186,10 @@ func (h *PreBlockHandler) WrappedPreBlocker(mm *module.Manager) sdk.PreBlocker {
 			return response, err
 		}
 
+		// record the oracle performance of each validator. failures are logged and discarded, since the
+		// prices have already been written and performance tracking must never halt the chain
+		h.trackValidatorPerformance(ctx, req.DecidedLastCommit, previous, prices)
+
 		return response, nil
 	}
 }
@@ -203,6 +250,9 @@ func (h *PreBlockHandler) PreBlocker() sdk.PreBlocker {
 			"height", req.Height,
 		)
 
+		// snapshot the on-chain prices before they are updated, so that stale reports can be detected
+		previous := h.onChainPrices(ctx)
+
 		// decode vote-extensions + apply prices to state
 		prices, err = h.pa.ApplyPricesFromVoteExtensions(ctx, req)
 		if err != nil {
@@ -215,6 +265,10 @@ func (h *PreBlockHandler) PreBlocker() sdk.PreBlocker {
 			return &sdk.ResponsePreBlock{}, err
 		}
 
+		// record the oracle performance of each validator. failures are logged and discarded, since the
+		// prices have already been written and performance tracking must never halt the chain
+		h.trackValidatorPerformance(ctx, req.DecidedLastCommit, previous, prices)
+
 		return &sdk.ResponsePreBlock{}, nil
 	}
 }
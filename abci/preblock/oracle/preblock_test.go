@@ -2,6 +2,7 @@ package oracle_test
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 	"testing"
 
@@ -676,6 +677,11 @@ func (s *PreBlockTestSuite) TestValidatorReports() {
 
 		// mock oracle keeper calls
 		mockOracleKeeper.On("GetAllCurrencyPairs", s.ctx).Return([]slinkytypes.CurrencyPair{btcUsd, mogUsd}, nil)
+		mockOracleKeeper.On("ApplyPriceGuardrail", s.ctx, mock.Anything, mock.Anything).Return(
+			func(_ sdk.Context, _ slinkytypes.CurrencyPair, qp oracletypes.QuotePrice) (oracletypes.QuotePrice, bool, error) {
+				return qp, true, nil
+			},
+		)
 		mockOracleKeeper.On("SetPriceForCurrencyPair", s.ctx, btcUsd, mock.Anything).Return(nil)
 		mockOracleKeeper.On("SetPriceForCurrencyPair", s.ctx, mogUsd, mock.Anything).Return(nil)
 
@@ -749,3 +755,176 @@ func (s *PreBlockTestSuite) TestValidatorReports() {
 		s.Require().NoError(err)
 	})
 }
+
+func (s *PreBlockTestSuite) TestValidatorPerformanceTracking() {
+	mockValidatorStore := voteweightedmocks.NewValidatorStore(s.T())
+	aggregationFn := voteweighted.MedianFromContext(
+		log.NewTestLogger(s.T()),
+		mockValidatorStore,
+		voteweighted.DefaultPowerThreshold,
+	)
+
+	s.Run("records the reports of each validator in the decided commit", func() {
+		s.ctx = testutils.UpdateContextWithVEHeight(s.ctx, 2).WithBlockHeight(3)
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, oracletypes.NewParamsWithPerformanceTracking(0, 10, 1)))
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, s.currencyPairs[0], oracletypes.QuotePrice{
+			Price: math.NewInt(100),
+		}))
+
+		s.handler = preblock.NewOraclePreBlockHandler(
+			log.NewTestLogger(s.T()),
+			aggregationFn,
+			&s.oracleKeeper,
+			servicemetrics.NewNopMetrics(),
+			currencypair.NewDefaultCurrencyPairStrategy(
+				&s.oracleKeeper,
+			),
+			s.veCodec,
+			s.commitCodec,
+		)
+
+		encode := func(price int64) []byte {
+			bz, err := big.NewInt(price).GobEncode()
+			s.Require().NoError(err)
+			return bz
+		}
+
+		// ca1 and ca2 report all prices, ca3 only reports the previous price of the first currency pair, and
+		// ca4 is absent
+		ca1 := sdk.ConsAddress([]byte("ca1"))
+		ca2 := sdk.ConsAddress([]byte("ca2"))
+		ca3 := sdk.ConsAddress([]byte("ca3"))
+		ca4 := sdk.ConsAddress([]byte("ca4"))
+
+		votes := make([]cometabci.ExtendedVoteInfo, 0)
+		for _, vote := range []struct {
+			validator sdk.ConsAddress
+			prices    map[uint64][]byte
+			power     int64
+		}{
+			{ca1, map[uint64][]byte{0: encode(110), 1: encode(200), 2: encode(300)}, 2},
+			{ca2, map[uint64][]byte{0: encode(110), 1: encode(200), 2: encode(300)}, 2},
+			{ca3, map[uint64][]byte{0: encode(100)}, 1},
+		} {
+			ve, err := testutils.CreateExtendedVoteInfo(vote.validator, vote.prices, s.veCodec)
+			s.Require().NoError(err)
+			votes = append(votes, ve)
+
+			validator := voteweightedmocks.NewValidatorI(s.T())
+			validator.On("GetBondedTokens").Return(math.NewInt(vote.power))
+			mockValidatorStore.On("ValidatorByConsAddr", s.ctx, vote.validator).Return(validator, nil)
+		}
+		mockValidatorStore.On("TotalBondedTokens", s.ctx).Return(math.NewInt(5), nil)
+
+		_, extCommitBz, err := testutils.CreateExtendedCommitInfo(votes, s.commitCodec)
+		s.Require().NoError(err)
+
+		decidedCommit := cometabci.CommitInfo{}
+		for _, vote := range votes {
+			decidedCommit.Votes = append(decidedCommit.Votes, cometabci.VoteInfo{
+				Validator:   vote.Validator,
+				BlockIdFlag: cometproto.BlockIDFlagCommit,
+			})
+		}
+		decidedCommit.Votes = append(decidedCommit.Votes, cometabci.VoteInfo{
+			Validator:   cometabci.Validator{Address: ca4},
+			BlockIdFlag: cometproto.BlockIDFlagAbsent,
+		})
+
+		_, err = s.handler.WrappedPreBlocker(s.mm)(s.ctx, &cometabci.RequestFinalizeBlock{
+			Txs:               [][]byte{extCommitBz},
+			DecidedLastCommit: decidedCommit,
+		})
+		s.Require().NoError(err)
+
+		vp, err := s.oracleKeeper.GetValidatorPerformance(s.ctx, ca1, 1)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(1), vp.Blocks)
+		s.Require().Equal(uint64(3), vp.Reports)
+		s.Require().Equal(uint64(0), vp.MissedReports)
+		s.Require().Equal(uint64(0), vp.StaleReports)
+		s.Require().Equal(uint64(0), vp.MedianDeviationBps())
+
+		vp, err = s.oracleKeeper.GetValidatorPerformance(s.ctx, ca3, 1)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(3), vp.Reports)
+		s.Require().Equal(uint64(2), vp.MissedReports)
+		s.Require().Equal(uint64(1), vp.StaleReports)
+		s.Require().Equal(uint64(1000), vp.MedianDeviationBps())
+
+		vp, err = s.oracleKeeper.GetValidatorPerformance(s.ctx, ca4, 1)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(1), vp.AbsentVotes)
+		s.Require().Equal(uint64(3), vp.Reports)
+		s.Require().Equal(uint64(3), vp.MissedReports)
+	})
+
+	s.Run("a failing performance hook does not halt the chain, and its changes are discarded", func() {
+		s.ctx = testutils.UpdateContextWithVEHeight(s.ctx, 2).WithBlockHeight(3)
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, oracletypes.NewParamsWithPerformanceTracking(0, 1, 1)))
+		s.oracleKeeper.SetValidatorPerformanceHooks(failingPerformanceHooks{})
+
+		s.handler = preblock.NewOraclePreBlockHandler(
+			log.NewTestLogger(s.T()),
+			aggregationFn,
+			&s.oracleKeeper,
+			servicemetrics.NewNopMetrics(),
+			currencypair.NewDefaultCurrencyPairStrategy(
+				&s.oracleKeeper,
+			),
+			s.veCodec,
+			s.commitCodec,
+		)
+
+		price, err := big.NewInt(100).GobEncode()
+		s.Require().NoError(err)
+
+		ca1 := sdk.ConsAddress([]byte("ca1"))
+		ve, err := testutils.CreateExtendedVoteInfo(ca1, map[uint64][]byte{0: price}, s.veCodec)
+		s.Require().NoError(err)
+
+		validator := voteweightedmocks.NewValidatorI(s.T())
+		validator.On("GetBondedTokens").Return(math.NewInt(1))
+		mockValidatorStore.On("ValidatorByConsAddr", mock.Anything, ca1).Return(validator, nil)
+		mockValidatorStore.On("TotalBondedTokens", mock.Anything).Return(math.NewInt(1), nil)
+
+		_, extCommitBz, err := testutils.CreateExtendedCommitInfo([]cometabci.ExtendedVoteInfo{ve}, s.commitCodec)
+		s.Require().NoError(err)
+
+		req := &cometabci.RequestFinalizeBlock{
+			Txs: [][]byte{extCommitBz},
+			DecidedLastCommit: cometabci.CommitInfo{
+				Votes: []cometabci.VoteInfo{{Validator: ve.Validator, BlockIdFlag: cometproto.BlockIDFlagCommit}},
+			},
+		}
+
+		// the first block starts the first performance window
+		_, err = s.handler.WrappedPreBlocker(s.mm)(s.ctx, req)
+		s.Require().NoError(err)
+
+		// the second block completes the first performance window, for which the hook fails
+		s.ctx = s.ctx.WithBlockHeight(4)
+		_, err = s.handler.WrappedPreBlocker(s.mm)(s.ctx, req)
+		s.Require().NoError(err)
+
+		qp, err := s.oracleKeeper.GetPriceForCurrencyPair(s.ctx, s.currencyPairs[0])
+		s.Require().NoError(err)
+		s.Require().Equal(math.NewInt(100), qp.Price)
+		s.Require().Equal(uint64(4), qp.BlockHeight)
+
+		window, err := s.oracleKeeper.GetPerformanceWindow(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(1), window.Window)
+
+		vp, err := s.oracleKeeper.GetValidatorPerformance(s.ctx, ca1, 1)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(1), vp.Blocks)
+	})
+}
+
+// failingPerformanceHooks are validator performance hooks that always fail.
+type failingPerformanceHooks struct{}
+
+func (failingPerformanceHooks) AfterPerformanceWindowCompleted(sdk.Context, uint64, []oracletypes.ValidatorPerformance) error {
+	return fmt.Errorf("hook failed")
+}
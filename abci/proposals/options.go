@@ -1,5 +1,10 @@
 package proposals
 
+import (
+	"github.com/zoguxprotocol/slinky/abci/strategies/registry"
+	"github.com/zoguxprotocol/slinky/abci/ve"
+)
+
 // Option is a function that enables optional configuration of the ProposalHandler.
 type Option func(*ProposalHandler)
 
@@ -10,3 +15,21 @@ func RetainOracleDataInWrappedProposalHandler() Option {
 		p.retainOracleDataInWrappedHandler = true
 	}
 }
+
+// WithOracleKeyStore returns an Option that configures the ProposalHandler to verify the oracle
+// signatures of the vote extensions against the oracle keys registered by the validators, in
+// addition to the signatures by their consensus keys.
+func WithOracleKeyStore(keys ve.OracleKeyStore) Option {
+	return func(p *ProposalHandler) {
+		p.oracleKeys = keys
+	}
+}
+
+// WithRegistry returns an Option that configures the ProposalHandler to encode and decode the extended
+// commits, and to validate the vote extensions, with the codecs and the currency pair strategy registered
+// for their height, instead of the ones given to NewProposalHandler.
+func WithRegistry(versions *registry.Registry) Option {
+	return func(p *ProposalHandler) {
+		p.versions = versions
+	}
+}
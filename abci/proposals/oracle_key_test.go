@@ -0,0 +1,108 @@
+package proposals_test
+
+import (
+	"cosmossdk.io/log"
+	cometabci "github.com/cometbft/cometbft/abci/types"
+	cometproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/zoguxprotocol/slinky/abci/proposals"
+	currencypairmocks "github.com/zoguxprotocol/slinky/abci/strategies/currencypair/mocks"
+	"github.com/zoguxprotocol/slinky/abci/ve"
+	vetypes "github.com/zoguxprotocol/slinky/abci/ve/types"
+	servicemetrics "github.com/zoguxprotocol/slinky/service/metrics"
+)
+
+// oracleKeyStore returns the same oracle key of each validator for all heights.
+type oracleKeyStore map[string]cryptotypes.PubKey
+
+func (s oracleKeyStore) GetOracleKeyForHeight(_ sdk.Context, validator sdk.ConsAddress, _ int64) (cryptotypes.PubKey, error) {
+	return s[validator.String()], nil
+}
+
+func (s *ProposalsTestSuite) TestValidateOracleSignatures() {
+	privKey1 := ed25519.GenPrivKey()
+	privKey2 := ed25519.GenPrivKey()
+	keys := oracleKeyStore{
+		val1.String(): privKey1.PubKey(),
+		val2.String(): privKey2.PubKey(),
+	}
+
+	ctx := s.ctx.WithChainID("test-chain").WithBlockHeight(5)
+
+	// vote extensions included at height 5 were created at height 4
+	vote := func(validator sdk.ConsAddress, privKey cryptotypes.PrivKey) cometabci.ExtendedVoteInfo {
+		voteExt := vetypes.OracleVoteExtension{Prices: prices1}
+		if privKey != nil {
+			s.Require().NoError(ve.SignOracleVoteExtension("test-chain", 4, validator, privKey, &voteExt))
+		}
+
+		bz, err := s.codec.Encode(voteExt)
+		s.Require().NoError(err)
+
+		return cometabci.ExtendedVoteInfo{
+			Validator:          cometabci.Validator{Address: validator, Power: 1},
+			VoteExtension:      bz,
+			ExtensionSignature: []byte("signature"),
+			BlockIdFlag:        cometproto.BlockIDFlagCommit,
+		}
+	}
+
+	newHandler := func() *proposals.ProposalHandler {
+		cpStrategy := currencypairmocks.NewCurrencyPairStrategy(s.T())
+		cpStrategy.On("GetMaxNumCP", mock.Anything).Return(uint64(2), nil)
+
+		return proposals.NewProposalHandler(
+			log.NewNopLogger(),
+			nil,
+			nil,
+			ve.NoOpValidateVoteExtensions,
+			s.codec,
+			s.extCommitCodec,
+			cpStrategy,
+			servicemetrics.NewNopMetrics(),
+			proposals.WithOracleKeyStore(keys),
+		)
+	}
+
+	s.Run("vote extensions signed by the oracle keys are valid", func() {
+		info := cometabci.ExtendedCommitInfo{
+			Votes: []cometabci.ExtendedVoteInfo{
+				vote(val1, privKey1),
+				vote(val2, privKey2),
+				vote(val3, nil),
+			},
+		}
+
+		s.Require().NoError(newHandler().ValidateExtendedCommitInfo(ctx, 5, info))
+	})
+
+	s.Run("vote extensions not signed by the oracle keys are invalid", func() {
+		info := cometabci.ExtendedCommitInfo{
+			Votes: []cometabci.ExtendedVoteInfo{
+				vote(val1, privKey1),
+				vote(val2, privKey1),
+			},
+		}
+
+		s.Require().Error(newHandler().ValidateExtendedCommitInfo(ctx, 5, info))
+	})
+
+	s.Run("vote extensions not signed by the oracle keys are pruned", func() {
+		info := cometabci.ExtendedCommitInfo{
+			Votes: []cometabci.ExtendedVoteInfo{
+				vote(val1, privKey1),
+				vote(val2, nil),
+			},
+		}
+
+		pruned, err := newHandler().PruneAndValidateExtendedCommitInfo(ctx, info)
+		s.Require().NoError(err)
+		s.Require().Equal(cometproto.BlockIDFlagCommit, pruned.Votes[0].BlockIdFlag)
+		s.Require().Equal(cometproto.BlockIDFlagAbsent, pruned.Votes[1].BlockIdFlag)
+		s.Require().Nil(pruned.Votes[1].VoteExtension)
+	})
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/zoguxprotocol/slinky/abci/strategies/codec"
 	"github.com/zoguxprotocol/slinky/abci/strategies/currencypair"
+	"github.com/zoguxprotocol/slinky/abci/strategies/registry"
 	slinkyabci "github.com/zoguxprotocol/slinky/abci/types"
 	"github.com/zoguxprotocol/slinky/abci/ve"
 	servicemetrics "github.com/zoguxprotocol/slinky/service/metrics"
@@ -57,6 +58,14 @@ type ProposalHandler struct {
 	// proposal handler should pass the injected extended commit info to the
 	// wrapped proposal handler.
 	retainOracleDataInWrappedHandler bool
+
+	// oracleKeys are the oracle keys registered by the validators. If set, the oracle
+	// signatures of the vote extensions are verified.
+	oracleKeys ve.OracleKeyStore
+
+	// versions is the registry of the codecs and strategies of the vote extensions. If set, it takes
+	// precedence over the codecs and the currency pair strategy.
+	versions *registry.Registry
 }
 
 // NewProposalHandler returns a new ProposalHandler.
@@ -155,7 +164,7 @@ func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 
 			// Create the vote extension injection data which will be injected into the proposal. These contain the
 			// oracle data for the current block which will be committed to state in PreBlock.
-			extInfoBz, err = h.extendedCommitCodec.Encode(extInfo)
+			extInfoBz, err = h.versionAt(req.Height).ExtendedCommitCodec.Encode(extInfo)
 			if err != nil {
 				h.logger.Error(
 					"failed to extended commit info",
@@ -215,6 +224,19 @@ func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 	}
 }
 
+// versionAt returns the codecs and the currency pair strategy active at the given height.
+func (h *ProposalHandler) versionAt(height int64) registry.Version {
+	if h.versions == nil {
+		return registry.Version{
+			VoteExtensionCodec:   h.voteExtensionCodec,
+			ExtendedCommitCodec:  h.extendedCommitCodec,
+			CurrencyPairStrategy: h.currencyPairStrategy,
+		}
+	}
+
+	return h.versions.VersionAt(height)
+}
+
 // injectAndResize returns a tx array containing the injectTx at the beginning followed by appTxs.
 // The returned transaction array is bounded by maxSizeBytes, and the function is idempotent meaning the
 // injectTx will only appear once regardless of how many times you attempt to inject it.
@@ -304,7 +326,7 @@ func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 
 			// Validate the vote extensions included in the proposal.
 			var extInfo cometabci.ExtendedCommitInfo
-			extInfo, err = h.extendedCommitCodec.Decode(extCommitBz)
+			extInfo, err = h.versionAt(req.Height).ExtendedCommitCodec.Decode(extCommitBz)
 			if err != nil {
 				h.logger.Error("failed to unmarshal commit info", "err", err)
 				err = slinkyabci.CodecError{
@@ -0,0 +1,121 @@
+package proposals_test
+
+import (
+	"cosmossdk.io/log"
+	cometabci "github.com/cometbft/cometbft/abci/types"
+	cometproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/zoguxprotocol/slinky/abci/proposals"
+	"github.com/zoguxprotocol/slinky/abci/strategies/codec"
+	currencypairmocks "github.com/zoguxprotocol/slinky/abci/strategies/currencypair/mocks"
+	"github.com/zoguxprotocol/slinky/abci/strategies/registry"
+	"github.com/zoguxprotocol/slinky/abci/testutils"
+	"github.com/zoguxprotocol/slinky/abci/ve"
+	servicemetrics "github.com/zoguxprotocol/slinky/service/metrics"
+)
+
+func (s *ProposalsTestSuite) TestProposalsWithRegistry() {
+	cpStrategy := currencypairmocks.NewCurrencyPairStrategy(s.T())
+	cpStrategy.On("GetMaxNumCP", mock.Anything).Return(uint64(2), nil).Maybe()
+
+	upgradedExtCommitCodec := codec.NewCompressionExtendedCommitCodec(
+		codec.NewDefaultExtendedCommitCodec(),
+		codec.NewZStdCompressor(),
+	)
+
+	// the extended commit codec and the vote extension version change at height 10
+	versions, err := registry.NewRegistry(
+		registry.Version{
+			Height:               0,
+			Version:              registry.UnversionedVersion,
+			VoteExtensionCodec:   s.codec,
+			ExtendedCommitCodec:  s.extCommitCodec,
+			CurrencyPairStrategy: cpStrategy,
+		},
+		registry.Version{
+			Height:               10,
+			Version:              1,
+			VoteExtensionCodec:   s.codec,
+			ExtendedCommitCodec:  upgradedExtCommitCodec,
+			CurrencyPairStrategy: cpStrategy,
+		},
+	)
+	s.Require().NoError(err)
+
+	handler := proposals.NewProposalHandler(
+		log.NewNopLogger(),
+		baseapp.NoOpPrepareProposal(),
+		baseapp.NoOpProcessProposal(),
+		ve.NoOpValidateVoteExtensions,
+		s.codec,
+		s.extCommitCodec,
+		cpStrategy,
+		servicemetrics.NewNopMetrics(),
+		proposals.WithRegistry(versions),
+	)
+
+	unversioned, err := testutils.CreateExtendedVoteInfo(val1, prices1, versions.VersionAt(9).VoteExtensionCodec)
+	s.Require().NoError(err)
+	versioned, err := testutils.CreateExtendedVoteInfo(val2, prices2, versions.VersionAt(10).VoteExtensionCodec)
+	s.Require().NoError(err)
+
+	s.Run("vote extensions of the previous height's version are kept at the upgrade height", func() {
+		ctx := s.ctx.WithBlockHeight(10)
+		resp, err := handler.PrepareProposalHandler()(ctx, s.createRequestPrepareProposal(
+			cometabci.ExtendedCommitInfo{Votes: []cometabci.ExtendedVoteInfo{unversioned, versioned}},
+			nil,
+			10,
+		))
+		s.Require().NoError(err)
+		s.Require().Len(resp.Txs, 1)
+
+		// the extended commit is encoded with the codec of the upgrade height
+		_, err = s.extCommitCodec.Decode(resp.Txs[0])
+		s.Require().Error(err)
+		extInfo, err := upgradedExtCommitCodec.Decode(resp.Txs[0])
+		s.Require().NoError(err)
+
+		s.Require().Equal(cometproto.BlockIDFlagCommit, extInfo.Votes[0].BlockIdFlag)
+		s.Require().Equal(cometproto.BlockIDFlagAbsent, extInfo.Votes[1].BlockIdFlag)
+	})
+
+	s.Run("vote extensions of another version are pruned after the upgrade height", func() {
+		ctx := s.ctx.WithBlockHeight(11)
+		resp, err := handler.PrepareProposalHandler()(ctx, s.createRequestPrepareProposal(
+			cometabci.ExtendedCommitInfo{Votes: []cometabci.ExtendedVoteInfo{unversioned, versioned}},
+			nil,
+			11,
+		))
+		s.Require().NoError(err)
+
+		extInfo, err := upgradedExtCommitCodec.Decode(resp.Txs[0])
+		s.Require().NoError(err)
+		s.Require().Equal(cometproto.BlockIDFlagAbsent, extInfo.Votes[0].BlockIdFlag)
+		s.Require().Equal(cometproto.BlockIDFlagCommit, extInfo.Votes[1].BlockIdFlag)
+	})
+
+	s.Run("proposals are processed with the version of their height", func() {
+		ctx := s.ctx.WithBlockHeight(11)
+		_, upgraded, err := testutils.CreateExtendedCommitInfo([]cometabci.ExtendedVoteInfo{versioned}, upgradedExtCommitCodec)
+		s.Require().NoError(err)
+		_, legacy, err := testutils.CreateExtendedCommitInfo([]cometabci.ExtendedVoteInfo{versioned}, s.extCommitCodec)
+		s.Require().NoError(err)
+
+		resp, err := handler.ProcessProposalHandler()(ctx, s.createRequestProcessProposal([][]byte{upgraded}, cometabci.CommitInfo{}, 11))
+		s.Require().NoError(err)
+		s.Require().Equal(cometabci.ResponseProcessProposal_ACCEPT, resp.Status)
+
+		resp, err = handler.ProcessProposalHandler()(ctx, s.createRequestProcessProposal([][]byte{legacy}, cometabci.CommitInfo{}, 11))
+		s.Require().Error(err)
+		s.Require().Equal(cometabci.ResponseProcessProposal_REJECT, resp.Status)
+
+		// a proposal with vote extensions of another version is rejected
+		_, mixed, err := testutils.CreateExtendedCommitInfo([]cometabci.ExtendedVoteInfo{unversioned}, upgradedExtCommitCodec)
+		s.Require().NoError(err)
+		resp, err = handler.ProcessProposalHandler()(ctx, s.createRequestProcessProposal([][]byte{mixed}, cometabci.CommitInfo{}, 11))
+		s.Require().Error(err)
+		s.Require().Equal(cometabci.ResponseProcessProposal_REJECT, resp.Status)
+	})
+}
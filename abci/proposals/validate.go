@@ -5,15 +5,14 @@ import (
 	cometproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	"github.com/zoguxprotocol/slinky/abci/strategies/codec"
-	"github.com/zoguxprotocol/slinky/abci/strategies/currencypair"
 	"github.com/zoguxprotocol/slinky/abci/ve"
 )
 
 // ValidateExtendedCommitInfo validates the extended commit info for a block. It first
 // ensures that the vote extensions compose a super-majority of the signatures and
 // voting power for the block. Then, it ensures that oracle vote extensions are correctly
-// marshalled and contain valid prices.
+// marshalled, contain valid prices and, if an oracle key store is configured, are signed by
+// the oracle keys of their validators.
 func (h *ProposalHandler) ValidateExtendedCommitInfo(
 	ctx sdk.Context,
 	height int64,
@@ -33,7 +32,7 @@ func (h *ProposalHandler) ValidateExtendedCommitInfo(
 	for _, vote := range extendedCommitInfo.Votes {
 		address := sdk.ConsAddress(vote.Validator.Address)
 		// The vote extension are from the previous block.
-		if err := validateVoteExtension(ctx, vote, h.voteExtensionCodec, h.currencyPairStrategy); err != nil {
+		if err := h.validateVoteExtension(ctx, vote); err != nil {
 			h.logger.Error(
 				"failed to validate oracle vote extension",
 				"height", height,
@@ -57,7 +56,7 @@ func (h *ProposalHandler) PruneAndValidateExtendedCommitInfo(
 	// Validate all oracle vote extensions.
 	for i, vote := range extendedCommitInfo.Votes {
 		// validate the vote-extension
-		if err := validateVoteExtension(ctx, vote, h.voteExtensionCodec, h.currencyPairStrategy); err != nil {
+		if err := h.validateVoteExtension(ctx, vote); err != nil {
 			h.logger.Info(
 				"failed to validate vote extension - pruning vote",
 				"err", err,
@@ -85,26 +84,29 @@ func (h *ProposalHandler) PruneAndValidateExtendedCommitInfo(
 	return extendedCommitInfo, nil
 }
 
-func validateVoteExtension(
+func (h *ProposalHandler) validateVoteExtension(
 	ctx sdk.Context,
 	vote cometabci.ExtendedVoteInfo,
-	voteExtensionCodec codec.VoteExtensionCodec,
-	currencyPairStrategy currencypair.CurrencyPairStrategy,
 ) error {
 	// vote is not voted for if VE is nil
 	if vote.VoteExtension == nil && vote.ExtensionSignature == nil {
 		return nil
 	}
 
-	voteExt, err := voteExtensionCodec.Decode(vote.VoteExtension)
+	// The vote extensions are from the previous block, and are decoded with the version of that block.
+	version := h.versionAt(ctx.BlockHeight() - 1)
+	voteExt, err := version.VoteExtensionCodec.Decode(vote.VoteExtension)
 	if err != nil {
 		return err
 	}
 
-	// The vote extensions are from the previous block.
-	if err := ve.ValidateOracleVoteExtension(ctx, voteExt, currencyPairStrategy); err != nil {
+	if err := ve.ValidateOracleVoteExtension(ctx, voteExt, version.CurrencyPairStrategy); err != nil {
 		return err
 	}
 
+	if h.oracleKeys != nil && len(vote.VoteExtension) != 0 {
+		return ve.VerifyOracleSignature(ctx, h.oracleKeys, vote.Validator.Address, ctx.BlockHeight()-1, voteExt)
+	}
+
 	return nil
 }
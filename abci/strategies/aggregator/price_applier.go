@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/zoguxprotocol/slinky/abci/strategies/codec"
+	"github.com/zoguxprotocol/slinky/abci/strategies/registry"
 	slinkyabcitypes "github.com/zoguxprotocol/slinky/abci/types"
 	oracletypes "github.com/zoguxprotocol/slinky/x/oracle/types"
 
@@ -21,9 +22,10 @@ import (
 //go:generate mockery --name PriceApplier --filename mock_price_applier.go
 type PriceApplier interface {
 	// ApplyPricesFromVoteExtensions derives the aggregate prices per asset in accordance with the given
-	// vote extensions + VoteAggregator. If a price exists for an asset, it is written to state. The
-	// prices aggregated from vote-extensions are returned if no errors are encountered in execution,
-	// otherwise an error is returned + nil prices.
+	// vote extensions + VoteAggregator. If a price exists for an asset, it is checked against the asset's
+	// guardrail and written to state. The prices written to state, i.e. after any guardrail clamping and
+	// excluding rejected prices, are returned if no errors are encountered in execution, otherwise an
+	// error is returned + nil prices.
 	ApplyPricesFromVoteExtensions(ctx sdk.Context, req *cometabci.RequestFinalizeBlock) (map[slinkytypes.CurrencyPair]*big.Int, error)
 
 	// GetPriceForValidator gets the prices reported by a given validator. This method depends
@@ -45,6 +47,9 @@ type oraclePriceApplier struct {
 	// codecs
 	voteExtensionCodec  codec.VoteExtensionCodec
 	extendedCommitCodec codec.ExtendedCommitCodec
+
+	// versions is the registry of the codecs. If set, it takes precedence over the codecs.
+	versions *registry.Registry
 }
 
 // NewOraclePriceApplier returns a new oraclePriceApplier.
@@ -64,10 +69,33 @@ func NewOraclePriceApplier(
 	}
 }
 
+// NewVersionedOraclePriceApplier returns a new oraclePriceApplier that decodes the extended commits with the
+// codec registered for the height of the block, and the vote extensions with the codec registered for the
+// height they were created at, i.e. the preceding height. The VoteAggregator should use the same registry.
+func NewVersionedOraclePriceApplier(
+	va VoteAggregator,
+	ok slinkyabcitypes.OracleKeeper,
+	versions *registry.Registry,
+	logger log.Logger,
+) PriceApplier {
+	return &oraclePriceApplier{
+		va:       va,
+		ok:       ok,
+		logger:   logger,
+		versions: versions,
+	}
+}
+
 func (opa *oraclePriceApplier) ApplyPricesFromVoteExtensions(ctx sdk.Context, req *cometabci.RequestFinalizeBlock) (map[slinkytypes.CurrencyPair]*big.Int, error) {
+	veCodec, extCommitCodec := opa.voteExtensionCodec, opa.extendedCommitCodec
+	if opa.versions != nil {
+		veCodec = opa.versions.VersionAt(req.Height - 1).VoteExtensionCodec
+		extCommitCodec = opa.versions.VersionAt(req.Height).ExtendedCommitCodec
+	}
+
 	// If vote extensions have been enabled, the extended commit info - which
 	// contains the vote extensions - must be included in the request.
-	votes, err := GetOracleVotes(req.Txs, opa.voteExtensionCodec, opa.extendedCommitCodec)
+	votes, err := GetOracleVotes(req.Txs, veCodec, extCommitCodec)
 	if err != nil {
 		opa.logger.Error(
 			"failed to get extended commit info from proposal",
@@ -101,6 +129,7 @@ func (opa *oraclePriceApplier) ApplyPricesFromVoteExtensions(ctx sdk.Context, re
 	}
 
 	currencyPairs := opa.ok.GetAllCurrencyPairs(ctx)
+	written := make(map[slinkytypes.CurrencyPair]*big.Int, len(currencyPairs))
 	for _, cp := range currencyPairs {
 		price, ok := prices[cp]
 		if !ok || price == nil {
@@ -129,6 +158,28 @@ func (opa *oraclePriceApplier) ApplyPricesFromVoteExtensions(ctx sdk.Context, re
 			BlockHeight:    uint64(ctx.BlockHeight()), //nolint:gosec
 		}
 
+		// Check the quote price against the currency pair's guardrail, which may clamp or reject it.
+		quotePrice, accepted, err := opa.ok.ApplyPriceGuardrail(ctx, cp, quotePrice)
+		if err != nil {
+			opa.logger.Error(
+				"failed to apply price guardrail for currency pair",
+				"currency_pair", cp.String(),
+				"err", err,
+			)
+
+			return nil, err
+		}
+
+		if !accepted {
+			opa.logger.Info(
+				"price rejected by guardrail",
+				"currency_pair", cp.String(),
+				"price", price.String(),
+			)
+
+			continue
+		}
+
 		if err := opa.ok.SetPriceForCurrencyPair(ctx, cp, quotePrice); err != nil {
 			opa.logger.Error(
 				"failed to set price for currency pair",
@@ -140,6 +191,8 @@ func (opa *oraclePriceApplier) ApplyPricesFromVoteExtensions(ctx sdk.Context, re
 			return nil, err
 		}
 
+		written[cp] = quotePrice.Price.BigInt()
+
 		opa.logger.Debug(
 			"set price for currency pair",
 			"currency_pair", cp.String(),
@@ -147,7 +200,7 @@ func (opa *oraclePriceApplier) ApplyPricesFromVoteExtensions(ctx sdk.Context, re
 		)
 	}
 
-	return prices, nil
+	return written, nil
 }
 
 func (opa *oraclePriceApplier) GetPricesForValidator(validator sdk.ConsAddress) map[slinkytypes.CurrencyPair]*big.Int {
@@ -203,6 +203,12 @@ func TestPriceApplier(t *testing.T) {
 			[]slinkytypes.CurrencyPair{cp, slinkytypes.NewCurrencyPair("ETH", "USD")}, // ignore last cp
 		)
 
+		ok.On("ApplyPriceGuardrail", ctx, cp, mock.Anything).Return(
+			func(_ sdk.Context, _ slinkytypes.CurrencyPair, qp oracletypes.QuotePrice) (oracletypes.QuotePrice, bool, error) {
+				return qp, true, nil
+			},
+		)
+
 		ok.On("SetPriceForCurrencyPair", ctx, cp, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
 			qp := args.Get(2).(oracletypes.QuotePrice)
 
@@ -230,3 +236,87 @@ func TestPriceApplier(t *testing.T) {
 		require.Equal(t, expPrices, valPrices)
 	})
 }
+
+func TestPriceApplierGuardrail(t *testing.T) {
+	veCodec := codec.NewDefaultVoteExtensionCodec()
+	extCommitcodec := codec.NewDefaultExtendedCommitCodec()
+
+	va := mocks.NewVoteAggregator(t)
+	ok := abcimocks.NewOracleKeeper(t)
+
+	pa := aggregator.NewOraclePriceApplier(
+		va,
+		ok,
+		veCodec,
+		extCommitcodec,
+		log.NewNopLogger(),
+	)
+
+	ca := sdk.ConsAddress("val1")
+	vote, err := testutils.CreateExtendedVoteInfo(
+		ca,
+		map[uint64][]byte{1: big.NewInt(100).Bytes()},
+		veCodec,
+	)
+	require.NoError(t, err)
+
+	_, extCommitInfoBz, err := testutils.CreateExtendedCommitInfo(
+		[]abcitypes.ExtendedVoteInfo{vote},
+		extCommitcodec,
+	)
+	require.NoError(t, err)
+
+	ctx := sdk.Context{}.WithBlockHeader(cmtproto.Header{
+		Time: time.Now(),
+	}).WithBlockHeight(1)
+
+	btc := slinkytypes.NewCurrencyPair("BTC", "USD")
+	eth := slinkytypes.NewCurrencyPair("ETH", "USD")
+
+	va.On("AggregateOracleVotes", ctx, mock.Anything).Return(map[slinkytypes.CurrencyPair]*big.Int{
+		btc: big.NewInt(100),
+		eth: big.NewInt(200),
+	}, nil)
+	ok.On("GetAllCurrencyPairs", ctx).Return([]slinkytypes.CurrencyPair{btc, eth})
+
+	// the BTC price is clamped, the ETH price is rejected
+	ok.On("ApplyPriceGuardrail", ctx, btc, mock.Anything).Return(
+		func(_ sdk.Context, _ slinkytypes.CurrencyPair, qp oracletypes.QuotePrice) (oracletypes.QuotePrice, bool, error) {
+			qp.Price = qp.Price.SubRaw(10)
+			return qp, true, nil
+		},
+	)
+	ok.On("ApplyPriceGuardrail", ctx, eth, mock.Anything).Return(oracletypes.QuotePrice{}, false, nil)
+
+	ok.On("SetPriceForCurrencyPair", ctx, btc, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		qp := args.Get(2).(oracletypes.QuotePrice)
+		require.Equal(t, big.NewInt(90), qp.Price.BigInt())
+	}).Once()
+
+	prices, err := pa.ApplyPricesFromVoteExtensions(ctx, &abcitypes.RequestFinalizeBlock{
+		Txs: [][]byte{extCommitInfoBz},
+	})
+	require.NoError(t, err)
+
+	// the prices written to state are returned, i.e. the clamped BTC price and no ETH price
+	require.Equal(t, map[slinkytypes.CurrencyPair]*big.Int{
+		btc: big.NewInt(90),
+	}, prices)
+	ok.AssertNotCalled(t, "SetPriceForCurrencyPair", ctx, eth, mock.Anything)
+
+	t.Run("if applying the guardrail fails, fail", func(t *testing.T) {
+		ctx := ctx.WithBlockHeight(2)
+
+		va.On("AggregateOracleVotes", ctx, mock.Anything).Return(map[slinkytypes.CurrencyPair]*big.Int{
+			btc: big.NewInt(100),
+		}, nil)
+		ok.On("GetAllCurrencyPairs", ctx).Return([]slinkytypes.CurrencyPair{btc})
+		ok.On("ApplyPriceGuardrail", ctx, btc, mock.Anything).Return(oracletypes.QuotePrice{}, false, fmt.Errorf("error"))
+
+		prices, err := pa.ApplyPricesFromVoteExtensions(ctx, &abcitypes.RequestFinalizeBlock{
+			Txs: [][]byte{extCommitInfoBz},
+		})
+		require.Error(t, err)
+		require.Nil(t, prices)
+	})
+}
@@ -0,0 +1,164 @@
+package aggregator_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/log"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zoguxprotocol/slinky/abci/strategies/aggregator"
+	"github.com/zoguxprotocol/slinky/abci/strategies/aggregator/mocks"
+	"github.com/zoguxprotocol/slinky/abci/strategies/codec"
+	currencypairmocks "github.com/zoguxprotocol/slinky/abci/strategies/currencypair/mocks"
+	"github.com/zoguxprotocol/slinky/abci/strategies/registry"
+	"github.com/zoguxprotocol/slinky/abci/testutils"
+	abcimocks "github.com/zoguxprotocol/slinky/abci/types/mocks"
+	vetypes "github.com/zoguxprotocol/slinky/abci/ve/types"
+	pkgaggregator "github.com/zoguxprotocol/slinky/aggregator"
+	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
+)
+
+// newUpgradeRegistry returns a registry whose codecs and strategy change at height 10.
+func newUpgradeRegistry(
+	t *testing.T,
+	before, after *currencypairmocks.CurrencyPairStrategy,
+) *registry.Registry {
+	t.Helper()
+
+	versions, err := registry.NewRegistry(
+		registry.Version{
+			Height:               0,
+			Version:              registry.UnversionedVersion,
+			VoteExtensionCodec:   codec.NewDefaultVoteExtensionCodec(),
+			ExtendedCommitCodec:  codec.NewDefaultExtendedCommitCodec(),
+			CurrencyPairStrategy: before,
+		},
+		registry.Version{
+			Height:               10,
+			Version:              1,
+			VoteExtensionCodec:   codec.NewDefaultVoteExtensionCodec(),
+			ExtendedCommitCodec:  codec.NewCompressionExtendedCommitCodec(codec.NewDefaultExtendedCommitCodec(), codec.NewZStdCompressor()),
+			CurrencyPairStrategy: after,
+		},
+	)
+	require.NoError(t, err)
+
+	return versions
+}
+
+func TestVersionedPriceApplier(t *testing.T) {
+	versions := newUpgradeRegistry(
+		t,
+		currencypairmocks.NewCurrencyPairStrategy(t),
+		currencypairmocks.NewCurrencyPairStrategy(t),
+	)
+
+	va := mocks.NewVoteAggregator(t)
+	ok := abcimocks.NewOracleKeeper(t)
+	pa := aggregator.NewVersionedOraclePriceApplier(va, ok, versions, log.NewNopLogger())
+
+	prices := map[uint64][]byte{1: []byte("price1")}
+	proposal := func(height int64) [][]byte {
+		vote, err := testutils.CreateExtendedVoteInfo(val1, prices, versions.VersionAt(height-1).VoteExtensionCodec)
+		require.NoError(t, err)
+
+		_, bz, err := testutils.CreateExtendedCommitInfo(
+			[]abcitypes.ExtendedVoteInfo{vote},
+			versions.VersionAt(height).ExtendedCommitCodec,
+		)
+		require.NoError(t, err)
+
+		return [][]byte{bz}
+	}
+
+	votes := []aggregator.Vote{
+		{
+			ConsAddress:         val1,
+			OracleVoteExtension: vetypes.OracleVoteExtension{Prices: prices},
+		},
+	}
+
+	for _, height := range []int64{9, 10, 11} {
+		t.Run(fmt.Sprintf("proposal at height %d is decoded with the versions of its height", height), func(t *testing.T) {
+			ctx := sdk.Context{}.WithBlockHeight(height)
+			va.On("AggregateOracleVotes", ctx, votes).Return(map[slinkytypes.CurrencyPair]*big.Int{}, nil).Once()
+			ok.On("GetAllCurrencyPairs", ctx).Return(nil).Once()
+
+			_, err := pa.ApplyPricesFromVoteExtensions(ctx, &abcitypes.RequestFinalizeBlock{
+				Txs:    proposal(height),
+				Height: height,
+			})
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("vote extensions of another version fail to decode", func(t *testing.T) {
+		ctx := sdk.Context{}.WithBlockHeight(11)
+
+		_, err := pa.ApplyPricesFromVoteExtensions(ctx, &abcitypes.RequestFinalizeBlock{
+			Txs:    proposal(10),
+			Height: 11,
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestVersionedVoteAggregator(t *testing.T) {
+	before := currencypairmocks.NewCurrencyPairStrategy(t)
+	after := currencypairmocks.NewCurrencyPairStrategy(t)
+	versions := newUpgradeRegistry(t, before, after)
+
+	// the aggregated price is the price of val1
+	aggregateFn := func(sdk.Context) pkgaggregator.AggregateFn[string, map[slinkytypes.CurrencyPair]*big.Int] {
+		return func(providers pkgaggregator.AggregatedProviderData[string, map[slinkytypes.CurrencyPair]*big.Int]) map[slinkytypes.CurrencyPair]*big.Int {
+			return providers[val1.String()]
+		}
+	}
+	va := aggregator.NewVersionedVoteAggregator(log.NewNopLogger(), aggregateFn, versions)
+
+	votes := []aggregator.Vote{
+		{
+			ConsAddress: val1,
+			OracleVoteExtension: vetypes.OracleVoteExtension{
+				Prices: map[uint64][]byte{0: oneHundred.Bytes()},
+			},
+		},
+	}
+
+	cases := []struct {
+		name     string
+		height   int64
+		strategy *currencypairmocks.CurrencyPairStrategy
+		price    *big.Int
+	}{
+		{
+			name:     "vote extensions created before the upgrade height",
+			height:   10,
+			strategy: before,
+			price:    oneHundred,
+		},
+		{
+			name:     "vote extensions created at the upgrade height",
+			height:   11,
+			strategy: after,
+			price:    twoHundred,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithBlockHeight(tc.height)
+			tc.strategy.On("FromID", ctx, uint64(0)).Return(btcUSD, nil).Once()
+			tc.strategy.On("GetDecodedPrice", ctx, btcUSD, mock.Anything).Return(tc.price, nil).Once()
+
+			prices, err := va.AggregateOracleVotes(ctx, votes)
+			require.NoError(t, err)
+			require.Equal(t, tc.price, prices[btcUSD])
+		})
+	}
+}
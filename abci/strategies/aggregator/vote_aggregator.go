@@ -9,6 +9,7 @@ import (
 
 	"github.com/zoguxprotocol/slinky/abci/strategies/codec"
 	"github.com/zoguxprotocol/slinky/abci/strategies/currencypair"
+	"github.com/zoguxprotocol/slinky/abci/strategies/registry"
 	slinkyabci "github.com/zoguxprotocol/slinky/abci/types"
 	vetypes "github.com/zoguxprotocol/slinky/abci/ve/types"
 	"github.com/zoguxprotocol/slinky/aggregator"
@@ -103,6 +104,23 @@ func NewDefaultVoteAggregator(
 	}
 }
 
+// NewVersionedVoteAggregator returns a VoteAggregator that decodes the prices of the vote extensions with
+// the currency pair strategy registered for the height the vote extensions were created at, i.e. the height
+// preceding the block they are aggregated in.
+func NewVersionedVoteAggregator(
+	logger log.Logger,
+	aggregateFn aggregator.AggregateFnFromContext[string, map[slinkytypes.CurrencyPair]*big.Int],
+	versions *registry.Registry,
+) VoteAggregator {
+	return &DefaultVoteAggregator{
+		logger: logger,
+		priceAggregator: aggregator.NewDataAggregator(
+			aggregator.WithAggregateFnFromContext(aggregateFn),
+		),
+		versions: versions,
+	}
+}
+
 type DefaultVoteAggregator struct {
 	// validator address -> currency-pair -> price
 	priceAggregator *aggregator.DataAggregator[string, map[slinkytypes.CurrencyPair]*big.Int]
@@ -110,6 +128,10 @@ type DefaultVoteAggregator struct {
 	// decoding prices / currency-pair ids
 	currencyPairStrategy currencypair.CurrencyPairStrategy
 
+	// versions is the registry of the currency pair strategies. If set, it takes precedence
+	// over the currency pair strategy.
+	versions *registry.Registry
+
 	logger log.Logger
 }
 
@@ -117,12 +139,18 @@ func (dva *DefaultVoteAggregator) AggregateOracleVotes(ctx sdk.Context, votes []
 	// Reset the price aggregator and set the aggregationFn to use the latest application-state.
 	dva.priceAggregator.ResetProviderData()
 
+	// The vote extensions were created at the previous height.
+	strategy := dva.currencyPairStrategy
+	if dva.versions != nil {
+		strategy = dva.versions.VersionAt(ctx.BlockHeight() - 1).CurrencyPairStrategy
+	}
+
 	// Iterate through all vote extensions and consolidate all price info before
 	// aggregating.
 	for _, vote := range votes {
 		consAddrStr := vote.ConsAddress.String()
 
-		if err := dva.addVoteToAggregator(ctx, consAddrStr, vote.OracleVoteExtension); err != nil {
+		if err := dva.addVoteToAggregator(ctx, strategy, consAddrStr, vote.OracleVoteExtension); err != nil {
 			dva.logger.Error(
 				"failed to add vote to aggregator",
 				"validator_address", consAddrStr,
@@ -149,7 +177,12 @@ func (dva *DefaultVoteAggregator) AggregateOracleVotes(ctx sdk.Context, votes []
 // into the price aggregator. The oracle data is provided in the form of a vote
 // extension. The vote extension contains the prices for each currency pair that
 // the validator is providing for the current block.
-func (dva *DefaultVoteAggregator) addVoteToAggregator(ctx sdk.Context, address string, oracleData vetypes.OracleVoteExtension) error {
+func (dva *DefaultVoteAggregator) addVoteToAggregator(
+	ctx sdk.Context,
+	strategy currencypair.CurrencyPairStrategy,
+	address string,
+	oracleData vetypes.OracleVoteExtension,
+) error {
 	if len(oracleData.Prices) == 0 {
 		return nil
 	}
@@ -167,7 +200,7 @@ func (dva *DefaultVoteAggregator) addVoteToAggregator(ctx sdk.Context, address s
 		}
 
 		// Convert the asset into a currency pair.
-		cp, err := dva.currencyPairStrategy.FromID(ctx, cpID)
+		cp, err := strategy.FromID(ctx, cpID)
 		if err != nil {
 			dva.logger.Debug(
 				"failed to convert currency pair id to currency pair",
@@ -179,7 +212,7 @@ func (dva *DefaultVoteAggregator) addVoteToAggregator(ctx sdk.Context, address s
 			continue
 		}
 
-		price, err := dva.currencyPairStrategy.GetDecodedPrice(ctx, cp, priceBz)
+		price, err := strategy.GetDecodedPrice(ctx, cp, priceBz)
 		if err != nil {
 			dva.logger.Debug(
 				"failed to decode price",
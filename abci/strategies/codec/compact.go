@@ -0,0 +1,173 @@
+package codec
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"slices"
+
+	slinkyabci "github.com/zoguxprotocol/slinky/abci/types"
+	vetypes "github.com/zoguxprotocol/slinky/abci/ve/types"
+)
+
+// CompactVoteExtensionCodecV1 is the version byte of the vote extensions encoded by the
+// CompactVoteExtensionCodec. It is not a valid first byte of a protobuf encoded vote
+// extension, so that a vote extension encoded by another codec fails to decode instead
+// of being misinterpreted.
+const CompactVoteExtensionCodecV1 byte = 0x01
+
+// CompactVoteExtensionCodec is a VoteExtensionCodec that packs the prices of a vote extension
+// instead of encoding the map of prices as protobuf. It is meant to be used with the
+// CompactCurrencyPairStrategy, which encodes prices as varints. A vote extension is encoded as:
+//
+//  1. the version byte (CompactVoteExtensionCodecV1).
+//  2. the smallest currency pair ID, and the span of the IDs (largest - smallest + 1), as uvarints.
+//  3. a bitmap of span bits, where bit i is set if the vote extension has a price for the ID
+//     smallest + i.
+//  4. the prices, ordered by ID, each as a varint without length prefix.
+//  5. the length of the oracle signature, as a uvarint, followed by the signature.
+//
+// Empty byte arrays are decoded as empty vote extensions.
+type CompactVoteExtensionCodec struct{}
+
+// NewCompactVoteExtensionCodec returns a new CompactVoteExtensionCodec.
+func NewCompactVoteExtensionCodec() *CompactVoteExtensionCodec {
+	return &CompactVoteExtensionCodec{}
+}
+
+// Encode encodes the vote extension. It returns an error if any of the prices is not a single
+// canonical varint.
+func (codec *CompactVoteExtensionCodec) Encode(ve vetypes.OracleVoteExtension) ([]byte, error) {
+	ids := make([]uint64, 0, len(ve.Prices))
+	size := 0
+	for id, price := range ve.Prices {
+		if n, err := readVarint(price); err != nil || n != len(price) {
+			return nil, fmt.Errorf("price for id %d is not a varint", id)
+		}
+
+		ids = append(ids, id)
+		size += len(price)
+	}
+	slices.Sort(ids)
+
+	var base, span uint64
+	if len(ids) > 0 {
+		base = ids[0]
+		span = ids[len(ids)-1] - base + 1
+	}
+
+	if span > math.MaxInt/8 {
+		return nil, fmt.Errorf("span of currency pair ids is too large: %d", span)
+	}
+
+	bitmap := make([]byte, (span+7)/8)
+	for _, id := range ids {
+		offset := id - base
+		bitmap[offset/8] |= 1 << (offset % 8)
+	}
+
+	bz := make([]byte, 0, 1+2*binary.MaxVarintLen64+len(bitmap)+size+binary.MaxVarintLen64+len(ve.OracleSignature))
+	bz = append(bz, CompactVoteExtensionCodecV1)
+	bz = binary.AppendUvarint(bz, base)
+	bz = binary.AppendUvarint(bz, span)
+	bz = append(bz, bitmap...)
+	for _, id := range ids {
+		bz = append(bz, ve.Prices[id]...)
+	}
+
+	bz = binary.AppendUvarint(bz, uint64(len(ve.OracleSignature)))
+	return append(bz, ve.OracleSignature...), nil
+}
+
+// Decode decodes the vote extension. It returns an error if the version is not supported, or if
+// the encoding is malformed or has trailing bytes.
+func (codec *CompactVoteExtensionCodec) Decode(bz []byte) (vetypes.OracleVoteExtension, error) {
+	if len(bz) == 0 {
+		return vetypes.OracleVoteExtension{}, nil
+	}
+
+	if bz[0] != CompactVoteExtensionCodecV1 {
+		return vetypes.OracleVoteExtension{}, fmt.Errorf("unsupported compact vote extension version: %d", bz[0])
+	}
+	bz = bz[1:]
+
+	base, n := binary.Uvarint(bz)
+	if n <= 0 {
+		return vetypes.OracleVoteExtension{}, fmt.Errorf("invalid currency pair id base")
+	}
+	bz = bz[n:]
+
+	span, n := binary.Uvarint(bz)
+	if n <= 0 {
+		return vetypes.OracleVoteExtension{}, fmt.Errorf("invalid currency pair id span")
+	}
+	bz = bz[n:]
+
+	// an empty span encodes no prices, so the base must be zero for the encoding to be canonical
+	if span == 0 && base != 0 {
+		return vetypes.OracleVoteExtension{}, fmt.Errorf("invalid currency pair id base %d for an empty span", base)
+	}
+	if span > uint64(len(bz))*8 || (span > 0 && base > math.MaxUint64-(span-1)) {
+		return vetypes.OracleVoteExtension{}, fmt.Errorf("invalid currency pair id span: %d", span)
+	}
+
+	bitmapLen := int((span + 7) / 8)
+	bitmap := bz[:bitmapLen]
+	bz = bz[bitmapLen:]
+
+	// the bits beyond the span must not be set, and the last id of the span must be present
+	if span%8 != 0 && bitmap[bitmapLen-1]>>(span%8) != 0 {
+		return vetypes.OracleVoteExtension{}, fmt.Errorf("invalid bitmap: bits set beyond the span")
+	}
+	if span > 0 && (bitmap[0]&1 == 0 || bitmap[(span-1)/8]&(1<<((span-1)%8)) == 0) {
+		return vetypes.OracleVoteExtension{}, fmt.Errorf("invalid bitmap: span is not minimal")
+	}
+
+	var ve vetypes.OracleVoteExtension
+	for offset := uint64(0); offset < span; offset++ {
+		if bitmap[offset/8]&(1<<(offset%8)) == 0 {
+			continue
+		}
+
+		n, err := readVarint(bz)
+		if err != nil {
+			return vetypes.OracleVoteExtension{}, fmt.Errorf("invalid price for id %d: %w", base+offset, err)
+		}
+
+		if ve.Prices == nil {
+			ve.Prices = make(map[uint64][]byte)
+		}
+		ve.Prices[base+offset] = slices.Clone(bz[:n])
+		bz = bz[n:]
+	}
+
+	sigLen, n := binary.Uvarint(bz)
+	if n <= 0 || sigLen != uint64(len(bz)-n) {
+		return vetypes.OracleVoteExtension{}, fmt.Errorf("invalid oracle signature")
+	}
+
+	if sigLen > 0 {
+		ve.OracleSignature = slices.Clone(bz[n:])
+	}
+
+	return ve, nil
+}
+
+// readVarint returns the length of the canonical varint at the start of the given byte array. Varints
+// longer than the maximum price size are rejected.
+func readVarint(bz []byte) (int, error) {
+	for i := 0; i < len(bz) && i < slinkyabci.MaximumPriceSize; i++ {
+		if bz[i]&0x80 != 0 {
+			continue
+		}
+
+		// a varint with a trailing zero group is not canonical
+		if i > 0 && bz[i] == 0 {
+			return 0, fmt.Errorf("varint is not canonical")
+		}
+
+		return i + 1, nil
+	}
+
+	return 0, fmt.Errorf("varint is truncated or too long")
+}
@@ -0,0 +1,106 @@
+package codec_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	compression "github.com/zoguxprotocol/slinky/abci/strategies/codec"
+	"github.com/zoguxprotocol/slinky/abci/strategies/currencypair"
+	vetypes "github.com/zoguxprotocol/slinky/abci/ve/types"
+)
+
+func TestCompactVoteExtensionCodec(t *testing.T) {
+	codec := compression.NewCompactVoteExtensionCodec()
+
+	t.Run("test encoding / decoding", func(t *testing.T) {
+		ve := vetypes.OracleVoteExtension{
+			Prices: map[uint64][]byte{
+				3:  currencypair.EncodeVarint(big.NewInt(100)),
+				4:  currencypair.EncodeVarint(big.NewInt(1_000_000_000)),
+				12: currencypair.EncodeVarint(big.NewInt(0)),
+			},
+			OracleSignature: []byte("signature"),
+		}
+
+		bz, err := codec.Encode(ve)
+		require.NoError(t, err)
+		require.Equal(t, compression.CompactVoteExtensionCodecV1, bz[0])
+
+		decoded, err := codec.Decode(bz)
+		require.NoError(t, err)
+		require.Equal(t, ve, decoded)
+	})
+
+	t.Run("test encoding / decoding an empty vote extension", func(t *testing.T) {
+		bz, err := codec.Encode(vetypes.OracleVoteExtension{})
+		require.NoError(t, err)
+
+		decoded, err := codec.Decode(bz)
+		require.NoError(t, err)
+		require.Empty(t, decoded.Prices)
+		require.Empty(t, decoded.OracleSignature)
+	})
+
+	t.Run("test decoding empty byte array", func(t *testing.T) {
+		_, err := codec.Decode([]byte{})
+		require.NoError(t, err)
+	})
+
+	t.Run("test encoding prices that are not varints", func(t *testing.T) {
+		price, err := big.NewInt(100).GobEncode()
+		require.NoError(t, err)
+
+		_, err = codec.Encode(vetypes.OracleVoteExtension{Prices: map[uint64][]byte{0: price}})
+		require.Error(t, err)
+	})
+
+	t.Run("test encoding is smaller than the default codec", func(t *testing.T) {
+		compact := vetypes.OracleVoteExtension{Prices: make(map[uint64][]byte)}
+		gob := vetypes.OracleVoteExtension{Prices: make(map[uint64][]byte)}
+		for id := uint64(0); id < 1000; id++ {
+			price := big.NewInt(int64(6_000_000_000_000 + id))
+			compact.Prices[id] = currencypair.EncodeVarint(price)
+
+			bz, err := price.GobEncode()
+			require.NoError(t, err)
+			gob.Prices[id] = bz
+		}
+
+		compactBz, err := codec.Encode(compact)
+		require.NoError(t, err)
+
+		gobBz, err := compression.NewDefaultVoteExtensionCodec().Encode(gob)
+		require.NoError(t, err)
+
+		require.Less(t, len(compactBz), len(gobBz)*2/3)
+	})
+
+	t.Run("test decoding vote extensions encoded by the default codec", func(t *testing.T) {
+		bz, err := compression.NewDefaultVoteExtensionCodec().Encode(vetypes.OracleVoteExtension{
+			Prices: map[uint64][]byte{0: currencypair.EncodeVarint(big.NewInt(100))},
+		})
+		require.NoError(t, err)
+
+		_, err = codec.Decode(bz)
+		require.Error(t, err)
+	})
+
+	t.Run("test decoding malformed vote extensions", func(t *testing.T) {
+		v1 := compression.CompactVoteExtensionCodecV1
+		for _, bz := range [][]byte{
+			{0x02, 0x00, 0x00, 0x00},                 // unsupported version
+			{v1, 0x05, 0x00},                         // non-zero base with an empty span
+			{v1, 0x00, 0x09, 0xff, 0x01},             // span larger than the bitmap
+			{v1, 0x00, 0x02, 0x01, 0x01, 0x00},       // last id of the span not present
+			{v1, 0x00, 0x01, 0x03, 0x01, 0x00},       // bits set beyond the span
+			{v1, 0x00, 0x01, 0x01, 0x80},             // truncated price
+			{v1, 0x00, 0x01, 0x01, 0x01, 0x02},       // truncated signature
+			{v1, 0x00, 0x01, 0x01, 0x01, 0x00, 0x00}, // trailing bytes
+		} {
+			_, err := codec.Decode(bz)
+			require.Error(t, err, "%X", bz)
+		}
+	})
+}
@@ -0,0 +1,55 @@
+package codec
+
+import (
+	"fmt"
+
+	vetypes "github.com/zoguxprotocol/slinky/abci/ve/types"
+)
+
+// VersionedVoteExtensionCodec is a VoteExtensionCodec that prefixes the vote extensions encoded by an
+// underlying codec with a version byte. Vote extensions with a different version byte fail to decode, so
+// that the vote extensions of validators running a different codec version are rejected instead of being
+// misinterpreted. Empty byte arrays are decoded as empty vote extensions.
+type VersionedVoteExtensionCodec struct {
+	version byte
+	codec   VoteExtensionCodec
+}
+
+// NewVersionedVoteExtensionCodec returns a new VersionedVoteExtensionCodec given a version byte and an
+// underlying codec.
+func NewVersionedVoteExtensionCodec(version byte, codec VoteExtensionCodec) *VersionedVoteExtensionCodec {
+	return &VersionedVoteExtensionCodec{
+		version: version,
+		codec:   codec,
+	}
+}
+
+// Version returns the version byte of the codec.
+func (codec *VersionedVoteExtensionCodec) Version() byte {
+	return codec.version
+}
+
+// Encode returns the vote extension encoded by the underlying codec, prefixed with the version byte.
+func (codec *VersionedVoteExtensionCodec) Encode(ve vetypes.OracleVoteExtension) ([]byte, error) {
+	bz, err := codec.codec.Encode(ve)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{codec.version}, bz...), nil
+}
+
+// Decode checks the version byte of the vote extension and then decodes the rest using the underlying codec.
+func (codec *VersionedVoteExtensionCodec) Decode(bz []byte) (vetypes.OracleVoteExtension, error) {
+	if len(bz) == 0 {
+		return vetypes.OracleVoteExtension{}, nil
+	}
+
+	if bz[0] != codec.version {
+		return vetypes.OracleVoteExtension{}, fmt.Errorf(
+			"unexpected vote extension version: expected %d, got %d", codec.version, bz[0],
+		)
+	}
+
+	return codec.codec.Decode(bz[1:])
+}
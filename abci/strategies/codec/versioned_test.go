@@ -0,0 +1,54 @@
+package codec_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	compression "github.com/zoguxprotocol/slinky/abci/strategies/codec"
+	vetypes "github.com/zoguxprotocol/slinky/abci/ve/types"
+)
+
+func TestVersionedVoteExtensionCodec(t *testing.T) {
+	ve := vetypes.OracleVoteExtension{
+		Prices: map[uint64][]byte{
+			1: []byte("1"),
+			2: []byte("2"),
+		},
+	}
+
+	v1 := compression.NewVersionedVoteExtensionCodec(1, compression.NewDefaultVoteExtensionCodec())
+	v2 := compression.NewVersionedVoteExtensionCodec(2, compression.NewDefaultVoteExtensionCodec())
+
+	t.Run("test encoding / decoding", func(t *testing.T) {
+		bz, err := v1.Encode(ve)
+		require.NoError(t, err)
+		require.Equal(t, byte(1), bz[0])
+
+		decodedVe, err := v1.Decode(bz)
+		require.NoError(t, err)
+		require.Equal(t, ve.Prices, decodedVe.Prices)
+	})
+
+	t.Run("test decoding empty byte array", func(t *testing.T) {
+		decodedVe, err := v1.Decode([]byte{})
+		require.NoError(t, err)
+		require.Empty(t, decodedVe.Prices)
+	})
+
+	t.Run("test decoding another version fails", func(t *testing.T) {
+		bz, err := v2.Encode(ve)
+		require.NoError(t, err)
+
+		_, err = v1.Decode(bz)
+		require.Error(t, err)
+	})
+
+	t.Run("test decoding an unversioned vote extension fails", func(t *testing.T) {
+		bz, err := compression.NewDefaultVoteExtensionCodec().Encode(ve)
+		require.NoError(t, err)
+
+		_, err = v1.Decode(bz)
+		require.Error(t, err)
+	})
+}
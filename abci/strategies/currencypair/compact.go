@@ -0,0 +1,96 @@
+package currencypair
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
+)
+
+// CompactCurrencyPairStrategy is a strategy that inherits from the DefaultCurrencyPairStrategy but
+// encodes/decodes the raw price as an unsigned varint (LEB128) instead of a gob encoded big.Int. Prices
+// are fixed-point integers, so that most of them fit in a few bytes. It is meant to be used with the
+// CompactVoteExtensionCodec, which packs the varints without length prefixes.
+type CompactCurrencyPairStrategy struct {
+	*DefaultCurrencyPairStrategy
+}
+
+// NewCompactCurrencyPairStrategy returns a new CompactCurrencyPairStrategy instance.
+func NewCompactCurrencyPairStrategy(oracleKeeper OracleKeeper) *CompactCurrencyPairStrategy {
+	return &CompactCurrencyPairStrategy{
+		DefaultCurrencyPairStrategy: NewDefaultCurrencyPairStrategy(oracleKeeper),
+	}
+}
+
+// GetEncodedPrice returns the price encoded as an unsigned varint. This method returns an error if the
+// price is negative.
+func (s *CompactCurrencyPairStrategy) GetEncodedPrice(
+	_ sdk.Context,
+	_ slinkytypes.CurrencyPair,
+	price *big.Int,
+) ([]byte, error) {
+	if price.Sign() < 0 {
+		return nil, fmt.Errorf("price cannot be negative: %s", price.String())
+	}
+
+	return EncodeVarint(price), nil
+}
+
+// GetDecodedPrice returns the price decoded from an unsigned varint. This method returns an error if the
+// bytes are not a single canonical varint.
+func (s *CompactCurrencyPairStrategy) GetDecodedPrice(
+	_ sdk.Context,
+	_ slinkytypes.CurrencyPair,
+	priceBytes []byte,
+) (*big.Int, error) {
+	return DecodeVarint(priceBytes)
+}
+
+// EncodeVarint encodes a non-negative integer as an unsigned varint (LEB128), i.e. in groups of 7 bits,
+// least significant group first, where the most significant bit of each byte is set if more bytes follow.
+func EncodeVarint(value *big.Int) []byte {
+	if value.IsUint64() {
+		return binary.AppendUvarint(nil, value.Uint64())
+	}
+
+	bz := make([]byte, 0, (value.BitLen()+6)/7)
+	rest := new(big.Int).Set(value)
+	group := new(big.Int)
+	for rest.BitLen() > 7 {
+		group.And(rest, big.NewInt(0x7f))
+		bz = append(bz, byte(group.Uint64())|0x80)
+		rest.Rsh(rest, 7)
+	}
+
+	return append(bz, byte(rest.Uint64()))
+}
+
+// DecodeVarint decodes an unsigned varint (LEB128). This method returns an error if the bytes are not
+// exactly one canonical varint, i.e. if they are truncated, have trailing bytes, or a trailing zero group.
+func DecodeVarint(bz []byte) (*big.Int, error) {
+	if len(bz) == 0 {
+		return nil, fmt.Errorf("empty varint")
+	}
+
+	for i, b := range bz {
+		last := i == len(bz)-1
+		if (b&0x80 == 0) != last {
+			return nil, fmt.Errorf("invalid varint: %X", bz)
+		}
+	}
+
+	if len(bz) > 1 && bz[len(bz)-1] == 0 {
+		return nil, fmt.Errorf("varint is not canonical: %X", bz)
+	}
+
+	value := new(big.Int)
+	for i := len(bz) - 1; i >= 0; i-- {
+		value.Lsh(value, 7)
+		value.Or(value, big.NewInt(int64(bz[i]&0x7f)))
+	}
+
+	return value, nil
+}
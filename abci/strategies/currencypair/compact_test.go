@@ -0,0 +1,74 @@
+package currencypair_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	strategies "github.com/zoguxprotocol/slinky/abci/strategies/currencypair"
+	"github.com/zoguxprotocol/slinky/abci/strategies/currencypair/mocks"
+)
+
+func TestCompactCurrencyPairStrategyEncodeDecode(t *testing.T) {
+	strategy := strategies.NewCompactCurrencyPairStrategy(mocks.NewOracleKeeper(t))
+	ctx := sdk.Context{}
+
+	large, ok := new(big.Int).SetString("100000000000000000000000", 10)
+	require.True(t, ok)
+
+	tcs := []struct {
+		name  string
+		price *big.Int
+		size  int
+	}{
+		{"zero", big.NewInt(0), 1},
+		{"single byte", big.NewInt(127), 1},
+		{"two bytes", big.NewInt(128), 2},
+		{"uint64", new(big.Int).SetUint64(^uint64(0)), 10},
+		{"larger than uint64", large, 11},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := strategy.GetEncodedPrice(ctx, btcusd, tc.price)
+			require.NoError(t, err)
+			require.Len(t, bz, tc.size)
+
+			price, err := strategy.GetDecodedPrice(ctx, btcusd, bz)
+			require.NoError(t, err)
+			require.Equal(t, 0, tc.price.Cmp(price))
+		})
+	}
+
+	t.Run("negative prices cannot be encoded", func(t *testing.T) {
+		_, err := strategy.GetEncodedPrice(ctx, btcusd, big.NewInt(-1))
+		require.Error(t, err)
+	})
+}
+
+func TestDecodeVarint(t *testing.T) {
+	tcs := []struct {
+		name       string
+		bz         []byte
+		expectPass bool
+	}{
+		{"empty - fail", nil, false},
+		{"truncated - fail", []byte{0x80}, false},
+		{"trailing bytes - fail", []byte{0x01, 0x01}, false},
+		{"trailing zero group - fail", []byte{0x80, 0x00}, false},
+		{"canonical - pass", []byte{0x80, 0x01}, true},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := strategies.DecodeVarint(tc.bz)
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
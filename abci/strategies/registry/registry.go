@@ -0,0 +1,121 @@
+package registry
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/zoguxprotocol/slinky/abci/strategies/codec"
+	"github.com/zoguxprotocol/slinky/abci/strategies/currencypair"
+)
+
+// UnversionedVersion is the version of the vote extensions that are encoded without a version byte,
+// i.e. the encoding used by chains before they register any other version.
+const UnversionedVersion byte = 0
+
+// Version is a version of the codecs and the currency pair strategy used for vote extensions, which is
+// active from its activation height until the activation height of the next version.
+type Version struct {
+	// Height is the height from which on the version is active. The vote extensions created at
+	// this height, and the extended commits proposed at this height, are encoded with the version.
+	Height int64
+
+	// Version is the version byte prefixed to the encoded vote extensions. The vote extensions
+	// of UnversionedVersion are not prefixed.
+	Version byte
+
+	// VoteExtensionCodec is the codec of the vote extensions. For versions other than the
+	// UnversionedVersion, the registry wraps it in a VersionedVoteExtensionCodec.
+	VoteExtensionCodec codec.VoteExtensionCodec
+
+	// ExtendedCommitCodec is the codec of the extended commits.
+	ExtendedCommitCodec codec.ExtendedCommitCodec
+
+	// CurrencyPairStrategy is the strategy used to encode the prices of the vote extensions.
+	CurrencyPairStrategy currencypair.CurrencyPairStrategy
+}
+
+// Registry is a height-indexed registry of the versions of the codecs and currency pair strategy used for
+// vote extensions. The vote extension, proposal and pre-block handlers consult the registry by block height,
+// so that the codecs and the strategy can be switched at an upgrade height without coordinating a binary
+// switch. Note that the vote extensions created at a height are decoded at the next height, with the
+// version active at the height they were created.
+type Registry struct {
+	// versions are ordered by activation height.
+	versions []Version
+}
+
+// NewRegistry returns a new Registry given the versions. The versions must have distinct activation heights
+// and version bytes, and one of them must be active from height 0 on.
+func NewRegistry(versions ...Version) (*Registry, error) {
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("at least one version must be registered")
+	}
+
+	versions = append([]Version(nil), versions...)
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].Height < versions[j].Height
+	})
+
+	if versions[0].Height != 0 {
+		return nil, fmt.Errorf("the first version must be active from height 0, got %d", versions[0].Height)
+	}
+
+	seen := make(map[byte]struct{}, len(versions))
+	for i, v := range versions {
+		if i > 0 && v.Height == versions[i-1].Height {
+			return nil, fmt.Errorf("duplicate activation height %d", v.Height)
+		}
+
+		if _, ok := seen[v.Version]; ok {
+			return nil, fmt.Errorf("duplicate version %d", v.Version)
+		}
+		seen[v.Version] = struct{}{}
+
+		if v.VoteExtensionCodec == nil || v.ExtendedCommitCodec == nil || v.CurrencyPairStrategy == nil {
+			return nil, fmt.Errorf("version %d must have a vote extension codec, an extended commit codec and a currency pair strategy", v.Version)
+		}
+
+		if v.Version != UnversionedVersion {
+			versions[i].VoteExtensionCodec = codec.NewVersionedVoteExtensionCodec(v.Version, v.VoteExtensionCodec)
+		}
+	}
+
+	return &Registry{versions: versions}, nil
+}
+
+// NewStaticRegistry returns a Registry with a single, unversioned, version given the codecs and the
+// currency pair strategy. This is equivalent to configuring the handlers with fixed codecs.
+func NewStaticRegistry(
+	veCodec codec.VoteExtensionCodec,
+	ecCodec codec.ExtendedCommitCodec,
+	strategy currencypair.CurrencyPairStrategy,
+) *Registry {
+	return &Registry{
+		versions: []Version{
+			{
+				Height:               0,
+				Version:              UnversionedVersion,
+				VoteExtensionCodec:   veCodec,
+				ExtendedCommitCodec:  ecCodec,
+				CurrencyPairStrategy: strategy,
+			},
+		},
+	}
+}
+
+// VersionAt returns the version active at the given height. Negative heights are treated as height 0.
+func (r *Registry) VersionAt(height int64) Version {
+	i := sort.Search(len(r.versions), func(i int) bool {
+		return r.versions[i].Height > height
+	})
+	if i == 0 {
+		return r.versions[0]
+	}
+
+	return r.versions[i-1]
+}
+
+// Versions returns the registered versions, ordered by activation height.
+func (r *Registry) Versions() []Version {
+	return append([]Version(nil), r.versions...)
+}
@@ -0,0 +1,164 @@
+package registry_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zoguxprotocol/slinky/abci/strategies/codec"
+	"github.com/zoguxprotocol/slinky/abci/strategies/currencypair"
+	"github.com/zoguxprotocol/slinky/abci/strategies/registry"
+	vetypes "github.com/zoguxprotocol/slinky/abci/ve/types"
+)
+
+func newVersion(height int64, version byte, veCodec codec.VoteExtensionCodec) registry.Version {
+	return registry.Version{
+		Height:               height,
+		Version:              version,
+		VoteExtensionCodec:   veCodec,
+		ExtendedCommitCodec:  codec.NewDefaultExtendedCommitCodec(),
+		CurrencyPairStrategy: currencypair.NewDefaultCurrencyPairStrategy(nil),
+	}
+}
+
+func TestNewRegistry(t *testing.T) {
+	cases := []struct {
+		name     string
+		versions []registry.Version
+		valid    bool
+	}{
+		{
+			name:  "no versions",
+			valid: false,
+		},
+		{
+			name: "single version from height 0",
+			versions: []registry.Version{
+				newVersion(0, registry.UnversionedVersion, codec.NewDefaultVoteExtensionCodec()),
+			},
+			valid: true,
+		},
+		{
+			name: "unordered versions",
+			versions: []registry.Version{
+				newVersion(100, 1, codec.NewCompactVoteExtensionCodec()),
+				newVersion(0, registry.UnversionedVersion, codec.NewDefaultVoteExtensionCodec()),
+			},
+			valid: true,
+		},
+		{
+			name: "first version is not active from height 0",
+			versions: []registry.Version{
+				newVersion(1, registry.UnversionedVersion, codec.NewDefaultVoteExtensionCodec()),
+			},
+			valid: false,
+		},
+		{
+			name: "duplicate activation heights",
+			versions: []registry.Version{
+				newVersion(0, registry.UnversionedVersion, codec.NewDefaultVoteExtensionCodec()),
+				newVersion(0, 1, codec.NewDefaultVoteExtensionCodec()),
+			},
+			valid: false,
+		},
+		{
+			name: "duplicate versions",
+			versions: []registry.Version{
+				newVersion(0, 1, codec.NewDefaultVoteExtensionCodec()),
+				newVersion(100, 1, codec.NewCompactVoteExtensionCodec()),
+			},
+			valid: false,
+		},
+		{
+			name: "missing codec",
+			versions: []registry.Version{
+				newVersion(0, registry.UnversionedVersion, nil),
+			},
+			valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := registry.NewRegistry(tc.versions...)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestRegistryVersionAt(t *testing.T) {
+	versions, err := registry.NewRegistry(
+		newVersion(200, 2, codec.NewCompactVoteExtensionCodec()),
+		newVersion(0, registry.UnversionedVersion, codec.NewDefaultVoteExtensionCodec()),
+		newVersion(100, 1, codec.NewDefaultVoteExtensionCodec()),
+	)
+	require.NoError(t, err)
+
+	cases := []struct {
+		height  int64
+		version byte
+	}{
+		{height: -1, version: registry.UnversionedVersion},
+		{height: 0, version: registry.UnversionedVersion},
+		{height: 99, version: registry.UnversionedVersion},
+		{height: 100, version: 1},
+		{height: 199, version: 1},
+		{height: 200, version: 2},
+		{height: 1000, version: 2},
+	}
+
+	for _, tc := range cases {
+		require.Equal(t, tc.version, versions.VersionAt(tc.height).Version, "height %d", tc.height)
+	}
+
+	require.Len(t, versions.Versions(), 3)
+	require.Equal(t, int64(0), versions.Versions()[0].Height)
+}
+
+func TestRegistryVersionByte(t *testing.T) {
+	versions, err := registry.NewRegistry(
+		newVersion(0, registry.UnversionedVersion, codec.NewDefaultVoteExtensionCodec()),
+		newVersion(100, 1, codec.NewDefaultVoteExtensionCodec()),
+	)
+	require.NoError(t, err)
+
+	ve := vetypes.OracleVoteExtension{
+		Prices: map[uint64][]byte{1: []byte("1")},
+	}
+
+	unversioned, err := versions.VersionAt(99).VoteExtensionCodec.Encode(ve)
+	require.NoError(t, err)
+
+	expected, err := codec.NewDefaultVoteExtensionCodec().Encode(ve)
+	require.NoError(t, err)
+	require.Equal(t, expected, unversioned)
+
+	versioned, err := versions.VersionAt(100).VoteExtensionCodec.Encode(ve)
+	require.NoError(t, err)
+	require.Equal(t, append([]byte{1}, expected...), versioned)
+
+	// vote extensions encoded with the version of another height fail to decode
+	_, err = versions.VersionAt(100).VoteExtensionCodec.Decode(unversioned)
+	require.Error(t, err)
+
+	decoded, err := versions.VersionAt(100).VoteExtensionCodec.Decode(versioned)
+	require.NoError(t, err)
+	require.Equal(t, ve.Prices, decoded.Prices)
+}
+
+func TestNewStaticRegistry(t *testing.T) {
+	veCodec := codec.NewDefaultVoteExtensionCodec()
+	versions := registry.NewStaticRegistry(
+		veCodec,
+		codec.NewDefaultExtendedCommitCodec(),
+		currencypair.NewDefaultCurrencyPairStrategy(nil),
+	)
+
+	version := versions.VersionAt(1000)
+	require.Equal(t, registry.UnversionedVersion, version.Version)
+	require.Equal(t, veCodec, version.VoteExtensionCodec)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"context"
+	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc"
@@ -17,10 +18,25 @@ import (
 //
 //go:generate mockery --name OracleKeeper --filename mock_oracle_keeper.go
 type OracleKeeper interface { //golint:ignore
+	ApplyPriceGuardrail(ctx sdk.Context, cp slinkytypes.CurrencyPair, qp oracletypes.QuotePrice) (oracletypes.QuotePrice, bool, error)
 	GetAllCurrencyPairs(ctx sdk.Context) []slinkytypes.CurrencyPair
 	SetPriceForCurrencyPair(ctx sdk.Context, cp slinkytypes.CurrencyPair, qp oracletypes.QuotePrice) error
 }
 
+// ValidatorPerformanceKeeper defines the interface that can optionally be fulfilled by the
+// oracle keeper to track the oracle performance of validators. If the OracleKeeper given to the
+// PreBlock handler implements it, the prices reported by each validator are recorded against the
+// prices committed by every block.
+type ValidatorPerformanceKeeper interface {
+	IsPerformanceTrackingEnabled(ctx sdk.Context) bool
+	GetPriceForCurrencyPair(ctx sdk.Context, cp slinkytypes.CurrencyPair) (oracletypes.QuotePrice, error)
+	TrackValidatorPerformance(
+		ctx sdk.Context,
+		reports []oracletypes.ValidatorReport,
+		previous, committed map[slinkytypes.CurrencyPair]*big.Int,
+	) error
+}
+
 // OracleClient defines the interface that must be fulfilled by the slinky client.
 // This interface is utilized by the vote extension handler to fetch prices.
 type OracleClient interface {
@@ -17,6 +17,41 @@ type OracleKeeper struct {
 	mock.Mock
 }
 
+// ApplyPriceGuardrail provides a mock function with given fields: ctx, cp, qp
+func (_m *OracleKeeper) ApplyPriceGuardrail(ctx types.Context, cp pkgtypes.CurrencyPair, qp oracletypes.QuotePrice) (oracletypes.QuotePrice, bool, error) {
+	ret := _m.Called(ctx, cp, qp)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyPriceGuardrail")
+	}
+
+	var r0 oracletypes.QuotePrice
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(types.Context, pkgtypes.CurrencyPair, oracletypes.QuotePrice) (oracletypes.QuotePrice, bool, error)); ok {
+		return rf(ctx, cp, qp)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, pkgtypes.CurrencyPair, oracletypes.QuotePrice) oracletypes.QuotePrice); ok {
+		r0 = rf(ctx, cp, qp)
+	} else {
+		r0 = ret.Get(0).(oracletypes.QuotePrice)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, pkgtypes.CurrencyPair, oracletypes.QuotePrice) bool); ok {
+		r1 = rf(ctx, cp, qp)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(types.Context, pkgtypes.CurrencyPair, oracletypes.QuotePrice) error); ok {
+		r2 = rf(ctx, cp, qp)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // GetAllCurrencyPairs provides a mock function with given fields: ctx
 func (_m *OracleKeeper) GetAllCurrencyPairs(ctx types.Context) []pkgtypes.CurrencyPair {
 	ret := _m.Called(ctx)
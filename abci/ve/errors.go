@@ -69,3 +69,17 @@ func (e ValidateVoteExtensionError) Error() string {
 func (e ValidateVoteExtensionError) Label() string {
 	return "ValidateVoteExtensionError"
 }
+
+// OracleSignatureError is an error that is returned when a vote extension cannot be signed with the
+// oracle key of the validator.
+type OracleSignatureError struct {
+	Err error
+}
+
+func (e OracleSignatureError) Error() string {
+	return fmt.Sprintf("oracle signature error: %s", e.Err.Error())
+}
+
+func (e OracleSignatureError) Label() string {
+	return "OracleSignatureError"
+}
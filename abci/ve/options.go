@@ -0,0 +1,39 @@
+package ve
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zoguxprotocol/slinky/abci/strategies/registry"
+)
+
+// Option is a function that enables optional configuration of the VoteExtensionHandler.
+type Option func(*VoteExtensionHandler)
+
+// WithOracleKeyStore returns an Option that configures the VoteExtensionHandler to verify the
+// oracle signatures of the vote extensions against the oracle keys registered by the validators.
+func WithOracleKeyStore(keys OracleKeyStore) Option {
+	return func(h *VoteExtensionHandler) {
+		h.oracleKeys = keys
+	}
+}
+
+// WithOracleSigner returns an Option that configures the VoteExtensionHandler to sign the vote
+// extensions of the given validator with its oracle key. The handler signs with the private key
+// matching the oracle key registered by the validator, so that the keys can be rotated by
+// configuring both the current and the next private key. It requires WithOracleKeyStore.
+func WithOracleSigner(validator sdk.ConsAddress, privKeys ...cryptotypes.PrivKey) Option {
+	return func(h *VoteExtensionHandler) {
+		h.validator = validator
+		h.oraclePrivKeys = privKeys
+	}
+}
+
+// WithRegistry returns an Option that configures the VoteExtensionHandler to encode and verify the vote
+// extensions with the codec and the currency pair strategy registered for their height, instead of the
+// ones given to NewVoteExtensionHandler. The price applier of the handler should use the same registry.
+func WithRegistry(versions *registry.Registry) Option {
+	return func(h *VoteExtensionHandler) {
+		h.versions = versions
+	}
+}
@@ -0,0 +1,124 @@
+package ve
+
+import (
+	"fmt"
+	"slices"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	vetypes "github.com/zoguxprotocol/slinky/abci/ve/types"
+)
+
+// OracleKeyStore defines the interface contract required for verifying the oracle signatures
+// of vote extensions. Typically, this will be implemented by the x/oracle module, with which
+// the validators register their oracle keys.
+type OracleKeyStore interface {
+	// GetOracleKeyForHeight returns the public oracle key a validator signs the vote extensions
+	// created at the given height with, or nil if the validator had no oracle key registered at
+	// that height.
+	GetOracleKeyForHeight(ctx sdk.Context, validator sdk.ConsAddress, height int64) (cryptotypes.PubKey, error)
+}
+
+// NewOracleAttestation returns the attestation a validator signs with its oracle key for the
+// prices of a vote extension created at the given height. The prices are ordered by ID so that
+// the attestation is deterministic.
+func NewOracleAttestation(
+	chainID string,
+	height int64,
+	validator sdk.ConsAddress,
+	ve vetypes.OracleVoteExtension,
+) vetypes.OracleAttestation {
+	prices := make([]vetypes.OraclePrice, 0, len(ve.Prices))
+	for id, price := range ve.Prices {
+		prices = append(prices, vetypes.OraclePrice{Id: id, Price: price})
+	}
+
+	slices.SortFunc(prices, func(a, b vetypes.OraclePrice) int {
+		switch {
+		case a.Id < b.Id:
+			return -1
+		case a.Id > b.Id:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	return vetypes.OracleAttestation{
+		ChainId:   chainID,
+		Height:    height,
+		Validator: validator,
+		Prices:    prices,
+	}
+}
+
+// SignOracleVoteExtension signs the prices of a vote extension with the oracle key of the
+// validator, and sets the signature on the vote extension.
+func SignOracleVoteExtension(
+	chainID string,
+	height int64,
+	validator sdk.ConsAddress,
+	privKey cryptotypes.PrivKey,
+	ve *vetypes.OracleVoteExtension,
+) error {
+	attestation := NewOracleAttestation(chainID, height, validator, *ve)
+	bz, err := attestation.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal oracle attestation: %w", err)
+	}
+
+	sig, err := privKey.Sign(bz)
+	if err != nil {
+		return fmt.Errorf("failed to sign oracle attestation: %w", err)
+	}
+
+	ve.OracleSignature = sig
+	return nil
+}
+
+// VerifyOracleAttestation verifies the signature of an attestation by an oracle key. It only
+// depends on the oracle key, so that the attestations can be verified without the consensus
+// keys of the validators (e.g. by light clients).
+func VerifyOracleAttestation(pubKey cryptotypes.PubKey, attestation vetypes.OracleAttestation, sig []byte) error {
+	bz, err := attestation.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal oracle attestation: %w", err)
+	}
+
+	if !pubKey.VerifySignature(bz, sig) {
+		return fmt.Errorf("invalid oracle signature for validator %s at height %d", sdk.ConsAddress(attestation.Validator).String(), attestation.Height)
+	}
+
+	return nil
+}
+
+// VerifyOracleSignature verifies the oracle signature of a vote extension created by a validator at
+// the given height. If the validator had an oracle key registered at that height, the vote extension
+// must be signed by it. Otherwise, the vote extension must not carry an oracle signature.
+func VerifyOracleSignature(
+	ctx sdk.Context,
+	keys OracleKeyStore,
+	validator sdk.ConsAddress,
+	height int64,
+	ve vetypes.OracleVoteExtension,
+) error {
+	pubKey, err := keys.GetOracleKeyForHeight(ctx, validator, height)
+	if err != nil {
+		return fmt.Errorf("failed to get oracle key of validator %s: %w", validator.String(), err)
+	}
+
+	if pubKey == nil {
+		if len(ve.OracleSignature) != 0 {
+			return fmt.Errorf("oracle signature present but no oracle key registered; validator %s", validator.String())
+		}
+
+		return nil
+	}
+
+	if len(ve.OracleSignature) == 0 {
+		return fmt.Errorf("oracle signature is missing; validator %s", validator.String())
+	}
+
+	return VerifyOracleAttestation(pubKey, NewOracleAttestation(ctx.ChainID(), height, validator, ve), ve.OracleSignature)
+}
@@ -0,0 +1,225 @@
+package ve_test
+
+import (
+	"time"
+
+	"cosmossdk.io/log"
+	cometabci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+
+	aggregatormocks "github.com/zoguxprotocol/slinky/abci/strategies/aggregator/mocks"
+	"github.com/zoguxprotocol/slinky/abci/strategies/codec"
+	mockstrategies "github.com/zoguxprotocol/slinky/abci/strategies/currencypair/mocks"
+	"github.com/zoguxprotocol/slinky/abci/ve"
+	abcitypes "github.com/zoguxprotocol/slinky/abci/ve/types"
+	"github.com/zoguxprotocol/slinky/service/clients/oracle/mocks"
+	servicemetrics "github.com/zoguxprotocol/slinky/service/metrics"
+	servicetypes "github.com/zoguxprotocol/slinky/service/servers/oracle/types"
+)
+
+var oracleKeyValidator = sdk.ConsAddress("validator")
+
+// staticOracleKeyStore returns the same oracle key of each validator for all heights.
+type staticOracleKeyStore map[string]cryptotypes.PubKey
+
+func (s staticOracleKeyStore) GetOracleKeyForHeight(_ sdk.Context, validator sdk.ConsAddress, _ int64) (cryptotypes.PubKey, error) {
+	return s[validator.String()], nil
+}
+
+func (s *VoteExtensionTestSuite) TestVerifyOracleSignature() {
+	privKey := ed25519.GenPrivKey()
+	otherPrivKey := ed25519.GenPrivKey()
+	ctx := s.ctx.WithChainID("test-chain")
+
+	prices := map[uint64][]byte{
+		0: oneHundred.Bytes(),
+		1: twoHundred.Bytes(),
+	}
+	signed := func(privKey cryptotypes.PrivKey, chainID string, height int64) abcitypes.OracleVoteExtension {
+		voteExt := abcitypes.OracleVoteExtension{Prices: prices}
+		s.Require().NoError(ve.SignOracleVoteExtension(chainID, height, oracleKeyValidator, privKey, &voteExt))
+		return voteExt
+	}
+
+	registered := staticOracleKeyStore{oracleKeyValidator.String(): privKey.PubKey()}
+
+	cases := []struct {
+		name          string
+		keys          staticOracleKeyStore
+		voteExt       abcitypes.OracleVoteExtension
+		expectedError bool
+	}{
+		{
+			name:    "no oracle key registered and no signature",
+			keys:    staticOracleKeyStore{},
+			voteExt: abcitypes.OracleVoteExtension{Prices: prices},
+		},
+		{
+			name:          "no oracle key registered but a signature",
+			keys:          staticOracleKeyStore{},
+			voteExt:       signed(privKey, "test-chain", 10),
+			expectedError: true,
+		},
+		{
+			name:          "oracle key registered but no signature",
+			keys:          registered,
+			voteExt:       abcitypes.OracleVoteExtension{Prices: prices},
+			expectedError: true,
+		},
+		{
+			name:    "valid signature",
+			keys:    registered,
+			voteExt: signed(privKey, "test-chain", 10),
+		},
+		{
+			name:          "signature by another key",
+			keys:          registered,
+			voteExt:       signed(otherPrivKey, "test-chain", 10),
+			expectedError: true,
+		},
+		{
+			name:          "signature for another height",
+			keys:          registered,
+			voteExt:       signed(privKey, "test-chain", 9),
+			expectedError: true,
+		},
+		{
+			name:          "signature for another chain",
+			keys:          registered,
+			voteExt:       signed(privKey, "other-chain", 10),
+			expectedError: true,
+		},
+		{
+			name: "tampered prices",
+			keys: registered,
+			voteExt: func() abcitypes.OracleVoteExtension {
+				voteExt := signed(privKey, "test-chain", 10)
+				voteExt.Prices = map[uint64][]byte{0: twoHundred.Bytes(), 1: twoHundred.Bytes()}
+				return voteExt
+			}(),
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			err := ve.VerifyOracleSignature(ctx, tc.keys, oracleKeyValidator, 10, tc.voteExt)
+			if tc.expectedError {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	s.Run("attestations are verified with the oracle key alone", func() {
+		voteExt := signed(privKey, "test-chain", 10)
+		attestation := ve.NewOracleAttestation("test-chain", 10, oracleKeyValidator, voteExt)
+
+		s.Require().Equal([]abcitypes.OraclePrice{
+			{Id: 0, Price: oneHundred.Bytes()},
+			{Id: 1, Price: twoHundred.Bytes()},
+		}, attestation.Prices)
+		s.Require().NoError(ve.VerifyOracleAttestation(privKey.PubKey(), attestation, voteExt.OracleSignature))
+		s.Require().Error(ve.VerifyOracleAttestation(otherPrivKey.PubKey(), attestation, voteExt.OracleSignature))
+	})
+}
+
+func (s *VoteExtensionTestSuite) TestExtendVoteWithOracleSigner() {
+	currentKey := ed25519.GenPrivKey()
+	nextKey := ed25519.GenPrivKey()
+	ctx := s.ctx.WithChainID("test-chain")
+
+	cdc := codec.NewCompressionVoteExtensionCodec(
+		codec.NewDefaultVoteExtensionCodec(),
+		codec.NewZLibCompressor(),
+	)
+
+	newHandler := func(keys staticOracleKeyStore) *ve.VoteExtensionHandler {
+		oracleClient := mocks.NewOracleClient(s.T())
+		oracleClient.On("Prices", mock.Anything, mock.Anything).Return(
+			&servicetypes.QueryPricesResponse{Prices: singlePrice},
+			nil,
+		).Maybe()
+
+		cps := mockstrategies.NewCurrencyPairStrategy(s.T())
+		cps.On("ID", mock.Anything, btcUSD).Return(uint64(0), nil).Maybe()
+		cps.On("GetEncodedPrice", mock.Anything, btcUSD, oneHundred).Return(oneHundred.Bytes(), nil).Maybe()
+		cps.On("GetMaxNumCP", mock.Anything).Return(uint64(1), nil).Maybe()
+
+		priceApplier := aggregatormocks.NewPriceApplier(s.T())
+		priceApplier.On("ApplyPricesFromVoteExtensions", mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+
+		return ve.NewVoteExtensionHandler(
+			log.NewTestLogger(s.T()),
+			oracleClient,
+			time.Second,
+			cps,
+			cdc,
+			priceApplier,
+			servicemetrics.NewNopMetrics(),
+			ve.WithOracleKeyStore(keys),
+			ve.WithOracleSigner(oracleKeyValidator, currentKey, nextKey),
+		)
+	}
+
+	for _, privKey := range []cryptotypes.PrivKey{currentKey, nextKey} {
+		s.Run("vote extension is signed with the registered key", func() {
+			keys := staticOracleKeyStore{oracleKeyValidator.String(): privKey.PubKey()}
+			h := newHandler(keys)
+
+			resp, err := h.ExtendVoteHandler()(ctx, &cometabci.RequestExtendVote{Height: 10})
+			s.Require().NoError(err)
+			s.Require().NotEmpty(resp.VoteExtension)
+
+			voteExt, err := cdc.Decode(resp.VoteExtension)
+			s.Require().NoError(err)
+			s.Require().NoError(ve.VerifyOracleSignature(ctx, keys, oracleKeyValidator, 10, voteExt))
+
+			verifyResp, err := h.VerifyVoteExtensionHandler()(ctx, &cometabci.RequestVerifyVoteExtension{
+				ValidatorAddress: oracleKeyValidator,
+				Height:           10,
+				VoteExtension:    resp.VoteExtension,
+			})
+			s.Require().NoError(err)
+			s.Require().Equal(cometabci.ResponseVerifyVoteExtension_ACCEPT, verifyResp.Status)
+		})
+	}
+
+	s.Run("vote extension is not signed without a registered key", func() {
+		h := newHandler(staticOracleKeyStore{})
+
+		resp, err := h.ExtendVoteHandler()(ctx, &cometabci.RequestExtendVote{Height: 10})
+		s.Require().NoError(err)
+
+		voteExt, err := cdc.Decode(resp.VoteExtension)
+		s.Require().NoError(err)
+		s.Require().Empty(voteExt.OracleSignature)
+	})
+
+	s.Run("empty vote extension if no private key matches the registered key", func() {
+		h := newHandler(staticOracleKeyStore{oracleKeyValidator.String(): ed25519.GenPrivKey().PubKey()})
+
+		resp, err := h.ExtendVoteHandler()(ctx, &cometabci.RequestExtendVote{Height: 10})
+		s.Require().NoError(err)
+		s.Require().Empty(resp.VoteExtension)
+	})
+
+	s.Run("unsigned vote extension is rejected if a key is registered", func() {
+		unsigned := newHandler(staticOracleKeyStore{})
+		resp, err := unsigned.ExtendVoteHandler()(ctx, &cometabci.RequestExtendVote{Height: 10})
+		s.Require().NoError(err)
+
+		h := newHandler(staticOracleKeyStore{oracleKeyValidator.String(): currentKey.PubKey()})
+		verifyResp, err := h.VerifyVoteExtensionHandler()(ctx, &cometabci.RequestVerifyVoteExtension{
+			ValidatorAddress: oracleKeyValidator,
+			Height:           10,
+			VoteExtension:    resp.VoteExtension,
+		})
+		s.Require().Error(err)
+		s.Require().Equal(cometabci.ResponseVerifyVoteExtension_REJECT, verifyResp.Status)
+	})
+}
@@ -0,0 +1,95 @@
+package ve_test
+
+import (
+	"time"
+
+	"cosmossdk.io/log"
+	cometabci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/mock"
+
+	aggregatormocks "github.com/zoguxprotocol/slinky/abci/strategies/aggregator/mocks"
+	"github.com/zoguxprotocol/slinky/abci/strategies/codec"
+	mockstrategies "github.com/zoguxprotocol/slinky/abci/strategies/currencypair/mocks"
+	"github.com/zoguxprotocol/slinky/abci/strategies/registry"
+	"github.com/zoguxprotocol/slinky/abci/ve"
+	"github.com/zoguxprotocol/slinky/service/clients/oracle/mocks"
+	servicemetrics "github.com/zoguxprotocol/slinky/service/metrics"
+	servicetypes "github.com/zoguxprotocol/slinky/service/servers/oracle/types"
+)
+
+func (s *VoteExtensionTestSuite) TestVoteExtensionsWithRegistry() {
+	cps := mockstrategies.NewCurrencyPairStrategy(s.T())
+	cps.On("ID", mock.Anything, btcUSD).Return(uint64(0), nil).Maybe()
+	cps.On("GetEncodedPrice", mock.Anything, btcUSD, oneHundred).Return(oneHundred.Bytes(), nil).Maybe()
+	cps.On("GetMaxNumCP", mock.Anything).Return(uint64(1), nil).Maybe()
+
+	versions, err := registry.NewRegistry(
+		registry.Version{
+			Height:               0,
+			Version:              registry.UnversionedVersion,
+			VoteExtensionCodec:   codec.NewDefaultVoteExtensionCodec(),
+			ExtendedCommitCodec:  codec.NewDefaultExtendedCommitCodec(),
+			CurrencyPairStrategy: cps,
+		},
+		registry.Version{
+			Height:  10,
+			Version: 1,
+			VoteExtensionCodec: codec.NewCompressionVoteExtensionCodec(
+				codec.NewDefaultVoteExtensionCodec(),
+				codec.NewZStdCompressor(),
+			),
+			ExtendedCommitCodec:  codec.NewDefaultExtendedCommitCodec(),
+			CurrencyPairStrategy: cps,
+		},
+	)
+	s.Require().NoError(err)
+
+	oracleClient := mocks.NewOracleClient(s.T())
+	oracleClient.On("Prices", mock.Anything, mock.Anything).Return(
+		&servicetypes.QueryPricesResponse{Prices: singlePrice},
+		nil,
+	).Maybe()
+
+	priceApplier := aggregatormocks.NewPriceApplier(s.T())
+	priceApplier.On("ApplyPricesFromVoteExtensions", mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+
+	// the fixed codec is overridden by the registry
+	h := ve.NewVoteExtensionHandler(
+		log.NewTestLogger(s.T()),
+		oracleClient,
+		time.Second,
+		cps,
+		codec.NewDefaultVoteExtensionCodec(),
+		priceApplier,
+		servicemetrics.NewNopMetrics(),
+		ve.WithRegistry(versions),
+	)
+
+	extend := func(height int64) []byte {
+		resp, err := h.ExtendVoteHandler()(s.ctx, &cometabci.RequestExtendVote{Height: height})
+		s.Require().NoError(err)
+		s.Require().NotEmpty(resp.VoteExtension)
+		return resp.VoteExtension
+	}
+	verify := func(height int64, bz []byte) cometabci.ResponseVerifyVoteExtension_VerifyStatus {
+		resp, _ := h.VerifyVoteExtensionHandler()(s.ctx, &cometabci.RequestVerifyVoteExtension{
+			Height:        height,
+			VoteExtension: bz,
+		})
+		return resp.Status
+	}
+
+	before, after := extend(9), extend(10)
+	s.Require().NotEqual(byte(1), before[0])
+	s.Require().Equal(byte(1), after[0])
+
+	s.Run("vote extensions are verified with the version of their height", func() {
+		s.Require().Equal(cometabci.ResponseVerifyVoteExtension_ACCEPT, verify(9, before))
+		s.Require().Equal(cometabci.ResponseVerifyVoteExtension_ACCEPT, verify(10, after))
+	})
+
+	s.Run("vote extensions of another version are rejected", func() {
+		s.Require().Equal(cometabci.ResponseVerifyVoteExtension_REJECT, verify(10, before))
+		s.Require().Equal(cometabci.ResponseVerifyVoteExtension_REJECT, verify(9, after))
+	})
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -28,6 +29,10 @@ type OracleVoteExtension struct {
 	// 0x123.. (bytes). Notice the `id` function is determined by the
 	// `CurrencyPairIDStrategy` used in the VoteExtensionHandler.
 	Prices map[uint64][]byte `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// OracleSignature is the signature of the OracleAttestation of the prices by
+	// the oracle key of the validator. It is only set if the validator has
+	// registered an oracle key.
+	OracleSignature []byte `protobuf:"bytes,2,opt,name=oracle_signature,json=oracleSignature,proto3" json:"oracle_signature,omitempty"`
 }
 
 func (m *OracleVoteExtension) Reset()         { *m = OracleVoteExtension{} }
@@ -70,9 +75,149 @@ func (m *OracleVoteExtension) GetPrices() map[uint64][]byte {
 	return nil
 }
 
+func (m *OracleVoteExtension) GetOracleSignature() []byte {
+	if m != nil {
+		return m.OracleSignature
+	}
+	return nil
+}
+
+// OracleAttestation is the payload signed by the oracle key of a validator.
+// Its protobuf encoding is the sign bytes of the oracle signature of a vote
+// extension.
+type OracleAttestation struct {
+	// ChainId is the ID of the chain the vote extension was created for.
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Height is the height the vote extension was created for.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Validator is the consensus address of the validator.
+	Validator []byte `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
+	// Prices are the prices of the vote extension, ordered by ID.
+	Prices []OraclePrice `protobuf:"bytes,4,rep,name=prices,proto3" json:"prices"`
+}
+
+func (m *OracleAttestation) Reset()         { *m = OracleAttestation{} }
+func (m *OracleAttestation) String() string { return proto.CompactTextString(m) }
+func (*OracleAttestation) ProtoMessage()    {}
+func (*OracleAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cca9d70763a0957a, []int{1}
+}
+func (m *OracleAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleAttestation.Merge(m, src)
+}
+func (m *OracleAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleAttestation proto.InternalMessageInfo
+
+func (m *OracleAttestation) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *OracleAttestation) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *OracleAttestation) GetValidator() []byte {
+	if m != nil {
+		return m.Validator
+	}
+	return nil
+}
+
+func (m *OracleAttestation) GetPrices() []OraclePrice {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
+}
+
+// OraclePrice is a price of a vote extension.
+type OraclePrice struct {
+	// Id is the ID of the currency pair, as determined by the
+	// CurrencyPairIDStrategy.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Price is the encoded price.
+	Price []byte `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (m *OraclePrice) Reset()         { *m = OraclePrice{} }
+func (m *OraclePrice) String() string { return proto.CompactTextString(m) }
+func (*OraclePrice) ProtoMessage()    {}
+func (*OraclePrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cca9d70763a0957a, []int{2}
+}
+func (m *OraclePrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OraclePrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OraclePrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OraclePrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OraclePrice.Merge(m, src)
+}
+func (m *OraclePrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *OraclePrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_OraclePrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OraclePrice proto.InternalMessageInfo
+
+func (m *OraclePrice) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *OraclePrice) GetPrice() []byte {
+	if m != nil {
+		return m.Price
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*OracleVoteExtension)(nil), "slinky.abci.v1.OracleVoteExtension")
 	proto.RegisterMapType((map[uint64][]byte)(nil), "slinky.abci.v1.OracleVoteExtension.PricesEntry")
+	proto.RegisterType((*OracleAttestation)(nil), "slinky.abci.v1.OracleAttestation")
+	proto.RegisterType((*OraclePrice)(nil), "slinky.abci.v1.OraclePrice")
 }
 
 func init() {
@@ -80,22 +225,31 @@ func init() {
 }
 
 var fileDescriptor_cca9d70763a0957a = []byte{
-	// 236 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x29, 0xce, 0xc9, 0xcc,
-	0xcb, 0xae, 0xd4, 0x4f, 0x4c, 0x4a, 0xce, 0xd4, 0x2f, 0x33, 0xd4, 0x2f, 0xcb, 0x2f, 0x49, 0x8d,
-	0x4f, 0xad, 0x28, 0x49, 0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0x2b, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0xe2, 0x83, 0xa8, 0xd2, 0x03, 0xa9, 0xd2, 0x2b, 0x33, 0x54, 0x9a, 0xc9, 0xc8, 0x25, 0xec,
-	0x5f, 0x94, 0x98, 0x9c, 0x93, 0x1a, 0x96, 0x5f, 0x92, 0xea, 0x0a, 0x53, 0x2e, 0xe4, 0xce, 0xc5,
-	0x56, 0x50, 0x94, 0x99, 0x9c, 0x5a, 0x2c, 0xc1, 0xa8, 0xc0, 0xac, 0xc1, 0x6d, 0xa4, 0xaf, 0x87,
-	0xaa, 0x51, 0x0f, 0x8b, 0x26, 0xbd, 0x00, 0xb0, 0x0e, 0xd7, 0xbc, 0x92, 0xa2, 0xca, 0x20, 0xa8,
-	0x76, 0x29, 0x4b, 0x2e, 0x6e, 0x24, 0x61, 0x21, 0x01, 0x2e, 0xe6, 0xec, 0xd4, 0x4a, 0x09, 0x46,
-	0x05, 0x46, 0x0d, 0x96, 0x20, 0x10, 0x53, 0x48, 0x84, 0x8b, 0xb5, 0x2c, 0x31, 0xa7, 0x34, 0x55,
-	0x82, 0x49, 0x81, 0x51, 0x83, 0x27, 0x08, 0xc2, 0xb1, 0x62, 0xb2, 0x60, 0x74, 0x72, 0x3f, 0xf1,
-	0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8,
-	0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xdd, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24,
-	0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xaa, 0xfc, 0xf4, 0xd2, 0x0a, 0xb0, 0xe7, 0x92, 0xf3, 0x73, 0xf4,
-	0x51, 0x02, 0x21, 0x55, 0xbf, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x2c, 0x6d, 0x0c, 0x08,
-	0x00, 0x00, 0xff, 0xff, 0x8e, 0x22, 0xf1, 0xb0, 0x23, 0x01, 0x00, 0x00,
+	// 384 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xb1, 0x6e, 0xe2, 0x40,
+	0x10, 0xf5, 0xda, 0x3e, 0xee, 0x58, 0x4e, 0x1c, 0xb7, 0x87, 0x4e, 0x3e, 0xee, 0xe4, 0x43, 0x28,
+	0x05, 0x29, 0xe2, 0x15, 0xa1, 0x09, 0xe9, 0x82, 0x84, 0x50, 0xaa, 0x44, 0x8e, 0x94, 0x22, 0x0d,
+	0x5a, 0xec, 0x95, 0xbd, 0xc2, 0xf1, 0x22, 0x7b, 0x6d, 0xe1, 0x7c, 0x45, 0xfe, 0x20, 0x3f, 0x93,
+	0x82, 0x92, 0x32, 0x55, 0x14, 0xc1, 0x8f, 0x44, 0x5e, 0xe3, 0x04, 0x24, 0xba, 0x79, 0x33, 0x6f,
+	0x9e, 0xe7, 0x3d, 0x2f, 0x3c, 0x8a, 0x03, 0x16, 0xce, 0x32, 0x4c, 0xa6, 0x0e, 0xc3, 0x69, 0x0f,
+	0xa7, 0x5c, 0xd0, 0x09, 0x5d, 0x08, 0x1a, 0xc6, 0x8c, 0x87, 0xb1, 0x35, 0x8f, 0xb8, 0xe0, 0xa8,
+	0x5e, 0xb0, 0xac, 0x9c, 0x65, 0xa5, 0xbd, 0x56, 0xd3, 0xe3, 0x1e, 0x97, 0x23, 0x9c, 0x57, 0x05,
+	0xab, 0xf3, 0x0c, 0xe0, 0xaf, 0xab, 0x88, 0x38, 0x01, 0xbd, 0xe5, 0x82, 0x8e, 0x4a, 0x11, 0x34,
+	0x86, 0x95, 0x79, 0xc4, 0x1c, 0x1a, 0x1b, 0xa0, 0xad, 0x75, 0x6b, 0xa7, 0xd8, 0xda, 0x97, 0xb3,
+	0x0e, 0x2c, 0x59, 0xd7, 0x72, 0x63, 0x14, 0x8a, 0x28, 0xb3, 0xb7, 0xeb, 0xe8, 0x18, 0x36, 0xb8,
+	0xa4, 0x4e, 0x62, 0xe6, 0x85, 0x44, 0x24, 0x11, 0x35, 0xd4, 0x36, 0xe8, 0x7e, 0xb7, 0x7f, 0x14,
+	0xfd, 0x9b, 0xb2, 0xdd, 0x1a, 0xc0, 0xda, 0x8e, 0x02, 0x6a, 0x40, 0x6d, 0x46, 0x33, 0x03, 0xb4,
+	0x41, 0x57, 0xb7, 0xf3, 0x12, 0x35, 0xe1, 0x97, 0x94, 0x04, 0x49, 0x29, 0x50, 0x80, 0x73, 0xf5,
+	0x0c, 0x74, 0x9e, 0x00, 0xfc, 0x59, 0x5c, 0x74, 0x21, 0x04, 0x8d, 0x05, 0x11, 0xb9, 0x89, 0x3f,
+	0xf0, 0x9b, 0xe3, 0x13, 0x16, 0x4e, 0x98, 0x2b, 0x65, 0xaa, 0xf6, 0x57, 0x89, 0x2f, 0x5d, 0xf4,
+	0x1b, 0x56, 0x7c, 0xca, 0x3c, 0x5f, 0x48, 0x2d, 0xcd, 0xde, 0x22, 0xf4, 0x0f, 0x56, 0x53, 0x12,
+	0x30, 0x97, 0x08, 0x1e, 0x19, 0x9a, 0xfc, 0xcc, 0x67, 0x03, 0x0d, 0x3e, 0x52, 0xd1, 0x65, 0x2a,
+	0x7f, 0x0f, 0xa7, 0x22, 0x5d, 0x0c, 0xf5, 0xe5, 0xeb, 0x7f, 0xa5, 0xcc, 0xa1, 0xd3, 0x87, 0xb5,
+	0x9d, 0x21, 0xaa, 0x43, 0x75, 0x7b, 0x94, 0x6e, 0xab, 0xcc, 0xcd, 0xad, 0x49, 0x62, 0x69, 0x4d,
+	0x82, 0xe1, 0x78, 0xb9, 0x36, 0xc1, 0x6a, 0x6d, 0x82, 0xb7, 0xb5, 0x09, 0x1e, 0x37, 0xa6, 0xb2,
+	0xda, 0x98, 0xca, 0xcb, 0xc6, 0x54, 0xee, 0x4e, 0x3c, 0x26, 0xfc, 0x64, 0x6a, 0x39, 0xfc, 0x1e,
+	0x3f, 0x70, 0x2f, 0x59, 0xc8, 0xdf, 0xe9, 0xf0, 0x00, 0xef, 0x3d, 0x0e, 0x8a, 0x45, 0x36, 0xa7,
+	0xf1, 0xb4, 0x22, 0xc7, 0xfd, 0xf7, 0x01, 0x00, 0x06, 0x17, 0xc8, 0x73, 0x3b, 0x02, 0x00, 0x00,
 }
 
 func (m *OracleVoteExtension) Marshal() (dAtA []byte, err error) {
@@ -118,6 +272,13 @@ func (m *OracleVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OracleSignature) > 0 {
+		i -= len(m.OracleSignature)
+		copy(dAtA[i:], m.OracleSignature)
+		i = encodeVarintVoteExtensions(dAtA, i, uint64(len(m.OracleSignature)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Prices) > 0 {
 		for k := range m.Prices {
 			v := m.Prices[k]
@@ -140,6 +301,97 @@ func (m *OracleVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OracleAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVoteExtensions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintVoteExtensions(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintVoteExtensions(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintVoteExtensions(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OraclePrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OraclePrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OraclePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintVoteExtensions(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintVoteExtensions(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVoteExtensions(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVoteExtensions(v)
 	base := offset
@@ -169,6 +421,52 @@ func (m *OracleVoteExtension) Size() (n int) {
 			n += mapEntrySize + 1 + sovVoteExtensions(uint64(mapEntrySize))
 		}
 	}
+	l = len(m.OracleSignature)
+	if l > 0 {
+		n += 1 + l + sovVoteExtensions(uint64(l))
+	}
+	return n
+}
+
+func (m *OracleAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovVoteExtensions(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovVoteExtensions(uint64(m.Height))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovVoteExtensions(uint64(l))
+	}
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovVoteExtensions(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OraclePrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovVoteExtensions(uint64(m.Id))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovVoteExtensions(uint64(l))
+	}
 	return n
 }
 
@@ -321,6 +619,312 @@ func (m *OracleVoteExtension) Unmarshal(dAtA []byte) error {
 			}
 			m.Prices[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtensions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleSignature = append(m.OracleSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.OracleSignature == nil {
+				m.OracleSignature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoteExtensions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoteExtensions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtensions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtensions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtensions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Validator == nil {
+				m.Validator = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtensions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, OraclePrice{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoteExtensions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OraclePrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoteExtensions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OraclePrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OraclePrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtensions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtensions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = append(m.Price[:0], dAtA[iNdEx:postIndex]...)
+			if m.Price == nil {
+				m.Price = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVoteExtensions(dAtA[iNdEx:])
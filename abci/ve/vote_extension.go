@@ -9,11 +9,13 @@ import (
 
 	"cosmossdk.io/log"
 	cometabci "github.com/cometbft/cometbft/abci/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/zoguxprotocol/slinky/abci/strategies/aggregator"
 	compression "github.com/zoguxprotocol/slinky/abci/strategies/codec"
 	"github.com/zoguxprotocol/slinky/abci/strategies/currencypair"
+	"github.com/zoguxprotocol/slinky/abci/strategies/registry"
 	slinkyabci "github.com/zoguxprotocol/slinky/abci/types"
 	"github.com/zoguxprotocol/slinky/abci/ve/types"
 	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
@@ -47,6 +49,20 @@ type VoteExtensionHandler struct {
 
 	// metrics is the service metrics interface that the vote-extension handler will use to report metrics.
 	metrics servicemetrics.Metrics
+
+	// oracleKeys are the oracle keys registered by the validators. If set, the oracle signatures of
+	// the vote extensions are verified.
+	oracleKeys OracleKeyStore
+
+	// validator is the consensus address of the validator the vote extensions are signed for.
+	validator sdk.ConsAddress
+
+	// oraclePrivKeys are the private oracle keys the vote extensions may be signed with.
+	oraclePrivKeys []cryptotypes.PrivKey
+
+	// versions is the registry of the codecs and strategies of the vote extensions. If set, it
+	// takes precedence over the currency pair strategy and the codec.
+	versions *registry.Registry
 }
 
 // NewVoteExtensionHandler returns a new VoteExtensionHandler.
@@ -58,8 +74,9 @@ func NewVoteExtensionHandler(
 	codec compression.VoteExtensionCodec,
 	priceApplier aggregator.PriceApplier,
 	metrics servicemetrics.Metrics,
+	opts ...Option,
 ) *VoteExtensionHandler {
-	return &VoteExtensionHandler{
+	h := &VoteExtensionHandler{
 		logger:               logger,
 		oracleClient:         oracleClient,
 		timeout:              timeout,
@@ -68,6 +85,13 @@ func NewVoteExtensionHandler(
 		metrics:              metrics,
 		priceApplier:         priceApplier,
 	}
+
+	// apply options
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
 }
 
 // ExtendVoteHandler returns a handler that extends a vote with the oracle's
@@ -166,8 +190,10 @@ func (h *VoteExtensionHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
 			return &cometabci.ResponseExtendVote{VoteExtension: []byte{}}, err
 		}
 
+		voteExtensionCodec, strategy := h.versionAt(req.Height)
+
 		// Transform the response prices into a vote extension.
-		voteExt, err := h.transformOracleServicePrices(ctx, oracleResp.Prices)
+		voteExt, err := h.transformOracleServicePrices(ctx, strategy, oracleResp.Prices)
 		if err != nil {
 			h.logger.Error(
 				"failed to transform oracle prices for vote extension; returning empty vote extension",
@@ -182,7 +208,22 @@ func (h *VoteExtensionHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
 			return &cometabci.ResponseExtendVote{VoteExtension: []byte{}}, err
 		}
 
-		bz, err := h.voteExtensionCodec.Encode(voteExt)
+		// Sign the prices with the oracle key of the validator, if it has registered one.
+		if err = h.signOracleVoteExtension(ctx, req.Height, &voteExt); err != nil {
+			h.logger.Error(
+				"failed to sign vote extension with oracle key; returning empty vote extension",
+				"height", req.Height,
+				"err", err,
+			)
+
+			err = OracleSignatureError{
+				Err: err,
+			}
+
+			return &cometabci.ResponseExtendVote{VoteExtension: []byte{}}, err
+		}
+
+		bz, err := voteExtensionCodec.Encode(voteExt)
 		if err != nil {
 			h.logger.Error(
 				"failed to marshal vote extension; returning empty vote extension",
@@ -243,8 +284,9 @@ func (h *VoteExtensionHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtens
 			return &cometabci.ResponseVerifyVoteExtension{Status: cometabci.ResponseVerifyVoteExtension_ACCEPT}, nil
 		}
 
-		// decode the vote-extension bytes
-		voteExtension, err := h.voteExtensionCodec.Decode(req.VoteExtension)
+		// decode the vote-extension bytes with the codec of the height it was created at
+		voteExtensionCodec, strategy := h.versionAt(req.Height)
+		voteExtension, err := voteExtensionCodec.Decode(req.VoteExtension)
 		if err != nil {
 			h.logger.Error(
 				"failed to decode vote extension",
@@ -258,7 +300,7 @@ func (h *VoteExtensionHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtens
 			return &cometabci.ResponseVerifyVoteExtension{Status: cometabci.ResponseVerifyVoteExtension_REJECT}, err
 		}
 
-		if err := ValidateOracleVoteExtension(ctx, voteExtension, h.currencyPairStrategy); err != nil {
+		if err := ValidateOracleVoteExtension(ctx, voteExtension, strategy); err != nil {
 			h.logger.Error(
 				"failed to validate vote extension",
 				"height", req.Height,
@@ -271,6 +313,22 @@ func (h *VoteExtensionHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtens
 			return &cometabci.ResponseVerifyVoteExtension{Status: cometabci.ResponseVerifyVoteExtension_REJECT}, err
 		}
 
+		// verify the oracle signature of the vote extension, in addition to the signature by the consensus key
+		if h.oracleKeys != nil {
+			if err := VerifyOracleSignature(ctx, h.oracleKeys, req.ValidatorAddress, req.Height, voteExtension); err != nil {
+				h.logger.Error(
+					"failed to verify oracle signature of vote extension",
+					"height", req.Height,
+					"err", err,
+				)
+				err = ValidateVoteExtensionError{
+					Err: err,
+				}
+
+				return &cometabci.ResponseVerifyVoteExtension{Status: cometabci.ResponseVerifyVoteExtension_REJECT}, err
+			}
+		}
+
 		h.logger.Debug(
 			"validated vote extension",
 			"height", req.Height,
@@ -284,10 +342,50 @@ func (h *VoteExtensionHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtens
 	}
 }
 
+// versionAt returns the codec and the currency pair strategy of the vote extensions created at the given height.
+func (h *VoteExtensionHandler) versionAt(height int64) (compression.VoteExtensionCodec, currencypair.CurrencyPairStrategy) {
+	if h.versions == nil {
+		return h.voteExtensionCodec, h.currencyPairStrategy
+	}
+
+	version := h.versions.VersionAt(height)
+	return version.VoteExtensionCodec, version.CurrencyPairStrategy
+}
+
+// signOracleVoteExtension signs the vote extension created at the given height with the oracle key the
+// validator has registered, if any. This fails if none of the configured private keys matches the
+// registered key.
+func (h *VoteExtensionHandler) signOracleVoteExtension(ctx sdk.Context, height int64, ve *types.OracleVoteExtension) error {
+	if h.oracleKeys == nil || h.validator.Empty() {
+		return nil
+	}
+
+	pubKey, err := h.oracleKeys.GetOracleKeyForHeight(ctx, h.validator, height)
+	if err != nil {
+		return err
+	}
+
+	if pubKey == nil {
+		return nil
+	}
+
+	for _, privKey := range h.oraclePrivKeys {
+		if privKey.PubKey().Equals(pubKey) {
+			return SignOracleVoteExtension(ctx.ChainID(), height, h.validator, privKey, ve)
+		}
+	}
+
+	return fmt.Errorf("no private key configured for the oracle key %X of validator %s", pubKey.Bytes(), h.validator.String())
+}
+
 // transformOracleServicePrices transforms the oracle service prices into a vote extension. It
 // does this by iterating over the prices submitted by the oracle service and determining the
 // correct decoded price / ID based on the currency pair strategy.
-func (h *VoteExtensionHandler) transformOracleServicePrices(ctx sdk.Context, prices map[string]string) (types.OracleVoteExtension, error) {
+func (h *VoteExtensionHandler) transformOracleServicePrices(
+	ctx sdk.Context,
+	strategy currencypair.CurrencyPairStrategy,
+	prices map[string]string,
+) (types.OracleVoteExtension, error) {
 	strategyPrices := make(map[uint64][]byte)
 
 	// Iterate over the prices and transform them into the correct format.
@@ -303,7 +401,7 @@ func (h *VoteExtensionHandler) transformOracleServicePrices(ctx sdk.Context, pri
 		}
 
 		// Determine if the currency pair is supported by the network.
-		cpID, err := h.currencyPairStrategy.ID(ctx, cp)
+		cpID, err := strategy.ID(ctx, cp)
 		if err != nil {
 			h.logger.Debug(
 				"failed to get currency pair ID",
@@ -315,7 +413,7 @@ func (h *VoteExtensionHandler) transformOracleServicePrices(ctx sdk.Context, pri
 		}
 
 		// Determine the encoded price for the currency pair based on the strategy.
-		encodedPrice, err := h.currencyPairStrategy.GetEncodedPrice(ctx, cp, rawPrice)
+		encodedPrice, err := strategy.GetEncodedPrice(ctx, cp, rawPrice)
 		if err != nil {
 			h.logger.Debug(
 				"failed to get current price for currency pair",
@@ -4,6 +4,7 @@ package abciv1
 import (
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -96,14 +97,16 @@ func (x *_OracleVoteExtension_1_map) IsValid() bool {
 }
 
 var (
-	md_OracleVoteExtension        protoreflect.MessageDescriptor
-	fd_OracleVoteExtension_prices protoreflect.FieldDescriptor
+	md_OracleVoteExtension                  protoreflect.MessageDescriptor
+	fd_OracleVoteExtension_prices           protoreflect.FieldDescriptor
+	fd_OracleVoteExtension_oracle_signature protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_slinky_abci_v1_vote_extensions_proto_init()
 	md_OracleVoteExtension = File_slinky_abci_v1_vote_extensions_proto.Messages().ByName("OracleVoteExtension")
 	fd_OracleVoteExtension_prices = md_OracleVoteExtension.Fields().ByName("prices")
+	fd_OracleVoteExtension_oracle_signature = md_OracleVoteExtension.Fields().ByName("oracle_signature")
 }
 
 var _ protoreflect.Message = (*fastReflection_OracleVoteExtension)(nil)
@@ -177,6 +180,12 @@ func (x *fastReflection_OracleVoteExtension) Range(f func(protoreflect.FieldDesc
 			return
 		}
 	}
+	if len(x.OracleSignature) != 0 {
+		value := protoreflect.ValueOfBytes(x.OracleSignature)
+		if !f(fd_OracleVoteExtension_oracle_signature, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -194,6 +203,8 @@ func (x *fastReflection_OracleVoteExtension) Has(fd protoreflect.FieldDescriptor
 	switch fd.FullName() {
 	case "slinky.abci.v1.OracleVoteExtension.prices":
 		return len(x.Prices) != 0
+	case "slinky.abci.v1.OracleVoteExtension.oracle_signature":
+		return len(x.OracleSignature) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.abci.v1.OracleVoteExtension"))
@@ -212,6 +223,8 @@ func (x *fastReflection_OracleVoteExtension) Clear(fd protoreflect.FieldDescript
 	switch fd.FullName() {
 	case "slinky.abci.v1.OracleVoteExtension.prices":
 		x.Prices = nil
+	case "slinky.abci.v1.OracleVoteExtension.oracle_signature":
+		x.OracleSignature = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.abci.v1.OracleVoteExtension"))
@@ -234,6 +247,9 @@ func (x *fastReflection_OracleVoteExtension) Get(descriptor protoreflect.FieldDe
 		}
 		mapValue := &_OracleVoteExtension_1_map{m: &x.Prices}
 		return protoreflect.ValueOfMap(mapValue)
+	case "slinky.abci.v1.OracleVoteExtension.oracle_signature":
+		value := x.OracleSignature
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.abci.v1.OracleVoteExtension"))
@@ -258,6 +274,8 @@ func (x *fastReflection_OracleVoteExtension) Set(fd protoreflect.FieldDescriptor
 		mv := value.Map()
 		cmv := mv.(*_OracleVoteExtension_1_map)
 		x.Prices = *cmv.m
+	case "slinky.abci.v1.OracleVoteExtension.oracle_signature":
+		x.OracleSignature = value.Bytes()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.abci.v1.OracleVoteExtension"))
@@ -284,6 +302,8 @@ func (x *fastReflection_OracleVoteExtension) Mutable(fd protoreflect.FieldDescri
 		}
 		value := &_OracleVoteExtension_1_map{m: &x.Prices}
 		return protoreflect.ValueOfMap(value)
+	case "slinky.abci.v1.OracleVoteExtension.oracle_signature":
+		panic(fmt.Errorf("field oracle_signature of message slinky.abci.v1.OracleVoteExtension is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.abci.v1.OracleVoteExtension"))
@@ -300,6 +320,8 @@ func (x *fastReflection_OracleVoteExtension) NewField(fd protoreflect.FieldDescr
 	case "slinky.abci.v1.OracleVoteExtension.prices":
 		m := make(map[uint64][]byte)
 		return protoreflect.ValueOfMap(&_OracleVoteExtension_1_map{m: &m})
+	case "slinky.abci.v1.OracleVoteExtension.oracle_signature":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.abci.v1.OracleVoteExtension"))
@@ -393,6 +415,10 @@ func (x *fastReflection_OracleVoteExtension) ProtoMethods() *protoiface.Methods
 				}
 			}
 		}
+		l = len(x.OracleSignature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -422,6 +448,13 @@ func (x *fastReflection_OracleVoteExtension) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.OracleSignature) > 0 {
+			i -= len(x.OracleSignature)
+			copy(dAtA[i:], x.OracleSignature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OracleSignature)))
+			i--
+			dAtA[i] = 0x12
+		}
 		if len(x.Prices) > 0 {
 			MaRsHaLmAp := func(k uint64, v []byte) (protoiface.MarshalOutput, error) {
 				baseI := i
@@ -626,6 +659,40 @@ func (x *fastReflection_OracleVoteExtension) ProtoMethods() *protoiface.Methods
 				}
 				x.Prices[mapkey] = mapvalue
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleSignature", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OracleSignature = append(x.OracleSignature[:0], dAtA[iNdEx:postIndex]...)
+				if x.OracleSignature == nil {
+					x.OracleSignature = []byte{}
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -661,122 +728,1432 @@ func (x *fastReflection_OracleVoteExtension) ProtoMethods() *protoiface.Methods
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: slinky/abci/v1/vote_extensions.proto
+var _ protoreflect.List = (*_OracleAttestation_4_list)(nil)
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+type _OracleAttestation_4_list struct {
+	list *[]*OraclePrice
+}
+
+func (x *_OracleAttestation_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_OracleAttestation_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_OracleAttestation_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OraclePrice)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_OracleAttestation_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OraclePrice)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_OracleAttestation_4_list) AppendMutable() protoreflect.Value {
+	v := new(OraclePrice)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_OracleAttestation_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_OracleAttestation_4_list) NewElement() protoreflect.Value {
+	v := new(OraclePrice)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_OracleAttestation_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_OracleAttestation           protoreflect.MessageDescriptor
+	fd_OracleAttestation_chain_id  protoreflect.FieldDescriptor
+	fd_OracleAttestation_height    protoreflect.FieldDescriptor
+	fd_OracleAttestation_validator protoreflect.FieldDescriptor
+	fd_OracleAttestation_prices    protoreflect.FieldDescriptor
 )
 
-// OracleVoteExtension defines the vote extension structure for oracle prices.
-type OracleVoteExtension struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_slinky_abci_v1_vote_extensions_proto_init()
+	md_OracleAttestation = File_slinky_abci_v1_vote_extensions_proto.Messages().ByName("OracleAttestation")
+	fd_OracleAttestation_chain_id = md_OracleAttestation.Fields().ByName("chain_id")
+	fd_OracleAttestation_height = md_OracleAttestation.Fields().ByName("height")
+	fd_OracleAttestation_validator = md_OracleAttestation.Fields().ByName("validator")
+	fd_OracleAttestation_prices = md_OracleAttestation.Fields().ByName("prices")
+}
 
-	// Prices defines a map of id(CurrencyPair) -> price.Bytes() . i.e. 1 ->
-	// 0x123.. (bytes). Notice the `id` function is determined by the
-	// `CurrencyPairIDStrategy` used in the VoteExtensionHandler.
-	Prices map[uint64][]byte `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+var _ protoreflect.Message = (*fastReflection_OracleAttestation)(nil)
+
+type fastReflection_OracleAttestation OracleAttestation
+
+func (x *OracleAttestation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OracleAttestation)(x)
 }
 
-func (x *OracleVoteExtension) Reset() {
-	*x = OracleVoteExtension{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_slinky_abci_v1_vote_extensions_proto_msgTypes[0]
+func (x *OracleAttestation) slowProtoReflect() protoreflect.Message {
+	mi := &file_slinky_abci_v1_vote_extensions_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *OracleVoteExtension) String() string {
-	return protoimpl.X.MessageStringOf(x)
+var _fastReflection_OracleAttestation_messageType fastReflection_OracleAttestation_messageType
+var _ protoreflect.MessageType = fastReflection_OracleAttestation_messageType{}
+
+type fastReflection_OracleAttestation_messageType struct{}
+
+func (x fastReflection_OracleAttestation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OracleAttestation)(nil)
+}
+func (x fastReflection_OracleAttestation_messageType) New() protoreflect.Message {
+	return new(fastReflection_OracleAttestation)
+}
+func (x fastReflection_OracleAttestation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleAttestation
 }
 
-func (*OracleVoteExtension) ProtoMessage() {}
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_OracleAttestation) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleAttestation
+}
 
-// Deprecated: Use OracleVoteExtension.ProtoReflect.Descriptor instead.
-func (*OracleVoteExtension) Descriptor() ([]byte, []int) {
-	return file_slinky_abci_v1_vote_extensions_proto_rawDescGZIP(), []int{0}
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_OracleAttestation) Type() protoreflect.MessageType {
+	return _fastReflection_OracleAttestation_messageType
 }
 
-func (x *OracleVoteExtension) GetPrices() map[uint64][]byte {
-	if x != nil {
-		return x.Prices
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_OracleAttestation) New() protoreflect.Message {
+	return new(fastReflection_OracleAttestation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_OracleAttestation) Interface() protoreflect.ProtoMessage {
+	return (*OracleAttestation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_OracleAttestation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ChainId != "" {
+		value := protoreflect.ValueOfString(x.ChainId)
+		if !f(fd_OracleAttestation_chain_id, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_OracleAttestation_height, value) {
+			return
+		}
+	}
+	if len(x.Validator) != 0 {
+		value := protoreflect.ValueOfBytes(x.Validator)
+		if !f(fd_OracleAttestation_validator, value) {
+			return
+		}
+	}
+	if len(x.Prices) != 0 {
+		value := protoreflect.ValueOfList(&_OracleAttestation_4_list{list: &x.Prices})
+		if !f(fd_OracleAttestation_prices, value) {
+			return
+		}
 	}
-	return nil
 }
 
-var File_slinky_abci_v1_vote_extensions_proto protoreflect.FileDescriptor
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_OracleAttestation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "slinky.abci.v1.OracleAttestation.chain_id":
+		return x.ChainId != ""
+	case "slinky.abci.v1.OracleAttestation.height":
+		return x.Height != int64(0)
+	case "slinky.abci.v1.OracleAttestation.validator":
+		return len(x.Validator) != 0
+	case "slinky.abci.v1.OracleAttestation.prices":
+		return len(x.Prices) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.abci.v1.OracleAttestation"))
+		}
+		panic(fmt.Errorf("message slinky.abci.v1.OracleAttestation does not contain field %s", fd.FullName()))
+	}
+}
 
-var file_slinky_abci_v1_vote_extensions_proto_rawDesc = []byte{
-	0x0a, 0x24, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x61, 0x62, 0x63, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x61,
-	0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x22, 0x99, 0x01, 0x0a, 0x13, 0x4f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x47,
-	0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f,
-	0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
-	0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x42, 0xab, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b,
-	0x79, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x42, 0x13, 0x56, 0x6f, 0x74, 0x65, 0x45,
-	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x61, 0x62, 0x63, 0x69, 0x2f, 0x76,
-	0x31, 0x3b, 0x61, 0x62, 0x63, 0x69, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x41, 0x58, 0xaa, 0x02,
-	0x0e, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x41, 0x62, 0x63, 0x69, 0x2e, 0x56, 0x31, 0xca,
-	0x02, 0x0e, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x41, 0x62, 0x63, 0x69, 0x5c, 0x56, 0x31,
-	0xe2, 0x02, 0x1a, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x41, 0x62, 0x63, 0x69, 0x5c, 0x56,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10,
-	0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x3a, 0x3a, 0x41, 0x62, 0x63, 0x69, 0x3a, 0x3a, 0x56, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleAttestation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "slinky.abci.v1.OracleAttestation.chain_id":
+		x.ChainId = ""
+	case "slinky.abci.v1.OracleAttestation.height":
+		x.Height = int64(0)
+	case "slinky.abci.v1.OracleAttestation.validator":
+		x.Validator = nil
+	case "slinky.abci.v1.OracleAttestation.prices":
+		x.Prices = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.abci.v1.OracleAttestation"))
+		}
+		panic(fmt.Errorf("message slinky.abci.v1.OracleAttestation does not contain field %s", fd.FullName()))
+	}
 }
 
-var (
-	file_slinky_abci_v1_vote_extensions_proto_rawDescOnce sync.Once
-	file_slinky_abci_v1_vote_extensions_proto_rawDescData = file_slinky_abci_v1_vote_extensions_proto_rawDesc
-)
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_OracleAttestation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "slinky.abci.v1.OracleAttestation.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfString(value)
+	case "slinky.abci.v1.OracleAttestation.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "slinky.abci.v1.OracleAttestation.validator":
+		value := x.Validator
+		return protoreflect.ValueOfBytes(value)
+	case "slinky.abci.v1.OracleAttestation.prices":
+		if len(x.Prices) == 0 {
+			return protoreflect.ValueOfList(&_OracleAttestation_4_list{})
+		}
+		listValue := &_OracleAttestation_4_list{list: &x.Prices}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.abci.v1.OracleAttestation"))
+		}
+		panic(fmt.Errorf("message slinky.abci.v1.OracleAttestation does not contain field %s", descriptor.FullName()))
+	}
+}
 
-func file_slinky_abci_v1_vote_extensions_proto_rawDescGZIP() []byte {
-	file_slinky_abci_v1_vote_extensions_proto_rawDescOnce.Do(func() {
-		file_slinky_abci_v1_vote_extensions_proto_rawDescData = protoimpl.X.CompressGZIP(file_slinky_abci_v1_vote_extensions_proto_rawDescData)
-	})
-	return file_slinky_abci_v1_vote_extensions_proto_rawDescData
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleAttestation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "slinky.abci.v1.OracleAttestation.chain_id":
+		x.ChainId = value.Interface().(string)
+	case "slinky.abci.v1.OracleAttestation.height":
+		x.Height = value.Int()
+	case "slinky.abci.v1.OracleAttestation.validator":
+		x.Validator = value.Bytes()
+	case "slinky.abci.v1.OracleAttestation.prices":
+		lv := value.List()
+		clv := lv.(*_OracleAttestation_4_list)
+		x.Prices = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.abci.v1.OracleAttestation"))
+		}
+		panic(fmt.Errorf("message slinky.abci.v1.OracleAttestation does not contain field %s", fd.FullName()))
+	}
 }
 
-var file_slinky_abci_v1_vote_extensions_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
-var file_slinky_abci_v1_vote_extensions_proto_goTypes = []interface{}{
-	(*OracleVoteExtension)(nil), // 0: slinky.abci.v1.OracleVoteExtension
-	nil,                         // 1: slinky.abci.v1.OracleVoteExtension.PricesEntry
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleAttestation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.abci.v1.OracleAttestation.prices":
+		if x.Prices == nil {
+			x.Prices = []*OraclePrice{}
+		}
+		value := &_OracleAttestation_4_list{list: &x.Prices}
+		return protoreflect.ValueOfList(value)
+	case "slinky.abci.v1.OracleAttestation.chain_id":
+		panic(fmt.Errorf("field chain_id of message slinky.abci.v1.OracleAttestation is not mutable"))
+	case "slinky.abci.v1.OracleAttestation.height":
+		panic(fmt.Errorf("field height of message slinky.abci.v1.OracleAttestation is not mutable"))
+	case "slinky.abci.v1.OracleAttestation.validator":
+		panic(fmt.Errorf("field validator of message slinky.abci.v1.OracleAttestation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.abci.v1.OracleAttestation"))
+		}
+		panic(fmt.Errorf("message slinky.abci.v1.OracleAttestation does not contain field %s", fd.FullName()))
+	}
 }
-var file_slinky_abci_v1_vote_extensions_proto_depIdxs = []int32{
-	1, // 0: slinky.abci.v1.OracleVoteExtension.prices:type_name -> slinky.abci.v1.OracleVoteExtension.PricesEntry
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_OracleAttestation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.abci.v1.OracleAttestation.chain_id":
+		return protoreflect.ValueOfString("")
+	case "slinky.abci.v1.OracleAttestation.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "slinky.abci.v1.OracleAttestation.validator":
+		return protoreflect.ValueOfBytes(nil)
+	case "slinky.abci.v1.OracleAttestation.prices":
+		list := []*OraclePrice{}
+		return protoreflect.ValueOfList(&_OracleAttestation_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.abci.v1.OracleAttestation"))
+		}
+		panic(fmt.Errorf("message slinky.abci.v1.OracleAttestation does not contain field %s", fd.FullName()))
+	}
 }
 
-func init() { file_slinky_abci_v1_vote_extensions_proto_init() }
-func file_slinky_abci_v1_vote_extensions_proto_init() {
-	if File_slinky_abci_v1_vote_extensions_proto != nil {
-		return
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_OracleAttestation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in slinky.abci.v1.OracleAttestation", d.FullName()))
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_slinky_abci_v1_vote_extensions_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OracleVoteExtension); i {
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_OracleAttestation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleAttestation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_OracleAttestation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_OracleAttestation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*OracleAttestation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ChainId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Prices) > 0 {
+			for _, e := range x.Prices {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*OracleAttestation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Prices) > 0 {
+			for iNdEx := len(x.Prices) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Prices[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.ChainId) > 0 {
+			i -= len(x.ChainId)
+			copy(dAtA[i:], x.ChainId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*OracleAttestation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleAttestation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChainId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validator = append(x.Validator[:0], dAtA[iNdEx:postIndex]...)
+				if x.Validator == nil {
+					x.Validator = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Prices = append(x.Prices, &OraclePrice{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Prices[len(x.Prices)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_OraclePrice       protoreflect.MessageDescriptor
+	fd_OraclePrice_id    protoreflect.FieldDescriptor
+	fd_OraclePrice_price protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_slinky_abci_v1_vote_extensions_proto_init()
+	md_OraclePrice = File_slinky_abci_v1_vote_extensions_proto.Messages().ByName("OraclePrice")
+	fd_OraclePrice_id = md_OraclePrice.Fields().ByName("id")
+	fd_OraclePrice_price = md_OraclePrice.Fields().ByName("price")
+}
+
+var _ protoreflect.Message = (*fastReflection_OraclePrice)(nil)
+
+type fastReflection_OraclePrice OraclePrice
+
+func (x *OraclePrice) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OraclePrice)(x)
+}
+
+func (x *OraclePrice) slowProtoReflect() protoreflect.Message {
+	mi := &file_slinky_abci_v1_vote_extensions_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_OraclePrice_messageType fastReflection_OraclePrice_messageType
+var _ protoreflect.MessageType = fastReflection_OraclePrice_messageType{}
+
+type fastReflection_OraclePrice_messageType struct{}
+
+func (x fastReflection_OraclePrice_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OraclePrice)(nil)
+}
+func (x fastReflection_OraclePrice_messageType) New() protoreflect.Message {
+	return new(fastReflection_OraclePrice)
+}
+func (x fastReflection_OraclePrice_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OraclePrice
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_OraclePrice) Descriptor() protoreflect.MessageDescriptor {
+	return md_OraclePrice
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_OraclePrice) Type() protoreflect.MessageType {
+	return _fastReflection_OraclePrice_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_OraclePrice) New() protoreflect.Message {
+	return new(fastReflection_OraclePrice)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_OraclePrice) Interface() protoreflect.ProtoMessage {
+	return (*OraclePrice)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_OraclePrice) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_OraclePrice_id, value) {
+			return
+		}
+	}
+	if len(x.Price) != 0 {
+		value := protoreflect.ValueOfBytes(x.Price)
+		if !f(fd_OraclePrice_price, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_OraclePrice) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "slinky.abci.v1.OraclePrice.id":
+		return x.Id != uint64(0)
+	case "slinky.abci.v1.OraclePrice.price":
+		return len(x.Price) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.abci.v1.OraclePrice"))
+		}
+		panic(fmt.Errorf("message slinky.abci.v1.OraclePrice does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OraclePrice) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "slinky.abci.v1.OraclePrice.id":
+		x.Id = uint64(0)
+	case "slinky.abci.v1.OraclePrice.price":
+		x.Price = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.abci.v1.OraclePrice"))
+		}
+		panic(fmt.Errorf("message slinky.abci.v1.OraclePrice does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_OraclePrice) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "slinky.abci.v1.OraclePrice.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.abci.v1.OraclePrice.price":
+		value := x.Price
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.abci.v1.OraclePrice"))
+		}
+		panic(fmt.Errorf("message slinky.abci.v1.OraclePrice does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OraclePrice) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "slinky.abci.v1.OraclePrice.id":
+		x.Id = value.Uint()
+	case "slinky.abci.v1.OraclePrice.price":
+		x.Price = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.abci.v1.OraclePrice"))
+		}
+		panic(fmt.Errorf("message slinky.abci.v1.OraclePrice does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OraclePrice) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.abci.v1.OraclePrice.id":
+		panic(fmt.Errorf("field id of message slinky.abci.v1.OraclePrice is not mutable"))
+	case "slinky.abci.v1.OraclePrice.price":
+		panic(fmt.Errorf("field price of message slinky.abci.v1.OraclePrice is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.abci.v1.OraclePrice"))
+		}
+		panic(fmt.Errorf("message slinky.abci.v1.OraclePrice does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_OraclePrice) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.abci.v1.OraclePrice.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.abci.v1.OraclePrice.price":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.abci.v1.OraclePrice"))
+		}
+		panic(fmt.Errorf("message slinky.abci.v1.OraclePrice does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_OraclePrice) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in slinky.abci.v1.OraclePrice", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_OraclePrice) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OraclePrice) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_OraclePrice) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_OraclePrice) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*OraclePrice)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Price)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*OraclePrice)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Price) > 0 {
+			i -= len(x.Price)
+			copy(dAtA[i:], x.Price)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Price)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*OraclePrice)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OraclePrice: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OraclePrice: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Price = append(x.Price[:0], dAtA[iNdEx:postIndex]...)
+				if x.Price == nil {
+					x.Price = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: slinky/abci/v1/vote_extensions.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// OracleVoteExtension defines the vote extension structure for oracle prices.
+type OracleVoteExtension struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Prices defines a map of id(CurrencyPair) -> price.Bytes() . i.e. 1 ->
+	// 0x123.. (bytes). Notice the `id` function is determined by the
+	// `CurrencyPairIDStrategy` used in the VoteExtensionHandler.
+	Prices map[uint64][]byte `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// OracleSignature is the signature of the OracleAttestation of the prices by
+	// the oracle key of the validator. It is only set if the validator has
+	// registered an oracle key.
+	OracleSignature []byte `protobuf:"bytes,2,opt,name=oracle_signature,json=oracleSignature,proto3" json:"oracle_signature,omitempty"`
+}
+
+func (x *OracleVoteExtension) Reset() {
+	*x = OracleVoteExtension{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_slinky_abci_v1_vote_extensions_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OracleVoteExtension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OracleVoteExtension) ProtoMessage() {}
+
+// Deprecated: Use OracleVoteExtension.ProtoReflect.Descriptor instead.
+func (*OracleVoteExtension) Descriptor() ([]byte, []int) {
+	return file_slinky_abci_v1_vote_extensions_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OracleVoteExtension) GetPrices() map[uint64][]byte {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *OracleVoteExtension) GetOracleSignature() []byte {
+	if x != nil {
+		return x.OracleSignature
+	}
+	return nil
+}
+
+// OracleAttestation is the payload signed by the oracle key of a validator.
+// Its protobuf encoding is the sign bytes of the oracle signature of a vote
+// extension.
+type OracleAttestation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ChainId is the ID of the chain the vote extension was created for.
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Height is the height the vote extension was created for.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Validator is the consensus address of the validator.
+	Validator []byte `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
+	// Prices are the prices of the vote extension, ordered by ID.
+	Prices []*OraclePrice `protobuf:"bytes,4,rep,name=prices,proto3" json:"prices,omitempty"`
+}
+
+func (x *OracleAttestation) Reset() {
+	*x = OracleAttestation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_slinky_abci_v1_vote_extensions_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OracleAttestation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OracleAttestation) ProtoMessage() {}
+
+// Deprecated: Use OracleAttestation.ProtoReflect.Descriptor instead.
+func (*OracleAttestation) Descriptor() ([]byte, []int) {
+	return file_slinky_abci_v1_vote_extensions_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OracleAttestation) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *OracleAttestation) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *OracleAttestation) GetValidator() []byte {
+	if x != nil {
+		return x.Validator
+	}
+	return nil
+}
+
+func (x *OracleAttestation) GetPrices() []*OraclePrice {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+// OraclePrice is a price of a vote extension.
+type OraclePrice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id is the ID of the currency pair, as determined by the
+	// CurrencyPairIDStrategy.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Price is the encoded price.
+	Price []byte `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (x *OraclePrice) Reset() {
+	*x = OraclePrice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_slinky_abci_v1_vote_extensions_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OraclePrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OraclePrice) ProtoMessage() {}
+
+// Deprecated: Use OraclePrice.ProtoReflect.Descriptor instead.
+func (*OraclePrice) Descriptor() ([]byte, []int) {
+	return file_slinky_abci_v1_vote_extensions_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OraclePrice) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OraclePrice) GetPrice() []byte {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+var File_slinky_abci_v1_vote_extensions_proto protoreflect.FileDescriptor
+
+var file_slinky_abci_v1_vote_extensions_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x61, 0x62, 0x63, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x61,
+	0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc4, 0x01, 0x0a,
+	0x13, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x61, 0x62,
+	0x63, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x56, 0x6f, 0x74, 0x65,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x29, 0x0a,
+	0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x39, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x9f, 0x01, 0x0a, 0x11, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x41, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x06, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x6c, 0x69,
+	0x6e, 0x6b, 0x79, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x73, 0x22, 0x33, 0x0a, 0x0b, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0xab, 0x01, 0x0a, 0x12, 0x63,
+	0x6f, 0x6d, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76,
+	0x31, 0x42, 0x13, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x6c, 0x69, 0x6e, 0x6b,
+	0x79, 0x2f, 0x61, 0x62, 0x63, 0x69, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x62, 0x63, 0x69, 0x76, 0x31,
+	0xa2, 0x02, 0x03, 0x53, 0x41, 0x58, 0xaa, 0x02, 0x0e, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e,
+	0x41, 0x62, 0x63, 0x69, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79,
+	0x5c, 0x41, 0x62, 0x63, 0x69, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x53, 0x6c, 0x69, 0x6e, 0x6b,
+	0x79, 0x5c, 0x41, 0x62, 0x63, 0x69, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x3a, 0x3a,
+	0x41, 0x62, 0x63, 0x69, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_slinky_abci_v1_vote_extensions_proto_rawDescOnce sync.Once
+	file_slinky_abci_v1_vote_extensions_proto_rawDescData = file_slinky_abci_v1_vote_extensions_proto_rawDesc
+)
+
+func file_slinky_abci_v1_vote_extensions_proto_rawDescGZIP() []byte {
+	file_slinky_abci_v1_vote_extensions_proto_rawDescOnce.Do(func() {
+		file_slinky_abci_v1_vote_extensions_proto_rawDescData = protoimpl.X.CompressGZIP(file_slinky_abci_v1_vote_extensions_proto_rawDescData)
+	})
+	return file_slinky_abci_v1_vote_extensions_proto_rawDescData
+}
+
+var file_slinky_abci_v1_vote_extensions_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_slinky_abci_v1_vote_extensions_proto_goTypes = []interface{}{
+	(*OracleVoteExtension)(nil), // 0: slinky.abci.v1.OracleVoteExtension
+	(*OracleAttestation)(nil),   // 1: slinky.abci.v1.OracleAttestation
+	(*OraclePrice)(nil),         // 2: slinky.abci.v1.OraclePrice
+	nil,                         // 3: slinky.abci.v1.OracleVoteExtension.PricesEntry
+}
+var file_slinky_abci_v1_vote_extensions_proto_depIdxs = []int32{
+	3, // 0: slinky.abci.v1.OracleVoteExtension.prices:type_name -> slinky.abci.v1.OracleVoteExtension.PricesEntry
+	2, // 1: slinky.abci.v1.OracleAttestation.prices:type_name -> slinky.abci.v1.OraclePrice
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_slinky_abci_v1_vote_extensions_proto_init() }
+func file_slinky_abci_v1_vote_extensions_proto_init() {
+	if File_slinky_abci_v1_vote_extensions_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_slinky_abci_v1_vote_extensions_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OracleVoteExtension); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_slinky_abci_v1_vote_extensions_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OracleAttestation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_slinky_abci_v1_vote_extensions_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OraclePrice); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -794,7 +2171,7 @@ func file_slinky_abci_v1_vote_extensions_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_slinky_abci_v1_vote_extensions_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
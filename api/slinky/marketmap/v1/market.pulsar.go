@@ -1237,12 +1237,65 @@ func (x *fastReflection_Ticker) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_ProviderConfig_5_list)(nil)
+
+type _ProviderConfig_5_list struct {
+	list *[]*v1.CurrencyPair
+}
+
+func (x *_ProviderConfig_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ProviderConfig_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ProviderConfig_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1.CurrencyPair)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ProviderConfig_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1.CurrencyPair)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ProviderConfig_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1.CurrencyPair)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ProviderConfig_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ProviderConfig_5_list) NewElement() protoreflect.Value {
+	v := new(v1.CurrencyPair)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ProviderConfig_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_ProviderConfig                   protoreflect.MessageDescriptor
 	fd_ProviderConfig_name              protoreflect.FieldDescriptor
 	fd_ProviderConfig_off_chain_ticker  protoreflect.FieldDescriptor
 	fd_ProviderConfig_normalize_by_pair protoreflect.FieldDescriptor
 	fd_ProviderConfig_invert            protoreflect.FieldDescriptor
+	fd_ProviderConfig_conversion_path   protoreflect.FieldDescriptor
+	fd_ProviderConfig_redemption_rate   protoreflect.FieldDescriptor
 	fd_ProviderConfig_metadata_JSON     protoreflect.FieldDescriptor
 )
 
@@ -1253,6 +1306,8 @@ func init() {
 	fd_ProviderConfig_off_chain_ticker = md_ProviderConfig.Fields().ByName("off_chain_ticker")
 	fd_ProviderConfig_normalize_by_pair = md_ProviderConfig.Fields().ByName("normalize_by_pair")
 	fd_ProviderConfig_invert = md_ProviderConfig.Fields().ByName("invert")
+	fd_ProviderConfig_conversion_path = md_ProviderConfig.Fields().ByName("conversion_path")
+	fd_ProviderConfig_redemption_rate = md_ProviderConfig.Fields().ByName("redemption_rate")
 	fd_ProviderConfig_metadata_JSON = md_ProviderConfig.Fields().ByName("metadata_JSON")
 }
 
@@ -1345,6 +1400,18 @@ func (x *fastReflection_ProviderConfig) Range(f func(protoreflect.FieldDescripto
 			return
 		}
 	}
+	if len(x.ConversionPath) != 0 {
+		value := protoreflect.ValueOfList(&_ProviderConfig_5_list{list: &x.ConversionPath})
+		if !f(fd_ProviderConfig_conversion_path, value) {
+			return
+		}
+	}
+	if x.RedemptionRate != nil {
+		value := protoreflect.ValueOfMessage(x.RedemptionRate.ProtoReflect())
+		if !f(fd_ProviderConfig_redemption_rate, value) {
+			return
+		}
+	}
 	if x.Metadata_JSON != "" {
 		value := protoreflect.ValueOfString(x.Metadata_JSON)
 		if !f(fd_ProviderConfig_metadata_JSON, value) {
@@ -1374,6 +1441,10 @@ func (x *fastReflection_ProviderConfig) Has(fd protoreflect.FieldDescriptor) boo
 		return x.NormalizeByPair != nil
 	case "slinky.marketmap.v1.ProviderConfig.invert":
 		return x.Invert != false
+	case "slinky.marketmap.v1.ProviderConfig.conversion_path":
+		return len(x.ConversionPath) != 0
+	case "slinky.marketmap.v1.ProviderConfig.redemption_rate":
+		return x.RedemptionRate != nil
 	case "slinky.marketmap.v1.ProviderConfig.metadata_JSON":
 		return x.Metadata_JSON != ""
 	default:
@@ -1400,13 +1471,816 @@ func (x *fastReflection_ProviderConfig) Clear(fd protoreflect.FieldDescriptor) {
 		x.NormalizeByPair = nil
 	case "slinky.marketmap.v1.ProviderConfig.invert":
 		x.Invert = false
+	case "slinky.marketmap.v1.ProviderConfig.conversion_path":
+		x.ConversionPath = nil
+	case "slinky.marketmap.v1.ProviderConfig.redemption_rate":
+		x.RedemptionRate = nil
 	case "slinky.marketmap.v1.ProviderConfig.metadata_JSON":
 		x.Metadata_JSON = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.ProviderConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.ProviderConfig"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.ProviderConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ProviderConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "slinky.marketmap.v1.ProviderConfig.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "slinky.marketmap.v1.ProviderConfig.off_chain_ticker":
+		value := x.OffChainTicker
+		return protoreflect.ValueOfString(value)
+	case "slinky.marketmap.v1.ProviderConfig.normalize_by_pair":
+		value := x.NormalizeByPair
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "slinky.marketmap.v1.ProviderConfig.invert":
+		value := x.Invert
+		return protoreflect.ValueOfBool(value)
+	case "slinky.marketmap.v1.ProviderConfig.conversion_path":
+		if len(x.ConversionPath) == 0 {
+			return protoreflect.ValueOfList(&_ProviderConfig_5_list{})
+		}
+		listValue := &_ProviderConfig_5_list{list: &x.ConversionPath}
+		return protoreflect.ValueOfList(listValue)
+	case "slinky.marketmap.v1.ProviderConfig.redemption_rate":
+		value := x.RedemptionRate
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "slinky.marketmap.v1.ProviderConfig.metadata_JSON":
+		value := x.Metadata_JSON
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.ProviderConfig"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.ProviderConfig does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProviderConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.ProviderConfig.name":
+		x.Name = value.Interface().(string)
+	case "slinky.marketmap.v1.ProviderConfig.off_chain_ticker":
+		x.OffChainTicker = value.Interface().(string)
+	case "slinky.marketmap.v1.ProviderConfig.normalize_by_pair":
+		x.NormalizeByPair = value.Message().Interface().(*v1.CurrencyPair)
+	case "slinky.marketmap.v1.ProviderConfig.invert":
+		x.Invert = value.Bool()
+	case "slinky.marketmap.v1.ProviderConfig.conversion_path":
+		lv := value.List()
+		clv := lv.(*_ProviderConfig_5_list)
+		x.ConversionPath = *clv.list
+	case "slinky.marketmap.v1.ProviderConfig.redemption_rate":
+		x.RedemptionRate = value.Message().Interface().(*RedemptionRate)
+	case "slinky.marketmap.v1.ProviderConfig.metadata_JSON":
+		x.Metadata_JSON = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.ProviderConfig"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.ProviderConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProviderConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.ProviderConfig.normalize_by_pair":
+		if x.NormalizeByPair == nil {
+			x.NormalizeByPair = new(v1.CurrencyPair)
+		}
+		return protoreflect.ValueOfMessage(x.NormalizeByPair.ProtoReflect())
+	case "slinky.marketmap.v1.ProviderConfig.conversion_path":
+		if x.ConversionPath == nil {
+			x.ConversionPath = []*v1.CurrencyPair{}
+		}
+		value := &_ProviderConfig_5_list{list: &x.ConversionPath}
+		return protoreflect.ValueOfList(value)
+	case "slinky.marketmap.v1.ProviderConfig.redemption_rate":
+		if x.RedemptionRate == nil {
+			x.RedemptionRate = new(RedemptionRate)
+		}
+		return protoreflect.ValueOfMessage(x.RedemptionRate.ProtoReflect())
+	case "slinky.marketmap.v1.ProviderConfig.name":
+		panic(fmt.Errorf("field name of message slinky.marketmap.v1.ProviderConfig is not mutable"))
+	case "slinky.marketmap.v1.ProviderConfig.off_chain_ticker":
+		panic(fmt.Errorf("field off_chain_ticker of message slinky.marketmap.v1.ProviderConfig is not mutable"))
+	case "slinky.marketmap.v1.ProviderConfig.invert":
+		panic(fmt.Errorf("field invert of message slinky.marketmap.v1.ProviderConfig is not mutable"))
+	case "slinky.marketmap.v1.ProviderConfig.metadata_JSON":
+		panic(fmt.Errorf("field metadata_JSON of message slinky.marketmap.v1.ProviderConfig is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.ProviderConfig"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.ProviderConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ProviderConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.ProviderConfig.name":
+		return protoreflect.ValueOfString("")
+	case "slinky.marketmap.v1.ProviderConfig.off_chain_ticker":
+		return protoreflect.ValueOfString("")
+	case "slinky.marketmap.v1.ProviderConfig.normalize_by_pair":
+		m := new(v1.CurrencyPair)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "slinky.marketmap.v1.ProviderConfig.invert":
+		return protoreflect.ValueOfBool(false)
+	case "slinky.marketmap.v1.ProviderConfig.conversion_path":
+		list := []*v1.CurrencyPair{}
+		return protoreflect.ValueOfList(&_ProviderConfig_5_list{list: &list})
+	case "slinky.marketmap.v1.ProviderConfig.redemption_rate":
+		m := new(RedemptionRate)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "slinky.marketmap.v1.ProviderConfig.metadata_JSON":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.ProviderConfig"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.ProviderConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ProviderConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in slinky.marketmap.v1.ProviderConfig", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ProviderConfig) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProviderConfig) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ProviderConfig) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ProviderConfig) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ProviderConfig)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OffChainTicker)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NormalizeByPair != nil {
+			l = options.Size(x.NormalizeByPair)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Invert {
+			n += 2
+		}
+		if len(x.ConversionPath) > 0 {
+			for _, e := range x.ConversionPath {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.RedemptionRate != nil {
+			l = options.Size(x.RedemptionRate)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Metadata_JSON)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ProviderConfig)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Metadata_JSON) > 0 {
+			i -= len(x.Metadata_JSON)
+			copy(dAtA[i:], x.Metadata_JSON)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata_JSON)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if x.RedemptionRate != nil {
+			encoded, err := options.Marshal(x.RedemptionRate)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.ConversionPath) > 0 {
+			for iNdEx := len(x.ConversionPath) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ConversionPath[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.Invert {
+			i--
+			if x.Invert {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.NormalizeByPair != nil {
+			encoded, err := options.Marshal(x.NormalizeByPair)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.OffChainTicker) > 0 {
+			i -= len(x.OffChainTicker)
+			copy(dAtA[i:], x.OffChainTicker)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OffChainTicker)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ProviderConfig)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProviderConfig: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProviderConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OffChainTicker", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OffChainTicker = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NormalizeByPair", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.NormalizeByPair == nil {
+					x.NormalizeByPair = &v1.CurrencyPair{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NormalizeByPair); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Invert", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Invert = bool(v != 0)
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConversionPath", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ConversionPath = append(x.ConversionPath, &v1.CurrencyPair{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ConversionPath[len(x.ConversionPath)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RedemptionRate", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RedemptionRate == nil {
+					x.RedemptionRate = &RedemptionRate{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RedemptionRate); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata_JSON", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Metadata_JSON = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RedemptionRate               protoreflect.MessageDescriptor
+	fd_RedemptionRate_rate          protoreflect.FieldDescriptor
+	fd_RedemptionRate_market_rate   protoreflect.FieldDescriptor
+	fd_RedemptionRate_max_deviation protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_slinky_marketmap_v1_market_proto_init()
+	md_RedemptionRate = File_slinky_marketmap_v1_market_proto.Messages().ByName("RedemptionRate")
+	fd_RedemptionRate_rate = md_RedemptionRate.Fields().ByName("rate")
+	fd_RedemptionRate_market_rate = md_RedemptionRate.Fields().ByName("market_rate")
+	fd_RedemptionRate_max_deviation = md_RedemptionRate.Fields().ByName("max_deviation")
+}
+
+var _ protoreflect.Message = (*fastReflection_RedemptionRate)(nil)
+
+type fastReflection_RedemptionRate RedemptionRate
+
+func (x *RedemptionRate) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RedemptionRate)(x)
+}
+
+func (x *RedemptionRate) slowProtoReflect() protoreflect.Message {
+	mi := &file_slinky_marketmap_v1_market_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RedemptionRate_messageType fastReflection_RedemptionRate_messageType
+var _ protoreflect.MessageType = fastReflection_RedemptionRate_messageType{}
+
+type fastReflection_RedemptionRate_messageType struct{}
+
+func (x fastReflection_RedemptionRate_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RedemptionRate)(nil)
+}
+func (x fastReflection_RedemptionRate_messageType) New() protoreflect.Message {
+	return new(fastReflection_RedemptionRate)
+}
+func (x fastReflection_RedemptionRate_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RedemptionRate
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RedemptionRate) Descriptor() protoreflect.MessageDescriptor {
+	return md_RedemptionRate
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RedemptionRate) Type() protoreflect.MessageType {
+	return _fastReflection_RedemptionRate_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RedemptionRate) New() protoreflect.Message {
+	return new(fastReflection_RedemptionRate)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RedemptionRate) Interface() protoreflect.ProtoMessage {
+	return (*RedemptionRate)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RedemptionRate) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Rate != nil {
+		value := protoreflect.ValueOfMessage(x.Rate.ProtoReflect())
+		if !f(fd_RedemptionRate_rate, value) {
+			return
+		}
+	}
+	if x.MarketRate != nil {
+		value := protoreflect.ValueOfMessage(x.MarketRate.ProtoReflect())
+		if !f(fd_RedemptionRate_market_rate, value) {
+			return
+		}
+	}
+	if x.MaxDeviation != "" {
+		value := protoreflect.ValueOfString(x.MaxDeviation)
+		if !f(fd_RedemptionRate_max_deviation, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RedemptionRate) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.RedemptionRate.rate":
+		return x.Rate != nil
+	case "slinky.marketmap.v1.RedemptionRate.market_rate":
+		return x.MarketRate != nil
+	case "slinky.marketmap.v1.RedemptionRate.max_deviation":
+		return x.MaxDeviation != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.RedemptionRate"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.RedemptionRate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RedemptionRate) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.RedemptionRate.rate":
+		x.Rate = nil
+	case "slinky.marketmap.v1.RedemptionRate.market_rate":
+		x.MarketRate = nil
+	case "slinky.marketmap.v1.RedemptionRate.max_deviation":
+		x.MaxDeviation = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.RedemptionRate"))
 		}
-		panic(fmt.Errorf("message slinky.marketmap.v1.ProviderConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.marketmap.v1.RedemptionRate does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1416,28 +2290,22 @@ func (x *fastReflection_ProviderConfig) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ProviderConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RedemptionRate) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "slinky.marketmap.v1.ProviderConfig.name":
-		value := x.Name
-		return protoreflect.ValueOfString(value)
-	case "slinky.marketmap.v1.ProviderConfig.off_chain_ticker":
-		value := x.OffChainTicker
-		return protoreflect.ValueOfString(value)
-	case "slinky.marketmap.v1.ProviderConfig.normalize_by_pair":
-		value := x.NormalizeByPair
+	case "slinky.marketmap.v1.RedemptionRate.rate":
+		value := x.Rate
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "slinky.marketmap.v1.ProviderConfig.invert":
-		value := x.Invert
-		return protoreflect.ValueOfBool(value)
-	case "slinky.marketmap.v1.ProviderConfig.metadata_JSON":
-		value := x.Metadata_JSON
+	case "slinky.marketmap.v1.RedemptionRate.market_rate":
+		value := x.MarketRate
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "slinky.marketmap.v1.RedemptionRate.max_deviation":
+		value := x.MaxDeviation
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.ProviderConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.RedemptionRate"))
 		}
-		panic(fmt.Errorf("message slinky.marketmap.v1.ProviderConfig does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message slinky.marketmap.v1.RedemptionRate does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1451,23 +2319,19 @@ func (x *fastReflection_ProviderConfig) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ProviderConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_RedemptionRate) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "slinky.marketmap.v1.ProviderConfig.name":
-		x.Name = value.Interface().(string)
-	case "slinky.marketmap.v1.ProviderConfig.off_chain_ticker":
-		x.OffChainTicker = value.Interface().(string)
-	case "slinky.marketmap.v1.ProviderConfig.normalize_by_pair":
-		x.NormalizeByPair = value.Message().Interface().(*v1.CurrencyPair)
-	case "slinky.marketmap.v1.ProviderConfig.invert":
-		x.Invert = value.Bool()
-	case "slinky.marketmap.v1.ProviderConfig.metadata_JSON":
-		x.Metadata_JSON = value.Interface().(string)
+	case "slinky.marketmap.v1.RedemptionRate.rate":
+		x.Rate = value.Message().Interface().(*ProviderConfig)
+	case "slinky.marketmap.v1.RedemptionRate.market_rate":
+		x.MarketRate = value.Message().Interface().(*ProviderConfig)
+	case "slinky.marketmap.v1.RedemptionRate.max_deviation":
+		x.MaxDeviation = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.ProviderConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.RedemptionRate"))
 		}
-		panic(fmt.Errorf("message slinky.marketmap.v1.ProviderConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.marketmap.v1.RedemptionRate does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1481,60 +2345,56 @@ func (x *fastReflection_ProviderConfig) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ProviderConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RedemptionRate) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "slinky.marketmap.v1.ProviderConfig.normalize_by_pair":
-		if x.NormalizeByPair == nil {
-			x.NormalizeByPair = new(v1.CurrencyPair)
-		}
-		return protoreflect.ValueOfMessage(x.NormalizeByPair.ProtoReflect())
-	case "slinky.marketmap.v1.ProviderConfig.name":
-		panic(fmt.Errorf("field name of message slinky.marketmap.v1.ProviderConfig is not mutable"))
-	case "slinky.marketmap.v1.ProviderConfig.off_chain_ticker":
-		panic(fmt.Errorf("field off_chain_ticker of message slinky.marketmap.v1.ProviderConfig is not mutable"))
-	case "slinky.marketmap.v1.ProviderConfig.invert":
-		panic(fmt.Errorf("field invert of message slinky.marketmap.v1.ProviderConfig is not mutable"))
-	case "slinky.marketmap.v1.ProviderConfig.metadata_JSON":
-		panic(fmt.Errorf("field metadata_JSON of message slinky.marketmap.v1.ProviderConfig is not mutable"))
+	case "slinky.marketmap.v1.RedemptionRate.rate":
+		if x.Rate == nil {
+			x.Rate = new(ProviderConfig)
+		}
+		return protoreflect.ValueOfMessage(x.Rate.ProtoReflect())
+	case "slinky.marketmap.v1.RedemptionRate.market_rate":
+		if x.MarketRate == nil {
+			x.MarketRate = new(ProviderConfig)
+		}
+		return protoreflect.ValueOfMessage(x.MarketRate.ProtoReflect())
+	case "slinky.marketmap.v1.RedemptionRate.max_deviation":
+		panic(fmt.Errorf("field max_deviation of message slinky.marketmap.v1.RedemptionRate is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.ProviderConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.RedemptionRate"))
 		}
-		panic(fmt.Errorf("message slinky.marketmap.v1.ProviderConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.marketmap.v1.RedemptionRate does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ProviderConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RedemptionRate) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "slinky.marketmap.v1.ProviderConfig.name":
-		return protoreflect.ValueOfString("")
-	case "slinky.marketmap.v1.ProviderConfig.off_chain_ticker":
-		return protoreflect.ValueOfString("")
-	case "slinky.marketmap.v1.ProviderConfig.normalize_by_pair":
-		m := new(v1.CurrencyPair)
+	case "slinky.marketmap.v1.RedemptionRate.rate":
+		m := new(ProviderConfig)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "slinky.marketmap.v1.ProviderConfig.invert":
-		return protoreflect.ValueOfBool(false)
-	case "slinky.marketmap.v1.ProviderConfig.metadata_JSON":
+	case "slinky.marketmap.v1.RedemptionRate.market_rate":
+		m := new(ProviderConfig)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "slinky.marketmap.v1.RedemptionRate.max_deviation":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.ProviderConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.RedemptionRate"))
 		}
-		panic(fmt.Errorf("message slinky.marketmap.v1.ProviderConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.marketmap.v1.RedemptionRate does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ProviderConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_RedemptionRate) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in slinky.marketmap.v1.ProviderConfig", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in slinky.marketmap.v1.RedemptionRate", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1542,7 +2402,7 @@ func (x *fastReflection_ProviderConfig) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ProviderConfig) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_RedemptionRate) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1553,7 +2413,7 @@ func (x *fastReflection_ProviderConfig) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ProviderConfig) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_RedemptionRate) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1565,7 +2425,7 @@ func (x *fastReflection_ProviderConfig) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ProviderConfig) IsValid() bool {
+func (x *fastReflection_RedemptionRate) IsValid() bool {
 	return x != nil
 }
 
@@ -1575,9 +2435,9 @@ func (x *fastReflection_ProviderConfig) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ProviderConfig) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_RedemptionRate) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ProviderConfig)
+		x := input.Message.Interface().(*RedemptionRate)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1589,22 +2449,15 @@ func (x *fastReflection_ProviderConfig) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Name)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.OffChainTicker)
-		if l > 0 {
+		if x.Rate != nil {
+			l = options.Size(x.Rate)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.NormalizeByPair != nil {
-			l = options.Size(x.NormalizeByPair)
+		if x.MarketRate != nil {
+			l = options.Size(x.MarketRate)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Invert {
-			n += 2
-		}
-		l = len(x.Metadata_JSON)
+		l = len(x.MaxDeviation)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -1618,7 +2471,7 @@ func (x *fastReflection_ProviderConfig) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ProviderConfig)
+		x := input.Message.Interface().(*RedemptionRate)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1637,25 +2490,15 @@ func (x *fastReflection_ProviderConfig) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Metadata_JSON) > 0 {
-			i -= len(x.Metadata_JSON)
-			copy(dAtA[i:], x.Metadata_JSON)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata_JSON)))
-			i--
-			dAtA[i] = 0x7a
-		}
-		if x.Invert {
-			i--
-			if x.Invert {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
+		if len(x.MaxDeviation) > 0 {
+			i -= len(x.MaxDeviation)
+			copy(dAtA[i:], x.MaxDeviation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxDeviation)))
 			i--
-			dAtA[i] = 0x20
+			dAtA[i] = 0x1a
 		}
-		if x.NormalizeByPair != nil {
-			encoded, err := options.Marshal(x.NormalizeByPair)
+		if x.MarketRate != nil {
+			encoded, err := options.Marshal(x.MarketRate)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1666,19 +2509,19 @@ func (x *fastReflection_ProviderConfig) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.OffChainTicker) > 0 {
-			i -= len(x.OffChainTicker)
-			copy(dAtA[i:], x.OffChainTicker)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OffChainTicker)))
-			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Name) > 0 {
-			i -= len(x.Name)
-			copy(dAtA[i:], x.Name)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+		if x.Rate != nil {
+			encoded, err := options.Marshal(x.Rate)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -1693,7 +2536,7 @@ func (x *fastReflection_ProviderConfig) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ProviderConfig)
+		x := input.Message.Interface().(*RedemptionRate)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1725,17 +2568,17 @@ func (x *fastReflection_ProviderConfig) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProviderConfig: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RedemptionRate: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProviderConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RedemptionRate: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1745,59 +2588,31 @@ func (x *fastReflection_ProviderConfig) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Name = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OffChainTicker", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if x.Rate == nil {
+					x.Rate = &ProviderConfig{}
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rate); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.OffChainTicker = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NormalizeByPair", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarketRate", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1824,36 +2639,16 @@ func (x *fastReflection_ProviderConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.NormalizeByPair == nil {
-					x.NormalizeByPair = &v1.CurrencyPair{}
+				if x.MarketRate == nil {
+					x.MarketRate = &ProviderConfig{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NormalizeByPair); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MarketRate); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Invert", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.Invert = bool(v != 0)
-			case 15:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata_JSON", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDeviation", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1881,7 +2676,7 @@ func (x *fastReflection_ProviderConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Metadata_JSON = string(dAtA[iNdEx:postIndex])
+				x.MaxDeviation = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -2028,7 +2823,7 @@ func (x *MarketMap) ProtoReflect() protoreflect.Message {
 }
 
 func (x *MarketMap) slowProtoReflect() protoreflect.Message {
-	mi := &file_slinky_marketmap_v1_market_proto_msgTypes[3]
+	mi := &file_slinky_marketmap_v1_market_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2760,6 +3555,19 @@ type ProviderConfig struct {
 	// Invert is a boolean indicating if the BASE and QUOTE of the market should
 	// be inverted. i.e. BASE -> QUOTE, QUOTE -> BASE
 	Invert bool `protobuf:"varint,4,opt,name=invert,proto3" json:"invert,omitempty"`
+	// ConversionPath is the ordered list of currency pairs for this ticker to be
+	// converted through. The price of the OffChainTicker is multiplied by the
+	// index price of each pair in order. For example, if the desired Ticker is
+	// FOO/USD, this market could be reached using: OffChainTicker = FOO/ETH
+	// ConversionPath = [ETH/BTC, BTC/USD]. This field is optional, and cannot be
+	// set together with NormalizeByPair.
+	ConversionPath []*v1.CurrencyPair `protobuf:"bytes,5,rep,name=conversion_path,json=conversionPath,proto3" json:"conversion_path,omitempty"`
+	// RedemptionRate is the (optional) redemption rate feed of a liquid staking
+	// token. If set, the price of the OffChainTicker, which is the price of the
+	// underlying asset, is multiplied by the redemption rate i.e. the price of
+	// stETH/USD is reached using: OffChainTicker = ETH/USD RedemptionRate =
+	// stETH -> ETH exchange rate.
+	RedemptionRate *RedemptionRate `protobuf:"bytes,6,opt,name=redemption_rate,json=redemptionRate,proto3" json:"redemption_rate,omitempty"`
 	// MetadataJSON is a string of JSON that encodes any extra configuration
 	// for the given provider config.
 	Metadata_JSON string `protobuf:"bytes,15,opt,name=metadata_JSON,json=metadataJSON,proto3" json:"metadata_JSON,omitempty"`
@@ -2813,6 +3621,20 @@ func (x *ProviderConfig) GetInvert() bool {
 	return false
 }
 
+func (x *ProviderConfig) GetConversionPath() []*v1.CurrencyPair {
+	if x != nil {
+		return x.ConversionPath
+	}
+	return nil
+}
+
+func (x *ProviderConfig) GetRedemptionRate() *RedemptionRate {
+	if x != nil {
+		return x.RedemptionRate
+	}
+	return nil
+}
+
 func (x *ProviderConfig) GetMetadata_JSON() string {
 	if x != nil {
 		return x.Metadata_JSON
@@ -2820,6 +3642,68 @@ func (x *ProviderConfig) GetMetadata_JSON() string {
 	return ""
 }
 
+// RedemptionRate is the redemption rate feed of a liquid staking token, i.e.
+// the amount of the underlying asset that one unit of the token can be redeemed
+// for.
+type RedemptionRate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Rate is the provider config of the on-chain feed that reports the
+	// redemption rate i.e. an ERC-4626 vault or a Cosmos LST host zone.
+	Rate *ProviderConfig `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	// MarketRate is the (optional) provider config of the feed that reports the
+	// market price of the token in units of the underlying asset i.e. a DEX pool.
+	// If set, it is used as a sanity check of the redemption rate.
+	MarketRate *ProviderConfig `protobuf:"bytes,2,opt,name=market_rate,json=marketRate,proto3" json:"market_rate,omitempty"`
+	// MaxDeviation is the maximum relative deviation, as a decimal string i.e.
+	// "0.05", of the market rate from the redemption rate. It must be set iff
+	// MarketRate is set.
+	MaxDeviation string `protobuf:"bytes,3,opt,name=max_deviation,json=maxDeviation,proto3" json:"max_deviation,omitempty"`
+}
+
+func (x *RedemptionRate) Reset() {
+	*x = RedemptionRate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_slinky_marketmap_v1_market_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedemptionRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedemptionRate) ProtoMessage() {}
+
+// Deprecated: Use RedemptionRate.ProtoReflect.Descriptor instead.
+func (*RedemptionRate) Descriptor() ([]byte, []int) {
+	return file_slinky_marketmap_v1_market_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RedemptionRate) GetRate() *ProviderConfig {
+	if x != nil {
+		return x.Rate
+	}
+	return nil
+}
+
+func (x *RedemptionRate) GetMarketRate() *ProviderConfig {
+	if x != nil {
+		return x.MarketRate
+	}
+	return nil
+}
+
+func (x *RedemptionRate) GetMaxDeviation() string {
+	if x != nil {
+		return x.MaxDeviation
+	}
+	return ""
+}
+
 // MarketMap maps ticker strings to their Markets.
 type MarketMap struct {
 	state         protoimpl.MessageState
@@ -2834,7 +3718,7 @@ type MarketMap struct {
 func (x *MarketMap) Reset() {
 	*x = MarketMap{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_slinky_marketmap_v1_market_proto_msgTypes[3]
+		mi := &file_slinky_marketmap_v1_market_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2848,7 +3732,7 @@ func (*MarketMap) ProtoMessage() {}
 
 // Deprecated: Use MarketMap.ProtoReflect.Descriptor instead.
 func (*MarketMap) Descriptor() ([]byte, []int) {
-	return file_slinky_marketmap_v1_market_proto_rawDescGZIP(), []int{3}
+	return file_slinky_marketmap_v1_market_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *MarketMap) GetMarkets() map[string]*Market {
@@ -2893,7 +3777,7 @@ var file_slinky_marketmap_v1_market_proto_rawDesc = []byte{
 	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x4a, 0x53,
 	0x4f, 0x4e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
 	0x74, 0x61, 0x4a, 0x53, 0x4f, 0x4e, 0x3a, 0x08, 0x98, 0xa0, 0x1f, 0x00, 0x80, 0xdc, 0x20, 0x00,
-	0x22, 0xd6, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x22, 0xf2, 0x02, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e,
 	0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x66, 0x66, 0x5f, 0x63,
 	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
@@ -2904,34 +3788,56 @@ var file_slinky_marketmap_v1_market_proto_rawDesc = []byte{
 	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52, 0x0f, 0x6e, 0x6f, 0x72,
 	0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x50, 0x61, 0x69, 0x72, 0x12, 0x16, 0x0a, 0x06,
 	0x69, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x6e,
-	0x76, 0x65, 0x72, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0xbb, 0x01, 0x0a, 0x09, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x12, 0x4b, 0x0a, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b,
-	0x79, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x73, 0x1a, 0x57, 0x0a, 0x0c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x08, 0x98,
-	0xa0, 0x1f, 0x00, 0x80, 0xdc, 0x20, 0x00, 0x42, 0xc6, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e,
-	0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70,
-	0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x6d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d,
-	0x61, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x4d, 0x58, 0xaa, 0x02, 0x13, 0x53, 0x6c, 0x69,
-	0x6e, 0x6b, 0x79, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x56, 0x31,
-	0xca, 0x02, 0x13, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x6d, 0x61, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c,
-	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x53, 0x6c, 0x69, 0x6e, 0x6b,
-	0x79, 0x3a, 0x3a, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x3a, 0x3a, 0x56, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x76, 0x65, 0x72, 0x74, 0x12, 0x4c, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x4c, 0x0a, 0x0f, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x6c,
+	0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65,
+	0x52, 0x0e, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x4a, 0x53, 0x4f,
+	0x4e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0xba, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73,
+	0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x0a, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0xbb, 0x01, 0x0a, 0x09, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x70,
+	0x12, 0x4b, 0x0a, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61,
+	0x70, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x1a, 0x57, 0x0a,
+	0x0c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x08, 0x98, 0xa0, 0x1f, 0x00, 0x80, 0xdc, 0x20, 0x00,
+	0x42, 0xc6, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x6c,
+	0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76,
+	0x31, 0x3b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x03,
+	0x53, 0x4d, 0x58, 0xaa, 0x02, 0x13, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x53, 0x6c, 0x69, 0x6e,
+	0x6b, 0x79, 0x5c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x5c, 0x56, 0x31, 0xe2,
+	0x02, 0x1f, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d,
+	0x61, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x15, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x3a, 0x3a, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x6d, 0x61, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -2946,27 +3852,32 @@ func file_slinky_marketmap_v1_market_proto_rawDescGZIP() []byte {
 	return file_slinky_marketmap_v1_market_proto_rawDescData
 }
 
-var file_slinky_marketmap_v1_market_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_slinky_marketmap_v1_market_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_slinky_marketmap_v1_market_proto_goTypes = []interface{}{
 	(*Market)(nil),          // 0: slinky.marketmap.v1.Market
 	(*Ticker)(nil),          // 1: slinky.marketmap.v1.Ticker
 	(*ProviderConfig)(nil),  // 2: slinky.marketmap.v1.ProviderConfig
-	(*MarketMap)(nil),       // 3: slinky.marketmap.v1.MarketMap
-	nil,                     // 4: slinky.marketmap.v1.MarketMap.MarketsEntry
-	(*v1.CurrencyPair)(nil), // 5: slinky.types.v1.CurrencyPair
+	(*RedemptionRate)(nil),  // 3: slinky.marketmap.v1.RedemptionRate
+	(*MarketMap)(nil),       // 4: slinky.marketmap.v1.MarketMap
+	nil,                     // 5: slinky.marketmap.v1.MarketMap.MarketsEntry
+	(*v1.CurrencyPair)(nil), // 6: slinky.types.v1.CurrencyPair
 }
 var file_slinky_marketmap_v1_market_proto_depIdxs = []int32{
-	1, // 0: slinky.marketmap.v1.Market.ticker:type_name -> slinky.marketmap.v1.Ticker
-	2, // 1: slinky.marketmap.v1.Market.provider_configs:type_name -> slinky.marketmap.v1.ProviderConfig
-	5, // 2: slinky.marketmap.v1.Ticker.currency_pair:type_name -> slinky.types.v1.CurrencyPair
-	5, // 3: slinky.marketmap.v1.ProviderConfig.normalize_by_pair:type_name -> slinky.types.v1.CurrencyPair
-	4, // 4: slinky.marketmap.v1.MarketMap.markets:type_name -> slinky.marketmap.v1.MarketMap.MarketsEntry
-	0, // 5: slinky.marketmap.v1.MarketMap.MarketsEntry.value:type_name -> slinky.marketmap.v1.Market
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	1,  // 0: slinky.marketmap.v1.Market.ticker:type_name -> slinky.marketmap.v1.Ticker
+	2,  // 1: slinky.marketmap.v1.Market.provider_configs:type_name -> slinky.marketmap.v1.ProviderConfig
+	6,  // 2: slinky.marketmap.v1.Ticker.currency_pair:type_name -> slinky.types.v1.CurrencyPair
+	6,  // 3: slinky.marketmap.v1.ProviderConfig.normalize_by_pair:type_name -> slinky.types.v1.CurrencyPair
+	6,  // 4: slinky.marketmap.v1.ProviderConfig.conversion_path:type_name -> slinky.types.v1.CurrencyPair
+	3,  // 5: slinky.marketmap.v1.ProviderConfig.redemption_rate:type_name -> slinky.marketmap.v1.RedemptionRate
+	2,  // 6: slinky.marketmap.v1.RedemptionRate.rate:type_name -> slinky.marketmap.v1.ProviderConfig
+	2,  // 7: slinky.marketmap.v1.RedemptionRate.market_rate:type_name -> slinky.marketmap.v1.ProviderConfig
+	5,  // 8: slinky.marketmap.v1.MarketMap.markets:type_name -> slinky.marketmap.v1.MarketMap.MarketsEntry
+	0,  // 9: slinky.marketmap.v1.MarketMap.MarketsEntry.value:type_name -> slinky.marketmap.v1.Market
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_slinky_marketmap_v1_market_proto_init() }
@@ -3012,6 +3923,18 @@ func file_slinky_marketmap_v1_market_proto_init() {
 			}
 		}
 		file_slinky_marketmap_v1_market_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedemptionRate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_slinky_marketmap_v1_market_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MarketMap); i {
 			case 0:
 				return &v.state
@@ -3030,7 +3953,7 @@ func file_slinky_marketmap_v1_market_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_slinky_marketmap_v1_market_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -24,6 +24,14 @@ const (
 	Query_GetPrices_FullMethodName                  = "/slinky.oracle.v1.Query/GetPrices"
 	Query_GetCurrencyPairMapping_FullMethodName     = "/slinky.oracle.v1.Query/GetCurrencyPairMapping"
 	Query_GetCurrencyPairMappingList_FullMethodName = "/slinky.oracle.v1.Query/GetCurrencyPairMappingList"
+	Query_GetPriceHistory_FullMethodName            = "/slinky.oracle.v1.Query/GetPriceHistory"
+	Query_GetTWAP_FullMethodName                    = "/slinky.oracle.v1.Query/GetTWAP"
+	Query_GetMedianOverWindow_FullMethodName        = "/slinky.oracle.v1.Query/GetMedianOverWindow"
+	Query_GetPriceStatus_FullMethodName             = "/slinky.oracle.v1.Query/GetPriceStatus"
+	Query_GetValidatorPerformance_FullMethodName    = "/slinky.oracle.v1.Query/GetValidatorPerformance"
+	Query_GetValidatorPerformances_FullMethodName   = "/slinky.oracle.v1.Query/GetValidatorPerformances"
+	Query_GetOracleKey_FullMethodName               = "/slinky.oracle.v1.Query/GetOracleKey"
+	Query_Params_FullMethodName                     = "/slinky.oracle.v1.Query/Params"
 )
 
 // QueryClient is the client API for Query service.
@@ -46,6 +54,29 @@ type QueryClient interface {
 	// useful for indexers that have access to the ID of a currency pair, but no
 	// way to get the underlying currency pair from it.
 	GetCurrencyPairMappingList(ctx context.Context, in *GetCurrencyPairMappingListRequest, opts ...grpc.CallOption) (*GetCurrencyPairMappingListResponse, error)
+	// Given a CurrencyPair, return the most recent QuotePrices stored for that
+	// CurrencyPair, ordered from oldest to newest.
+	GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error)
+	// Given a CurrencyPair and a window, return the time-weighted average of
+	// the stored QuotePrices for that CurrencyPair over the window.
+	GetTWAP(ctx context.Context, in *GetTWAPRequest, opts ...grpc.CallOption) (*GetTWAPResponse, error)
+	// Given a CurrencyPair and a window, return the median of the stored
+	// QuotePrices for that CurrencyPair over the window.
+	GetMedianOverWindow(ctx context.Context, in *GetMedianOverWindowRequest, opts ...grpc.CallOption) (*GetMedianOverWindowResponse, error)
+	// Given a CurrencyPair, return its price guardrail and whether its price is
+	// stale or halted.
+	GetPriceStatus(ctx context.Context, in *GetPriceStatusRequest, opts ...grpc.CallOption) (*GetPriceStatusResponse, error)
+	// Given a validator consensus address and a performance window, return the
+	// oracle performance of the validator over the window.
+	GetValidatorPerformance(ctx context.Context, in *GetValidatorPerformanceRequest, opts ...grpc.CallOption) (*GetValidatorPerformanceResponse, error)
+	// Given a performance window, return the oracle performance of all
+	// validators over the window.
+	GetValidatorPerformances(ctx context.Context, in *GetValidatorPerformancesRequest, opts ...grpc.CallOption) (*GetValidatorPerformancesResponse, error)
+	// Given a validator consensus address, return the oracle key registered by
+	// the validator.
+	GetOracleKey(ctx context.Context, in *GetOracleKeyRequest, opts ...grpc.CallOption) (*GetOracleKeyResponse, error)
+	// Params returns the current x/oracle module parameters.
+	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
 }
 
 type queryClient struct {
@@ -106,6 +137,86 @@ func (c *queryClient) GetCurrencyPairMappingList(ctx context.Context, in *GetCur
 	return out, nil
 }
 
+func (c *queryClient) GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_GetPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTWAP(ctx context.Context, in *GetTWAPRequest, opts ...grpc.CallOption) (*GetTWAPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTWAPResponse)
+	err := c.cc.Invoke(ctx, Query_GetTWAP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetMedianOverWindow(ctx context.Context, in *GetMedianOverWindowRequest, opts ...grpc.CallOption) (*GetMedianOverWindowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMedianOverWindowResponse)
+	err := c.cc.Invoke(ctx, Query_GetMedianOverWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPriceStatus(ctx context.Context, in *GetPriceStatusRequest, opts ...grpc.CallOption) (*GetPriceStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPriceStatusResponse)
+	err := c.cc.Invoke(ctx, Query_GetPriceStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetValidatorPerformance(ctx context.Context, in *GetValidatorPerformanceRequest, opts ...grpc.CallOption) (*GetValidatorPerformanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetValidatorPerformanceResponse)
+	err := c.cc.Invoke(ctx, Query_GetValidatorPerformance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetValidatorPerformances(ctx context.Context, in *GetValidatorPerformancesRequest, opts ...grpc.CallOption) (*GetValidatorPerformancesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetValidatorPerformancesResponse)
+	err := c.cc.Invoke(ctx, Query_GetValidatorPerformances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetOracleKey(ctx context.Context, in *GetOracleKeyRequest, opts ...grpc.CallOption) (*GetOracleKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOracleKeyResponse)
+	err := c.cc.Invoke(ctx, Query_GetOracleKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -126,6 +237,29 @@ type QueryServer interface {
 	// useful for indexers that have access to the ID of a currency pair, but no
 	// way to get the underlying currency pair from it.
 	GetCurrencyPairMappingList(context.Context, *GetCurrencyPairMappingListRequest) (*GetCurrencyPairMappingListResponse, error)
+	// Given a CurrencyPair, return the most recent QuotePrices stored for that
+	// CurrencyPair, ordered from oldest to newest.
+	GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error)
+	// Given a CurrencyPair and a window, return the time-weighted average of
+	// the stored QuotePrices for that CurrencyPair over the window.
+	GetTWAP(context.Context, *GetTWAPRequest) (*GetTWAPResponse, error)
+	// Given a CurrencyPair and a window, return the median of the stored
+	// QuotePrices for that CurrencyPair over the window.
+	GetMedianOverWindow(context.Context, *GetMedianOverWindowRequest) (*GetMedianOverWindowResponse, error)
+	// Given a CurrencyPair, return its price guardrail and whether its price is
+	// stale or halted.
+	GetPriceStatus(context.Context, *GetPriceStatusRequest) (*GetPriceStatusResponse, error)
+	// Given a validator consensus address and a performance window, return the
+	// oracle performance of the validator over the window.
+	GetValidatorPerformance(context.Context, *GetValidatorPerformanceRequest) (*GetValidatorPerformanceResponse, error)
+	// Given a performance window, return the oracle performance of all
+	// validators over the window.
+	GetValidatorPerformances(context.Context, *GetValidatorPerformancesRequest) (*GetValidatorPerformancesResponse, error)
+	// Given a validator consensus address, return the oracle key registered by
+	// the validator.
+	GetOracleKey(context.Context, *GetOracleKeyRequest) (*GetOracleKeyResponse, error)
+	// Params returns the current x/oracle module parameters.
+	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -151,6 +285,30 @@ func (UnimplementedQueryServer) GetCurrencyPairMapping(context.Context, *GetCurr
 func (UnimplementedQueryServer) GetCurrencyPairMappingList(context.Context, *GetCurrencyPairMappingListRequest) (*GetCurrencyPairMappingListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCurrencyPairMappingList not implemented")
 }
+func (UnimplementedQueryServer) GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceHistory not implemented")
+}
+func (UnimplementedQueryServer) GetTWAP(context.Context, *GetTWAPRequest) (*GetTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTWAP not implemented")
+}
+func (UnimplementedQueryServer) GetMedianOverWindow(context.Context, *GetMedianOverWindowRequest) (*GetMedianOverWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMedianOverWindow not implemented")
+}
+func (UnimplementedQueryServer) GetPriceStatus(context.Context, *GetPriceStatusRequest) (*GetPriceStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceStatus not implemented")
+}
+func (UnimplementedQueryServer) GetValidatorPerformance(context.Context, *GetValidatorPerformanceRequest) (*GetValidatorPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorPerformance not implemented")
+}
+func (UnimplementedQueryServer) GetValidatorPerformances(context.Context, *GetValidatorPerformancesRequest) (*GetValidatorPerformancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorPerformances not implemented")
+}
+func (UnimplementedQueryServer) GetOracleKey(context.Context, *GetOracleKeyRequest) (*GetOracleKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOracleKey not implemented")
+}
+func (UnimplementedQueryServer) Params(context.Context, *ParamsRequest) (*ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -262,6 +420,150 @@ func _Query_GetCurrencyPairMappingList_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPriceHistory(ctx, req.(*GetPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTWAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetTWAP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTWAP(ctx, req.(*GetTWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetMedianOverWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMedianOverWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetMedianOverWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetMedianOverWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMedianOverWindow(ctx, req.(*GetMedianOverWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPriceStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPriceStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetPriceStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPriceStatus(ctx, req.(*GetPriceStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetValidatorPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetValidatorPerformance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetValidatorPerformance(ctx, req.(*GetValidatorPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetValidatorPerformances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorPerformancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetValidatorPerformances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetValidatorPerformances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetValidatorPerformances(ctx, req.(*GetValidatorPerformancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetOracleKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOracleKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetOracleKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetOracleKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetOracleKey(ctx, req.(*GetOracleKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -289,6 +591,38 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCurrencyPairMappingList",
 			Handler:    _Query_GetCurrencyPairMappingList_Handler,
 		},
+		{
+			MethodName: "GetPriceHistory",
+			Handler:    _Query_GetPriceHistory_Handler,
+		},
+		{
+			MethodName: "GetTWAP",
+			Handler:    _Query_GetTWAP_Handler,
+		},
+		{
+			MethodName: "GetMedianOverWindow",
+			Handler:    _Query_GetMedianOverWindow_Handler,
+		},
+		{
+			MethodName: "GetPriceStatus",
+			Handler:    _Query_GetPriceStatus_Handler,
+		},
+		{
+			MethodName: "GetValidatorPerformance",
+			Handler:    _Query_GetValidatorPerformance_Handler,
+		},
+		{
+			MethodName: "GetValidatorPerformances",
+			Handler:    _Query_GetValidatorPerformances_Handler,
+		},
+		{
+			MethodName: "GetOracleKey",
+			Handler:    _Query_GetOracleKey_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "slinky/oracle/v1/query.proto",
@@ -21,6 +21,9 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	Msg_AddCurrencyPairs_FullMethodName    = "/slinky.oracle.v1.Msg/AddCurrencyPairs"
 	Msg_RemoveCurrencyPairs_FullMethodName = "/slinky.oracle.v1.Msg/RemoveCurrencyPairs"
+	Msg_UpdateParams_FullMethodName        = "/slinky.oracle.v1.Msg/UpdateParams"
+	Msg_SetPriceGuardrails_FullMethodName  = "/slinky.oracle.v1.Msg/SetPriceGuardrails"
+	Msg_SetOracleKey_FullMethodName        = "/slinky.oracle.v1.Msg/SetOracleKey"
 )
 
 // MsgClient is the client API for Msg service.
@@ -37,6 +40,16 @@ type MsgClient interface {
 	// given set of currency-pairs from the module's state. Thus these
 	// CurrencyPairs will no longer have price-data available from this module.
 	RemoveCurrencyPairs(ctx context.Context, in *MsgRemoveCurrencyPairs, opts ...grpc.CallOption) (*MsgRemoveCurrencyPairsResponse, error)
+	// UpdateParams defines a method for updating the x/oracle module
+	// parameters. It can only be executed by the module authority.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetPriceGuardrails defines a method for setting the price guardrails of a
+	// set of CurrencyPairs. It can only be executed by the module authority.
+	SetPriceGuardrails(ctx context.Context, in *MsgSetPriceGuardrails, opts ...grpc.CallOption) (*MsgSetPriceGuardrailsResponse, error)
+	// SetOracleKey defines a method for a validator to register the key it
+	// signs the prices of its vote extensions with. It can only be executed by
+	// the operator of the validator.
+	SetOracleKey(ctx context.Context, in *MsgSetOracleKey, opts ...grpc.CallOption) (*MsgSetOracleKeyResponse, error)
 }
 
 type msgClient struct {
@@ -67,6 +80,36 @@ func (c *msgClient) RemoveCurrencyPairs(ctx context.Context, in *MsgRemoveCurren
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPriceGuardrails(ctx context.Context, in *MsgSetPriceGuardrails, opts ...grpc.CallOption) (*MsgSetPriceGuardrailsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetPriceGuardrailsResponse)
+	err := c.cc.Invoke(ctx, Msg_SetPriceGuardrails_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetOracleKey(ctx context.Context, in *MsgSetOracleKey, opts ...grpc.CallOption) (*MsgSetOracleKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetOracleKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_SetOracleKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -81,6 +124,16 @@ type MsgServer interface {
 	// given set of currency-pairs from the module's state. Thus these
 	// CurrencyPairs will no longer have price-data available from this module.
 	RemoveCurrencyPairs(context.Context, *MsgRemoveCurrencyPairs) (*MsgRemoveCurrencyPairsResponse, error)
+	// UpdateParams defines a method for updating the x/oracle module
+	// parameters. It can only be executed by the module authority.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetPriceGuardrails defines a method for setting the price guardrails of a
+	// set of CurrencyPairs. It can only be executed by the module authority.
+	SetPriceGuardrails(context.Context, *MsgSetPriceGuardrails) (*MsgSetPriceGuardrailsResponse, error)
+	// SetOracleKey defines a method for a validator to register the key it
+	// signs the prices of its vote extensions with. It can only be executed by
+	// the operator of the validator.
+	SetOracleKey(context.Context, *MsgSetOracleKey) (*MsgSetOracleKeyResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -97,6 +150,15 @@ func (UnimplementedMsgServer) AddCurrencyPairs(context.Context, *MsgAddCurrencyP
 func (UnimplementedMsgServer) RemoveCurrencyPairs(context.Context, *MsgRemoveCurrencyPairs) (*MsgRemoveCurrencyPairsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveCurrencyPairs not implemented")
 }
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (UnimplementedMsgServer) SetPriceGuardrails(context.Context, *MsgSetPriceGuardrails) (*MsgSetPriceGuardrailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPriceGuardrails not implemented")
+}
+func (UnimplementedMsgServer) SetOracleKey(context.Context, *MsgSetOracleKey) (*MsgSetOracleKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOracleKey not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -154,6 +216,60 @@ func _Msg_RemoveCurrencyPairs_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPriceGuardrails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPriceGuardrails)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPriceGuardrails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetPriceGuardrails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPriceGuardrails(ctx, req.(*MsgSetPriceGuardrails))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetOracleKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetOracleKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetOracleKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetOracleKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetOracleKey(ctx, req.(*MsgSetOracleKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -169,6 +285,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveCurrencyPairs",
 			Handler:    _Msg_RemoveCurrencyPairs_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "SetPriceGuardrails",
+			Handler:    _Msg_SetPriceGuardrails_Handler,
+		},
+		{
+			MethodName: "SetOracleKey",
+			Handler:    _Msg_SetOracleKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "slinky/oracle/v1/tx.proto",
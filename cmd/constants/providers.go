@@ -9,6 +9,7 @@ import (
 	coinbaseapi "github.com/zoguxprotocol/slinky/providers/apis/coinbase"
 	"github.com/zoguxprotocol/slinky/providers/apis/coingecko"
 	"github.com/zoguxprotocol/slinky/providers/apis/coinmarketcap"
+	"github.com/zoguxprotocol/slinky/providers/apis/defi/erc4626"
 	"github.com/zoguxprotocol/slinky/providers/apis/defi/osmosis"
 	"github.com/zoguxprotocol/slinky/providers/apis/defi/raydium"
 	"github.com/zoguxprotocol/slinky/providers/apis/defi/uniswapv3"
@@ -55,6 +56,16 @@ var (
 			API:  osmosis.DefaultAPIConfig,
 			Type: types.ConfigType,
 		},
+		{
+			Name: erc4626.ProviderNames[constants.ETHEREUM],
+			API:  erc4626.DefaultETHAPIConfig,
+			Type: types.ConfigType,
+		},
+		{
+			Name: erc4626.ProviderNames[constants.BASE],
+			API:  erc4626.DefaultBaseAPIConfig,
+			Type: types.ConfigType,
+		},
 
 		// Exchange API providers
 		{
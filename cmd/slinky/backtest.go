@@ -0,0 +1,224 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	cmdconfig "github.com/zoguxprotocol/slinky/cmd/slinky/config"
+	"github.com/zoguxprotocol/slinky/pkg/backtest"
+	"github.com/zoguxprotocol/slinky/providers/apis/marketmap"
+)
+
+const outputCSV = "csv"
+
+var (
+	backtestCmd = &cobra.Command{
+		Use:   "backtest",
+		Short: "Backtest a market map against recorded provider prices.",
+		Long: `Backtest a market map against recorded provider prices.
+
+The provider prices are read from a CSV file with a header, or a JSONL file, with the columns (or keys) timestamp,
+provider, ticker and price, and optionally volume. The ticker is the off-chain ticker referenced by the provider configs
+of the market map, and timestamps are either RFC3339 or unix seconds.
+
+The index price aggregator is run with the given market map at every tick, using the latest price of each provider
+that is not older than --max-price-age, as the oracle would. The report contains, for every market, how often it could
+not be priced because fewer than MinProviderCount providers had a price for it, and the deviation of its price from
+the optional --reference series (columns timestamp, ticker and price, where the ticker is e.g. BTC/USD).
+
+With -o csv, the aggregated price series is written instead of the report.`,
+		Example: "slinky backtest --prices prices.csv --market-map markets.json --reference onchain.csv --interval 1s",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return runBacktest(cmd.OutOrStdout())
+		},
+	}
+
+	// flag-bound values.
+	backtestPricesPath    string
+	backtestMarketMapPath string
+	backtestReferencePath string
+	backtestOracleCfgPath string
+	backtestInterval      time.Duration
+	backtestMaxPriceAge   time.Duration
+	backtestOutput        string
+)
+
+func init() {
+	backtestCmd.Flags().StringVar(
+		&backtestPricesPath,
+		"prices",
+		"",
+		"Path to the provider prices (.csv or .jsonl).",
+	)
+	backtestCmd.Flags().StringVar(
+		&backtestMarketMapPath,
+		"market-map",
+		"",
+		"Path to the market map JSON file to backtest.",
+	)
+	backtestCmd.Flags().StringVar(
+		&backtestReferencePath,
+		"reference",
+		"",
+		"Path to the reference prices to compare the aggregated prices to (.csv or .jsonl).",
+	)
+	backtestCmd.Flags().StringVar(
+		&backtestOracleCfgPath,
+		"oracle-config",
+		"",
+		"Path to the oracle config whose outlier filter is applied (disabled if empty).",
+	)
+	backtestCmd.Flags().DurationVar(
+		&backtestInterval,
+		"interval",
+		0,
+		"Time between two ticks (defaults to a tick at every distinct timestamp of the provider prices).",
+	)
+	backtestCmd.Flags().DurationVar(
+		&backtestMaxPriceAge,
+		"max-price-age",
+		cmdconfig.DefaultMaxPriceAge,
+		"Maximum age of a provider or reference price for it to be used at a tick (0 to never expire prices).",
+	)
+	backtestCmd.Flags().StringVarP(
+		&backtestOutput,
+		"output",
+		"o",
+		outputText,
+		"Output format (text, json, csv).",
+	)
+	for _, flag := range []string{"prices", "market-map"} {
+		if err := backtestCmd.MarkFlagRequired(flag); err != nil {
+			panic(err)
+		}
+	}
+
+	rootCmd.AddCommand(backtestCmd)
+}
+
+func runBacktest(out io.Writer) error {
+	if backtestOutput != outputText && backtestOutput != outputJSON && backtestOutput != outputCSV {
+		return fmt.Errorf("invalid output format %q; expected %s, %s or %s", backtestOutput, outputText, outputJSON, outputCSV)
+	}
+
+	mm, err := readMarketMapFile(backtestMarketMapPath)
+	if err != nil {
+		return err
+	}
+
+	prices, err := backtest.ReadProviderPrices(backtestPricesPath)
+	if err != nil {
+		return fmt.Errorf("failed to read provider prices: %w", err)
+	}
+
+	var reference []backtest.ReferencePrice
+	if backtestReferencePath != "" {
+		if reference, err = backtest.ReadReferencePrices(backtestReferencePath); err != nil {
+			return fmt.Errorf("failed to read reference prices: %w", err)
+		}
+	}
+
+	cfg := backtest.Config{
+		Interval:    backtestInterval,
+		MaxPriceAge: backtestMaxPriceAge,
+	}
+	if backtestOracleCfgPath != "" {
+		oracleCfg, err := cmdconfig.ReadOracleConfigWithOverrides(backtestOracleCfgPath, marketmap.Name)
+		if err != nil {
+			return fmt.Errorf("failed to get oracle config: %w", err)
+		}
+		cfg.OutlierFilter = oracleCfg.OutlierFilter
+	}
+
+	result, err := backtest.Run(zap.NewNop(), mm, prices, reference, cfg)
+	if err != nil {
+		return err
+	}
+
+	switch backtestOutput {
+	case outputJSON:
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(result)
+	case outputCSV:
+		return writeBacktestSeries(out, result)
+	default:
+		return writeBacktestReport(out, result)
+	}
+}
+
+// writeBacktestReport writes a human-readable summary of the backtest.
+func writeBacktestReport(w io.Writer, result backtest.Result) error {
+	if len(result.Ticks) > 0 {
+		fmt.Fprintf(
+			w,
+			"%d ticks from %s to %s\n\n",
+			len(result.Ticks),
+			result.Ticks[0].Time.Format(time.RFC3339),
+			result.Ticks[len(result.Ticks)-1].Time.Format(time.RFC3339),
+		)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "MARKET\tTICKS\tPRICED\tMISSING\tMISSING %\tMEAN DEV (BPS)\tMAX DEV (BPS)\tMAX DEV AT")
+	for _, market := range result.Markets {
+		meanDev, maxDev, maxDevAt := "-", "-", "-"
+		if market.Deviation != nil {
+			meanDev = strconv.FormatFloat(market.Deviation.MeanBps, 'f', 2, 64)
+			maxDev = strconv.FormatFloat(market.Deviation.MaxBps, 'f', 2, 64)
+			maxDevAt = market.Deviation.MaxTime.Format(time.RFC3339)
+		}
+
+		fmt.Fprintf(
+			tw,
+			"%s\t%d\t%d\t%d\t%.2f\t%s\t%s\t%s\n",
+			market.Ticker,
+			market.Ticks,
+			market.Priced,
+			market.Missing,
+			100*market.MissingRate(),
+			meanDev,
+			maxDev,
+			maxDevAt,
+		)
+	}
+
+	return tw.Flush()
+}
+
+// writeBacktestSeries writes the aggregated price series as CSV, with a row per tick and priced market.
+func writeBacktestSeries(w io.Writer, result backtest.Result) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"timestamp", "ticker", "price"}); err != nil {
+		return err
+	}
+
+	for _, tick := range result.Ticks {
+		for _, market := range result.Markets {
+			price, ok := tick.Prices[market.Ticker]
+			if !ok {
+				continue
+			}
+
+			if err := cw.Write([]string{
+				tick.Time.Format(time.RFC3339Nano),
+				market.Ticker,
+				price.Text('f', -1),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	cmdconfig "github.com/zoguxprotocol/slinky/cmd/slinky/config"
+	"github.com/zoguxprotocol/slinky/pkg/backtest"
+	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
+	mmtypes "github.com/zoguxprotocol/slinky/x/marketmap/types"
+)
+
+func TestRunBacktest(t *testing.T) {
+	btcusd := mmtypes.Market{
+		Ticker: mmtypes.Ticker{
+			CurrencyPair:     slinkytypes.NewCurrencyPair("BTC", "USD"),
+			Decimals:         8,
+			MinProviderCount: 2,
+			Enabled:          true,
+		},
+		ProviderConfigs: []mmtypes.ProviderConfig{
+			{Name: "coinbase", OffChainTicker: "BTC-USD"},
+			{Name: "kraken", OffChainTicker: "XXBTZUSD"},
+		},
+	}
+
+	dir := t.TempDir()
+	marketMapPath := filepath.Join(dir, "markets.json")
+	require.NoError(t, mmtypes.WriteMarketMapToFile(mmtypes.MarketMap{Markets: map[string]mmtypes.Market{
+		btcusd.Ticker.String(): btcusd,
+	}}, marketMapPath))
+
+	pricesPath := filepath.Join(dir, "prices.csv")
+	require.NoError(t, os.WriteFile(pricesPath, []byte(`timestamp,provider,ticker,price
+2024-01-01T00:00:00Z,coinbase,BTC-USD,100
+2024-01-01T00:00:01Z,kraken,XXBTZUSD,102
+`), 0o600))
+
+	referencePath := filepath.Join(dir, "reference.jsonl")
+	require.NoError(t, os.WriteFile(referencePath, []byte(`{"timestamp":"2024-01-01T00:00:00Z","ticker":"BTC/USD","price":100}`), 0o600))
+
+	t.Cleanup(func() {
+		backtestPricesPath, backtestMarketMapPath, backtestReferencePath, backtestOutput = "", "", "", outputText
+		backtestMaxPriceAge = cmdconfig.DefaultMaxPriceAge
+	})
+	backtestPricesPath, backtestMarketMapPath, backtestReferencePath = pricesPath, marketMapPath, referencePath
+	backtestMaxPriceAge = time.Minute
+
+	t.Run("text", func(t *testing.T) {
+		backtestOutput = outputText
+
+		var out bytes.Buffer
+		require.NoError(t, runBacktest(&out))
+		require.Contains(t, out.String(), "2 ticks from 2024-01-01T00:00:00Z to 2024-01-01T00:00:01Z")
+		require.Regexp(t, `BTC/USD\s+2\s+1\s+1\s+50.00\s+100.00\s+100.00\s+2024-01-01T00:00:01Z`, out.String())
+	})
+
+	t.Run("json", func(t *testing.T) {
+		backtestOutput = outputJSON
+
+		var out bytes.Buffer
+		require.NoError(t, runBacktest(&out))
+
+		var result backtest.Result
+		require.NoError(t, json.Unmarshal(out.Bytes(), &result))
+		require.Len(t, result.Ticks, 2)
+		require.Equal(t, 1, result.Markets[0].Missing)
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		backtestOutput = outputCSV
+
+		var out bytes.Buffer
+		require.NoError(t, runBacktest(&out))
+		require.Equal(t, []string{
+			"timestamp,ticker,price",
+			"2024-01-01T00:00:01Z,BTC/USD,101",
+		}, strings.Split(strings.TrimSpace(out.String()), "\n"))
+	})
+
+	t.Run("invalid output", func(t *testing.T) {
+		backtestOutput = "yaml"
+		require.Error(t, runBacktest(&bytes.Buffer{}))
+	})
+}
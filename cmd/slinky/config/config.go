@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
@@ -26,6 +27,20 @@ const (
 	DefaultHost = "0.0.0.0"
 	// DefaultPort is the default for the slinky oracle server port.
 	DefaultPort = "8080"
+	// DefaultOutlierFilterEnabled is the default value for enabling the outlier filter in slinky.
+	DefaultOutlierFilterEnabled = false
+	// DefaultOutlierMaxMADs is the default number of median absolute deviations after which a price is an outlier.
+	DefaultOutlierMaxMADs = 5.0
+	// DefaultOutlierMaxDeviationBps is the default distance from the median, in basis points, after which a price is an outlier.
+	DefaultOutlierMaxDeviationBps = 500
+	// DefaultOutlierMinPrices is the default minimum number of prices a market needs before outliers are filtered.
+	DefaultOutlierMinPrices = 3
+	// DefaultOutlierScoreDecay is the default weight of the latest observation in a provider's deviation score.
+	DefaultOutlierScoreDecay = 0.1
+	// DefaultOutlierQuarantineThreshold is the default deviation score after which a provider is quarantined.
+	DefaultOutlierQuarantineThreshold = 0.5
+	// DefaultOutlierQuarantineDuration is the default cooldown period of a quarantined provider.
+	DefaultOutlierQuarantineDuration = 5 * time.Minute
 	// jsonFieldDelimiter is the delimiter used to separate fields in the JSON output.
 	jsonFieldDelimiter = "."
 	// SlinkyConfigEnvironmentPrefix is the prefix for environment variables that override the slinky config.
@@ -41,6 +56,15 @@ func DefaultOracleConfig() config.OracleConfig {
 			PrometheusServerAddress: DefaultPrometheusServerAddress,
 			Enabled:                 DefaultMetricsEnabled,
 		},
+		OutlierFilter: config.OutlierFilterConfig{
+			Enabled:             DefaultOutlierFilterEnabled,
+			MaxMADs:             DefaultOutlierMaxMADs,
+			MaxDeviationBps:     DefaultOutlierMaxDeviationBps,
+			MinPrices:           DefaultOutlierMinPrices,
+			ScoreDecay:          DefaultOutlierScoreDecay,
+			QuarantineThreshold: DefaultOutlierQuarantineThreshold,
+			QuarantineDuration:  DefaultOutlierQuarantineDuration,
+		},
 		Providers: make(map[string]config.ProviderConfig),
 		Host:      DefaultHost,
 		Port:      DefaultPort,
@@ -26,7 +26,7 @@ import (
 	"github.com/zoguxprotocol/slinky/pkg/log"
 	oraclemath "github.com/zoguxprotocol/slinky/pkg/math/oracle"
 	"github.com/zoguxprotocol/slinky/providers/apis/marketmap"
-	oraclefactory "github.com/zoguxprotocol/slinky/providers/factories/oracle"
+	"github.com/zoguxprotocol/slinky/providers/replay"
 	mmservicetypes "github.com/zoguxprotocol/slinky/service/clients/marketmap/types"
 	oracleserver "github.com/zoguxprotocol/slinky/service/servers/oracle"
 	promserver "github.com/zoguxprotocol/slinky/service/servers/prometheus"
@@ -76,6 +76,10 @@ var (
 	maxAge              int
 	disableCompressLogs bool
 	disableRotatingLogs bool
+	disableConfigReload bool
+	recordTo            string
+	replayFrom          string
+	replaySpeed         float64
 )
 
 const (
@@ -188,6 +192,34 @@ func init() {
 		"",
 		"Use a custom listen-to endpoint for market-map (overwrites what is provided in oracle-config).",
 	)
+	rootCmd.Flags().BoolVarP(
+		&disableConfigReload,
+		"disable-config-reload",
+		"",
+		false,
+		"Disable reloading the oracle config when the file changes or on SIGHUP.",
+	)
+	rootCmd.Flags().StringVarP(
+		&recordTo,
+		"record-to",
+		"",
+		"",
+		"Record every provider response to the given archive file.",
+	)
+	rootCmd.Flags().StringVarP(
+		&replayFrom,
+		"replay-from",
+		"",
+		"",
+		"Replay the provider responses in the given archive file instead of querying the providers. Requires --market-config-path.",
+	)
+	rootCmd.Flags().Float64VarP(
+		&replaySpeed,
+		"replay-speed",
+		"",
+		replay.DefaultSpeed,
+		"Speed at which the archive is replayed, relative to the time it was recorded in. 0 replays responses as fast as they are requested.",
+	)
 
 	// these flags are connected to the OracleConfig.
 	rootCmd.Flags().Bool(
@@ -241,7 +273,9 @@ func init() {
 
 // start the oracle-grpc server + oracle process, cancel on interrupt or terminate.
 func main() {
-	rootCmd.Execute()
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
 }
 
 func runOracle() error {
@@ -270,28 +304,9 @@ func runOracle() error {
 	logger := log.NewLogger(logCfg)
 	defer logger.Sync()
 
-	var cfg config.OracleConfig
-	var err error
-
-	cfg, err = cmdconfig.ReadOracleConfigWithOverrides(oracleCfgPath, marketMapProvider)
+	cfg, err := readOracleConfig()
 	if err != nil {
-		return fmt.Errorf("failed to get oracle config: %w", err)
-	}
-
-	// overwrite endpoint
-	if marketMapEndPoint != "" {
-		cfg, err = overwriteMarketMapEndpoint(cfg, marketMapEndPoint)
-		if err != nil {
-			return fmt.Errorf("failed to overwrite market endpoint %s: %w", marketMapEndPoint, err)
-		}
-	}
-
-	// check that the marketmap endpoint they provided is correct.
-	if marketMapProvider == marketmap.Name {
-		mmEndpoint := cfg.Providers[marketMapProvider].API.Endpoints[0].URL
-		if err := isValidGRPCEndpoint(mmEndpoint); err != nil {
-			return err
-		}
+		return err
 	}
 
 	var marketCfg mmtypes.MarketMap
@@ -313,6 +328,7 @@ func runOracle() error {
 		logger,
 		marketCfg,
 		metrics,
+		oraclemath.WithOutlierFilter(cfg.OutlierFilter),
 	)
 	if err != nil {
 		return fmt.Errorf("failed to create data aggregator: %w", err)
@@ -322,11 +338,15 @@ func runOracle() error {
 	oracleOpts := []oracle.Option{
 		oracle.WithLogger(logger),
 		oracle.WithMarketMap(marketCfg),
-		oracle.WithPriceAPIQueryHandlerFactory(oraclefactory.APIQueryHandlerFactory),             // Replace with custom API query handler factory.
-		oracle.WithPriceWebSocketQueryHandlerFactory(oraclefactory.WebSocketQueryHandlerFactory), // Replace with custom websocket query handler factory.
-		oracle.WithMarketMapperFactory(oraclefactory.MarketMapProviderFactory),
 		oracle.WithMetrics(metrics),
 	}
+
+	providerOpts, closeProviders, err := providerFactoryOptions(logger, cfg)
+	if err != nil {
+		return err
+	}
+	defer closeProviders()
+	oracleOpts = append(oracleOpts, providerOpts...)
 	if updateMarketCfgPath != "" {
 		oracleOpts = append(oracleOpts, oracle.WithWriteTo(updateMarketCfgPath))
 	}
@@ -349,6 +369,19 @@ func runOracle() error {
 
 	srv := oracleserver.NewOracleServer(orc, logger)
 
+	// reload the oracle config in place when the file changes or on SIGHUP
+	if oracleCfgPath != "" && !disableConfigReload {
+		if reloadable, ok := orc.(*oracle.OracleImpl); ok {
+			go func() {
+				if err := watchOracleConfig(ctx, logger, oracleCfgPath, readOracleConfig, reloadable.UpdateConfig); err != nil {
+					logger.Error("failed to watch oracle config", zap.Error(err))
+				}
+			}()
+		} else {
+			logger.Warn("oracle does not support reloading its config; config changes require a restart")
+		}
+	}
+
 	// cancel oracle on interrupt or terminate
 	go func() {
 		<-sigs
@@ -393,6 +426,32 @@ func runOracle() error {
 	return nil
 }
 
+// readOracleConfig reads the oracle config from the configured path, and applies the command line overrides.
+func readOracleConfig() (config.OracleConfig, error) {
+	cfg, err := cmdconfig.ReadOracleConfigWithOverrides(oracleCfgPath, marketMapProvider)
+	if err != nil {
+		return config.OracleConfig{}, fmt.Errorf("failed to get oracle config: %w", err)
+	}
+
+	// overwrite endpoint
+	if marketMapEndPoint != "" {
+		cfg, err = overwriteMarketMapEndpoint(cfg, marketMapEndPoint)
+		if err != nil {
+			return config.OracleConfig{}, fmt.Errorf("failed to overwrite market endpoint %s: %w", marketMapEndPoint, err)
+		}
+	}
+
+	// check that the marketmap endpoint they provided is correct.
+	if marketMapProvider == marketmap.Name {
+		mmEndpoint := cfg.Providers[marketMapProvider].API.Endpoints[0].URL
+		if err := isValidGRPCEndpoint(mmEndpoint); err != nil {
+			return config.OracleConfig{}, err
+		}
+	}
+
+	return cfg, nil
+}
+
 func overwriteMarketMapEndpoint(cfg config.OracleConfig, overwrite string) (config.OracleConfig, error) {
 	for providerName, provider := range cfg.Providers {
 		if provider.Type == mmservicetypes.ConfigType {
@@ -0,0 +1,310 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	cmdconfig "github.com/zoguxprotocol/slinky/cmd/slinky/config"
+	"github.com/zoguxprotocol/slinky/providers/apis/marketmap"
+	apimetrics "github.com/zoguxprotocol/slinky/providers/base/api/metrics"
+	oraclefactory "github.com/zoguxprotocol/slinky/providers/factories/oracle"
+	mmkeeper "github.com/zoguxprotocol/slinky/x/marketmap/keeper"
+	mmtypes "github.com/zoguxprotocol/slinky/x/marketmap/types"
+)
+
+const (
+	// marketMapSourceGRPC is the prefix of a market map source that is queried from the x/marketmap module of a node.
+	marketMapSourceGRPC = "grpc:"
+	// marketMapSourceProvider is the prefix of a market map source that is fetched by a market map provider
+	// configured in the oracle config.
+	marketMapSourceProvider = "provider:"
+
+	outputText = "text"
+	outputJSON = "json"
+)
+
+var (
+	marketMapCmd = &cobra.Command{
+		Use:   "marketmap",
+		Short: "Market map tooling.",
+	}
+
+	marketMapDiffCmd = &cobra.Command{
+		Use:   "diff [from] [to]",
+		Short: "Diff two market maps and dry-run the update from one to the other.",
+		Long: `Diff two market maps and dry-run the update from one to the other.
+
+Each market map can be read from:
+  - a market map JSON file: path/to/markets.json
+  - the x/marketmap module of a node: grpc:<host:port>
+  - a market map provider configured in the oracle config (see --oracle-config): provider:<name>, e.g. provider:zogux_api
+
+The added, removed and changed markets and provider configs are printed, and the [to] market map is then validated with
+MarketMap.ValidateBasic and GetValidSubset. Finally, the update is dry-run against an in-memory x/marketmap keeper
+seeded with the [from] market map, which runs the same stateful checks as the chain (NormalizeByPair dependencies and
+the delete market validation hooks). The command fails if any validation fails.`,
+		Example: "slinky marketmap diff grpc:localhost:9090 markets.json",
+		Args:    cobra.ExactArgs(2),
+		// validation failures are reported in the output, so don't print the usage on them.
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMarketMapDiff(cmd.Context(), cmd.OutOrStdout(), args[0], args[1])
+		},
+	}
+
+	// flag-bound values.
+	diffOracleCfgPath string
+	diffOutput        string
+)
+
+func init() {
+	marketMapDiffCmd.Flags().StringVar(
+		&diffOracleCfgPath,
+		"oracle-config",
+		"",
+		"Path to the oracle config used for grpc: and provider: market map sources (defaults are used if empty).",
+	)
+	marketMapDiffCmd.Flags().StringVarP(
+		&diffOutput,
+		"output",
+		"o",
+		outputText,
+		"Output format (text, json).",
+	)
+
+	marketMapCmd.AddCommand(marketMapDiffCmd)
+	rootCmd.AddCommand(marketMapCmd)
+}
+
+// marketMapValidation is the result of validating a proposed market map.
+type marketMapValidation struct {
+	// ValidateBasic is the error returned by MarketMap.ValidateBasic, if any.
+	ValidateBasic string `json:"validate_basic,omitempty"`
+	// InvalidMarkets are the markets that are dropped by MarketMap.GetValidSubset.
+	InvalidMarkets []string `json:"invalid_markets,omitempty"`
+	// DryRun is the error returned by dry-running the update on an x/marketmap keeper, if any.
+	DryRun string `json:"dry_run,omitempty"`
+}
+
+// Failed returns true if any of the validations failed.
+func (v marketMapValidation) Failed() bool {
+	return v.ValidateBasic != "" || len(v.InvalidMarkets) > 0 || v.DryRun != ""
+}
+
+// marketMapDiffReport is the output of the marketmap diff command.
+type marketMapDiffReport struct {
+	Diff       mmtypes.MarketMapDiff `json:"diff"`
+	Validation marketMapValidation   `json:"validation"`
+}
+
+func runMarketMapDiff(ctx context.Context, out io.Writer, from, to string) error {
+	if diffOutput != outputText && diffOutput != outputJSON {
+		return fmt.Errorf("invalid output format %q; expected %s or %s", diffOutput, outputText, outputJSON)
+	}
+
+	fromMM, err := readMarketMapSource(ctx, from)
+	if err != nil {
+		return fmt.Errorf("failed to read market map %s: %w", from, err)
+	}
+
+	toMM, err := readMarketMapSource(ctx, to)
+	if err != nil {
+		return fmt.Errorf("failed to read market map %s: %w", to, err)
+	}
+
+	report := marketMapDiffReport{
+		Diff:       mmtypes.DiffMarketMaps(fromMM, toMM),
+		Validation: validateMarketMapUpdate(fromMM, toMM),
+	}
+
+	if diffOutput == outputJSON {
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			return err
+		}
+	} else {
+		writeMarketMapDiffReport(out, report)
+	}
+
+	if report.Validation.Failed() {
+		return fmt.Errorf("market map %s failed validation", to)
+	}
+
+	return nil
+}
+
+// validateMarketMapUpdate validates the proposed market map on its own, and dry-runs the update from the current one.
+func validateMarketMapUpdate(current, proposed mmtypes.MarketMap) marketMapValidation {
+	var v marketMapValidation
+
+	if err := proposed.ValidateBasic(); err != nil {
+		v.ValidateBasic = err.Error()
+	}
+
+	if valid, err := proposed.GetValidSubset(); err != nil {
+		if v.ValidateBasic == "" {
+			v.ValidateBasic = err.Error()
+		}
+	} else {
+		for ticker := range proposed.Markets {
+			if _, found := valid.Markets[ticker]; !found {
+				v.InvalidMarkets = append(v.InvalidMarkets, ticker)
+			}
+		}
+		sort.Strings(v.InvalidMarkets)
+	}
+
+	if err := mmkeeper.DryRunMarketMapUpdate(current, proposed); err != nil {
+		v.DryRun = err.Error()
+	}
+
+	return v
+}
+
+// readMarketMapSource reads a market map from a file, the x/marketmap module of a node, or a market map provider.
+func readMarketMapSource(ctx context.Context, source string) (mmtypes.MarketMap, error) {
+	var (
+		providerName string
+		endpoint     string
+	)
+
+	switch {
+	case strings.HasPrefix(source, marketMapSourceGRPC):
+		providerName = marketmap.Name
+		endpoint = strings.TrimPrefix(strings.TrimPrefix(source, marketMapSourceGRPC), "//")
+		if err := isValidGRPCEndpoint(endpoint); err != nil {
+			return mmtypes.MarketMap{}, err
+		}
+	case strings.HasPrefix(source, marketMapSourceProvider):
+		providerName = strings.TrimPrefix(source, marketMapSourceProvider)
+	default:
+		return readMarketMapFile(source)
+	}
+
+	cfg, err := cmdconfig.ReadOracleConfigWithOverrides(diffOracleCfgPath, providerName)
+	if err != nil {
+		return mmtypes.MarketMap{}, fmt.Errorf("failed to get oracle config: %w", err)
+	}
+
+	if endpoint != "" {
+		if cfg, err = overwriteMarketMapEndpoint(cfg, endpoint); err != nil {
+			return mmtypes.MarketMap{}, err
+		}
+	}
+
+	providerCfg, ok := cfg.Providers[providerName]
+	if !ok {
+		return mmtypes.MarketMap{}, fmt.Errorf("market map provider %s not found in oracle config", providerName)
+	}
+
+	fetcher, chains, err := oraclefactory.MarketMapFetcherFactory(zap.NewNop(), apimetrics.NewNopAPIMetrics(), providerCfg)
+	if err != nil {
+		return mmtypes.MarketMap{}, fmt.Errorf("failed to create market map fetcher: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, providerCfg.API.Timeout)
+	defer cancel()
+
+	resp := fetcher.Fetch(ctx, chains)
+	for _, chain := range chains {
+		if result, ok := resp.UnResolved[chain]; ok {
+			return mmtypes.MarketMap{}, fmt.Errorf("failed to fetch market map for %s: %w", chain, result)
+		}
+
+		if result, ok := resp.Resolved[chain]; ok && result.Value != nil {
+			return result.Value.MarketMap, nil
+		}
+	}
+
+	return mmtypes.MarketMap{}, fmt.Errorf("no market map fetched from %s", providerName)
+}
+
+// readMarketMapFile reads a market map from a file. Unlike mmtypes.ReadMarketMapFromFile, the market map is not
+// validated, as validating it is what the diff command reports on.
+func readMarketMapFile(path string) (mmtypes.MarketMap, error) {
+	var mm mmtypes.MarketMap
+
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return mm, fmt.Errorf("error reading market map file: %w", err)
+	}
+
+	if err := json.Unmarshal(bz, &mm); err != nil {
+		return mm, fmt.Errorf("error unmarshalling market map JSON: %w", err)
+	}
+
+	return mm, nil
+}
+
+// writeMarketMapDiffReport writes a human-readable version of the report.
+func writeMarketMapDiffReport(out io.Writer, report marketMapDiffReport) {
+	diff := report.Diff
+	if diff.Empty() {
+		fmt.Fprintln(out, "no changes")
+	}
+
+	for _, market := range diff.Added {
+		fmt.Fprintf(out, "+ %s\n", market.Ticker.String())
+		fmt.Fprintf(out, "    ticker: %s\n", toJSON(market.Ticker))
+		for _, providerConfig := range market.ProviderConfigs {
+			fmt.Fprintf(out, "    + provider %s\n", toJSON(providerConfig))
+		}
+	}
+
+	for _, market := range diff.Removed {
+		fmt.Fprintf(out, "- %s\n", market.Ticker.String())
+	}
+
+	for _, market := range diff.Changed {
+		fmt.Fprintf(out, "~ %s\n", market.Ticker)
+		if market.OldTicker != nil {
+			fmt.Fprintf(out, "    ticker: %s -> %s\n", toJSON(market.OldTicker), toJSON(market.NewTicker))
+		}
+		for _, providerConfig := range market.AddedProviders {
+			fmt.Fprintf(out, "    + provider %s\n", toJSON(providerConfig))
+		}
+		for _, providerConfig := range market.RemovedProviders {
+			fmt.Fprintf(out, "    - provider %s\n", toJSON(providerConfig))
+		}
+		for _, providerConfig := range market.ChangedProviders {
+			fmt.Fprintf(out, "    ~ provider %s -> %s\n", toJSON(providerConfig.Old), toJSON(providerConfig.New))
+		}
+	}
+
+	v := report.Validation
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "validation:")
+	fmt.Fprintf(out, "  validate basic: %s\n", resultString(v.ValidateBasic))
+	if len(v.InvalidMarkets) > 0 {
+		fmt.Fprintf(out, "  valid subset: FAIL (dropped %s)\n", strings.Join(v.InvalidMarkets, ", "))
+	} else {
+		fmt.Fprintln(out, "  valid subset: ok")
+	}
+	fmt.Fprintf(out, "  dry run: %s\n", resultString(v.DryRun))
+}
+
+func resultString(err string) string {
+	if err == "" {
+		return "ok"
+	}
+
+	return "FAIL (" + err + ")"
+}
+
+func toJSON(v any) string {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return string(bz)
+}
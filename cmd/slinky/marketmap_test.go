@@ -0,0 +1,89 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
+	mmtypes "github.com/zoguxprotocol/slinky/x/marketmap/types"
+)
+
+func TestRunMarketMapDiff(t *testing.T) {
+	usdtusd := mmtypes.Market{
+		Ticker: mmtypes.Ticker{
+			CurrencyPair:     slinkytypes.NewCurrencyPair("USDT", "USD"),
+			Decimals:         8,
+			MinProviderCount: 1,
+			Enabled:          true,
+		},
+		ProviderConfigs: []mmtypes.ProviderConfig{{Name: "kucoin", OffChainTicker: "usdt-usd"}},
+	}
+
+	btcusd := mmtypes.Market{
+		Ticker: mmtypes.Ticker{
+			CurrencyPair:     slinkytypes.NewCurrencyPair("BTC", "USD"),
+			Decimals:         8,
+			MinProviderCount: 1,
+			Enabled:          true,
+		},
+		ProviderConfigs: []mmtypes.ProviderConfig{
+			{Name: "kucoin", OffChainTicker: "btc-usdt", NormalizeByPair: &usdtusd.Ticker.CurrencyPair},
+		},
+	}
+
+	dir := t.TempDir()
+	writeMarketMap := func(name string, markets ...mmtypes.Market) string {
+		mm := mmtypes.MarketMap{Markets: make(map[string]mmtypes.Market)}
+		for _, market := range markets {
+			mm.Markets[market.Ticker.String()] = market
+		}
+
+		path := filepath.Join(dir, name)
+		require.NoError(t, mmtypes.WriteMarketMapToFile(mm, path))
+		return path
+	}
+
+	current := writeMarketMap("current.json", usdtusd)
+	valid := writeMarketMap("valid.json", usdtusd, btcusd)
+	invalid := writeMarketMap("invalid.json", btcusd)
+
+	t.Cleanup(func() { diffOutput = outputText })
+
+	t.Run("valid update", func(t *testing.T) {
+		diffOutput = outputText
+
+		var out bytes.Buffer
+		require.NoError(t, runMarketMapDiff(context.Background(), &out, current, valid))
+		require.Contains(t, out.String(), "+ BTC/USD")
+		require.Contains(t, out.String(), "dry run: ok")
+	})
+
+	t.Run("removing a normalization market fails", func(t *testing.T) {
+		diffOutput = outputJSON
+
+		var out bytes.Buffer
+		require.Error(t, runMarketMapDiff(context.Background(), &out, valid, invalid))
+
+		var report marketMapDiffReport
+		require.NoError(t, json.Unmarshal(out.Bytes(), &report))
+		require.Equal(t, []mmtypes.Market{usdtusd}, report.Diff.Removed)
+		require.NotEmpty(t, report.Validation.ValidateBasic)
+		require.Equal(t, []string{"BTC/USD"}, report.Validation.InvalidMarkets)
+		require.NotEmpty(t, report.Validation.DryRun)
+	})
+
+	t.Run("invalid output format", func(t *testing.T) {
+		diffOutput = "yaml"
+		require.Error(t, runMarketMapDiff(context.Background(), &bytes.Buffer{}, current, valid))
+	})
+
+	t.Run("invalid grpc endpoint", func(t *testing.T) {
+		diffOutput = outputText
+		require.Error(t, runMarketMapDiff(context.Background(), &bytes.Buffer{}, "grpc:http://localhost", valid))
+	})
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/zoguxprotocol/slinky/oracle/config"
+)
+
+// configReloadPollInterval is the interval at which the oracle config file is checked for changes.
+var configReloadPollInterval = time.Second
+
+// watchOracleConfig reloads the oracle config whenever the file at path changes, or the process receives a
+// SIGHUP, and passes it to apply. The file is considered changed when its modification time or size changes,
+// which also detects files that are replaced rather than written to (e.g. by editors or symlinked config maps).
+// Reloads are best-effort: if the config cannot be read or applied, the error is logged and the oracle keeps
+// running with its current config. This blocks until ctx is cancelled.
+func watchOracleConfig(
+	ctx context.Context,
+	logger *zap.Logger,
+	path string,
+	read func() (config.OracleConfig, error),
+	apply func(config.OracleConfig) error,
+) error {
+	sighup := make(chan os.Signal, 1)
+	signal.Notify(sighup, syscall.SIGHUP)
+	defer signal.Stop(sighup)
+
+	last, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("watching oracle config for changes", zap.String("path", path))
+
+	reload := func(trigger string) {
+		cfg, err := read()
+		if err != nil {
+			logger.Error("failed to read oracle config; keeping current config", zap.String("trigger", trigger), zap.Error(err))
+			return
+		}
+
+		if err := apply(cfg); err != nil {
+			logger.Error("failed to apply oracle config", zap.String("trigger", trigger), zap.Error(err))
+			return
+		}
+
+		logger.Info("reloaded oracle config", zap.String("trigger", trigger))
+	}
+
+	ticker := time.NewTicker(configReloadPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sighup:
+			logger.Info("received SIGHUP; reloading oracle config")
+			reload("signal")
+		case <-ticker.C:
+			info, err := os.Stat(path)
+			if err != nil {
+				// The file may be briefly missing while it is being replaced.
+				logger.Debug("failed to stat oracle config", zap.Error(err))
+				continue
+			}
+
+			if info.ModTime().Equal(last.ModTime()) && info.Size() == last.Size() {
+				continue
+			}
+
+			last = info
+			reload("file")
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/zoguxprotocol/slinky/oracle/config"
+)
+
+func TestWatchOracleConfig(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "oracle.json")
+	require.NoError(t, os.WriteFile(path, []byte("{}"), 0o600))
+
+	applied := make(chan config.OracleConfig, 10)
+	read := func() (config.OracleConfig, error) {
+		return config.OracleConfig{Host: "localhost"}, nil
+	}
+	apply := func(cfg config.OracleConfig) error {
+		applied <- cfg
+		return nil
+	}
+
+	configReloadPollInterval = 50 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- watchOracleConfig(ctx, zap.NewNop(), path, read, apply)
+	}()
+
+	// Give the watcher time to stat the file before it is changed.
+	time.Sleep(100 * time.Millisecond)
+
+	t.Run("reloads when the file is written", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(path, []byte(`{"host":"localhost"}`), 0o600))
+		require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Second)))
+
+		select {
+		case cfg := <-applied:
+			require.Equal(t, "localhost", cfg.Host)
+		case <-time.After(5 * time.Second):
+			t.Fatal("config was not reloaded")
+		}
+	})
+
+	t.Run("ignores other files in the directory", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "market.json"), []byte("{}"), 0o600))
+
+		select {
+		case <-applied:
+			t.Fatal("config was reloaded")
+		case <-time.After(10 * configReloadPollInterval):
+		}
+	})
+
+	t.Run("reloads on SIGHUP", func(t *testing.T) {
+		require.NoError(t, syscall.Kill(os.Getpid(), syscall.SIGHUP))
+
+		select {
+		case <-applied:
+		case <-time.After(5 * time.Second):
+			t.Fatal("config was not reloaded")
+		}
+	})
+
+	cancel()
+	require.NoError(t, <-done)
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/zoguxprotocol/slinky/oracle"
+	"github.com/zoguxprotocol/slinky/oracle/config"
+	"github.com/zoguxprotocol/slinky/oracle/types"
+	oraclefactory "github.com/zoguxprotocol/slinky/providers/factories/oracle"
+	"github.com/zoguxprotocol/slinky/providers/replay"
+)
+
+// providerFactoryOptions returns the oracle options that determine how the providers are created. By default,
+// the providers query the live data sources. With --record-to every provider response is also written to an
+// archive, and with --replay-from the responses in an archive are served instead. The returned function
+// must be called once the oracle is stopped.
+func providerFactoryOptions(logger *zap.Logger, cfg config.OracleConfig) ([]oracle.Option, func(), error) {
+	switch {
+	case recordTo != "" && replayFrom != "":
+		return nil, nil, fmt.Errorf("--record-to and --replay-from cannot be used together")
+	case recordTo != "":
+		recorder, err := replay.NewRecorder(logger, recordTo)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create recorder: %w", err)
+		}
+
+		logger.Info("recording provider responses", zap.String("archive", recordTo))
+		return []oracle.Option{
+			oracle.WithPriceAPIQueryHandlerFactory(oraclefactory.NewAPIQueryHandlerFactory(recorder.WrapRequestHandler)),
+			oracle.WithPriceWebSocketQueryHandlerFactory(oraclefactory.NewWebSocketQueryHandlerFactory(recorder.WrapConnHandler)),
+			oracle.WithMarketMapperFactory(oraclefactory.MarketMapProviderFactory),
+		}, func() {
+			if err := recorder.Close(); err != nil {
+				logger.Error("failed to close archive", zap.Error(err))
+			}
+		}, nil
+	case replayFrom != "":
+		// the market map is not recorded, so the markets must be provided by the market config instead.
+		if marketCfgPath == "" {
+			return nil, nil, fmt.Errorf("--replay-from requires --market-config-path")
+		}
+
+		// providers with their own price fetchers cannot be replayed, and would query the live data sources.
+		if unsupported := unreplayableProviders(cfg); len(unsupported) > 0 {
+			return nil, nil, fmt.Errorf(
+				"--replay-from does not support the %s providers; remove them from the oracle config",
+				strings.Join(unsupported, ", "),
+			)
+		}
+
+		records, err := replay.ReadArchive(replayFrom)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read archive: %w", err)
+		}
+
+		replayer, err := replay.NewReplayer(logger, records, replay.WithSpeed(replaySpeed))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create replayer: %w", err)
+		}
+
+		logger.Info(
+			"replaying provider responses",
+			zap.String("archive", replayFrom),
+			zap.Int("records", len(records)),
+			zap.Float64("speed", replaySpeed),
+		)
+		return []oracle.Option{
+			oracle.WithPriceAPIQueryHandlerFactory(oraclefactory.NewAPIQueryHandlerFactory(replayer.WrapRequestHandler)),
+			oracle.WithPriceWebSocketQueryHandlerFactory(oraclefactory.NewWebSocketQueryHandlerFactory(replayer.WrapConnHandler)),
+		}, func() {}, nil
+	default:
+		return []oracle.Option{
+			oracle.WithPriceAPIQueryHandlerFactory(oraclefactory.APIQueryHandlerFactory),             // Replace with custom API query handler factory.
+			oracle.WithPriceWebSocketQueryHandlerFactory(oraclefactory.WebSocketQueryHandlerFactory), // Replace with custom websocket query handler factory.
+			oracle.WithMarketMapperFactory(oraclefactory.MarketMapProviderFactory),
+		}, func() {}, nil
+	}
+}
+
+// unreplayableProviders returns the sorted names of the API price providers in the oracle config whose
+// requests cannot be replayed.
+func unreplayableProviders(cfg config.OracleConfig) []string {
+	var unsupported []string
+	for _, provider := range cfg.Providers {
+		if provider.Type != types.ConfigType || !provider.API.Enabled {
+			continue
+		}
+
+		if !oraclefactory.SupportsRequestHandlerMiddleware(provider.Name) {
+			unsupported = append(unsupported, provider.Name)
+		}
+	}
+
+	sort.Strings(unsupported)
+	return unsupported
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/zoguxprotocol/slinky/oracle/config"
+	"github.com/zoguxprotocol/slinky/oracle/constants"
+	"github.com/zoguxprotocol/slinky/oracle/types"
+	"github.com/zoguxprotocol/slinky/providers/apis/defi/raydium"
+	"github.com/zoguxprotocol/slinky/providers/apis/defi/uniswapv3"
+)
+
+func TestProviderFactoryOptions(t *testing.T) {
+	dir := t.TempDir()
+	archive := filepath.Join(dir, "archive.jsonl")
+	require.NoError(t, os.WriteFile(archive, []byte(`{"provider":"okx_ws","type":"websocket","timestamp":"2024-01-01T00:00:00Z","body":"e30="}`+"\n"), 0o600))
+
+	t.Cleanup(func() {
+		recordTo, replayFrom, marketCfgPath, replaySpeed = "", "", "", 1
+	})
+
+	customFetchers := config.OracleConfig{
+		Providers: map[string]config.ProviderConfig{
+			raydium.Name: {
+				Name: raydium.Name,
+				API:  config.APIConfig{Enabled: true, Name: raydium.Name},
+				Type: types.ConfigType,
+			},
+			uniswapv3.ProviderNames[constants.ETHEREUM]: {
+				Name: uniswapv3.ProviderNames[constants.ETHEREUM],
+				API:  config.APIConfig{Enabled: true, Name: uniswapv3.ProviderNames[constants.ETHEREUM]},
+				Type: types.ConfigType,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		cfg        config.OracleConfig
+		recordTo   string
+		replayFrom string
+		marketCfg  string
+		speed      float64
+		numOpts    int
+		err        bool
+	}{
+		{
+			name:    "live providers",
+			numOpts: 3,
+		},
+		{
+			name:     "record",
+			recordTo: filepath.Join(dir, "record.jsonl"),
+			numOpts:  3,
+		},
+		{
+			name:       "replay",
+			replayFrom: archive,
+			marketCfg:  "markets.json",
+			speed:      1,
+			numOpts:    2,
+		},
+		{
+			name:     "record providers with their own price fetchers",
+			cfg:      customFetchers,
+			recordTo: filepath.Join(dir, "record.jsonl"),
+			numOpts:  3,
+		},
+		{
+			name:       "replay providers with their own price fetchers",
+			cfg:        customFetchers,
+			replayFrom: archive,
+			marketCfg:  "markets.json",
+			speed:      1,
+			err:        true,
+		},
+		{
+			name:       "replay without a market config",
+			replayFrom: archive,
+			err:        true,
+		},
+		{
+			name:       "replay a missing archive",
+			replayFrom: filepath.Join(dir, "missing.jsonl"),
+			marketCfg:  "markets.json",
+			err:        true,
+		},
+		{
+			name:       "negative replay speed",
+			replayFrom: archive,
+			marketCfg:  "markets.json",
+			speed:      -1,
+			err:        true,
+		},
+		{
+			name:       "record and replay",
+			recordTo:   filepath.Join(dir, "record.jsonl"),
+			replayFrom: archive,
+			marketCfg:  "markets.json",
+			err:        true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			recordTo, replayFrom, marketCfgPath, replaySpeed = tc.recordTo, tc.replayFrom, tc.marketCfg, tc.speed
+
+			opts, closeProviders, err := providerFactoryOptions(zap.NewNop(), tc.cfg)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, opts, tc.numOpts)
+			closeProviders()
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	cmthttp "github.com/cometbft/cometbft/rpc/client/http"
+	"github.com/cosmos/gogoproto/proto"
+
+	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
+	oracletypes "github.com/zoguxprotocol/slinky/x/oracle/types"
+)
+
+const (
+	getCurrencyPairMappingPath = "/slinky.oracle.v1.Query/GetCurrencyPairMapping"
+	getPricesPath              = "/slinky.oracle.v1.Query/GetPrices"
+)
+
+// chain is the subset of the node API used to inspect vote extensions.
+type chain interface {
+	// ExtendedCommit returns the first transaction of the block at the given height, which holds the
+	// extended commit when vote extensions are enabled, along with the height of the block. If the
+	// height is 0, the latest block is returned.
+	ExtendedCommit(ctx context.Context, height int64) ([]byte, int64, error)
+
+	// OracleState returns the currency pairs tracked by x/oracle at the given height, along with
+	// their prices.
+	OracleState(ctx context.Context, height int64) (oracleState, error)
+}
+
+// oracleState is the state of x/oracle at a given height.
+type oracleState struct {
+	// pairs maps the ID of each currency pair to the currency pair.
+	pairs map[uint64]slinkytypes.CurrencyPair
+	// prices maps each currency pair with a price to its price.
+	prices map[slinkytypes.CurrencyPair]*big.Int
+	// heights maps each currency pair with a price to the height at which its price was last updated.
+	heights map[slinkytypes.CurrencyPair]uint64
+}
+
+// committedPrices returns the prices that were updated by the block at the given height. Prices that
+// were not updated are stale, and were not committed by the block.
+func (s oracleState) committedPrices(height int64) map[slinkytypes.CurrencyPair]*big.Int {
+	committed := make(map[slinkytypes.CurrencyPair]*big.Int, len(s.prices))
+	for cp, price := range s.prices {
+		if height > 0 && s.heights[cp] == uint64(height) {
+			committed[cp] = price
+		}
+	}
+
+	return committed
+}
+
+// cometChain implements chain by querying a node over CometBFT RPC.
+type cometChain struct {
+	client *cmthttp.HTTP
+}
+
+var _ chain = cometChain{}
+
+func newCometChain(node string) (cometChain, error) {
+	client, err := cmthttp.New(node, "/websocket")
+	if err != nil {
+		return cometChain{}, err
+	}
+
+	return cometChain{client: client}, nil
+}
+
+func (c cometChain) ExtendedCommit(ctx context.Context, height int64) ([]byte, int64, error) {
+	var h *int64
+	if height != 0 {
+		h = &height
+	}
+
+	block, err := c.client.Block(ctx, h)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if len(block.Block.Txs) == 0 {
+		return nil, block.Block.Height, nil
+	}
+
+	return block.Block.Txs[0], block.Block.Height, nil
+}
+
+func (c cometChain) OracleState(ctx context.Context, height int64) (oracleState, error) {
+	state := oracleState{
+		pairs:   make(map[uint64]slinkytypes.CurrencyPair),
+		prices:  make(map[slinkytypes.CurrencyPair]*big.Int),
+		heights: make(map[slinkytypes.CurrencyPair]uint64),
+	}
+
+	// a height of 0 would query the latest state.
+	if height < 1 {
+		return state, nil
+	}
+
+	var mapping oracletypes.GetCurrencyPairMappingResponse
+	if err := c.query(ctx, getCurrencyPairMappingPath, height, &oracletypes.GetCurrencyPairMappingRequest{}, &mapping); err != nil {
+		return state, fmt.Errorf("failed to query currency pair mapping at height %d: %w", height, err)
+	}
+	state.pairs = mapping.CurrencyPairMapping
+
+	if len(state.pairs) == 0 {
+		return state, nil
+	}
+
+	ids := make([]string, 0, len(state.pairs))
+	for _, cp := range state.pairs {
+		ids = append(ids, cp.String())
+	}
+	sort.Strings(ids)
+
+	var prices oracletypes.GetPricesResponse
+	if err := c.query(ctx, getPricesPath, height, &oracletypes.GetPricesRequest{CurrencyPairIds: ids}, &prices); err != nil {
+		return state, fmt.Errorf("failed to query prices at height %d: %w", height, err)
+	}
+
+	for _, price := range prices.Prices {
+		cp, ok := state.pairs[price.Id]
+		if !ok || price.Price == nil || price.Price.Price.IsNil() || !price.Price.Price.IsPositive() {
+			continue
+		}
+
+		state.prices[cp] = price.Price.Price.BigInt()
+		state.heights[cp] = price.Price.BlockHeight
+	}
+
+	return state, nil
+}
+
+func (c cometChain) query(ctx context.Context, path string, height int64, req, resp proto.Message) error {
+	bz, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.client.ABCIQueryWithOptions(ctx, path, bz, rpcclient.ABCIQueryOptions{Height: height})
+	if err != nil {
+		return err
+	}
+	if !res.Response.IsOK() {
+		return fmt.Errorf("query failed with code %d: %s", res.Response.Code, res.Response.Log)
+	}
+
+	return proto.Unmarshal(res.Response.Value, resp)
+}
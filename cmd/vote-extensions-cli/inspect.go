@@ -0,0 +1,280 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/zoguxprotocol/slinky/abci/strategies/codec"
+	"github.com/zoguxprotocol/slinky/abci/strategies/currencypair"
+	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
+)
+
+const (
+	// strategyDefault decodes currency pair IDs as x/oracle IDs, and prices as absolute prices.
+	strategyDefault = "default"
+	// strategyDelta decodes currency pair IDs as x/oracle IDs, and prices as deltas from the on-chain
+	// prices (see currencypair.DeltaCurrencyPairStrategy).
+	strategyDelta = "delta"
+	// strategyHash decodes currency pair IDs as hashes of the currency pairs, and prices as absolute
+	// prices (see currencypair.HashCurrencyPairStrategy).
+	strategyHash = "hash"
+	// strategyCompact decodes currency pair IDs as x/oracle IDs, and prices as varints (see
+	// currencypair.CompactCurrencyPairStrategy).
+	strategyCompact = "compact"
+)
+
+// BlockReport is the decoded extended commit of a block.
+type BlockReport struct {
+	Height int64        `json:"height"`
+	Round  int32        `json:"round"`
+	Votes  []VoteReport `json:"votes"`
+	// Error is set if the extended commit of the block could not be decoded.
+	Error string `json:"error,omitempty"`
+
+	// committed holds the prices committed by the block, by ticker.
+	committed map[string]*big.Int
+}
+
+// VoteReport is the decoded vote extension of a validator.
+type VoteReport struct {
+	Validator   string        `json:"validator"`
+	Power       int64         `json:"power"`
+	BlockIDFlag string        `json:"block_id_flag"`
+	Prices      []PriceReport `json:"prices"`
+	// Error is set if the vote extension could not be decoded.
+	Error string `json:"error,omitempty"`
+}
+
+// PriceReport is a price of a vote extension.
+type PriceReport struct {
+	ID uint64 `json:"id"`
+	// Ticker is empty if the ID could not be resolved to a currency pair.
+	Ticker string `json:"ticker,omitempty"`
+	Price  string `json:"price,omitempty"`
+	// Committed is the price committed by the block for the ticker, if any.
+	Committed string `json:"committed,omitempty"`
+	// DeviationBps is the deviation of the price from the committed price, in basis points.
+	DeviationBps *float64 `json:"deviation_bps,omitempty"`
+	// Error is set if the price could not be decoded.
+	Error string `json:"error,omitempty"`
+}
+
+// inspector decodes the vote extensions of blocks.
+type inspector struct {
+	chain          chain
+	extCommitCodec codec.ExtendedCommitCodec
+	veCodec        codec.VoteExtensionCodec
+	strategy       string
+
+	// states caches the oracle states of the last queried heights, as consecutive blocks share them.
+	states map[int64]oracleState
+}
+
+func newInspector(
+	c chain,
+	extCommitCodec codec.ExtendedCommitCodec,
+	veCodec codec.VoteExtensionCodec,
+	strategy string,
+) (*inspector, error) {
+	switch strategy {
+	case strategyDefault, strategyDelta, strategyHash, strategyCompact:
+	default:
+		return nil, fmt.Errorf(
+			"unknown strategy %q, expected one of %s, %s, %s or %s",
+			strategy, strategyDefault, strategyDelta, strategyHash, strategyCompact,
+		)
+	}
+
+	return &inspector{
+		chain:          c,
+		extCommitCodec: extCommitCodec,
+		veCodec:        veCodec,
+		strategy:       strategy,
+		states:         make(map[int64]oracleState),
+	}, nil
+}
+
+// Inspect decodes the vote extensions included in the block at the given height, or the latest block
+// if the height is 0. The vote extensions were created at the previous height, so they are decoded
+// against the x/oracle state of the previous height, exactly like the chain does, and compared with
+// the prices committed by the block. An error is only returned if the node could not be queried;
+// decoding errors are recorded in the report.
+func (i *inspector) Inspect(ctx context.Context, height int64) (BlockReport, error) {
+	bz, height, err := i.chain.ExtendedCommit(ctx, height)
+	if err != nil {
+		return BlockReport{Height: height}, fmt.Errorf("failed to get block: %w", err)
+	}
+
+	report := BlockReport{Height: height}
+	if len(bz) == 0 {
+		report.Error = "block has no extended commit"
+		return report, nil
+	}
+
+	extCommit, err := i.extCommitCodec.Decode(bz)
+	if err != nil {
+		report.Error = fmt.Sprintf("failed to decode extended commit: %s", err)
+		return report, nil
+	}
+	report.Round = extCommit.Round
+
+	previous, err := i.state(ctx, height-1)
+	if err != nil {
+		return report, err
+	}
+	state, err := i.state(ctx, height)
+	if err != nil {
+		return report, err
+	}
+
+	committed := state.committedPrices(height)
+	report.committed = make(map[string]*big.Int, len(committed))
+	for cp, price := range committed {
+		report.committed[cp.String()] = price
+	}
+
+	resolve := i.resolver(previous)
+	for _, vote := range extCommit.Votes {
+		voteReport := VoteReport{
+			Validator:   fmt.Sprintf("%X", vote.Validator.Address),
+			Power:       vote.Validator.Power,
+			BlockIDFlag: vote.BlockIdFlag.String(),
+			Prices:      make([]PriceReport, 0),
+		}
+
+		if len(vote.VoteExtension) == 0 {
+			report.Votes = append(report.Votes, voteReport)
+			continue
+		}
+
+		ve, err := i.veCodec.Decode(vote.VoteExtension)
+		if err != nil {
+			voteReport.Error = fmt.Sprintf("failed to decode vote extension: %s", err)
+			report.Votes = append(report.Votes, voteReport)
+			continue
+		}
+
+		for id, priceBz := range ve.Prices {
+			priceReport := PriceReport{ID: id}
+
+			cp, ok := resolve(id)
+			if ok {
+				priceReport.Ticker = cp.String()
+			}
+
+			price, err := i.decodePrice(previous, cp, ok, priceBz)
+			if err != nil {
+				priceReport.Error = err.Error()
+				voteReport.Prices = append(voteReport.Prices, priceReport)
+				continue
+			}
+			priceReport.Price = price.String()
+
+			if ok {
+				if committedPrice, found := committed[cp]; found {
+					deviation := deviationBps(price, committedPrice)
+					priceReport.Committed = committedPrice.String()
+					priceReport.DeviationBps = &deviation
+				}
+			}
+
+			voteReport.Prices = append(voteReport.Prices, priceReport)
+		}
+
+		sort.Slice(voteReport.Prices, func(a, b int) bool {
+			return voteReport.Prices[a].ID < voteReport.Prices[b].ID
+		})
+		report.Votes = append(report.Votes, voteReport)
+	}
+
+	return report, nil
+}
+
+// state returns the oracle state at the given height, caching it for the next block.
+func (i *inspector) state(ctx context.Context, height int64) (oracleState, error) {
+	if state, ok := i.states[height]; ok {
+		return state, nil
+	}
+
+	state, err := i.chain.OracleState(ctx, height)
+	if err != nil {
+		return state, err
+	}
+
+	for h := range i.states {
+		if h < height-1 {
+			delete(i.states, h)
+		}
+	}
+	i.states[height] = state
+
+	return state, nil
+}
+
+// resolver returns a function that resolves the IDs of a vote extension to currency pairs.
+func (i *inspector) resolver(state oracleState) func(uint64) (slinkytypes.CurrencyPair, bool) {
+	ids := state.pairs
+	if i.strategy == strategyHash {
+		ids = make(map[uint64]slinkytypes.CurrencyPair, len(state.pairs))
+		for _, cp := range state.pairs {
+			hash, err := currencypair.CurrencyPairToHashID(cp.String())
+			if err != nil {
+				continue
+			}
+			ids[hash] = cp
+		}
+	}
+
+	return func(id uint64) (slinkytypes.CurrencyPair, bool) {
+		cp, ok := ids[id]
+		return cp, ok
+	}
+}
+
+// decodePrice decodes the price of a vote extension. Delta prices can only be decoded if the currency
+// pair is known.
+func (i *inspector) decodePrice(
+	previous oracleState,
+	cp slinkytypes.CurrencyPair,
+	resolved bool,
+	bz []byte,
+) (*big.Int, error) {
+	if i.strategy == strategyCompact {
+		price, err := currencypair.DecodeVarint(bz)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode price: %w", err)
+		}
+
+		return price, nil
+	}
+
+	price := new(big.Int)
+	if err := price.GobDecode(bz); err != nil {
+		return nil, fmt.Errorf("failed to decode price: %w", err)
+	}
+
+	if i.strategy != strategyDelta {
+		return price, nil
+	}
+
+	if !resolved {
+		return nil, fmt.Errorf("cannot decode delta price of unknown currency pair")
+	}
+	if onChainPrice, ok := previous.prices[cp]; ok {
+		price.Add(price, onChainPrice)
+	}
+	if price.Sign() < 0 {
+		return nil, fmt.Errorf("price cannot be negative: %s", price.String())
+	}
+
+	return price, nil
+}
+
+// deviationBps returns the deviation of the price from the reference price, in basis points.
+func deviationBps(price, reference *big.Int) float64 {
+	diff := new(big.Float).SetInt(new(big.Int).Sub(price, reference))
+	bps, _ := diff.Quo(diff, new(big.Float).SetInt(reference)).Float64()
+	return bps * 10000
+}
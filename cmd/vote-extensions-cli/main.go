@@ -1,12 +1,11 @@
 package main
 
 import (
+	"context"
 	"fmt"
-	"math/big"
+	"io"
 	"os"
 
-	cmtabci "github.com/cometbft/cometbft/abci/types"
-	cmthttp "github.com/cometbft/cometbft/rpc/client/http"
 	"github.com/spf13/cobra"
 
 	"github.com/zoguxprotocol/slinky/abci/strategies/codec"
@@ -15,7 +14,7 @@ import (
 var (
 	rootCmd = &cobra.Command{
 		Use:   "vote-extensions-cli",
-		Short: "Inspect the vote extensions of a given node, at a given height",
+		Short: "Inspect the vote extensions of a given node, at a given height or over a range of heights",
 		Long: `Use as follows to inspect the vote extensions of a given node, at a given height:
 		
 		vote-extensions-cli --node <http<s>://<url>:26657> --height <height> --extended-commit-codec <selector> --vote-extension-codec <selector>
@@ -23,80 +22,47 @@ var (
 			--node: The node to query
 			--height: The height to query. If not provided, the latest height will be used
 			--extended-commit-codec: The codec to use to decode the extended commit. Options are 1: standard encoding (default), 2: z-lib compressed encoding, 3: zstd compressed encoding
-			--vote-extension-codec: The codec to use to decode the vote extension. Options are 1: standard encoding (default), 2: z-lib compressed encoding, 3: zstd compressed encoding
+			--vote-extension-codec: The codec to use to decode the vote extension. Options are 1: standard encoding (default), 2: z-lib compressed encoding, 3: zstd compressed encoding, 4: compact encoding
+			--strategy: The currency pair strategy of the chain. Options are default (default), delta, hash and compact
+			--output: The output format. Options are text (default), json and csv
+
+		Currency pair IDs are resolved to tickers, and prices are compared with the prices committed by the block,
+		by querying x/oracle on the node. Use --from and --to instead of --height to summarize the participation,
+		missing tickers and deviation from the committed prices of each validator over a range of heights:
+
+		vote-extensions-cli --node <http<s>://<url>:26657> --from <height> --to <height> --output csv
 		`,
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			// create a comet-http client
-			client, err := cmthttp.New(node, "/websocket")
-			if err != nil {
-				return err
-			}
-
-			// get the block-data
-			height := &height
-			if *height == 0 {
-				height = nil
-			}
-
-			block, err := client.Block(cmd.Context(), height)
+			c, err := newCometChain(node)
 			if err != nil {
 				return err
 			}
 
-			// decode the extended commit
-			var extCommit cmtabci.ExtendedCommitInfo
-			extCommitCodec, veCodec := codecsFromFlags(extendedCommitCodec, voteExtensionCodec)
-			extCommit, err = extCommitCodec.Decode(block.Block.Txs[0])
-			if err != nil {
-				return fmt.Errorf("failed to decode extended commit: %w", err)
-			}
-
-			// log the round of this extended commit
-			cmd.Println("Height:", block.Block.Height, "Round:", extCommit.Round)
-
-			// decode the vote extensions
-			for _, vote := range extCommit.Votes {
-				// decode the vote extension
-				ve, err := veCodec.Decode(vote.VoteExtension)
-				if err != nil {
-					return err
-				}
-
-				// per price, unmarshal via gob decoding
-				for priceID, priceBz := range ve.Prices {
-					cmd.Println("Price ID:", priceID)
-
-					price := new(big.Int)
-					if err := price.GobDecode(priceBz); err != nil {
-						return err
-					}
-					cmd.Println("Price:", price)
-				}
-
-				// log the block id
-				cmd.Println("Block ID:", vote.BlockIdFlag)
-
-				// log the validator
-				cmd.Println("Validator:", vote.Validator)
-			}
-
-			return nil
+			return run(cmd.Context(), c, cmd.OutOrStdout())
 		},
 	}
 
 	// Flags.
 	node                string
 	height              int64
+	from                int64
+	to                  int64
 	extendedCommitCodec string
 	voteExtensionCodec  string
+	strategy            string
+	output              string
 )
 
 func init() {
 	rootCmd.PersistentFlags().StringVar(&node, "node", "", "The node to query")
 	rootCmd.PersistentFlags().Int64Var(&height, "height", 0, "The height to query. If not provided, the latest height will be used")
+	rootCmd.PersistentFlags().Int64Var(&from, "from", 0, "The first height of a range of heights to summarize")
+	rootCmd.PersistentFlags().Int64Var(&to, "to", 0, "The last height of a range of heights to summarize. If not provided, the latest height will be used")
 	rootCmd.PersistentFlags().StringVar(&extendedCommitCodec, "extended-commit-codec", "1", "The codec to use to decode the extended commit. Options are 1: standard encoding (default), 2: z-lib compressed encoding, 3: zstd compressed encoding")
-	rootCmd.PersistentFlags().StringVar(&voteExtensionCodec, "vote-extension-codec", "1", "The codec to use to decode the vote extension. Options are 1: standard encoding (default), 2: z-lib compressed encoding, 3: zstd compressed encoding")
+	rootCmd.PersistentFlags().StringVar(&voteExtensionCodec, "vote-extension-codec", "1", "The codec to use to decode the vote extension. Options are 1: standard encoding (default), 2: z-lib compressed encoding, 3: zstd compressed encoding, 4: compact encoding")
+	rootCmd.PersistentFlags().StringVar(&strategy, "strategy", strategyDefault, "The currency pair strategy of the chain. Options are default, delta, hash and compact")
+	rootCmd.PersistentFlags().StringVarP(&output, "output", "o", outputText, "The output format. Options are text, json and csv")
 }
 
 func main() {
@@ -105,7 +71,76 @@ func main() {
 	}
 }
 
-func codecsFromFlags(extCommitCodecFlag, veCodecFlag string) (codec.ExtendedCommitCodec, codec.VoteExtensionCodec) {
+// run inspects the vote extensions of the height, or summarizes them over the range of heights, given by the flags.
+func run(ctx context.Context, c chain, out io.Writer) error {
+	if output != outputText && output != outputJSON && output != outputCSV {
+		return fmt.Errorf("invalid output format %q; expected %s, %s or %s", output, outputText, outputJSON, outputCSV)
+	}
+
+	extCommitCodec, veCodec, err := codecsFromFlags(extendedCommitCodec, voteExtensionCodec)
+	if err != nil {
+		return err
+	}
+
+	i, err := newInspector(c, extCommitCodec, veCodec, strategy)
+	if err != nil {
+		return err
+	}
+
+	if from == 0 && to == 0 {
+		report, err := i.Inspect(ctx, height)
+		if err != nil {
+			return err
+		}
+
+		switch output {
+		case outputJSON:
+			return writeJSON(out, report)
+		case outputCSV:
+			return writeBlockCSV(out, report)
+		default:
+			return writeBlockText(out, report)
+		}
+	}
+
+	if height != 0 {
+		return fmt.Errorf("--height cannot be used with --from and --to")
+	}
+	if from < 1 {
+		return fmt.Errorf("--from must be set to a positive height")
+	}
+
+	last := to
+	if last == 0 {
+		if _, last, err = c.ExtendedCommit(ctx, 0); err != nil {
+			return fmt.Errorf("failed to get latest block: %w", err)
+		}
+	}
+	if last < from {
+		return fmt.Errorf("--to (%d) cannot be lower than --from (%d)", last, from)
+	}
+
+	reports := make([]BlockReport, 0, last-from+1)
+	for h := from; h <= last; h++ {
+		report, err := i.Inspect(ctx, h)
+		if err != nil {
+			return fmt.Errorf("height %d: %w", h, err)
+		}
+		reports = append(reports, report)
+	}
+
+	summary := summarize(from, last, reports)
+	switch output {
+	case outputJSON:
+		return writeJSON(out, summary)
+	case outputCSV:
+		return writeSummaryCSV(out, summary)
+	default:
+		return writeSummaryText(out, summary)
+	}
+}
+
+func codecsFromFlags(extCommitCodecFlag, veCodecFlag string) (codec.ExtendedCommitCodec, codec.VoteExtensionCodec, error) {
 	var extCommitCodec codec.ExtendedCommitCodec
 	var veCodec codec.VoteExtensionCodec
 
@@ -122,6 +157,8 @@ func codecsFromFlags(extCommitCodecFlag, veCodecFlag string) (codec.ExtendedComm
 			codec.NewDefaultExtendedCommitCodec(),
 			codec.NewZStdCompressor(),
 		)
+	default:
+		return nil, nil, fmt.Errorf("unknown extended commit codec %q", extCommitCodecFlag)
 	}
 
 	switch veCodecFlag {
@@ -137,7 +174,11 @@ func codecsFromFlags(extCommitCodecFlag, veCodecFlag string) (codec.ExtendedComm
 			codec.NewDefaultVoteExtensionCodec(),
 			codec.NewZStdCompressor(),
 		)
+	case "4":
+		veCodec = codec.NewCompactVoteExtensionCodec()
+	default:
+		return nil, nil, fmt.Errorf("unknown vote extension codec %q", veCodecFlag)
 	}
 
-	return extCommitCodec, veCodec
+	return extCommitCodec, veCodec, nil
 }
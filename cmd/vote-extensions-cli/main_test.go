@@ -0,0 +1,415 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zoguxprotocol/slinky/abci/strategies/codec"
+	"github.com/zoguxprotocol/slinky/abci/strategies/currencypair"
+	vetypes "github.com/zoguxprotocol/slinky/abci/ve/types"
+	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
+)
+
+var (
+	btcusd = slinkytypes.NewCurrencyPair("BTC", "USD")
+	ethusd = slinkytypes.NewCurrencyPair("ETH", "USD")
+
+	validatorA = []byte{0xaa}
+	validatorB = []byte{0xbb}
+)
+
+// fakeChain implements chain with fixed extended commits and oracle states.
+type fakeChain struct {
+	latest  int64
+	commits map[int64][]byte
+	states  map[int64]oracleState
+}
+
+func (c fakeChain) ExtendedCommit(_ context.Context, height int64) ([]byte, int64, error) {
+	if height == 0 {
+		height = c.latest
+	}
+
+	bz, ok := c.commits[height]
+	if !ok {
+		return nil, height, fmt.Errorf("block %d not found", height)
+	}
+
+	return bz, height, nil
+}
+
+func (c fakeChain) OracleState(_ context.Context, height int64) (oracleState, error) {
+	state, ok := c.states[height]
+	if !ok {
+		return oracleState{}, fmt.Errorf("state %d not found", height)
+	}
+
+	return state, nil
+}
+
+// newState returns an oracle state whose prices were all updated at the given height.
+func newState(height uint64, prices map[slinkytypes.CurrencyPair]int64) oracleState {
+	state := oracleState{
+		pairs:   map[uint64]slinkytypes.CurrencyPair{0: btcusd, 1: ethusd},
+		prices:  make(map[slinkytypes.CurrencyPair]*big.Int),
+		heights: make(map[slinkytypes.CurrencyPair]uint64),
+	}
+	for cp, price := range prices {
+		state.prices[cp] = big.NewInt(price)
+		state.heights[cp] = height
+	}
+
+	return state
+}
+
+// vote is the vote extension of a validator, by currency pair ID. A vote without prices is absent, and
+// raw overrides the encoded vote extension.
+type vote struct {
+	validator []byte
+	prices    map[uint64]int64
+	raw       []byte
+}
+
+func encodeCommit(t *testing.T, votes ...vote) []byte {
+	t.Helper()
+
+	extCommit := cmtabci.ExtendedCommitInfo{Round: 1}
+	for _, v := range votes {
+		info := cmtabci.ExtendedVoteInfo{
+			Validator:   cmtabci.Validator{Address: v.validator, Power: 10},
+			BlockIdFlag: cmtproto.BlockIDFlagCommit,
+		}
+
+		switch {
+		case v.raw != nil:
+			info.VoteExtension = v.raw
+		case v.prices == nil:
+			info.BlockIdFlag = cmtproto.BlockIDFlagAbsent
+		default:
+			ve := vetypes.OracleVoteExtension{Prices: make(map[uint64][]byte)}
+			for id, price := range v.prices {
+				bz, err := big.NewInt(price).GobEncode()
+				require.NoError(t, err)
+				ve.Prices[id] = bz
+			}
+
+			bz, err := codec.NewDefaultVoteExtensionCodec().Encode(ve)
+			require.NoError(t, err)
+			info.VoteExtension = bz
+		}
+
+		extCommit.Votes = append(extCommit.Votes, info)
+	}
+
+	bz, err := codec.NewDefaultExtendedCommitCodec().Encode(extCommit)
+	require.NoError(t, err)
+	return bz
+}
+
+func setFlags(t *testing.T, h, f, l int64, s, o string) {
+	t.Helper()
+
+	t.Cleanup(func() {
+		height, from, to = 0, 0, 0
+		strategy, output = strategyDefault, outputText
+		extendedCommitCodec, voteExtensionCodec = "1", "1"
+	})
+	height, from, to = h, f, l
+	strategy, output = s, o
+	extendedCommitCodec, voteExtensionCodec = "1", "1"
+}
+
+func TestInspectBlock(t *testing.T) {
+	c := fakeChain{
+		latest: 10,
+		commits: map[int64][]byte{
+			10: encodeCommit(t,
+				vote{validator: validatorA, prices: map[uint64]int64{0: 10100, 1: 2000, 7: 1}},
+				vote{validator: validatorB},
+				vote{validator: []byte{0xcc}, raw: []byte("garbage")},
+			),
+		},
+		states: map[int64]oracleState{
+			9:  newState(9, map[slinkytypes.CurrencyPair]int64{btcusd: 9000}),
+			10: newState(10, map[slinkytypes.CurrencyPair]int64{btcusd: 10000}),
+		},
+	}
+
+	t.Run("text", func(t *testing.T) {
+		setFlags(t, 0, 0, 0, strategyDefault, outputText)
+
+		var out bytes.Buffer
+		require.NoError(t, run(context.Background(), c, &out))
+		require.Contains(t, out.String(), "Height: 10 Round: 1")
+		require.Contains(t, out.String(), "Validator: AA Power: 10 Block ID Flag: BLOCK_ID_FLAG_COMMIT")
+		require.Contains(t, out.String(), "BTC/USD (id 0): 10100 (committed 10000, +100.00 bps)")
+		require.Contains(t, out.String(), "ETH/USD (id 1): 2000\n")
+		require.Contains(t, out.String(), "id 7: 1\n")
+		require.Contains(t, out.String(), "Validator: BB Power: 10 Block ID Flag: BLOCK_ID_FLAG_ABSENT\n  No prices")
+		require.Contains(t, out.String(), "Validator: CC Power: 10 Block ID Flag: BLOCK_ID_FLAG_COMMIT\n  Error: failed to decode vote extension")
+	})
+
+	t.Run("json", func(t *testing.T) {
+		setFlags(t, 10, 0, 0, strategyDefault, outputJSON)
+
+		var out bytes.Buffer
+		require.NoError(t, run(context.Background(), c, &out))
+
+		var report BlockReport
+		require.NoError(t, json.Unmarshal(out.Bytes(), &report))
+		require.Equal(t, int64(10), report.Height)
+		require.Len(t, report.Votes, 3)
+		require.Equal(t, "BTC/USD", report.Votes[0].Prices[0].Ticker)
+		require.InDelta(t, 100, *report.Votes[0].Prices[0].DeviationBps, 1e-9)
+		require.NotEmpty(t, report.Votes[2].Error)
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		setFlags(t, 10, 0, 0, strategyDefault, outputCSV)
+
+		var out bytes.Buffer
+		require.NoError(t, run(context.Background(), c, &out))
+
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		require.Len(t, lines, 6)
+		require.Equal(t, "height,validator,power,block_id_flag,id,ticker,price,committed,deviation_bps,error", lines[0])
+		require.Equal(t, "10,AA,10,BLOCK_ID_FLAG_COMMIT,0,BTC/USD,10100,10000,100.00,", lines[1])
+		require.Equal(t, "10,BB,10,BLOCK_ID_FLAG_ABSENT,,,,,,", lines[4])
+	})
+
+	t.Run("delta strategy", func(t *testing.T) {
+		setFlags(t, 10, 0, 0, strategyDelta, outputJSON)
+
+		var out bytes.Buffer
+		require.NoError(t, run(context.Background(), c, &out))
+
+		var report BlockReport
+		require.NoError(t, json.Unmarshal(out.Bytes(), &report))
+
+		// deltas are added to the prices of the previous height.
+		prices := report.Votes[0].Prices
+		require.Equal(t, "19100", prices[0].Price)
+		require.Equal(t, "2000", prices[1].Price)
+		require.NotEmpty(t, prices[2].Error)
+	})
+
+	t.Run("hash strategy", func(t *testing.T) {
+		hash, err := currencypair.CurrencyPairToHashID(ethusd.String())
+		require.NoError(t, err)
+
+		hashed := c
+		hashed.commits = map[int64][]byte{
+			10: encodeCommit(t, vote{validator: validatorA, prices: map[uint64]int64{hash: 2000}}),
+		}
+		setFlags(t, 10, 0, 0, strategyHash, outputText)
+
+		var out bytes.Buffer
+		require.NoError(t, run(context.Background(), hashed, &out))
+		require.Contains(t, out.String(), fmt.Sprintf("ETH/USD (id %d): 2000", hash))
+	})
+
+	t.Run("compact strategy", func(t *testing.T) {
+		bz, err := codec.NewCompactVoteExtensionCodec().Encode(vetypes.OracleVoteExtension{
+			Prices: map[uint64][]byte{
+				0: currencypair.EncodeVarint(big.NewInt(10100)),
+				1: currencypair.EncodeVarint(big.NewInt(2000)),
+			},
+		})
+		require.NoError(t, err)
+
+		compact := c
+		compact.commits = map[int64][]byte{
+			10: encodeCommit(t, vote{validator: validatorA, raw: bz}),
+		}
+		setFlags(t, 10, 0, 0, strategyCompact, outputText)
+		voteExtensionCodec = "4"
+
+		var out bytes.Buffer
+		require.NoError(t, run(context.Background(), compact, &out))
+		require.Contains(t, out.String(), "BTC/USD (id 0): 10100 (committed 10000, +100.00 bps)")
+		require.Contains(t, out.String(), "ETH/USD (id 1): 2000\n")
+	})
+
+	t.Run("stale price", func(t *testing.T) {
+		// the price of BTC/USD was not updated by the block, so it was not committed by the block.
+		stale := c
+		stale.states = map[int64]oracleState{
+			9:  c.states[9],
+			10: newState(9, map[slinkytypes.CurrencyPair]int64{btcusd: 10000}),
+		}
+		setFlags(t, 10, 0, 0, strategyDefault, outputJSON)
+
+		var out bytes.Buffer
+		require.NoError(t, run(context.Background(), stale, &out))
+
+		var report BlockReport
+		require.NoError(t, json.Unmarshal(out.Bytes(), &report))
+		require.Equal(t, "BTC/USD", report.Votes[0].Prices[0].Ticker)
+		require.Empty(t, report.Votes[0].Prices[0].Committed)
+		require.Nil(t, report.Votes[0].Prices[0].DeviationBps)
+	})
+
+	t.Run("block without extended commit", func(t *testing.T) {
+		empty := c
+		empty.commits = map[int64][]byte{10: {}}
+		setFlags(t, 10, 0, 0, strategyDefault, outputText)
+
+		var out bytes.Buffer
+		require.NoError(t, run(context.Background(), empty, &out))
+		require.Contains(t, out.String(), "Error: block has no extended commit")
+	})
+}
+
+func TestSummarizeRange(t *testing.T) {
+	c := fakeChain{
+		latest: 3,
+		commits: map[int64][]byte{
+			2: encodeCommit(t,
+				vote{validator: validatorA, prices: map[uint64]int64{0: 10100, 1: 2000}},
+				vote{validator: validatorB, prices: map[uint64]int64{0: 10000}},
+			),
+			3: encodeCommit(t,
+				vote{validator: validatorA, prices: map[uint64]int64{0: 9900, 1: 2000}},
+				vote{validator: validatorB},
+			),
+		},
+		states: map[int64]oracleState{
+			1: newState(1, nil),
+			2: newState(2, map[slinkytypes.CurrencyPair]int64{btcusd: 10000, ethusd: 2000}),
+			3: newState(3, map[slinkytypes.CurrencyPair]int64{btcusd: 10000, ethusd: 2000}),
+		},
+	}
+
+	t.Run("json", func(t *testing.T) {
+		setFlags(t, 0, 2, 0, strategyDefault, outputJSON)
+
+		var out bytes.Buffer
+		require.NoError(t, run(context.Background(), c, &out))
+
+		var summary RangeSummary
+		require.NoError(t, json.Unmarshal(out.Bytes(), &summary))
+		require.Equal(t, int64(2), summary.From)
+		require.Equal(t, int64(3), summary.To)
+		require.Equal(t, 2, summary.Blocks)
+		require.Empty(t, summary.FailedBlocks)
+		require.Len(t, summary.Validators, 2)
+
+		a, b := summary.Validators[0], summary.Validators[1]
+		require.Equal(t, "AA", a.Validator)
+		require.Equal(t, 2, a.Blocks)
+		require.Equal(t, 2, a.Participated)
+		require.Empty(t, a.MissingTickers)
+		require.Equal(t, 4, a.Deviation.Samples)
+		require.InDelta(t, 50, a.Deviation.MeanBps, 1e-9)
+		require.InDelta(t, 100, a.Deviation.MaxBps, 1e-9)
+
+		require.Equal(t, "BB", b.Validator)
+		require.Equal(t, 2, b.Blocks)
+		require.Equal(t, 1, b.Participated)
+		require.Equal(t, map[string]int{"ETH/USD": 1}, b.MissingTickers)
+		require.Equal(t, 0.5, b.ParticipationRate())
+	})
+
+	t.Run("stale prices", func(t *testing.T) {
+		// the price of ETH/USD was not updated by block 2, so validator BB did not miss it.
+		stale := c
+		stale.states = map[int64]oracleState{
+			1: newState(1, map[slinkytypes.CurrencyPair]int64{ethusd: 2000}),
+			2: newState(2, map[slinkytypes.CurrencyPair]int64{btcusd: 10000}),
+			3: newState(3, map[slinkytypes.CurrencyPair]int64{btcusd: 10000, ethusd: 2000}),
+		}
+		stale.states[2].prices[ethusd] = big.NewInt(2000)
+		stale.states[2].heights[ethusd] = 1
+		setFlags(t, 0, 2, 0, strategyDefault, outputJSON)
+
+		var out bytes.Buffer
+		require.NoError(t, run(context.Background(), stale, &out))
+
+		var summary RangeSummary
+		require.NoError(t, json.Unmarshal(out.Bytes(), &summary))
+		require.Len(t, summary.Validators, 2)
+
+		a, b := summary.Validators[0], summary.Validators[1]
+		require.Equal(t, 3, a.Deviation.Samples)
+		require.InDelta(t, 200.0/3, a.Deviation.MeanBps, 1e-9)
+		require.Empty(t, b.MissingTickers)
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		setFlags(t, 0, 2, 3, strategyDefault, outputCSV)
+
+		var out bytes.Buffer
+		require.NoError(t, run(context.Background(), c, &out))
+		require.Equal(t, []string{
+			"validator,blocks,participated,participation_rate,decode_errors,missing,mean_deviation_bps,max_deviation_bps,missing_tickers",
+			"AA,2,2,1.0000,0,0,50.00,100.00,",
+			"BB,2,1,0.5000,0,1,0.00,0.00,ETH/USD:1",
+		}, strings.Split(strings.TrimSpace(out.String()), "\n"))
+	})
+
+	t.Run("text", func(t *testing.T) {
+		setFlags(t, 0, 2, 3, strategyDefault, outputText)
+
+		var out bytes.Buffer
+		require.NoError(t, run(context.Background(), c, &out))
+		require.Contains(t, out.String(), "2 blocks from 2 to 3, 0 failed")
+		require.Regexp(t, `BB\s+2\s+1\s+50.00\s+0\s+1\s+0.00\s+0.00\s+ETH/USD:1`, out.String())
+	})
+}
+
+func TestRunInvalidFlags(t *testing.T) {
+	c := fakeChain{latest: 1}
+
+	testCases := []struct {
+		name  string
+		setup func(t *testing.T)
+	}{
+		{
+			name: "invalid output",
+			setup: func(t *testing.T) {
+				setFlags(t, 0, 0, 0, strategyDefault, "yaml")
+			},
+		},
+		{
+			name: "invalid strategy",
+			setup: func(t *testing.T) {
+				setFlags(t, 0, 0, 0, "median", outputText)
+			},
+		},
+		{
+			name: "invalid codec",
+			setup: func(t *testing.T) {
+				setFlags(t, 0, 0, 0, strategyDefault, outputText)
+				extendedCommitCodec = "4"
+			},
+		},
+		{
+			name: "height with range",
+			setup: func(t *testing.T) {
+				setFlags(t, 5, 1, 2, strategyDefault, outputText)
+			},
+		},
+		{
+			name: "inverted range",
+			setup: func(t *testing.T) {
+				setFlags(t, 0, 3, 2, strategyDefault, outputText)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setup(t)
+			require.Error(t, run(context.Background(), c, &bytes.Buffer{}))
+		})
+	}
+}
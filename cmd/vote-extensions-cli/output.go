@@ -0,0 +1,209 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	outputText = "text"
+	outputJSON = "json"
+	outputCSV  = "csv"
+)
+
+// writeJSON writes the value as indented JSON.
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// writeBlockText writes a human-readable report of the vote extensions of a block.
+func writeBlockText(w io.Writer, report BlockReport) error {
+	fmt.Fprintln(w, "Height:", report.Height, "Round:", report.Round)
+	if report.Error != "" {
+		fmt.Fprintln(w, "Error:", report.Error)
+		return nil
+	}
+
+	for _, vote := range report.Votes {
+		fmt.Fprintf(w, "\nValidator: %s Power: %d Block ID Flag: %s\n", vote.Validator, vote.Power, vote.BlockIDFlag)
+		if vote.Error != "" {
+			fmt.Fprintln(w, "  Error:", vote.Error)
+			continue
+		}
+		if len(vote.Prices) == 0 {
+			fmt.Fprintln(w, "  No prices")
+			continue
+		}
+
+		for _, price := range vote.Prices {
+			name := fmt.Sprintf("id %d", price.ID)
+			if price.Ticker != "" {
+				name = fmt.Sprintf("%s (id %d)", price.Ticker, price.ID)
+			}
+
+			switch {
+			case price.Error != "":
+				fmt.Fprintf(w, "  %s: error: %s\n", name, price.Error)
+			case price.DeviationBps != nil:
+				fmt.Fprintf(w, "  %s: %s (committed %s, %+.2f bps)\n", name, price.Price, price.Committed, *price.DeviationBps)
+			default:
+				fmt.Fprintf(w, "  %s: %s\n", name, price.Price)
+			}
+		}
+	}
+
+	return nil
+}
+
+// writeBlockCSV writes the vote extensions of a block as CSV, with a row per validator and price.
+func writeBlockCSV(w io.Writer, report BlockReport) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{
+		"height", "validator", "power", "block_id_flag", "id", "ticker", "price", "committed", "deviation_bps", "error",
+	}); err != nil {
+		return err
+	}
+
+	row := func(vote VoteReport, price PriceReport, hasPrice bool, errMsg string) error {
+		id, deviation := "", ""
+		if hasPrice {
+			id = strconv.FormatUint(price.ID, 10)
+		}
+		if price.DeviationBps != nil {
+			deviation = strconv.FormatFloat(*price.DeviationBps, 'f', 2, 64)
+		}
+
+		return cw.Write([]string{
+			strconv.FormatInt(report.Height, 10),
+			vote.Validator,
+			strconv.FormatInt(vote.Power, 10),
+			vote.BlockIDFlag,
+			id,
+			price.Ticker,
+			price.Price,
+			price.Committed,
+			deviation,
+			errMsg,
+		})
+	}
+
+	for _, vote := range report.Votes {
+		// validators without prices still get a row, so that missing vote extensions are visible.
+		if vote.Error != "" || len(vote.Prices) == 0 {
+			if err := row(vote, PriceReport{}, false, vote.Error); err != nil {
+				return err
+			}
+			continue
+		}
+
+		for _, price := range vote.Prices {
+			if err := row(vote, price, true, price.Error); err != nil {
+				return err
+			}
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// writeSummaryText writes a human-readable summary of a range of blocks.
+func writeSummaryText(w io.Writer, summary RangeSummary) error {
+	fmt.Fprintf(w, "%d blocks from %d to %d, %d failed\n", summary.Blocks, summary.From, summary.To, len(summary.FailedBlocks))
+	for _, block := range summary.FailedBlocks {
+		fmt.Fprintf(w, "  %d: %s\n", block.Height, block.Error)
+	}
+	fmt.Fprintln(w)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "VALIDATOR\tBLOCKS\tPARTICIPATED\tPARTICIPATION %\tDECODE ERRORS\tMISSING\tMEAN DEV (BPS)\tMAX DEV (BPS)\tMOST MISSED")
+	for _, v := range summary.Validators {
+		meanDev, maxDev, missed := "-", "-", mostMissed(v.MissingTickers, 3)
+		if missed == "" {
+			missed = "-"
+		}
+		if v.Deviation.Samples > 0 {
+			meanDev = strconv.FormatFloat(v.Deviation.MeanBps, 'f', 2, 64)
+			maxDev = strconv.FormatFloat(v.Deviation.MaxBps, 'f', 2, 64)
+		}
+
+		fmt.Fprintf(
+			tw,
+			"%s\t%d\t%d\t%.2f\t%d\t%d\t%s\t%s\t%s\n",
+			v.Validator,
+			v.Blocks,
+			v.Participated,
+			100*v.ParticipationRate(),
+			v.DecodeErrors,
+			v.Missing(),
+			meanDev,
+			maxDev,
+			missed,
+		)
+	}
+
+	return tw.Flush()
+}
+
+// writeSummaryCSV writes the summary of a range of blocks as CSV, with a row per validator.
+func writeSummaryCSV(w io.Writer, summary RangeSummary) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{
+		"validator", "blocks", "participated", "participation_rate", "decode_errors", "missing", "mean_deviation_bps", "max_deviation_bps", "missing_tickers",
+	}); err != nil {
+		return err
+	}
+
+	for _, v := range summary.Validators {
+		if err := cw.Write([]string{
+			v.Validator,
+			strconv.Itoa(v.Blocks),
+			strconv.Itoa(v.Participated),
+			strconv.FormatFloat(v.ParticipationRate(), 'f', 4, 64),
+			strconv.Itoa(v.DecodeErrors),
+			strconv.Itoa(v.Missing()),
+			strconv.FormatFloat(v.Deviation.MeanBps, 'f', 2, 64),
+			strconv.FormatFloat(v.Deviation.MaxBps, 'f', 2, 64),
+			mostMissed(v.MissingTickers, 0),
+		}); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// mostMissed formats the most missed tickers as ticker:count pairs separated by semicolons, ordered by
+// count. If limit is 0, all tickers are included.
+func mostMissed(missing map[string]int, limit int) string {
+	tickers := make([]string, 0, len(missing))
+	for ticker := range missing {
+		tickers = append(tickers, ticker)
+	}
+	sort.Slice(tickers, func(i, j int) bool {
+		if missing[tickers[i]] != missing[tickers[j]] {
+			return missing[tickers[i]] > missing[tickers[j]]
+		}
+		return tickers[i] < tickers[j]
+	})
+
+	if limit > 0 && len(tickers) > limit {
+		tickers = tickers[:limit]
+	}
+
+	pairs := make([]string, len(tickers))
+	for i, ticker := range tickers {
+		pairs[i] = fmt.Sprintf("%s:%d", ticker, missing[ticker])
+	}
+
+	return strings.Join(pairs, ";")
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"math"
+	"sort"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+)
+
+// RangeSummary summarizes the vote extensions of a range of blocks.
+type RangeSummary struct {
+	From   int64 `json:"from"`
+	To     int64 `json:"to"`
+	Blocks int   `json:"blocks"`
+	// FailedBlocks are the blocks whose extended commit could not be decoded.
+	FailedBlocks []FailedBlock      `json:"failed_blocks"`
+	Validators   []ValidatorSummary `json:"validators"`
+}
+
+// FailedBlock is a block whose extended commit could not be decoded.
+type FailedBlock struct {
+	Height int64  `json:"height"`
+	Error  string `json:"error"`
+}
+
+// ValidatorSummary summarizes the vote extensions of a validator over a range of blocks.
+type ValidatorSummary struct {
+	Validator string `json:"validator"`
+	// Blocks is the number of blocks whose extended commit includes the validator.
+	Blocks int `json:"blocks"`
+	// Participated is the number of blocks that include a vote extension of the validator with at
+	// least one price.
+	Participated int `json:"participated"`
+	// DecodeErrors is the number of vote extensions of the validator that could not be decoded.
+	DecodeErrors int `json:"decode_errors"`
+	// MissingTickers is the number of blocks, among the blocks the validator participated in, that
+	// committed a price for a ticker that the validator did not report, by ticker.
+	MissingTickers map[string]int `json:"missing_tickers"`
+	// Deviation is the deviation of the prices of the validator from the committed prices.
+	Deviation Deviation `json:"deviation"`
+}
+
+// Deviation summarizes the deviation of prices from the committed prices, in basis points.
+type Deviation struct {
+	Samples int `json:"samples"`
+	// MeanBps is the mean of the absolute deviations.
+	MeanBps float64 `json:"mean_bps"`
+	// MaxBps is the largest absolute deviation.
+	MaxBps float64 `json:"max_bps"`
+}
+
+// ParticipationRate returns the fraction of blocks the validator participated in.
+func (s ValidatorSummary) ParticipationRate() float64 {
+	if s.Blocks == 0 {
+		return 0
+	}
+
+	return float64(s.Participated) / float64(s.Blocks)
+}
+
+// Missing returns the total number of missing tickers of the validator.
+func (s ValidatorSummary) Missing() int {
+	var missing int
+	for _, count := range s.MissingTickers {
+		missing += count
+	}
+
+	return missing
+}
+
+// summarize summarizes the given block reports.
+func summarize(from, to int64, reports []BlockReport) RangeSummary {
+	summary := RangeSummary{
+		From:         from,
+		To:           to,
+		Blocks:       len(reports),
+		FailedBlocks: make([]FailedBlock, 0),
+		Validators:   make([]ValidatorSummary, 0),
+	}
+
+	validators := make(map[string]*ValidatorSummary)
+	for _, report := range reports {
+		if report.Error != "" {
+			summary.FailedBlocks = append(summary.FailedBlocks, FailedBlock{Height: report.Height, Error: report.Error})
+			continue
+		}
+
+		for _, vote := range report.Votes {
+			v, ok := validators[vote.Validator]
+			if !ok {
+				v = &ValidatorSummary{Validator: vote.Validator, MissingTickers: make(map[string]int)}
+				validators[vote.Validator] = v
+			}
+			v.Blocks++
+
+			if vote.Error != "" {
+				v.DecodeErrors++
+				continue
+			}
+			if vote.BlockIDFlag != cmtproto.BlockIDFlagCommit.String() || len(vote.Prices) == 0 {
+				continue
+			}
+			v.Participated++
+
+			reported := make(map[string]struct{}, len(vote.Prices))
+			for _, price := range vote.Prices {
+				if price.Ticker == "" || price.Error != "" {
+					continue
+				}
+				reported[price.Ticker] = struct{}{}
+
+				if price.DeviationBps != nil {
+					v.Deviation.add(*price.DeviationBps)
+				}
+			}
+
+			for ticker := range report.committed {
+				if _, ok := reported[ticker]; !ok {
+					v.MissingTickers[ticker]++
+				}
+			}
+		}
+	}
+
+	for _, v := range validators {
+		summary.Validators = append(summary.Validators, *v)
+	}
+	sort.Slice(summary.Validators, func(i, j int) bool {
+		return summary.Validators[i].Validator < summary.Validators[j].Validator
+	})
+
+	return summary
+}
+
+// add adds a sample to the deviation.
+func (d *Deviation) add(bps float64) {
+	bps = math.Abs(bps)
+
+	d.MeanBps += (bps - d.MeanBps) / float64(d.Samples+1)
+	d.Samples++
+	if bps > d.MaxBps {
+		d.MaxBps = bps
+	}
+}
@@ -15,6 +15,7 @@ var (
 	DefaultMetricsEnabled = false
 	DefaultPriceTTL       = 10 * time.Second
 	DefaultInterval       = 1500 * time.Millisecond
+	DefaultStreamPrices   = false
 
 	MaxInterval = 1 * time.Minute
 	MaxPriceTTL = 1 * time.Minute
@@ -59,6 +60,11 @@ price_ttl = "{{ .Oracle.PriceTTL }}"
 # is the block time of the chain. Otherwise, 1.5 seconds (1500ms) is a good default. If this
 # is greater than 1 minute (1m), the app will not start.
 interval = "{{ .Oracle.Interval }}"
+
+# StreamPrices determines whether the application subscribes to the oracle's price
+# stream instead of polling it every interval. If the stream fails, prices are polled
+# every interval until the stream is re-established.
+stream_prices = "{{ .Oracle.StreamPrices }}"
 `
 )
 
@@ -71,6 +77,7 @@ func NewDefaultAppConfig() AppConfig {
 		MetricsEnabled: DefaultMetricsEnabled,
 		PriceTTL:       DefaultPriceTTL,
 		Interval:       DefaultInterval,
+		StreamPrices:   DefaultStreamPrices,
 	}
 }
 
@@ -82,6 +89,7 @@ const (
 	flagPrometheusServerAddress = "oracle.prometheus_server_address"
 	flagPriceTTL                = "oracle.price_ttl"
 	flagInterval                = "oracle.interval"
+	flagStreamPrices            = "oracle.stream_prices"
 )
 
 // AppConfig contains the application side oracle configurations that must
@@ -107,6 +115,10 @@ type AppConfig struct {
 
 	// Interval is the time between each price update request.
 	Interval time.Duration `mapstructure:"interval" toml:"interval"`
+
+	// StreamPrices is a flag that determines whether prices are streamed from the oracle
+	// instead of polled every Interval.
+	StreamPrices bool `mapstructure:"stream_prices" toml:"stream_prices"`
 }
 
 // ValidateBasic performs basic validation of the app config.
@@ -215,6 +227,13 @@ func ReadConfigFromAppOpts(opts servertypes.AppOptions) (AppConfig, error) {
 		}
 	}
 
+	// get the stream prices flag
+	if v := opts.Get(flagStreamPrices); v != nil {
+		if cfg.StreamPrices, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+
 	if err := cfg.ValidateBasic(); err != nil {
 		return cfg, err
 	}
@@ -230,6 +249,7 @@ func (c AppConfig) String() string {
   Client Timeout: %s
   Metrics Enabled: %v
   Price TTL: %s
-  Interval: %s`,
-		c.Enabled, c.OracleAddress, c.ClientTimeout, c.MetricsEnabled, c.PriceTTL, c.Interval)
+  Interval: %s
+  Stream Prices: %v`,
+		c.Enabled, c.OracleAddress, c.ClientTimeout, c.MetricsEnabled, c.PriceTTL, c.Interval, c.StreamPrices)
 }
@@ -26,6 +26,10 @@ type OracleConfig struct {
 	// Metrics is the metrics configurations for the oracle.
 	Metrics MetricsConfig `json:"metrics"`
 
+	// OutlierFilter is the configuration of the filter that rejects provider prices that
+	// deviate too far from the cross-provider consensus.
+	OutlierFilter OutlierFilterConfig `json:"outlierFilter"`
+
 	// Host is the host that the oracle will listen on.
 	Host string `json:"host"`
 
@@ -57,6 +61,10 @@ func (c *OracleConfig) ValidateBasic() error {
 		return fmt.Errorf("oracle port cannot be empty")
 	}
 
+	if err := c.OutlierFilter.ValidateBasic(); err != nil {
+		return fmt.Errorf("outlier filter is not formatted correctly: %w", err)
+	}
+
 	return c.Metrics.ValidateBasic()
 }
 
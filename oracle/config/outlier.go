@@ -0,0 +1,79 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// OutlierFilterConfig is the configuration of the outlier filter that is applied to the
+// converted provider prices of each market before they are aggregated. A converted price
+// is considered an outlier if it is more than MaxMADs median absolute deviations or more
+// than MaxDeviationBps basis points away from the median of all converted prices of the
+// market.
+//
+// Every price that is checked updates a rolling, per-provider deviation score: an
+// exponentially weighted moving average of how often the provider's prices are rejected.
+// If QuarantineThreshold is set, providers whose score exceeds the threshold are ignored
+// by the aggregator for QuarantineDuration.
+type OutlierFilterConfig struct {
+	// Enabled indicates whether the outlier filter is enabled.
+	Enabled bool `json:"enabled"`
+
+	// MaxMADs is the maximum number of median absolute deviations a price can be away from
+	// the median before it is rejected. Set to 0 to disable the MAD check. The check is
+	// skipped for markets where the median absolute deviation is zero.
+	MaxMADs float64 `json:"maxMADs"`
+
+	// MaxDeviationBps is the maximum distance, in basis points, a price can be away from the
+	// median before it is rejected. Set to 0 to disable the basis points check.
+	MaxDeviationBps uint64 `json:"maxDeviationBps"`
+
+	// MinPrices is the minimum number of converted prices a market must have before the
+	// filter is applied. Consensus is not meaningful with fewer prices.
+	MinPrices int `json:"minPrices"`
+
+	// ScoreDecay is the weight, in (0, 1], given to the latest observation when updating a
+	// provider's rolling deviation score.
+	ScoreDecay float64 `json:"scoreDecay"`
+
+	// QuarantineThreshold is the deviation score, in (0, 1], above which a provider is
+	// quarantined. Set to 0 to disable quarantining.
+	QuarantineThreshold float64 `json:"quarantineThreshold"`
+
+	// QuarantineDuration is the cooldown period for which a quarantined provider's prices
+	// are ignored.
+	QuarantineDuration time.Duration `json:"quarantineDuration"`
+}
+
+// ValidateBasic performs basic validation of the outlier filter config.
+func (c *OutlierFilterConfig) ValidateBasic() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.MaxMADs < 0 {
+		return fmt.Errorf("outlier filter max MADs cannot be negative")
+	}
+
+	if c.MaxMADs == 0 && c.MaxDeviationBps == 0 {
+		return fmt.Errorf("outlier filter must set at least one of max MADs or max deviation bps")
+	}
+
+	if c.MinPrices < 2 {
+		return fmt.Errorf("outlier filter min prices must be at least 2")
+	}
+
+	if c.ScoreDecay <= 0 || c.ScoreDecay > 1 {
+		return fmt.Errorf("outlier filter score decay must be in (0, 1]")
+	}
+
+	if c.QuarantineThreshold < 0 || c.QuarantineThreshold > 1 {
+		return fmt.Errorf("outlier filter quarantine threshold must be in [0, 1]")
+	}
+
+	if c.QuarantineThreshold > 0 && c.QuarantineDuration <= 0 {
+		return fmt.Errorf("outlier filter quarantine duration must be greater than 0 if quarantining is enabled")
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zoguxprotocol/slinky/oracle/config"
+)
+
+func TestOutlierFilterConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      config.OutlierFilterConfig
+		expectedErr bool
+	}{
+		{
+			name:        "disabled filter is always valid",
+			config:      config.OutlierFilterConfig{},
+			expectedErr: false,
+		},
+		{
+			name: "good config",
+			config: config.OutlierFilterConfig{
+				Enabled:             true,
+				MaxMADs:             5,
+				MaxDeviationBps:     500,
+				MinPrices:           3,
+				ScoreDecay:          0.1,
+				QuarantineThreshold: 0.5,
+				QuarantineDuration:  time.Minute,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "good config without quarantine",
+			config: config.OutlierFilterConfig{
+				Enabled:    true,
+				MaxMADs:    5,
+				MinPrices:  3,
+				ScoreDecay: 0.1,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with no thresholds",
+			config: config.OutlierFilterConfig{
+				Enabled:    true,
+				MinPrices:  3,
+				ScoreDecay: 0.1,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with negative max MADs",
+			config: config.OutlierFilterConfig{
+				Enabled:         true,
+				MaxMADs:         -1,
+				MaxDeviationBps: 500,
+				MinPrices:       3,
+				ScoreDecay:      0.1,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with too few min prices",
+			config: config.OutlierFilterConfig{
+				Enabled:    true,
+				MaxMADs:    5,
+				MinPrices:  1,
+				ScoreDecay: 0.1,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with invalid score decay",
+			config: config.OutlierFilterConfig{
+				Enabled:    true,
+				MaxMADs:    5,
+				MinPrices:  3,
+				ScoreDecay: 1.5,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with quarantine threshold but no duration",
+			config: config.OutlierFilterConfig{
+				Enabled:             true,
+				MaxMADs:             5,
+				MinPrices:           3,
+				ScoreDecay:          0.1,
+				QuarantineThreshold: 0.5,
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
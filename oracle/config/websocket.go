@@ -141,6 +141,42 @@ type WebSocketConfig struct {
 	// MaxSubscriptionsPerBatch is the maximum number of subscription messages that the
 	// provider will send in a single batch/write.
 	MaxSubscriptionsPerBatch int `json:"maxSubscriptionsPerBatch"`
+
+	// Generic defines the messages exchanged with the websocket endpoint by generic websocket
+	// providers. It is ignored by all other providers.
+	Generic GenericWebSocketConfig `json:"generic"`
+}
+
+// GenericWebSocketConfig defines the messages that a generic websocket provider exchanges
+// with its endpoint. Templates can contain the {ticker} and {tickers} placeholders, which
+// are replaced with the off-chain tickers of the markets, and paths use the JSONPath-style
+// syntax of the pkg/json package.
+type GenericWebSocketConfig struct {
+	// SubscribeMessage is the template of the messages sent to subscribe to markets. If it
+	// contains {tickers}, markets are subscribed to in batches of MaxSubscriptionsPerBatch.
+	// Otherwise, one message is sent per market.
+	SubscribeMessage string `json:"subscribeMessage"`
+
+	// HeartbeatMessage is the message sent to the server every PingInterval. If empty, no
+	// heartbeat messages are sent.
+	HeartbeatMessage string `json:"heartbeatMessage"`
+
+	// PingPath is the path to the value that identifies a ping message sent by the server,
+	// e.g. $.ping. If empty, server pings are not answered.
+	PingPath string `json:"pingPath"`
+
+	// PongMessage is the template of the message sent in reply to a ping message of the
+	// server. The {ping} placeholder is replaced with the JSON value at PingPath.
+	PongMessage string `json:"pongMessage"`
+
+	// ChannelPath is the path to the value that identifies the market of a price update,
+	// e.g. $.arg.instId. Messages without this value, such as subscription acknowledgements,
+	// are ignored.
+	ChannelPath string `json:"channelPath"`
+
+	// Channel is the template of the value at ChannelPath for the updates of a market, e.g.
+	// tickers.{ticker}. If empty, the value must be the off-chain ticker of the market.
+	Channel string `json:"channel"`
 }
 
 // ValidateBasic performs basic validation of the websocket config.
@@ -271,6 +271,12 @@ type noOpPriceAggregator struct{}
 func (n noOpPriceAggregator) SetProviderPrices(_ string, _ oracletypes.Prices) {
 }
 
+func (n noOpPriceAggregator) SetProviderVolumes(_ string, _ oracletypes.Prices) {
+}
+
+func (n noOpPriceAggregator) SetProviderTimestamps(_ string, _ map[string]time.Time) {
+}
+
 func (n noOpPriceAggregator) UpdateMarketMap(_ mmtypes.MarketMap) {
 }
 
@@ -281,6 +287,10 @@ func (n noOpPriceAggregator) GetPrices() oracletypes.Prices {
 	return oracletypes.Prices{}
 }
 
+func (n noOpPriceAggregator) GetPriceBreakdowns() oracletypes.PriceBreakdowns {
+	return oracletypes.PriceBreakdowns{}
+}
+
 func (n noOpPriceAggregator) Reset() {
 }
 
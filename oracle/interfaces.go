@@ -15,7 +15,9 @@ type Oracle interface {
 	IsRunning() bool
 	GetLastSyncTime() time.Time
 	GetPrices() types.Prices
+	GetPriceBreakdowns() types.PriceBreakdowns
 	GetMarketMap() mmtypes.MarketMap
+	SubscribePrices() (<-chan struct{}, func())
 	Start(ctx context.Context) error
 	Stop()
 }
@@ -26,9 +28,12 @@ type Oracle interface {
 //go:generate mockery --name PriceAggregator
 type PriceAggregator interface {
 	SetProviderPrices(provider string, prices types.Prices)
+	SetProviderVolumes(provider string, volumes types.Prices)
+	SetProviderTimestamps(provider string, timestamps map[string]time.Time)
 	UpdateMarketMap(mmtypes.MarketMap)
 	AggregatePrices()
 	GetPrices() types.Prices
+	GetPriceBreakdowns() types.PriceBreakdowns
 	Reset()
 }
 
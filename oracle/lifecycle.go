@@ -25,19 +25,9 @@ func (o *OracleImpl) Start(ctx context.Context) error {
 	ctx, _ = o.setMainCtx(ctx)
 
 	// Start all price providers which have tickers.
-	for name, state := range o.priceProviders {
-		providerTickers, err := types.ProviderTickersFromMarketMap(name, o.marketMap)
-		if err != nil {
-			o.logger.Error("failed to create provider market map", zap.String("provider", name), zap.Error(err))
-			return err
-		}
-
-		// Update the provider's state.
-		_, err = o.UpdateProviderState(providerTickers, state)
-		if err != nil {
-			o.logger.Error("failed to update provider state", zap.String("provider", name), zap.Error(err))
-			return err
-		}
+	updateInterval, err := o.startPriceProviders()
+	if err != nil {
+		return err
 	}
 
 	// Start the market map provider.
@@ -58,7 +48,7 @@ func (o *OracleImpl) Start(ctx context.Context) error {
 	}
 
 	// Start price fetch loop.
-	ticker := time.NewTicker(o.cfg.UpdateInterval)
+	ticker := time.NewTicker(updateInterval)
 	defer ticker.Stop()
 	o.metrics.SetSlinkyBuildInfo()
 
@@ -74,6 +64,31 @@ func (o *OracleImpl) Start(ctx context.Context) error {
 	}
 }
 
+// startPriceProviders starts all price providers which have tickers, and returns the update interval
+// of the oracle. The lock is held throughout, so that the price providers and config are not replaced
+// by a concurrent config reload while the oracle is starting.
+func (o *OracleImpl) startPriceProviders() (time.Duration, error) {
+	o.mut.Lock()
+	defer o.mut.Unlock()
+
+	for name, state := range o.priceProviders {
+		providerTickers, err := types.ProviderTickersFromMarketMap(name, o.marketMap)
+		if err != nil {
+			o.logger.Error("failed to create provider market map", zap.String("provider", name), zap.Error(err))
+			return 0, err
+		}
+
+		// Update the provider's state.
+		_, err = o.UpdateProviderState(providerTickers, state)
+		if err != nil {
+			o.logger.Error("failed to update provider state", zap.String("provider", name), zap.Error(err))
+			return 0, err
+		}
+	}
+
+	return o.cfg.UpdateInterval, nil
+}
+
 // Stop stops the oracle. This is a synchronous operation that will
 // wait for all providers to exit.
 func (o *OracleImpl) Stop() {
@@ -113,4 +113,48 @@ func TestStart(t *testing.T) {
 		// Stop the oracle.
 		o.Stop()
 	})
+
+	t.Run("price subscribers are notified on every price update", func(t *testing.T) {
+		orc, err := oracle.New(
+			oracleCfg,
+			noOpPriceAggregator{},
+			oracle.WithLogger(logger),
+			oracle.WithPriceAPIQueryHandlerFactory(oraclefactory.APIQueryHandlerFactory),
+			oracle.WithPriceWebSocketQueryHandlerFactory(oraclefactory.WebSocketQueryHandlerFactory),
+			oracle.WithMarketMap(marketMap),
+		)
+		require.NoError(t, err)
+		o := orc.(*oracle.OracleImpl)
+
+		updates, unsubscribe := o.SubscribePrices()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			err := o.Start(ctx)
+			if !errors.Is(err, context.Canceled) {
+				t.Errorf("Start() should have returned context.Canceled error")
+			}
+		}()
+
+		select {
+		case <-updates:
+		case <-time.After(3 * oracleCfg.UpdateInterval):
+			t.Fatal("subscriber was not notified of a price update")
+		}
+		require.False(t, o.GetLastSyncTime().IsZero())
+
+		// the channel is closed once the subscription is cancelled
+		unsubscribe()
+		unsubscribe()
+		_, ok := <-updates
+		if ok {
+			// drain a pending notification
+			_, ok = <-updates
+		}
+		require.False(t, ok)
+
+		// Stop the oracle.
+		o.Stop()
+	})
 }
@@ -52,6 +52,17 @@ type Metrics interface {
 	// to calculate the final price for a given market.
 	AddProviderCountForMarket(market string, count int)
 
+	// AddProviderOutlier increments the number of times a provider's price for a given
+	// market was rejected by the outlier filter.
+	AddProviderOutlier(providerName, pairID string)
+
+	// UpdateProviderDeviationScore updates the rolling deviation score of a provider.
+	UpdateProviderDeviationScore(providerName string, score float64)
+
+	// SetProviderQuarantined sets whether a provider is currently quarantined by the
+	// outlier filter.
+	SetProviderQuarantined(providerName string, quarantined bool)
+
 	// SetSlinkyBuildInfo sets the build information for the Slinky binary.
 	SetSlinkyBuildInfo()
 }
@@ -64,6 +75,9 @@ type OracleMetricsImpl struct {
 	aggregatePrices *prometheus.GaugeVec
 	providerTick    *prometheus.CounterVec
 	providerCount   *prometheus.GaugeVec
+	outliers        *prometheus.CounterVec
+	deviationScores *prometheus.GaugeVec
+	quarantined     *prometheus.GaugeVec
 	slinkyBuildInfo *prometheus.GaugeVec
 }
 
@@ -109,6 +123,21 @@ func NewMetrics() Metrics {
 			Name:      "health_check_market_providers",
 			Help:      "Number of providers that were utilized to calculate the final price for a given market.",
 		}, []string{PairIDLabel}),
+		outliers: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: OracleSubsystem,
+			Name:      "provider_outliers_total",
+			Help:      "Number of provider prices rejected by the outlier filter.",
+		}, []string{ProviderLabel, PairIDLabel}),
+		deviationScores: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: OracleSubsystem,
+			Name:      "provider_deviation_score",
+			Help:      "Rolling rate at which a provider's prices are rejected by the outlier filter.",
+		}, []string{ProviderLabel}),
+		quarantined: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: OracleSubsystem,
+			Name:      "provider_quarantined",
+			Help:      "Whether a provider is quarantined by the outlier filter (1) or not (0).",
+		}, []string{ProviderLabel}),
 		slinkyBuildInfo: prometheus.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: OracleSubsystem,
 			Name:      "slinky_build_info",
@@ -123,6 +152,9 @@ func NewMetrics() Metrics {
 	prometheus.MustRegister(m.aggregatePrices)
 	prometheus.MustRegister(m.providerTick)
 	prometheus.MustRegister(m.providerCount)
+	prometheus.MustRegister(m.outliers)
+	prometheus.MustRegister(m.deviationScores)
+	prometheus.MustRegister(m.quarantined)
 	prometheus.MustRegister(m.slinkyBuildInfo)
 
 	return m
@@ -163,6 +195,20 @@ func (m *noOpOracleMetrics) AddProviderTick(_, _ string, _ bool) {
 func (m *noOpOracleMetrics) AddProviderCountForMarket(string, int) {
 }
 
+// AddProviderOutlier increments the number of times a provider's price for a given
+// market was rejected by the outlier filter.
+func (m *noOpOracleMetrics) AddProviderOutlier(_, _ string) {
+}
+
+// UpdateProviderDeviationScore updates the rolling deviation score of a provider.
+func (m *noOpOracleMetrics) UpdateProviderDeviationScore(string, float64) {
+}
+
+// SetProviderQuarantined sets whether a provider is currently quarantined by the
+// outlier filter.
+func (m *noOpOracleMetrics) SetProviderQuarantined(string, bool) {
+}
+
 // SetSlinkyBuildInfo sets the build information for the Slinky binary.
 func (m *noOpOracleMetrics) SetSlinkyBuildInfo() {}
 
@@ -228,6 +274,38 @@ func (m *OracleMetricsImpl) AddProviderCountForMarket(market string, count int)
 	).Set(float64(count))
 }
 
+// AddProviderOutlier increments the number of times a provider's price for a given
+// market was rejected by the outlier filter.
+func (m *OracleMetricsImpl) AddProviderOutlier(providerName, pairID string) {
+	m.outliers.With(prometheus.Labels{
+		ProviderLabel: strings.ToLower(providerName),
+		PairIDLabel:   strings.ToLower(pairID),
+	},
+	).Add(1)
+}
+
+// UpdateProviderDeviationScore updates the rolling deviation score of a provider.
+func (m *OracleMetricsImpl) UpdateProviderDeviationScore(providerName string, score float64) {
+	m.deviationScores.With(prometheus.Labels{
+		ProviderLabel: strings.ToLower(providerName),
+	},
+	).Set(score)
+}
+
+// SetProviderQuarantined sets whether a provider is currently quarantined by the
+// outlier filter.
+func (m *OracleMetricsImpl) SetProviderQuarantined(providerName string, quarantined bool) {
+	var value float64
+	if quarantined {
+		value = 1
+	}
+
+	m.quarantined.With(prometheus.Labels{
+		ProviderLabel: strings.ToLower(providerName),
+	},
+	).Set(value)
+}
+
 // SetSlinkyBuildInfo sets the build information for the Slinky binary. The version exported
 // is determined by the build time version in accordance with the build pkg.
 func (m *OracleMetricsImpl) SetSlinkyBuildInfo() {
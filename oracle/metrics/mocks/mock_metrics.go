@@ -14,6 +14,11 @@ func (_m *Metrics) AddProviderCountForMarket(market string, count int) {
 	_m.Called(market, count)
 }
 
+// AddProviderOutlier provides a mock function with given fields: providerName, pairID
+func (_m *Metrics) AddProviderOutlier(providerName string, pairID string) {
+	_m.Called(providerName, pairID)
+}
+
 // AddProviderTick provides a mock function with given fields: providerName, pairID, success
 func (_m *Metrics) AddProviderTick(providerName string, pairID string, success bool) {
 	_m.Called(providerName, pairID, success)
@@ -29,6 +34,11 @@ func (_m *Metrics) AddTickerTick(ticker string) {
 	_m.Called(ticker)
 }
 
+// SetProviderQuarantined provides a mock function with given fields: providerName, quarantined
+func (_m *Metrics) SetProviderQuarantined(providerName string, quarantined bool) {
+	_m.Called(providerName, quarantined)
+}
+
 // SetSlinkyBuildInfo provides a mock function with no fields
 func (_m *Metrics) SetSlinkyBuildInfo() {
 	_m.Called()
@@ -44,6 +54,11 @@ func (_m *Metrics) UpdatePrice(name string, pairID string, decimals uint64, pric
 	_m.Called(name, pairID, decimals, price)
 }
 
+// UpdateProviderDeviationScore provides a mock function with given fields: providerName, score
+func (_m *Metrics) UpdateProviderDeviationScore(providerName string, score float64) {
+	_m.Called(providerName, score)
+}
+
 // NewMetrics creates a new instance of Metrics. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMetrics(t interface {
@@ -7,6 +7,10 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	oracletypes "github.com/zoguxprotocol/slinky/oracle/types"
+
+	time "time"
+
 	types "github.com/zoguxprotocol/slinky/x/marketmap/types"
 )
 
@@ -20,6 +24,26 @@ func (_m *PriceAggregator) AggregatePrices() {
 	_m.Called()
 }
 
+// GetPriceBreakdowns provides a mock function with no fields
+func (_m *PriceAggregator) GetPriceBreakdowns() map[string][]oracletypes.ProviderPriceBreakdown {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceBreakdowns")
+	}
+
+	var r0 map[string][]oracletypes.ProviderPriceBreakdown
+	if rf, ok := ret.Get(0).(func() map[string][]oracletypes.ProviderPriceBreakdown); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]oracletypes.ProviderPriceBreakdown)
+		}
+	}
+
+	return r0
+}
+
 // GetPrices provides a mock function with no fields
 func (_m *PriceAggregator) GetPrices() map[string]*big.Float {
 	ret := _m.Called()
@@ -50,6 +74,16 @@ func (_m *PriceAggregator) SetProviderPrices(provider string, prices map[string]
 	_m.Called(provider, prices)
 }
 
+// SetProviderTimestamps provides a mock function with given fields: provider, timestamps
+func (_m *PriceAggregator) SetProviderTimestamps(provider string, timestamps map[string]time.Time) {
+	_m.Called(provider, timestamps)
+}
+
+// SetProviderVolumes provides a mock function with given fields: provider, volumes
+func (_m *PriceAggregator) SetProviderVolumes(provider string, volumes map[string]*big.Float) {
+	_m.Called(provider, volumes)
+}
+
 // UpdateMarketMap provides a mock function with given fields: _a0
 func (_m *PriceAggregator) UpdateMarketMap(_a0 types.MarketMap) {
 	_m.Called(_a0)
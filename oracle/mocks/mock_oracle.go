@@ -8,6 +8,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	oracletypes "github.com/zoguxprotocol/slinky/oracle/types"
+
 	time "time"
 
 	types "github.com/zoguxprotocol/slinky/x/marketmap/types"
@@ -54,6 +56,26 @@ func (_m *Oracle) GetMarketMap() types.MarketMap {
 	return r0
 }
 
+// GetPriceBreakdowns provides a mock function with no fields
+func (_m *Oracle) GetPriceBreakdowns() map[string][]oracletypes.ProviderPriceBreakdown {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceBreakdowns")
+	}
+
+	var r0 map[string][]oracletypes.ProviderPriceBreakdown
+	if rf, ok := ret.Get(0).(func() map[string][]oracletypes.ProviderPriceBreakdown); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]oracletypes.ProviderPriceBreakdown)
+		}
+	}
+
+	return r0
+}
+
 // GetPrices provides a mock function with no fields
 func (_m *Oracle) GetPrices() map[string]*big.Float {
 	ret := _m.Called()
@@ -115,6 +137,38 @@ func (_m *Oracle) Stop() {
 	_m.Called()
 }
 
+// SubscribePrices provides a mock function with no fields
+func (_m *Oracle) SubscribePrices() (<-chan struct{}, func()) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribePrices")
+	}
+
+	var r0 <-chan struct{}
+	var r1 func()
+	if rf, ok := ret.Get(0).(func() (<-chan struct{}, func())); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() <-chan struct{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan struct{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() func()); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	return r0, r1
+}
+
 // NewOracle creates a new instance of Oracle. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewOracle(t interface {
@@ -47,6 +47,10 @@ type OracleImpl struct { //nolint:revive
 	aggregator PriceAggregator
 	// lastPriceSync is the last time the oracle successfully updated its prices.
 	lastPriceSync time.Time
+	// subscribers are notified every time the oracle updates its prices.
+	subscribers map[chan struct{}]struct{}
+	// subMut guards the subscribers.
+	subMut sync.Mutex
 
 	// -------------------Oracle Configuration Fields-------------------//
 	//
@@ -85,9 +89,8 @@ type OracleImpl struct { //nolint:revive
 type ProviderState struct {
 	// Provider is the price provider implementation.
 	Provider *types.PriceProvider
-	// Cfg is the provider configuration.
-	//
-	// TODO: Deprecate this once we have synchronous configuration updates.
+	// Cfg is the provider configuration. This is replaced whenever the provider is
+	// recreated by a configuration update.
 	Cfg config.ProviderConfig
 }
 
@@ -108,6 +111,7 @@ func New(
 		cfg:             cfg,
 		aggregator:      aggregator,
 		priceProviders:  make(map[string]ProviderState), // this will be initialized via the Init method.
+		subscribers:     make(map[chan struct{}]struct{}),
 		logger:          zap.NewNop(),
 		wsMetrics:       wsmetrics.NewWebSocketMetricsFromConfig(cfg.Metrics),
 		apiMetrics:      apimetrics.NewAPIMetricsFromConfig(cfg.Metrics),
@@ -151,3 +155,46 @@ func (o *OracleImpl) GetLastSyncTime() time.Time {
 func (o *OracleImpl) GetPrices() types.Prices {
 	return o.aggregator.GetPrices()
 }
+
+// GetPriceBreakdowns returns the breakdown of each provider's price for each market, as of the
+// latest aggregation.
+func (o *OracleImpl) GetPriceBreakdowns() types.PriceBreakdowns {
+	return o.aggregator.GetPriceBreakdowns()
+}
+
+// SubscribePrices returns a channel that receives a notification every time the oracle updates its prices,
+// along with a function that cancels the subscription. Notifications are not queued, i.e. a subscriber that
+// is slower than the oracle only observes the latest update. The channel is closed once the subscription
+// is cancelled.
+func (o *OracleImpl) SubscribePrices() (<-chan struct{}, func()) {
+	o.subMut.Lock()
+	defer o.subMut.Unlock()
+
+	ch := make(chan struct{}, 1)
+	o.subscribers[ch] = struct{}{}
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			o.subMut.Lock()
+			defer o.subMut.Unlock()
+
+			delete(o.subscribers, ch)
+			close(ch)
+		})
+	}
+}
+
+// notifySubscribers notifies all subscribers that the oracle's prices have been updated.
+func (o *OracleImpl) notifySubscribers() {
+	o.subMut.Lock()
+	defer o.subMut.Unlock()
+
+	for ch := range o.subscribers {
+		select {
+		case ch <- struct{}{}:
+		default:
+			// the subscriber has yet to observe the previous update
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package oracle
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"go.uber.org/zap"
+
+	"github.com/zoguxprotocol/slinky/oracle/config"
+	"github.com/zoguxprotocol/slinky/oracle/types"
+	mmclienttypes "github.com/zoguxprotocol/slinky/service/clients/marketmap/types"
+)
+
+// UpdateConfig applies the given configuration to the running oracle in place. Price providers
+// that were added are created and started, price providers that were removed are stopped, and
+// price providers whose configuration changed (e.g. their interval, endpoints or API keys) are
+// restarted with the new configuration. All other price providers keep running. A price provider
+// that fails to be recreated keeps running with its previous configuration.
+//
+// Changes to the max price age take effect on the next price update. Changes to the update
+// interval, metrics, outlier filter, host, port and market map provider require a restart of
+// the oracle, and are ignored.
+func (o *OracleImpl) UpdateConfig(cfg config.OracleConfig) error {
+	if err := cfg.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid oracle config: %w", err)
+	}
+
+	o.mut.Lock()
+	defer o.mut.Unlock()
+
+	if o.mainCtx == nil {
+		return fmt.Errorf("oracle is not running")
+	}
+
+	updated := o.cfg
+	updated.MaxPriceAge = cfg.MaxPriceAge
+	updated.Providers = make(map[string]config.ProviderConfig, len(cfg.Providers))
+	o.warnOnRestartRequired(cfg)
+
+	// Stop all price providers that were removed. The market map provider is not removed, as
+	// it cannot be replaced without a restart.
+	for name, current := range o.cfg.Providers {
+		if current.Type == mmclienttypes.ConfigType {
+			updated.Providers[name] = current
+			continue
+		}
+
+		if _, ok := cfg.Providers[name]; ok {
+			continue
+		}
+
+		if state, ok := o.priceProviders[name]; ok {
+			state.Provider.Stop()
+			delete(o.priceProviders, name)
+		}
+
+		o.logger.Info(fmt.Sprintf("removed %s provider", name), zap.String("provider", name))
+	}
+
+	// Create or restart all price providers that were added or changed.
+	var errs []error
+	for name, next := range cfg.Providers {
+		if next.Type == mmclienttypes.ConfigType {
+			continue
+		}
+
+		current, exists := o.cfg.Providers[name]
+		if exists && reflect.DeepEqual(current, next) {
+			updated.Providers[name] = current
+			continue
+		}
+
+		if err := o.replacePriceProvider(next); err != nil {
+			o.logger.Error(
+				"failed to apply provider config",
+				zap.String("provider", name),
+				zap.Error(err),
+			)
+			errs = append(errs, fmt.Errorf("failed to apply %s provider config: %w", name, err))
+
+			// Keep the previous provider (if any) running with its previous configuration.
+			if exists {
+				updated.Providers[name] = current
+			}
+			continue
+		}
+
+		updated.Providers[name] = next
+	}
+
+	o.cfg = updated
+	return errors.Join(errs...)
+}
+
+// replacePriceProvider creates a price provider with the given configuration and starts it,
+// stopping the existing provider with the same name (if any). The new provider only replaces
+// the existing provider once it was created and updated successfully; otherwise the existing
+// provider keeps running.
+func (o *OracleImpl) replacePriceProvider(cfg config.ProviderConfig) error {
+	state, err := o.newPriceProvider(o.mainCtx, cfg)
+	if err != nil {
+		return err
+	}
+
+	providerTickers, err := types.ProviderTickersFromMarketMap(cfg.Name, o.marketMap)
+	if err != nil {
+		return err
+	}
+
+	state, err = o.UpdateProviderState(providerTickers, state)
+	if err != nil {
+		state.Provider.Stop()
+		return err
+	}
+
+	if previous, ok := o.priceProviders[cfg.Name]; ok {
+		previous.Provider.Stop()
+	}
+
+	o.priceProviders[cfg.Name] = state
+	o.logger.Info(fmt.Sprintf("applied %s provider config", cfg.Name), zap.String("provider", cfg.Name))
+	return nil
+}
+
+// warnOnRestartRequired logs a warning for every change in the given configuration that
+// cannot be applied without restarting the oracle.
+func (o *OracleImpl) warnOnRestartRequired(cfg config.OracleConfig) {
+	ignored := make([]string, 0)
+	if cfg.UpdateInterval != o.cfg.UpdateInterval {
+		ignored = append(ignored, "updateInterval")
+	}
+	if !reflect.DeepEqual(cfg.Metrics, o.cfg.Metrics) {
+		ignored = append(ignored, "metrics")
+	}
+	if cfg.OutlierFilter != o.cfg.OutlierFilter {
+		ignored = append(ignored, "outlierFilter")
+	}
+	if cfg.Host != o.cfg.Host || cfg.Port != o.cfg.Port {
+		ignored = append(ignored, "host/port")
+	}
+	for name, next := range cfg.Providers {
+		if current, ok := o.cfg.Providers[name]; next.Type == mmclienttypes.ConfigType && (!ok || !reflect.DeepEqual(current, next)) {
+			ignored = append(ignored, name)
+		}
+	}
+
+	if len(ignored) > 0 {
+		o.logger.Warn(
+			"oracle config changes require a restart and were not applied",
+			zap.Strings("fields", ignored),
+		)
+	}
+}
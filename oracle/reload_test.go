@@ -0,0 +1,129 @@
+package oracle_test
+
+import (
+	"context"
+	"maps"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zoguxprotocol/slinky/oracle"
+	"github.com/zoguxprotocol/slinky/oracle/config"
+	oracletypes "github.com/zoguxprotocol/slinky/oracle/types"
+	"github.com/zoguxprotocol/slinky/providers/apis/binance"
+	"github.com/zoguxprotocol/slinky/providers/apis/coinbase"
+	"github.com/zoguxprotocol/slinky/providers/apis/kraken"
+	oraclefactory "github.com/zoguxprotocol/slinky/providers/factories/oracle"
+	"github.com/zoguxprotocol/slinky/providers/websockets/okx"
+)
+
+func TestUpdateConfig(t *testing.T) {
+	newOracle := func(t *testing.T) *oracle.OracleImpl {
+		t.Helper()
+
+		orc, err := oracle.New(
+			copyConfig(oracleCfg),
+			noOpPriceAggregator{},
+			oracle.WithLogger(logger),
+			oracle.WithPriceAPIQueryHandlerFactory(oraclefactory.APIQueryHandlerFactory),
+			oracle.WithPriceWebSocketQueryHandlerFactory(oraclefactory.WebSocketQueryHandlerFactory),
+		)
+		require.NoError(t, err)
+
+		return orc.(*oracle.OracleImpl)
+	}
+
+	t.Run("errors when the oracle is not running", func(t *testing.T) {
+		o := newOracle(t)
+		require.Error(t, o.UpdateConfig(oracleCfg))
+	})
+
+	t.Run("errors on an invalid config", func(t *testing.T) {
+		o := newOracle(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go o.Start(ctx)
+		defer o.Stop()
+
+		require.Eventually(t, func() bool {
+			return o.UpdateConfig(oracleCfg) == nil
+		}, 5*time.Second, 50*time.Millisecond)
+
+		invalid := copyConfig(oracleCfg)
+		invalid.MaxPriceAge = 0
+		require.Error(t, o.UpdateConfig(invalid))
+		require.Len(t, o.GetProviderState(), len(oracleCfg.Providers))
+	})
+
+	t.Run("applies provider changes in place", func(t *testing.T) {
+		o := newOracle(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go o.Start(ctx)
+		defer o.Stop()
+
+		require.Eventually(t, func() bool {
+			return o.UpdateConfig(oracleCfg) == nil
+		}, 5*time.Second, 50*time.Millisecond)
+		before := maps.Clone(o.GetProviderState())
+
+		// Remove okx, change coinbase's interval, add kraken and leave binance unchanged.
+		updated := copyConfig(oracleCfg)
+		delete(updated.Providers, okx.Name)
+
+		coinbaseCfg := updated.Providers[coinbase.Name]
+		coinbaseCfg.API.Interval = 2 * coinbase.DefaultAPIConfig.Interval
+		updated.Providers[coinbase.Name] = coinbaseCfg
+
+		updated.Providers[kraken.Name] = config.ProviderConfig{
+			Name: kraken.Name,
+			API:  kraken.DefaultAPIConfig,
+			Type: oracletypes.ConfigType,
+		}
+		updated.MaxPriceAge = time.Minute
+		require.NoError(t, o.UpdateConfig(updated))
+
+		after := o.GetProviderState()
+		require.Len(t, after, 3)
+		require.NotContains(t, after, okx.Name)
+
+		// binance is left untouched.
+		require.Same(t, before[binance.Name].Provider, after[binance.Name].Provider)
+
+		// coinbase is recreated with the new config.
+		require.NotSame(t, before[coinbase.Name].Provider, after[coinbase.Name].Provider)
+		require.Equal(t, coinbaseCfg, after[coinbase.Name].Cfg)
+		require.Equal(t, coinbaseCfg.API.Interval, after[coinbase.Name].Provider.GetAPIConfig().Interval)
+
+		// kraken is created.
+		require.Contains(t, after, kraken.Name)
+	})
+
+	t.Run("keeps the previous provider if the new config cannot be applied", func(t *testing.T) {
+		o := newOracle(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go o.Start(ctx)
+		defer o.Stop()
+
+		require.Eventually(t, func() bool {
+			return o.UpdateConfig(oracleCfg) == nil
+		}, 5*time.Second, 50*time.Millisecond)
+		before := maps.Clone(o.GetProviderState())
+
+		// The query handler factory does not know the provider's new name.
+		updated := copyConfig(oracleCfg)
+		coinbaseCfg := updated.Providers[coinbase.Name]
+		coinbaseCfg.API.Name = "unknown"
+		updated.Providers[coinbase.Name] = coinbaseCfg
+		require.Error(t, o.UpdateConfig(updated))
+
+		after := o.GetProviderState()
+		require.Same(t, before[coinbase.Name].Provider, after[coinbase.Name].Provider)
+		require.Equal(t, oracleCfg.Providers[coinbase.Name], after[coinbase.Name].Cfg)
+	})
+}
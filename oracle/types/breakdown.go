@@ -0,0 +1,44 @@
+package types
+
+import (
+	"math/big"
+	"time"
+)
+
+// Reasons a provider price was not used to calculate the aggregated price of a market.
+const (
+	// ExclusionReasonQuarantined is the reason for prices of providers that are quarantined by the
+	// outlier filter.
+	ExclusionReasonQuarantined = "provider is quarantined"
+	// ExclusionReasonOutlier is the reason for prices that were rejected by the outlier filter.
+	ExclusionReasonOutlier = "price is an outlier"
+	// ExclusionReasonInsufficientProviders is the reason for prices of markets that did not have
+	// enough prices to meet the market's minimum provider count.
+	ExclusionReasonInsufficientProviders = "market has insufficient prices"
+)
+
+type (
+	// ProviderPriceBreakdown describes the price a single provider reported for a market, and how it
+	// contributed to the market's aggregated price.
+	ProviderPriceBreakdown struct {
+		// Provider is the name of the provider.
+		Provider string
+		// OffChainTicker is the provider's ticker for the market.
+		OffChainTicker string
+		// RawPrice is the price reported by the provider. This is nil if the provider has no price.
+		RawPrice *big.Float
+		// Timestamp is the time at which the provider reported the price.
+		Timestamp time.Time
+		// ConvertedPrice is the price after it was inverted and / or normalized by the index price of
+		// another market, as configured by the provider config. This is nil if the price could not be
+		// converted.
+		ConvertedPrice *big.Float
+		// Used is true if the converted price was used to calculate the aggregated price.
+		Used bool
+		// Reason is the reason the price was not used, if any.
+		Reason string
+	}
+
+	// PriceBreakdowns is a type alias for a map of ticker to the breakdown of each provider's price.
+	PriceBreakdowns = map[string][]ProviderPriceBreakdown
+)
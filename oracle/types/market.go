@@ -28,20 +28,23 @@ func ProviderTickersFromMarketMap(
 			continue
 		}
 
-		for _, cfg := range market.ProviderConfigs {
-			if cfg.Name != name {
-				continue
-			}
-			if _, ok := seenOffChainTickers[cfg.OffChainTicker]; ok {
-				continue
-			}
+		for _, providerConfig := range market.ProviderConfigs {
+			// The rate feeds of a redemption rate are fetched alongside the provider config.
+			for _, cfg := range providerConfig.Feeds() {
+				if cfg.Name != name {
+					continue
+				}
+				if _, ok := seenOffChainTickers[cfg.OffChainTicker]; ok {
+					continue
+				}
 
-			providerTicker := NewProviderTicker(
-				cfg.OffChainTicker,
-				cfg.Metadata_JSON,
-			)
-			providerTickers = append(providerTickers, providerTicker)
-			seenOffChainTickers[cfg.OffChainTicker] = struct{}{}
+				providerTicker := NewProviderTicker(
+					cfg.OffChainTicker,
+					cfg.Metadata_JSON,
+				)
+				providerTickers = append(providerTickers, providerTicker)
+				seenOffChainTickers[cfg.OffChainTicker] = struct{}{}
+			}
 		}
 	}
 
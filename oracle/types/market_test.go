@@ -134,6 +134,46 @@ func TestProviderTickersFromMarketMap(t *testing.T) {
 			},
 			err: false,
 		},
+		{
+			name:     "redemption rate feeds of the provider",
+			provider: "rate",
+			market: mmtypes.MarketMap{
+				Markets: map[string]mmtypes.Market{
+					"STETH/USD": {
+						Ticker: mmtypes.NewTicker("STETH", "USD", 8, 1, true),
+						ProviderConfigs: []mmtypes.ProviderConfig{
+							{
+								Name:           "test",
+								OffChainTicker: "ETH/USD",
+								RedemptionRate: &mmtypes.RedemptionRate{
+									Rate: mmtypes.ProviderConfig{
+										Name:           "rate",
+										OffChainTicker: "STETH_RATE",
+										Metadata_JSON:  "{}",
+									},
+									MarketRate: &mmtypes.ProviderConfig{
+										Name:           "rate",
+										OffChainTicker: "STETH/ETH",
+									},
+									MaxDeviation: "0.05",
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: []types.ProviderTicker{
+				types.NewProviderTicker(
+					"STETH_RATE",
+					"{}",
+				),
+				types.NewProviderTicker(
+					"STETH/ETH",
+					"",
+				),
+			},
+			err: false,
+		},
 	}
 
 	for _, tc := range cases {
@@ -94,6 +94,16 @@ func (t *ProviderTickers) Add(ticker ProviderTicker) {
 	t.cache[strings.ToUpper(ticker.GetOffChainTicker())] = ticker
 }
 
+// Remove removes a provider ticker from the list of provider tickers.
+func (t *ProviderTickers) Remove(ticker ProviderTicker) {
+	t.mut.Lock()
+	defer t.mut.Unlock()
+
+	delete(t.cache, strings.ToLower(ticker.GetOffChainTicker()))
+	delete(t.cache, ticker.GetOffChainTicker())
+	delete(t.cache, strings.ToUpper(ticker.GetOffChainTicker()))
+}
+
 // NoPriceChangeResponse is used to handle a message that indicates that the price has not changed.
 // In particular, this will update the base provider with the ResponseCodeUnchanged code for all tickers.
 func (t *ProviderTickers) NoPriceChangeResponse() PriceResponse {
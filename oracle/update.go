@@ -108,6 +108,9 @@ func (o *OracleImpl) fetchAllPrices() {
 
 	// update the last sync time
 	o.metrics.AddTick()
+
+	// notify any price subscribers of the update
+	o.notifySubscribers()
 }
 
 func (o *OracleImpl) fetchPrices(provider *types.PriceProvider) {
@@ -150,6 +153,8 @@ func (o *OracleImpl) fetchPrices(provider *types.PriceProvider) {
 	}
 
 	timeFilteredPrices := make(types.Prices)
+	timeFilteredVolumes := make(types.Prices)
+	timestamps := make(map[string]time.Time)
 	for pair, result := range prices {
 		// If the price is older than the maxCacheAge, skip it.
 		diff := time.Now().UTC().Sub(result.Timestamp)
@@ -174,6 +179,10 @@ func (o *OracleImpl) fetchPrices(provider *types.PriceProvider) {
 			zap.Duration("diff", diff),
 		)
 		timeFilteredPrices[pair.GetOffChainTicker()] = result.Value
+		timestamps[pair.GetOffChainTicker()] = result.Timestamp
+		if result.Volume != nil {
+			timeFilteredVolumes[pair.GetOffChainTicker()] = result.Volume
+		}
 	}
 
 	o.logger.Debug("provider returned prices",
@@ -182,6 +191,8 @@ func (o *OracleImpl) fetchPrices(provider *types.PriceProvider) {
 		zap.Int("prices", len(prices)),
 	)
 	o.aggregator.SetProviderPrices(provider.Name(), timeFilteredPrices)
+	o.aggregator.SetProviderVolumes(provider.Name(), timeFilteredVolumes)
+	o.aggregator.SetProviderTimestamps(provider.Name(), timestamps)
 }
 
 func (o *OracleImpl) setLastSyncTime(t time.Time) {
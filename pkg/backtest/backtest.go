@@ -0,0 +1,277 @@
+package backtest
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/zoguxprotocol/slinky/oracle/config"
+	oraclemetrics "github.com/zoguxprotocol/slinky/oracle/metrics"
+	oracletypes "github.com/zoguxprotocol/slinky/oracle/types"
+	oraclemath "github.com/zoguxprotocol/slinky/pkg/math/oracle"
+	mmtypes "github.com/zoguxprotocol/slinky/x/marketmap/types"
+)
+
+// Config configures a backtest.
+type Config struct {
+	// Interval is the time between two ticks. If zero, there is a tick at every distinct timestamp of the
+	// provider prices.
+	Interval time.Duration
+	// MaxPriceAge is the maximum age of a provider price, or reference price, at a tick for it to be used. If
+	// zero, prices never expire.
+	MaxPriceAge time.Duration
+	// OutlierFilter is the outlier filter configuration of the aggregator.
+	OutlierFilter config.OutlierFilterConfig
+}
+
+// Result is the result of a backtest.
+type Result struct {
+	// Ticks are the aggregated prices at each tick.
+	Ticks []Tick `json:"ticks"`
+	// Markets are the statistics of each market, sorted by ticker.
+	Markets []MarketReport `json:"markets"`
+}
+
+// Tick are the aggregated prices at a tick.
+type Tick struct {
+	// Time is the time of the tick.
+	Time time.Time `json:"time"`
+	// Prices are the unscaled aggregated prices by ticker. Markets that could not be priced are omitted.
+	Prices oracletypes.Prices `json:"prices"`
+}
+
+// MarketReport are the statistics of a market over a backtest.
+type MarketReport struct {
+	// Ticker is the currency pair of the market.
+	Ticker string `json:"ticker"`
+	// Ticks is the number of ticks at which the market was enabled.
+	Ticks int `json:"ticks"`
+	// Priced is the number of ticks at which the market was priced.
+	Priced int `json:"priced"`
+	// Missing is the number of ticks at which the market was not priced, because fewer than MinProviderCount
+	// providers had a price for it.
+	Missing int `json:"missing"`
+	// Deviation is the deviation of the aggregated price from the reference price. It is nil if the market
+	// was never priced while a reference price was available.
+	Deviation *Deviation `json:"deviation,omitempty"`
+}
+
+// MissingRate returns the fraction of ticks at which the market was missing.
+func (r MarketReport) MissingRate() float64 {
+	if r.Ticks == 0 {
+		return 0
+	}
+
+	return float64(r.Missing) / float64(r.Ticks)
+}
+
+// Deviation is the relative deviation of the aggregated prices of a market from a reference series, in basis
+// points.
+type Deviation struct {
+	// Samples is the number of ticks at which both an aggregated and a reference price were available.
+	Samples int `json:"samples"`
+	// MeanBps is the mean absolute deviation.
+	MeanBps float64 `json:"mean_bps"`
+	// MaxBps is the maximum absolute deviation.
+	MaxBps float64 `json:"max_bps"`
+	// MaxTime is the time of the tick with the maximum deviation.
+	MaxTime time.Time `json:"max_time"`
+}
+
+// Run runs the IndexPriceAggregator over the given provider prices, tick by tick, with the given market map. At
+// each tick, the latest price of every provider and off-chain ticker up to that tick is used, as the oracle would.
+// If reference prices are given, the aggregated prices are compared to the latest reference price at each tick.
+func Run(
+	logger *zap.Logger,
+	marketMap mmtypes.MarketMap,
+	prices []ProviderPrice,
+	reference []ReferencePrice,
+	cfg Config,
+) (Result, error) {
+	if logger == nil {
+		return Result{}, fmt.Errorf("logger cannot be nil")
+	}
+
+	if err := marketMap.ValidateBasic(); err != nil {
+		return Result{}, fmt.Errorf("invalid market map: %w", err)
+	}
+
+	if len(prices) == 0 {
+		return Result{}, fmt.Errorf("no provider prices")
+	}
+
+	if cfg.Interval < 0 || cfg.MaxPriceAge < 0 {
+		return Result{}, fmt.Errorf("interval and max price age cannot be negative")
+	}
+
+	prices = sortedByTime(prices, func(p ProviderPrice) time.Time { return p.Time })
+	reference = sortedByTime(reference, func(p ReferencePrice) time.Time { return p.Time })
+
+	var now time.Time
+	aggregator, err := oraclemath.NewIndexPriceAggregator(
+		logger,
+		marketMap,
+		oraclemetrics.NewNopMetrics(),
+		oraclemath.WithOutlierFilter(cfg.OutlierFilter),
+		oraclemath.WithClock(func() time.Time { return now }),
+	)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to create aggregator: %w", err)
+	}
+
+	var (
+		result     Result
+		latest     = make(map[string]map[string]ProviderPrice)
+		references = make(map[string]ReferencePrice)
+		reports    = make(map[string]*MarketReport)
+		deviations = make(map[string]float64)
+	)
+
+	for ticker := range marketMap.Markets {
+		reports[ticker] = &MarketReport{Ticker: ticker}
+	}
+
+	next, nextRef := 0, 0
+	for _, tick := range ticks(prices, cfg.Interval) {
+		now = tick
+
+		// apply every price reported up to the tick.
+		for ; next < len(prices) && !prices[next].Time.After(tick); next++ {
+			price := prices[next]
+			if latest[price.Provider] == nil {
+				latest[price.Provider] = make(map[string]ProviderPrice)
+			}
+			latest[price.Provider][price.OffChainTicker] = price
+		}
+		for ; nextRef < len(reference) && !reference[nextRef].Time.After(tick); nextRef++ {
+			references[reference[nextRef].Ticker] = reference[nextRef]
+		}
+
+		for provider, tickers := range latest {
+			providerPrices := make(oracletypes.Prices)
+			providerVolumes := make(oracletypes.Prices)
+			timestamps := make(map[string]time.Time)
+			for offChainTicker, price := range tickers {
+				if isStale(price.Time, tick, cfg.MaxPriceAge) {
+					continue
+				}
+
+				providerPrices[offChainTicker] = price.Price
+				timestamps[offChainTicker] = price.Time
+				if price.Volume != nil {
+					providerVolumes[offChainTicker] = price.Volume
+				}
+			}
+
+			aggregator.SetProviderPrices(provider, providerPrices)
+			aggregator.SetProviderVolumes(provider, providerVolumes)
+			aggregator.SetProviderTimestamps(provider, timestamps)
+		}
+
+		aggregator.AggregatePrices()
+		aggregated := aggregator.GetIndexPrices()
+		result.Ticks = append(result.Ticks, Tick{Time: tick, Prices: aggregated})
+
+		for ticker, market := range marketMap.Markets {
+			if !market.Ticker.Enabled {
+				continue
+			}
+
+			report := reports[ticker]
+			report.Ticks++
+
+			price, ok := aggregated[ticker]
+			if !ok {
+				report.Missing++
+				continue
+			}
+			report.Priced++
+
+			ref, ok := references[ticker]
+			if !ok || isStale(ref.Time, tick, cfg.MaxPriceAge) {
+				continue
+			}
+
+			bps := deviationBps(price, ref.Price)
+			if report.Deviation == nil {
+				report.Deviation = &Deviation{}
+			}
+			report.Deviation.Samples++
+			deviations[ticker] += bps
+			if bps > report.Deviation.MaxBps || report.Deviation.Samples == 1 {
+				report.Deviation.MaxBps = bps
+				report.Deviation.MaxTime = tick
+			}
+		}
+	}
+
+	for ticker, report := range reports {
+		if report.Deviation != nil {
+			report.Deviation.MeanBps = deviations[ticker] / float64(report.Deviation.Samples)
+		}
+		result.Markets = append(result.Markets, *report)
+	}
+	sort.Slice(result.Markets, func(i, j int) bool {
+		return result.Markets[i].Ticker < result.Markets[j].Ticker
+	})
+
+	return result, nil
+}
+
+// ticks returns the times of the ticks of a backtest over the given prices, which are sorted by time.
+func ticks(prices []ProviderPrice, interval time.Duration) []time.Time {
+	var ticks []time.Time
+
+	if interval == 0 {
+		for _, price := range prices {
+			if len(ticks) == 0 || price.Time.After(ticks[len(ticks)-1]) {
+				ticks = append(ticks, price.Time)
+			}
+		}
+
+		return ticks
+	}
+
+	start, end := prices[0].Time, prices[len(prices)-1].Time
+	for tick := start; !tick.After(end); tick = tick.Add(interval) {
+		ticks = append(ticks, tick)
+	}
+
+	// always include the last prices.
+	if last := ticks[len(ticks)-1]; last.Before(end) {
+		ticks = append(ticks, last.Add(interval))
+	}
+
+	return ticks
+}
+
+// isStale returns true if a price at the given time is older than the max price age at the tick.
+func isStale(t, tick time.Time, maxPriceAge time.Duration) bool {
+	return maxPriceAge > 0 && tick.Sub(t) > maxPriceAge
+}
+
+// deviationBps returns the absolute deviation of the price from the reference price, in basis points.
+func deviationBps(price, reference *big.Float) float64 {
+	diff := new(big.Float).Sub(price, reference)
+	diff.Abs(diff)
+	diff.Quo(diff, reference)
+	diff.Mul(diff, big.NewFloat(10_000))
+
+	bps, _ := diff.Float64()
+	return bps
+}
+
+// sortedByTime returns a copy of the given values sorted by time.
+func sortedByTime[T any](values []T, timeOf func(T) time.Time) []T {
+	sorted := make([]T, len(values))
+	copy(sorted, values)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return timeOf(sorted[i]).Before(timeOf(sorted[j]))
+	})
+
+	return sorted
+}
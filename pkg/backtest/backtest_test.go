@@ -0,0 +1,186 @@
+package backtest_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/zoguxprotocol/slinky/pkg/backtest"
+	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
+	mmtypes "github.com/zoguxprotocol/slinky/x/marketmap/types"
+)
+
+var (
+	start = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	usdtusd = mmtypes.Market{
+		Ticker: mmtypes.Ticker{
+			CurrencyPair:     slinkytypes.NewCurrencyPair("USDT", "USD"),
+			Decimals:         8,
+			MinProviderCount: 1,
+			Enabled:          true,
+		},
+		ProviderConfigs: []mmtypes.ProviderConfig{{Name: "coinbase", OffChainTicker: "USDT-USD"}},
+	}
+
+	btcusd = mmtypes.Market{
+		Ticker: mmtypes.Ticker{
+			CurrencyPair:     slinkytypes.NewCurrencyPair("BTC", "USD"),
+			Decimals:         8,
+			MinProviderCount: 2,
+			Enabled:          true,
+		},
+		ProviderConfigs: []mmtypes.ProviderConfig{
+			{Name: "coinbase", OffChainTicker: "BTC-USD"},
+			{Name: "kucoin", OffChainTicker: "BTC-USDT", NormalizeByPair: &usdtusd.Ticker.CurrencyPair},
+		},
+	}
+
+	marketMap = mmtypes.MarketMap{Markets: map[string]mmtypes.Market{
+		usdtusd.Ticker.String(): usdtusd,
+		btcusd.Ticker.String():  btcusd,
+	}}
+
+	prices = []backtest.ProviderPrice{
+		providerPrice(20, "coinbase", "BTC-USD", 104),
+		providerPrice(0, "coinbase", "USDT-USD", 1),
+		providerPrice(0, "coinbase", "BTC-USD", 100),
+		providerPrice(0, "kucoin", "BTC-USDT", 102),
+		providerPrice(10, "coinbase", "USDT-USD", 1.01),
+	}
+)
+
+func providerPrice(seconds int, provider, ticker string, price float64) backtest.ProviderPrice {
+	return backtest.ProviderPrice{
+		Time:           start.Add(time.Duration(seconds) * time.Second),
+		Provider:       provider,
+		OffChainTicker: ticker,
+		Price:          big.NewFloat(price),
+	}
+}
+
+func referencePrice(seconds int, ticker string, price float64) backtest.ReferencePrice {
+	return backtest.ReferencePrice{
+		Time:   start.Add(time.Duration(seconds) * time.Second),
+		Ticker: ticker,
+		Price:  big.NewFloat(price),
+	}
+}
+
+func TestRun(t *testing.T) {
+	reference := []backtest.ReferencePrice{
+		referencePrice(0, "USDT/USD", 1),
+		referencePrice(10, "BTC/USD", 100),
+	}
+
+	result, err := backtest.Run(zap.NewNop(), marketMap, prices, reference, backtest.Config{MaxPriceAge: 15 * time.Second})
+	require.NoError(t, err)
+
+	require.Len(t, result.Ticks, 3)
+	for i, tick := range result.Ticks {
+		require.Equal(t, start.Add(time.Duration(10*i)*time.Second), tick.Time)
+	}
+
+	// kucoin can only be converted once the USDT/USD index price is known, i.e. from the second tick, and is stale
+	// by the third tick.
+	require.NotContains(t, result.Ticks[0].Prices, "BTC/USD")
+	price, _ := result.Ticks[1].Prices["BTC/USD"].Float64()
+	require.Equal(t, 101.0, price)
+	require.NotContains(t, result.Ticks[2].Prices, "BTC/USD")
+
+	require.Len(t, result.Markets, 2)
+
+	btc := result.Markets[0]
+	require.Equal(t, "BTC/USD", btc.Ticker)
+	require.Equal(t, 3, btc.Ticks)
+	require.Equal(t, 1, btc.Priced)
+	require.Equal(t, 2, btc.Missing)
+	require.InDelta(t, 2.0/3, btc.MissingRate(), 1e-9)
+	require.NotNil(t, btc.Deviation)
+	require.Equal(t, 1, btc.Deviation.Samples)
+	require.InDelta(t, 100, btc.Deviation.MeanBps, 1e-6)
+	require.Equal(t, start.Add(10*time.Second), btc.Deviation.MaxTime)
+
+	usdt := result.Markets[1]
+	require.Equal(t, "USDT/USD", usdt.Ticker)
+	require.Equal(t, 3, usdt.Priced)
+	require.Zero(t, usdt.Missing)
+
+	// the reference price is stale on the third tick.
+	require.NotNil(t, usdt.Deviation)
+	require.Equal(t, 2, usdt.Deviation.Samples)
+	require.InDelta(t, 50, usdt.Deviation.MeanBps, 1e-6)
+	require.InDelta(t, 100, usdt.Deviation.MaxBps, 1e-6)
+	require.Equal(t, start.Add(10*time.Second), usdt.Deviation.MaxTime)
+}
+
+func TestRunMinProviderCount(t *testing.T) {
+	candidate := mmtypes.MarketMap{Markets: map[string]mmtypes.Market{}}
+	for ticker, market := range marketMap.Markets {
+		candidate.Markets[ticker] = market
+	}
+
+	lowered := btcusd
+	lowered.Ticker.MinProviderCount = 1
+	candidate.Markets[btcusd.Ticker.String()] = lowered
+
+	result, err := backtest.Run(zap.NewNop(), candidate, prices, nil, backtest.Config{MaxPriceAge: 15 * time.Second})
+	require.NoError(t, err)
+
+	require.Equal(t, "BTC/USD", result.Markets[0].Ticker)
+	require.Equal(t, 3, result.Markets[0].Priced)
+	require.Nil(t, result.Markets[0].Deviation)
+}
+
+func TestRunInterval(t *testing.T) {
+	result, err := backtest.Run(zap.NewNop(), marketMap, prices, nil, backtest.Config{
+		Interval:    15 * time.Second,
+		MaxPriceAge: 15 * time.Second,
+	})
+	require.NoError(t, err)
+
+	// the last tick is after the last price.
+	require.Len(t, result.Ticks, 3)
+	require.Equal(t, start, result.Ticks[0].Time)
+	require.Equal(t, start.Add(15*time.Second), result.Ticks[1].Time)
+	require.Equal(t, start.Add(30*time.Second), result.Ticks[2].Time)
+
+	// every price is stale on the last tick.
+	require.Empty(t, result.Ticks[2].Prices)
+	require.Equal(t, 1, result.Markets[1].Missing)
+}
+
+func TestRunInvalid(t *testing.T) {
+	testCases := []struct {
+		name      string
+		marketMap mmtypes.MarketMap
+		prices    []backtest.ProviderPrice
+		cfg       backtest.Config
+	}{
+		{
+			name:      "invalid market map",
+			marketMap: mmtypes.MarketMap{Markets: map[string]mmtypes.Market{btcusd.Ticker.String(): btcusd}},
+			prices:    prices,
+		},
+		{
+			name:      "no prices",
+			marketMap: marketMap,
+		},
+		{
+			name:      "negative interval",
+			marketMap: marketMap,
+			prices:    prices,
+			cfg:       backtest.Config{Interval: -time.Second},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := backtest.Run(zap.NewNop(), tc.marketMap, tc.prices, nil, tc.cfg)
+			require.Error(t, err)
+		})
+	}
+}
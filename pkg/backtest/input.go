@@ -0,0 +1,240 @@
+package backtest
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
+)
+
+// ProviderPrice is a price reported by a provider for one of its off-chain tickers.
+type ProviderPrice struct {
+	// Time is the time at which the price was reported.
+	Time time.Time
+	// Provider is the name of the provider.
+	Provider string
+	// OffChainTicker is the ticker of the price on the provider, as referenced by the provider configs of the
+	// market map.
+	OffChainTicker string
+	// Price is the price.
+	Price *big.Float
+	// Volume is the volume reported alongside the price, if any.
+	Volume *big.Float
+}
+
+// ReferencePrice is a price of a market in a reference series, e.g. the on-chain price history.
+type ReferencePrice struct {
+	// Time is the time of the price.
+	Time time.Time
+	// Ticker is the currency pair of the market, e.g. BTC/USD.
+	Ticker string
+	// Price is the price.
+	Price *big.Float
+}
+
+// ReadProviderPrices reads a time series of provider prices from a CSV file with a header or a JSONL file, based on
+// the extension of the file. The columns (or JSON keys) are timestamp, provider, ticker, price and optionally volume.
+// Timestamps are either RFC3339 or unix seconds. The prices are returned sorted by time.
+func ReadProviderPrices(path string) ([]ProviderPrice, error) {
+	rows, err := readRows(path, []string{"timestamp", "provider", "ticker", "price"})
+	if err != nil {
+		return nil, err
+	}
+
+	prices := make([]ProviderPrice, 0, len(rows))
+	for i, row := range rows {
+		price := ProviderPrice{
+			Provider:       row["provider"],
+			OffChainTicker: row["ticker"],
+		}
+
+		if price.Time, err = parseTimestamp(row["timestamp"]); err != nil {
+			return nil, fmt.Errorf("invalid timestamp in row %d: %w", i+1, err)
+		}
+		if price.Price, err = parsePrice(row["price"]); err != nil {
+			return nil, fmt.Errorf("invalid price in row %d: %w", i+1, err)
+		}
+		if row["volume"] != "" {
+			if price.Volume, err = parseNumber(row["volume"]); err != nil {
+				return nil, fmt.Errorf("invalid volume in row %d: %w", i+1, err)
+			}
+		}
+
+		prices = append(prices, price)
+	}
+
+	sort.SliceStable(prices, func(i, j int) bool {
+		return prices[i].Time.Before(prices[j].Time)
+	})
+
+	return prices, nil
+}
+
+// ReadReferencePrices reads a reference price series from a CSV file with a header or a JSONL file, based on the
+// extension of the file. The columns (or JSON keys) are timestamp, ticker and price. Timestamps are either RFC3339
+// or unix seconds. The prices are returned sorted by time.
+func ReadReferencePrices(path string) ([]ReferencePrice, error) {
+	rows, err := readRows(path, []string{"timestamp", "ticker", "price"})
+	if err != nil {
+		return nil, err
+	}
+
+	prices := make([]ReferencePrice, 0, len(rows))
+	for i, row := range rows {
+		var price ReferencePrice
+
+		cp, err := slinkytypes.CurrencyPairFromString(row["ticker"])
+		if err != nil {
+			return nil, fmt.Errorf("invalid ticker in row %d: %w", i+1, err)
+		}
+		price.Ticker = cp.String()
+
+		if price.Time, err = parseTimestamp(row["timestamp"]); err != nil {
+			return nil, fmt.Errorf("invalid timestamp in row %d: %w", i+1, err)
+		}
+		if price.Price, err = parsePrice(row["price"]); err != nil {
+			return nil, fmt.Errorf("invalid price in row %d: %w", i+1, err)
+		}
+
+		prices = append(prices, price)
+	}
+
+	sort.SliceStable(prices, func(i, j int) bool {
+		return prices[i].Time.Before(prices[j].Time)
+	})
+
+	return prices, nil
+}
+
+// readRows reads the rows of a CSV or JSONL file as maps of column to value, and checks that every row has the
+// required columns.
+func readRows(path string, required []string) ([]map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening %s: %w", path, err)
+	}
+	defer f.Close()
+
+	var rows []map[string]string
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		rows, err = readCSVRows(f)
+	} else {
+		rows, err = readJSONRows(f)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s: %w", path, err)
+	}
+
+	for i, row := range rows {
+		for _, column := range required {
+			if row[column] == "" {
+				return nil, fmt.Errorf("row %d of %s is missing %s", i+1, path, column)
+			}
+		}
+	}
+
+	return rows, nil
+}
+
+func readCSVRows(r io.Reader) ([]map[string]string, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	header := records[0]
+	for i := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(header[i]))
+	}
+
+	rows := make([]map[string]string, 0, len(records)-1)
+	for _, record := range records[1:] {
+		row := make(map[string]string, len(header))
+		for i, value := range record {
+			row[header[i]] = strings.TrimSpace(value)
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func readJSONRows(r io.Reader) ([]map[string]string, error) {
+	var rows []map[string]string
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		var values map[string]any
+		dec := json.NewDecoder(bytes.NewReader(scanner.Bytes()))
+		dec.UseNumber()
+		if err := dec.Decode(&values); err != nil {
+			return nil, fmt.Errorf("invalid JSON on line %d: %w", line, err)
+		}
+
+		row := make(map[string]string, len(values))
+		for key, value := range values {
+			if value != nil {
+				row[strings.ToLower(key)] = fmt.Sprint(value)
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, scanner.Err()
+}
+
+// parseTimestamp parses an RFC3339 timestamp, or a number of (possibly fractional) unix seconds.
+func parseTimestamp(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return t.UTC(), nil
+	}
+
+	seconds, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("expected an RFC3339 timestamp or unix seconds, got %q", s)
+	}
+
+	return time.Unix(0, int64(seconds*float64(time.Second))).UTC(), nil
+}
+
+func parsePrice(s string) (*big.Float, error) {
+	price, err := parseNumber(s)
+	if err != nil {
+		return nil, err
+	}
+	if price.Sign() <= 0 {
+		return nil, fmt.Errorf("price must be positive, got %s", s)
+	}
+
+	return price, nil
+}
+
+func parseNumber(s string) (*big.Float, error) {
+	number, ok := new(big.Float).SetString(s)
+	if !ok || number.IsInf() {
+		return nil, fmt.Errorf("invalid number %q", s)
+	}
+
+	return number, nil
+}
@@ -0,0 +1,137 @@
+package backtest_test
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zoguxprotocol/slinky/pkg/backtest"
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestReadProviderPrices(t *testing.T) {
+	expected := []backtest.ProviderPrice{
+		{
+			Time:           start,
+			Provider:       "kucoin",
+			OffChainTicker: "BTC-USDT",
+			Price:          big.NewFloat(42000),
+		},
+		{
+			Time:           start.Add(1500 * time.Millisecond),
+			Provider:       "coinbase",
+			OffChainTicker: "BTC-USD",
+			Price:          big.NewFloat(42000.5),
+			Volume:         big.NewFloat(0),
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		file    string
+		content string
+		err     bool
+	}{
+		{
+			name: "csv",
+			file: "prices.csv",
+			content: `timestamp, provider, ticker, price, volume
+1704067201.5, coinbase, BTC-USD, 42000.5, 0
+2024-01-01T00:00:00Z, kucoin, BTC-USDT, 42000,
+`,
+		},
+		{
+			name: "jsonl",
+			file: "prices.jsonl",
+			content: `{"timestamp": 1704067201.5, "provider": "coinbase", "ticker": "BTC-USD", "price": 42000.5, "volume": "0"}
+
+{"timestamp": "2024-01-01T00:00:00Z", "provider": "kucoin", "ticker": "BTC-USDT", "price": "42000"}
+`,
+		},
+		{
+			name:    "missing column",
+			file:    "prices.csv",
+			content: "timestamp,provider,price\n1704067200,coinbase,1\n",
+			err:     true,
+		},
+		{
+			name:    "invalid timestamp",
+			file:    "prices.jsonl",
+			content: `{"timestamp": "yesterday", "provider": "coinbase", "ticker": "BTC-USD", "price": 1}`,
+			err:     true,
+		},
+		{
+			name:    "negative price",
+			file:    "prices.jsonl",
+			content: `{"timestamp": 1704067200, "provider": "coinbase", "ticker": "BTC-USD", "price": -1}`,
+			err:     true,
+		},
+		{
+			name:    "infinite price",
+			file:    "prices.csv",
+			content: "timestamp,provider,ticker,price\n1704067200,coinbase,BTC-USD,Inf\n",
+			err:     true,
+		},
+		{
+			name:    "infinite volume",
+			file:    "prices.jsonl",
+			content: `{"timestamp": 1704067200, "provider": "coinbase", "ticker": "BTC-USD", "price": 1, "volume": "+Inf"}`,
+			err:     true,
+		},
+		{
+			name:    "invalid json",
+			file:    "prices.jsonl",
+			content: `{"timestamp": 1704067200,`,
+			err:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			prices, err := backtest.ReadProviderPrices(writeFile(t, tc.file, tc.content))
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, prices, len(expected))
+			for i := range expected {
+				require.Equal(t, expected[i].Time, prices[i].Time)
+				require.Equal(t, expected[i].Provider, prices[i].Provider)
+				require.Equal(t, expected[i].OffChainTicker, prices[i].OffChainTicker)
+				require.Zero(t, expected[i].Price.Cmp(prices[i].Price))
+				if expected[i].Volume == nil {
+					require.Nil(t, prices[i].Volume)
+				} else {
+					require.Zero(t, expected[i].Volume.Cmp(prices[i].Volume))
+				}
+			}
+		})
+	}
+}
+
+func TestReadReferencePrices(t *testing.T) {
+	prices, err := backtest.ReadReferencePrices(writeFile(t, "reference.csv", "timestamp,ticker,price\n1704067200,btc/usd,42000\n"))
+	require.NoError(t, err)
+	require.Len(t, prices, 1)
+	require.Equal(t, start, prices[0].Time)
+	require.Equal(t, "BTC/USD", prices[0].Ticker)
+
+	_, err = backtest.ReadReferencePrices(writeFile(t, "reference.csv", "timestamp,ticker,price\n1704067200,BTC-USD,42000\n"))
+	require.Error(t, err)
+
+	_, err = backtest.ReadReferencePrices(filepath.Join(t.TempDir(), "missing.csv"))
+	require.Error(t, err)
+}
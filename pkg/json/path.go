@@ -0,0 +1,263 @@
+package json
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Path is a compiled JSONPath-style expression that selects a single value in a JSON document. The supported
+// syntax is a subset of JSONPath:
+//
+//	$.data.price            object keys (the leading $ is optional)
+//	$["BTC-USD"].price      quoted object keys
+//	$.data[0]               array indices, negative indices count from the end
+//	$[?(@.symbol=="BTC")]   the first array element whose sub-path equals the given value
+type Path struct {
+	raw      string
+	segments []segment
+}
+
+type segmentKind int
+
+const (
+	segmentKey segmentKind = iota
+	segmentIndex
+	segmentFilter
+)
+
+type segment struct {
+	kind  segmentKind
+	key   string
+	index int
+	// filter is the sub-path compared to value for filter segments.
+	filter []segment
+	value  string
+}
+
+// CompilePath compiles the given JSONPath-style expression.
+func CompilePath(path string) (Path, error) {
+	s := strings.TrimSpace(path)
+	if s == "" {
+		return Path{}, fmt.Errorf("path cannot be empty")
+	}
+
+	s = strings.TrimPrefix(s, "$")
+	segments, err := parseSegments(s)
+	if err != nil {
+		return Path{}, fmt.Errorf("invalid path %q: %w", path, err)
+	}
+
+	return Path{raw: path, segments: segments}, nil
+}
+
+// String returns the expression the path was compiled from.
+func (p Path) String() string {
+	return p.raw
+}
+
+// Lookup returns the value selected by the path in the given document, as decoded by Decode.
+func (p Path) Lookup(doc any) (any, error) {
+	value, err := lookup(doc, p.segments)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", p.raw, err)
+	}
+
+	return value, nil
+}
+
+// Decode decodes a JSON document, preserving the precision of numbers.
+func Decode(bz []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// ToBigFloat converts a value selected by a path to a big.Float. Both JSON numbers and numeric strings are supported.
+func ToBigFloat(value any) (*big.Float, error) {
+	var s string
+	switch v := value.(type) {
+	case json.Number:
+		s = v.String()
+	case string:
+		s = strings.TrimSpace(v)
+	case float64:
+		return big.NewFloat(v), nil
+	default:
+		return nil, fmt.Errorf("expected a number, got %T", value)
+	}
+
+	f, ok := new(big.Float).SetString(s)
+	if !ok {
+		return nil, fmt.Errorf("invalid number %q", s)
+	}
+
+	return f, nil
+}
+
+func parseSegments(s string) ([]segment, error) {
+	var segments []segment
+
+	for i := 0; i < len(s); {
+		if s[i] == '[' {
+			seg, n, err := parseBracket(s[i:])
+			if err != nil {
+				return nil, err
+			}
+
+			segments = append(segments, seg)
+			i += n
+			continue
+		}
+
+		if s[i] == '.' {
+			i++
+		}
+
+		end := i
+		for end < len(s) && s[end] != '.' && s[end] != '[' {
+			end++
+		}
+		if end == i {
+			return nil, fmt.Errorf("empty key at position %d", i)
+		}
+
+		segments = append(segments, segment{kind: segmentKey, key: s[i:end]})
+		i = end
+	}
+
+	return segments, nil
+}
+
+// parseBracket parses a bracketed segment at the start of s, and returns it along with its length.
+func parseBracket(s string) (segment, int, error) {
+	end := strings.IndexByte(s, ']')
+	if end < 0 {
+		return segment{}, 0, fmt.Errorf("unterminated bracket in %q", s)
+	}
+
+	switch {
+	case strings.HasPrefix(s, `["`), strings.HasPrefix(s, `['`):
+		quote := s[1]
+		closing := strings.IndexByte(s[2:], quote)
+		if closing < 0 || len(s) < closing+4 || s[closing+3] != ']' {
+			return segment{}, 0, fmt.Errorf("unterminated quoted key in %q", s)
+		}
+
+		return segment{kind: segmentKey, key: s[2 : closing+2]}, closing + 4, nil
+	case strings.HasPrefix(s, "[?(@"):
+		closing := strings.Index(s, ")]")
+		if closing < 0 {
+			return segment{}, 0, fmt.Errorf("unterminated filter in %q", s)
+		}
+
+		expr := s[len("[?(@"):closing]
+		lhs, rhs, ok := strings.Cut(expr, "==")
+		if !ok {
+			return segment{}, 0, fmt.Errorf("filter %q must be of the form [?(@.path==value)]", s[:closing+2])
+		}
+
+		filter, err := parseSegments(strings.TrimSpace(lhs))
+		if err != nil {
+			return segment{}, 0, err
+		}
+		if len(filter) == 0 {
+			return segment{}, 0, fmt.Errorf("filter %q has an empty path", s[:closing+2])
+		}
+
+		value := strings.TrimSpace(rhs)
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		} else if len(value) >= 2 && value[0] == '\'' && value[len(value)-1] == '\'' {
+			value = value[1 : len(value)-1]
+		}
+
+		return segment{kind: segmentFilter, filter: filter, value: value}, closing + 2, nil
+	default:
+		index, err := strconv.Atoi(strings.TrimSpace(s[1:end]))
+		if err != nil {
+			return segment{}, 0, fmt.Errorf("invalid index %q", s[:end+1])
+		}
+
+		return segment{kind: segmentIndex, index: index}, end + 1, nil
+	}
+}
+
+func lookup(value any, segments []segment) (any, error) {
+	for _, seg := range segments {
+		switch seg.kind {
+		case segmentKey:
+			obj, ok := value.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("expected an object for key %q, got %T", seg.key, value)
+			}
+
+			if value, ok = obj[seg.key]; !ok {
+				return nil, fmt.Errorf("key %q not found", seg.key)
+			}
+		case segmentIndex:
+			arr, ok := value.([]any)
+			if !ok {
+				return nil, fmt.Errorf("expected an array for index %d, got %T", seg.index, value)
+			}
+
+			index := seg.index
+			if index < 0 {
+				index += len(arr)
+			}
+			if index < 0 || index >= len(arr) {
+				return nil, fmt.Errorf("index %d out of range for array of length %d", seg.index, len(arr))
+			}
+
+			value = arr[index]
+		case segmentFilter:
+			arr, ok := value.([]any)
+			if !ok {
+				return nil, fmt.Errorf("expected an array for filter, got %T", value)
+			}
+
+			var found bool
+			for _, elem := range arr {
+				v, err := lookup(elem, seg.filter)
+				if err != nil {
+					continue
+				}
+
+				if s, ok := scalarString(v); ok && s == seg.value {
+					value, found = elem, true
+					break
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("no element matches %q", seg.value)
+			}
+		}
+	}
+
+	return value, nil
+}
+
+// scalarString returns the string representation of a scalar JSON value.
+func scalarString(value any) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case json.Number:
+		return v.String(), true
+	case bool:
+		return strconv.FormatBool(v), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	default:
+		return "", false
+	}
+}
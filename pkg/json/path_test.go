@@ -0,0 +1,147 @@
+package json_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zoguxprotocol/slinky/pkg/json"
+)
+
+func TestPath(t *testing.T) {
+	doc, err := json.Decode([]byte(`{
+		"data": {
+			"price": "42000.5",
+			"BTC-USD": {"last": 42000.123456789012345678},
+			"list": [
+				{"symbol": "BTCUSDT", "price": 1, "active": true},
+				{"symbol": "ETHUSDT", "price": 2, "active": false}
+			]
+		},
+		"result": [[1704067200, "3.5"]]
+	}`))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		path     string
+		expected string
+		err      bool
+	}{
+		{
+			name:     "dotted keys",
+			path:     "$.data.price",
+			expected: "42000.5",
+		},
+		{
+			name:     "no leading $",
+			path:     "data.price",
+			expected: "42000.5",
+		},
+		{
+			name:     "quoted key preserves precision",
+			path:     `$.data["BTC-USD"].last`,
+			expected: "42000.123456789012345678",
+		},
+		{
+			name:     "single quoted key",
+			path:     `$.data['BTC-USD'].last`,
+			expected: "42000.123456789012345678",
+		},
+		{
+			name:     "nested indices",
+			path:     "$.result[0][1]",
+			expected: "3.5",
+		},
+		{
+			name:     "negative index",
+			path:     "$.result[-1][0]",
+			expected: "1704067200",
+		},
+		{
+			name:     "filter on string",
+			path:     `$.data.list[?(@.symbol=="ETHUSDT")].price`,
+			expected: "2",
+		},
+		{
+			name:     "filter on bool",
+			path:     `$.data.list[?(@.active == true)].symbol`,
+			expected: "BTCUSDT",
+		},
+		{
+			name: "filter without a match",
+			path: `$.data.list[?(@.symbol=='SOLUSDT')].price`,
+			err:  true,
+		},
+		{
+			name: "missing key",
+			path: "$.data.volume",
+			err:  true,
+		},
+		{
+			name: "index out of range",
+			path: "$.result[1]",
+			err:  true,
+		},
+		{
+			name: "key on an array",
+			path: "$.result.price",
+			err:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path, err := json.CompilePath(tc.path)
+			require.NoError(t, err)
+			require.Equal(t, tc.path, path.String())
+
+			value, err := path.Lookup(doc)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			f, err := json.ToBigFloat(value)
+			if tc.expected == "BTCUSDT" {
+				require.Equal(t, tc.expected, value)
+				return
+			}
+			require.NoError(t, err)
+
+			expected, ok := new(big.Float).SetString(tc.expected)
+			require.True(t, ok)
+			require.Zero(t, expected.Cmp(f))
+		})
+	}
+}
+
+func TestCompilePathInvalid(t *testing.T) {
+	for _, path := range []string{
+		"",
+		"$.data..price",
+		"$.data[",
+		"$.data[abc]",
+		`$.data["price]`,
+		"$.data[?(@.symbol)]",
+		"$.data[?(@.symbol==1]",
+		"$.data[?(@==1)]",
+	} {
+		_, err := json.CompilePath(path)
+		require.Error(t, err, path)
+	}
+}
+
+func TestToBigFloat(t *testing.T) {
+	for _, value := range []any{"abc", true, nil, map[string]any{}} {
+		_, err := json.ToBigFloat(value)
+		require.Error(t, err)
+	}
+
+	f, err := json.ToBigFloat(" 1.5 ")
+	require.NoError(t, err)
+	require.Zero(t, big.NewFloat(1.5).Cmp(f))
+}
@@ -138,6 +138,71 @@ func CalculateMedian(values []*big.Float) *big.Float {
 	return median
 }
 
+// CalculateWeightedMedian calculates the weighted median from a list of big.Float values
+// and their corresponding non-negative weights. The weighted median is the first value (in
+// sorted order) at which the cumulative weight reaches half of the total weight. Returns nil
+// if the inputs are empty, have mismatched lengths, or the total weight is zero.
+func CalculateWeightedMedian(values, weights []*big.Float) *big.Float {
+	if len(values) == 0 || len(values) != len(weights) {
+		return nil
+	}
+
+	type weightedValue struct {
+		value  *big.Float
+		weight *big.Float
+	}
+
+	total := new(big.Float)
+	weighted := make([]weightedValue, len(values))
+	for i := range values {
+		weighted[i] = weightedValue{value: values[i], weight: weights[i]}
+		total.Add(total, weights[i])
+	}
+
+	if total.Sign() <= 0 {
+		return nil
+	}
+
+	sort.SliceStable(weighted, func(i, j int) bool {
+		return weighted[i].value.Cmp(weighted[j].value) < 0
+	})
+
+	middle := new(big.Float).Quo(total, new(big.Float).SetUint64(2))
+	sum := new(big.Float)
+	for _, wv := range weighted {
+		sum.Add(sum, wv.weight)
+		if sum.Cmp(middle) >= 0 {
+			return wv.value
+		}
+	}
+
+	return weighted[len(weighted)-1].value
+}
+
+// CalculateTrimmedMean calculates the mean of a list of big.Float after removing the
+// trimPercent lowest and trimPercent highest values. The number of values removed from
+// each side is rounded down, so small lists may not be trimmed at all. Returns nil for an
+// empty list.
+func CalculateTrimmedMean(values []*big.Float, trimPercent uint64) *big.Float {
+	if len(values) == 0 {
+		return nil
+	}
+	SortBigFloats(values)
+
+	trim := len(values) * int(trimPercent) / 100 //nolint:gosec
+	if 2*trim >= len(values) {
+		trim = (len(values) - 1) / 2
+	}
+
+	kept := values[trim : len(values)-trim]
+	sum := new(big.Float)
+	for _, value := range kept {
+		sum.Add(sum, value)
+	}
+
+	return sum.Quo(sum, new(big.Float).SetInt64(int64(len(kept))))
+}
+
 // GetScalingFactor returns the scaling factor for the price based on the difference between
 // the token decimals in the erc20 token contracts or similar.
 func GetScalingFactor(
@@ -412,6 +412,115 @@ func TestCalculateMedian(t *testing.T) {
 	}
 }
 
+func TestCalculateWeightedMedian(t *testing.T) {
+	testCases := []struct {
+		name     string
+		values   []*big.Float
+		weights  []*big.Float
+		expected *big.Float
+	}{
+		{
+			name:     "do nothing for nil slice",
+			values:   nil,
+			weights:  nil,
+			expected: nil,
+		},
+		{
+			name:     "mismatched lengths",
+			values:   []*big.Float{big.NewFloat(1)},
+			weights:  []*big.Float{big.NewFloat(1), big.NewFloat(2)},
+			expected: nil,
+		},
+		{
+			name:     "zero total weight",
+			values:   []*big.Float{big.NewFloat(1), big.NewFloat(2)},
+			weights:  []*big.Float{big.NewFloat(0), big.NewFloat(0)},
+			expected: nil,
+		},
+		{
+			name:     "equal weights returns the lower middle value",
+			values:   []*big.Float{big.NewFloat(3), big.NewFloat(1), big.NewFloat(2)},
+			weights:  []*big.Float{big.NewFloat(1), big.NewFloat(1), big.NewFloat(1)},
+			expected: big.NewFloat(2),
+		},
+		{
+			name:     "heavy weight dominates",
+			values:   []*big.Float{big.NewFloat(100), big.NewFloat(1), big.NewFloat(2)},
+			weights:  []*big.Float{big.NewFloat(1), big.NewFloat(10), big.NewFloat(1)},
+			expected: big.NewFloat(1),
+		},
+		{
+			name:     "outlier with small weight is ignored",
+			values:   []*big.Float{big.NewFloat(1_000), big.NewFloat(10), big.NewFloat(11)},
+			weights:  []*big.Float{big.NewFloat(1), big.NewFloat(5), big.NewFloat(5)},
+			expected: big.NewFloat(11),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, math.CalculateWeightedMedian(tc.values, tc.weights))
+		})
+	}
+}
+
+func TestCalculateTrimmedMean(t *testing.T) {
+	testCases := []struct {
+		name        string
+		values      []*big.Float
+		trimPercent uint64
+		expected    *big.Float
+	}{
+		{
+			name:        "do nothing for nil slice",
+			values:      nil,
+			trimPercent: 10,
+			expected:    nil,
+		},
+		{
+			name:        "no trimming is a plain mean",
+			values:      []*big.Float{big.NewFloat(1), big.NewFloat(2), big.NewFloat(6)},
+			trimPercent: 0,
+			expected:    big.NewFloat(3),
+		},
+		{
+			name: "trims the lowest and highest values",
+			values: []*big.Float{
+				big.NewFloat(100),
+				big.NewFloat(2),
+				big.NewFloat(3),
+				big.NewFloat(-50),
+				big.NewFloat(4),
+			},
+			trimPercent: 20,
+			expected:    big.NewFloat(3),
+		},
+		{
+			name:        "small lists are not trimmed",
+			values:      []*big.Float{big.NewFloat(1), big.NewFloat(3)},
+			trimPercent: 10,
+			expected:    big.NewFloat(2),
+		},
+		{
+			name:        "a single value is always kept",
+			values:      []*big.Float{big.NewFloat(5)},
+			trimPercent: 49,
+			expected:    big.NewFloat(5),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := math.CalculateTrimmedMean(tc.values, tc.trimPercent)
+			if tc.expected == nil {
+				require.Nil(t, result)
+				return
+			}
+			require.Equal(t, 0, tc.expected.Cmp(result))
+		})
+	}
+}
+
 func TestSortBigInts(t *testing.T) {
 	testCases := []struct {
 		name     string
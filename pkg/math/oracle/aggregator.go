@@ -4,14 +4,17 @@ import (
 	"fmt"
 	"math/big"
 	"sync"
+	"time"
 
 	"go.uber.org/zap"
 
 	"github.com/zoguxprotocol/slinky/oracle"
+	"github.com/zoguxprotocol/slinky/oracle/config"
 	oraclemetrics "github.com/zoguxprotocol/slinky/oracle/metrics"
 	"github.com/zoguxprotocol/slinky/oracle/types"
 	"github.com/zoguxprotocol/slinky/pkg/math"
 	mmtypes "github.com/zoguxprotocol/slinky/x/marketmap/types"
+	"github.com/zoguxprotocol/slinky/x/marketmap/types/tickermetadata"
 )
 
 var _ oracle.PriceAggregator = &IndexPriceAggregator{}
@@ -34,6 +37,51 @@ type IndexPriceAggregator struct {
 	// providerPrices cache the unscaled prices for each provider. These are indexed by
 	// provider -> offChainTicker -> price.
 	providerPrices map[string]types.Prices
+	// providerVolumes cache the volumes reported by each provider alongside their prices.
+	// These are indexed by provider -> offChainTicker -> volume.
+	providerVolumes map[string]types.Prices
+	// providerTimestamps cache the times at which each provider reported their prices.
+	// These are indexed by provider -> offChainTicker -> timestamp.
+	providerTimestamps map[string]map[string]time.Time
+	// breakdowns cache the breakdown of each provider's price for each market, as of the
+	// latest aggregation. These are indexed by ticker.
+	breakdowns types.PriceBreakdowns
+	// aggregations cache the aggregation configuration of each market, parsed from the
+	// ticker metadata. These are indexed by ticker.
+	aggregations map[string]tickermetadata.Aggregation
+	// synthetics cache the synthetic configuration of each synthetic market, parsed from the
+	// ticker metadata. These are indexed by ticker.
+	synthetics map[string]tickermetadata.Synthetic
+	// indices cache the weighting of each synthetic index market, parsed from the ticker metadata.
+	// These are indexed by ticker.
+	indices map[string]tickermetadata.Index
+	// twapSamples cache the median prices used to compute the TWAP of each market that
+	// uses the TWAP strategy. These are indexed by ticker.
+	twapSamples map[string][]timedPrice
+
+	// outlierCfg is the configuration of the outlier filter applied to the converted prices.
+	outlierCfg config.OutlierFilterConfig
+	// deviationScores cache the rolling deviation score of each provider. These are indexed
+	// by provider.
+	deviationScores map[string]float64
+	// quarantinedUntil cache the time at which the quarantine of each quarantined provider
+	// expires. These are indexed by provider.
+	quarantinedUntil map[string]time.Time
+
+	// now returns the current time.
+	now func() time.Time
+}
+
+// ConvertedPrice is a provider price that has been converted to the target ticker of
+// a market, along with the volume the provider reported for it (if any).
+type ConvertedPrice struct {
+	// Provider is the name of the provider that reported the price.
+	Provider string
+	// Price is the converted price.
+	Price *big.Float
+	// Volume is the volume reported by the provider. This is nil if the provider did not
+	// report a volume.
+	Volume *big.Float
 }
 
 // NewIndexPriceAggregator returns a new Index Price Aggregator.
@@ -41,6 +89,7 @@ func NewIndexPriceAggregator(
 	logger *zap.Logger,
 	cfg mmtypes.MarketMap,
 	metrics oraclemetrics.Metrics,
+	opts ...Option,
 ) (*IndexPriceAggregator, error) {
 	if logger == nil {
 		return nil, fmt.Errorf("logger cannot be nil")
@@ -51,25 +100,46 @@ func NewIndexPriceAggregator(
 		metrics = oraclemetrics.NewNopMetrics()
 	}
 
-	return &IndexPriceAggregator{
-		logger:         logger.With(zap.String("process", "index_price_aggregator")),
-		cfg:            cfg,
-		metrics:        metrics,
-		indexPrices:    make(types.Prices),
-		scaledPrices:   make(types.Prices),
-		providerPrices: make(map[string]types.Prices),
-	}, nil
+	m := &IndexPriceAggregator{
+		logger:             logger.With(zap.String("process", "index_price_aggregator")),
+		metrics:            metrics,
+		indexPrices:        make(types.Prices),
+		scaledPrices:       make(types.Prices),
+		providerPrices:     make(map[string]types.Prices),
+		providerVolumes:    make(map[string]types.Prices),
+		providerTimestamps: make(map[string]map[string]time.Time),
+		breakdowns:         make(types.PriceBreakdowns),
+		twapSamples:        make(map[string][]timedPrice),
+		deviationScores:    make(map[string]float64),
+		quarantinedUntil:   make(map[string]time.Time),
+		now:                time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	if err := m.outlierCfg.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid outlier filter config: %w", err)
+	}
+
+	m.setMarketMap(cfg)
+
+	return m, nil
 }
 
-// AggregatePrices implements the aggregate function for the median price calculation. Specifically, this
+// AggregatePrices implements the aggregate function for the index price calculation. Specifically, this
 // aggregation function aggregates the prices seen by each provider by first converting each price to a
-// common ticker and then calculating the median of the converted prices. Prices are converted either
+// common ticker and then applying the market's aggregation strategy (the median by default) to the
+// converted prices. Prices are converted either
 //
 //  1. Directly from the base ticker to the target ticker. i.e. I have BTC/USD and I want BTC/USD.
 //  2. Using the index price of an asset. i.e. I have BTC/USDT and I want BTC/USD. I can convert
 //     BTC/USDT to BTC/USD using the index price of USDT/USD.
 //
-// The index price cache contains the previously calculated median prices.
+// The index price cache contains the previously calculated median prices. Synthetic markets have no
+// providers; their prices are derived from the index prices calculated in the same round, once all
+// other markets have been priced.
 func (m *IndexPriceAggregator) AggregatePrices() {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
@@ -79,12 +149,22 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 
 	var missingPrices []string
 
+	// Release any providers whose quarantine has expired before pricing the markets.
+	m.releaseQuarantinedProviders(m.now())
+
+	// The price breakdowns are re-populated as the converted prices of each market are calculated.
+	m.breakdowns = make(types.PriceBreakdowns)
+
 	for ticker, market := range m.cfg.Markets {
 		if !market.Ticker.Enabled {
 			m.logger.Debug("skipping disabled market", zap.Any("market", market))
 			continue
 		}
 
+		if _, ok := m.synthetics[ticker]; ok {
+			continue
+		}
+
 		// Get the converted prices for set of convertible markets.
 		// ex. BTC/USDT * Index USDT/USD = BTC/USD
 		//     BTC/USDC * Index USDC/USD = BTC/USD
@@ -95,6 +175,7 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 		// We need to have at least the minimum number of providers to calculate the median.
 		if len(convertedPrices) < int(target.MinProviderCount) { //nolint:gosec
 			missingPrices = append(missingPrices, ticker)
+			m.excludeAllPrices(target.String(), types.ExclusionReasonInsufficientProviders)
 			m.logger.Debug(
 				"insufficient amount of converted prices",
 				zap.String("target_ticker", ticker),
@@ -106,16 +187,15 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 			continue
 		}
 
-		// Take the median of the converted prices. This takes the average of the middle two
-		// prices if the number of prices is even.
-		price := math.CalculateMedian(convertedPrices)
+		// Aggregate the converted prices using the market's aggregation strategy.
+		price := m.CalculateAggregatedPrice(market, convertedPrices)
 		indexPrices[target.String()] = new(big.Float).Copy(price)
 
 		// Scale the price to the target ticker's decimals.
 		scaledPrices[target.String()] = math.ScaleBigFloat(new(big.Float).Copy(price), target.Decimals)
 
 		m.logger.Debug(
-			"calculated median price",
+			"calculated aggregated price",
 			zap.String("target_ticker", ticker),
 
 			zap.String("unscaled_price", indexPrices[target.String()].String()),
@@ -127,9 +207,12 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 		m.metrics.UpdateAggregatePrice(target.String(), target.GetDecimals(), floatPrice)
 	}
 
+	// Derive the prices of the synthetic markets from the index prices calculated above.
+	missingPrices = append(missingPrices, m.calculateSyntheticPrices(indexPrices, scaledPrices)...)
+
 	// Update the aggregated data. These prices are going to be used as the index prices the
 	// next time we calculate prices.
-	m.logger.Debug("calculated median prices for price feeds", zap.Int("num_prices", len(indexPrices)))
+	m.logger.Debug("calculated aggregated prices for price feeds", zap.Int("num_prices", len(indexPrices)))
 	if len(missingPrices) > 0 {
 		m.logger.Info("failed to calculate prices for price feeds", zap.Strings("missing_prices", missingPrices))
 	}
@@ -139,10 +222,12 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 
 // CalculateConvertedPrices calculates the converted prices for a given set of paths and target ticker.
 // The prices utilized are the prices most recently seen by the providers. Each price is within a
-// MaxPriceAge window so is safe to use.
+// MaxPriceAge window so is safe to use. Prices from quarantined providers are skipped and, if the
+// outlier filter is enabled, outliers are removed from the returned prices. The breakdown of each
+// provider's price is recorded for the market.
 func (m *IndexPriceAggregator) CalculateConvertedPrices(
 	market mmtypes.Market,
-) []*big.Float {
+) []ConvertedPrice {
 	m.logger.Debug("calculating converted prices", zap.String("ticker", market.Ticker.String()))
 	if len(market.ProviderConfigs) == 0 {
 		m.logger.Error(
@@ -153,11 +238,27 @@ func (m *IndexPriceAggregator) CalculateConvertedPrices(
 		return nil
 	}
 
-	convertedPrices := make([]*big.Float, 0, len(market.ProviderConfigs))
-	for _, cfg := range market.ProviderConfigs {
+	convertedPrices := make([]ConvertedPrice, 0, len(market.ProviderConfigs))
+	breakdowns := make([]types.ProviderPriceBreakdown, len(market.ProviderConfigs))
+	for i, cfg := range market.ProviderConfigs {
+		breakdowns[i] = m.newProviderPriceBreakdown(cfg)
+
+		if _, ok := m.quarantinedUntil[cfg.Name]; ok {
+			breakdowns[i].Reason = types.ExclusionReasonQuarantined
+			m.logger.Debug(
+				"skipping quarantined provider",
+				zap.String("target_ticker", market.Ticker.String()),
+				zap.Any("provider", cfg.Name),
+			)
+
+			m.metrics.AddProviderTick(cfg.Name, market.Ticker.String(), false)
+			continue
+		}
+
 		// Calculate the converted price.
 		adjustedPrice, err := m.CalculateAdjustedPrice(cfg)
 		if err != nil {
+			breakdowns[i].Reason = err.Error()
 			m.logger.Debug(
 				"failed to calculate converted price",
 				zap.Error(err),
@@ -169,7 +270,13 @@ func (m *IndexPriceAggregator) CalculateConvertedPrices(
 			continue
 		}
 
-		convertedPrices = append(convertedPrices, adjustedPrice)
+		breakdowns[i].ConvertedPrice = adjustedPrice
+		breakdowns[i].Used = true
+		convertedPrices = append(convertedPrices, ConvertedPrice{
+			Provider: cfg.Name,
+			Price:    adjustedPrice,
+			Volume:   m.GetProviderVolume(cfg),
+		})
 		m.logger.Debug(
 			"calculated converted price",
 			zap.String("target_ticker", market.Ticker.String()),
@@ -182,7 +289,23 @@ func (m *IndexPriceAggregator) CalculateConvertedPrices(
 		m.metrics.UpdatePrice(cfg.Name, market.Ticker.String(), market.Ticker.GetDecimals(), floatPrice)
 	}
 
-	return convertedPrices
+	filteredPrices := m.FilterOutliers(market, convertedPrices)
+	if len(filteredPrices) < len(convertedPrices) {
+		used := make(map[string]struct{}, len(filteredPrices))
+		for _, price := range filteredPrices {
+			used[price.Provider] = struct{}{}
+		}
+
+		for i := range breakdowns {
+			if _, ok := used[breakdowns[i].Provider]; breakdowns[i].Used && !ok {
+				breakdowns[i].Used = false
+				breakdowns[i].Reason = types.ExclusionReasonOutlier
+			}
+		}
+	}
+	m.breakdowns[market.Ticker.String()] = breakdowns
+
+	return filteredPrices
 }
 
 // CalculateAdjustedPrice calculates an adjusted price for a given set of operations (if applicable).
@@ -191,10 +314,13 @@ func (m *IndexPriceAggregator) CalculateConvertedPrices(
 //  1. A direct conversion from the base ticker to the target ticker i.e. we want BTC/USD and
 //     we have BTC/USD from a provider (e.g. Coinbase).
 //  2. We need to convert the price of a given asset against the index price of an asset.
+//  3. We need to convert the price of a given asset through a path of index prices i.e. we want
+//     FOO/USD and we have FOO/ETH, which is converted by the index prices of ETH/BTC and BTC/USD.
 //
-// In the first case, we can simply return the price of the provider. In the second case, we need
-// to adjust the price by the index price of the asset. If the index price is not available, we
-// return an error.
+// In the first case, we can simply return the price of the provider. In the other cases, we need
+// to adjust the price by the index price of each asset. If any index price is not available, we
+// return an error. Finally, if the provider config has a redemption rate, the price of the underlying
+// asset is multiplied by the redemption rate i.e. stETH/USD = ETH/USD * stETH/ETH redemption rate.
 func (m *IndexPriceAggregator) CalculateAdjustedPrice(
 	cfg mmtypes.ProviderConfig,
 ) (*big.Float, error) {
@@ -203,15 +329,25 @@ func (m *IndexPriceAggregator) CalculateAdjustedPrice(
 		return nil, err
 	}
 
-	if cfg.NormalizeByPair == nil {
-		return price, nil
+	// Make sure that the price is adjusted by the market price of each pair in order.
+	adjustedPrice := price
+	for _, pair := range cfg.ConversionPairs() {
+		indexPrice, err := m.GetIndexPrice(pair)
+		if err != nil {
+			return nil, err
+		}
+
+		adjustedPrice = new(big.Float).Mul(adjustedPrice, indexPrice)
 	}
 
-	normalizeByIndexPrice, err := m.GetIndexPrice(*cfg.NormalizeByPair)
-	if err != nil {
-		return nil, err
+	if cfg.RedemptionRate != nil {
+		rate, err := m.GetRedemptionRate(*cfg.RedemptionRate)
+		if err != nil {
+			return nil, err
+		}
+
+		adjustedPrice = new(big.Float).Mul(adjustedPrice, rate)
 	}
 
-	// Make sure that the price is adjusted by the market price.
-	return new(big.Float).Mul(price, normalizeByIndexPrice), nil
+	return adjustedPrice, nil
 }
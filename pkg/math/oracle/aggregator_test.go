@@ -3,23 +3,42 @@ package oracle_test
 import (
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/zoguxprotocol/slinky/oracle/constants"
 	"github.com/zoguxprotocol/slinky/oracle/metrics"
 	"github.com/zoguxprotocol/slinky/oracle/types"
 	"github.com/zoguxprotocol/slinky/pkg/math/oracle"
 	pkgtypes "github.com/zoguxprotocol/slinky/pkg/types"
 	"github.com/zoguxprotocol/slinky/providers/apis/binance"
 	"github.com/zoguxprotocol/slinky/providers/apis/coinbase"
+	"github.com/zoguxprotocol/slinky/providers/apis/defi/erc4626"
+	"github.com/zoguxprotocol/slinky/providers/apis/defi/uniswapv3"
 	"github.com/zoguxprotocol/slinky/providers/websockets/kucoin"
+	"github.com/zoguxprotocol/slinky/providers/websockets/okx"
 	mmtypes "github.com/zoguxprotocol/slinky/x/marketmap/types"
+	"github.com/zoguxprotocol/slinky/x/marketmap/types/tickermetadata"
 )
 
 var (
 	usdtusdCP = pkgtypes.NewCurrencyPair("USDT", "USD")
 	btcusdCP  = pkgtypes.NewCurrencyPair("BTC", "USD")
 	ethusdCP  = pkgtypes.NewCurrencyPair("ETH", "USD")
+
+	// stethRedemptionRate is the redemption rate of stETH in ETH, sanity checked against a DEX pool.
+	stethRedemptionRate = &mmtypes.RedemptionRate{
+		Rate: mmtypes.ProviderConfig{
+			Name:           erc4626.ProviderNames[constants.ETHEREUM],
+			OffChainTicker: "STETH/ETH",
+		},
+		MarketRate: &mmtypes.ProviderConfig{
+			Name:           uniswapv3.ProviderNames[constants.ETHEREUM],
+			OffChainTicker: "STETH/WETH",
+		},
+		MaxDeviation: "0.05",
+	}
 )
 
 func TestAggregateData(t *testing.T) {
@@ -164,6 +183,210 @@ func TestAggregateData(t *testing.T) {
 	}
 }
 
+func TestAggregateDataWithStrategy(t *testing.T) {
+	newMarketMap := func(aggregation tickermetadata.Aggregation) mmtypes.MarketMap {
+		bz, err := tickermetadata.MarshalAggregation(aggregation)
+		require.NoError(t, err)
+
+		ticker := BTC_USD
+		ticker.Metadata_JSON = string(bz)
+		return mmtypes.MarketMap{
+			Markets: map[string]mmtypes.Market{
+				ticker.String(): {
+					Ticker: ticker,
+					ProviderConfigs: []mmtypes.ProviderConfig{
+						{
+							Name:           coinbase.Name,
+							OffChainTicker: "BTC-USD",
+						},
+						{
+							Name:           binance.Name,
+							OffChainTicker: "BTCUSD",
+						},
+						{
+							Name:           kucoin.Name,
+							OffChainTicker: "BTC-USD",
+						},
+						{
+							Name:           okx.Name,
+							OffChainTicker: "BTC-USD",
+						},
+					},
+				},
+			},
+		}
+	}
+
+	setPrices := func(aggregator *oracle.IndexPriceAggregator) {
+		aggregator.SetProviderPrices(coinbase.Name, types.Prices{"BTC-USD": big.NewFloat(70_000)})
+		aggregator.SetProviderPrices(binance.Name, types.Prices{"BTCUSD": big.NewFloat(71_000)})
+		aggregator.SetProviderPrices(kucoin.Name, types.Prices{"BTC-USD": big.NewFloat(72_000)})
+		aggregator.SetProviderPrices(okx.Name, types.Prices{"BTC-USD": big.NewFloat(90_000)})
+	}
+
+	var (
+		trimPercent = uint64(25)
+		noTrim      = uint64(0)
+	)
+
+	testCases := []struct {
+		name          string
+		aggregation   tickermetadata.Aggregation
+		malleate      func(aggregator *oracle.IndexPriceAggregator)
+		expectedPrice *big.Float
+	}{
+		{
+			name:          "median by default",
+			aggregation:   tickermetadata.Aggregation{},
+			malleate:      setPrices,
+			expectedPrice: big.NewFloat(71_500),
+		},
+		{
+			name:        "volume weighted median",
+			aggregation: tickermetadata.NewAggregation(tickermetadata.AggregationStrategyVolumeWeightedMedian, nil, 0),
+			malleate: func(aggregator *oracle.IndexPriceAggregator) {
+				setPrices(aggregator)
+				aggregator.SetProviderVolumes(coinbase.Name, types.Prices{"BTC-USD": big.NewFloat(1_000)})
+				aggregator.SetProviderVolumes(binance.Name, types.Prices{"BTCUSD": big.NewFloat(100)})
+				aggregator.SetProviderVolumes(okx.Name, types.Prices{"BTC-USD": big.NewFloat(1)})
+			},
+			expectedPrice: big.NewFloat(70_000),
+		},
+		{
+			name:          "volume weighted median without volumes falls back to the median",
+			aggregation:   tickermetadata.NewAggregation(tickermetadata.AggregationStrategyVolumeWeightedMedian, nil, 0),
+			malleate:      setPrices,
+			expectedPrice: big.NewFloat(71_500),
+		},
+		{
+			name:        "volume weighted median with fewer volumes than the min provider count falls back to the median",
+			aggregation: tickermetadata.NewAggregation(tickermetadata.AggregationStrategyVolumeWeightedMedian, nil, 0),
+			malleate: func(aggregator *oracle.IndexPriceAggregator) {
+				setPrices(aggregator)
+				aggregator.SetProviderVolumes(coinbase.Name, types.Prices{"BTC-USD": big.NewFloat(1_000)})
+				aggregator.SetProviderVolumes(binance.Name, types.Prices{"BTCUSD": big.NewFloat(100)})
+				aggregator.SetProviderVolumes(okx.Name, types.Prices{"BTC-USD": big.NewFloat(0)})
+			},
+			expectedPrice: big.NewFloat(71_500),
+		},
+		{
+			name:          "trimmed mean",
+			aggregation:   tickermetadata.NewAggregation(tickermetadata.AggregationStrategyTrimmedMean, &trimPercent, 0),
+			malleate:      setPrices,
+			expectedPrice: big.NewFloat(71_500),
+		},
+		{
+			name:          "trimmed mean with an explicit trim percent of 0 is the mean",
+			aggregation:   tickermetadata.NewAggregation(tickermetadata.AggregationStrategyTrimmedMean, &noTrim, 0),
+			malleate:      setPrices,
+			expectedPrice: big.NewFloat(75_750),
+		},
+		{
+			name:          "twap with a single sample is the median",
+			aggregation:   tickermetadata.NewAggregation(tickermetadata.AggregationStrategyTWAP, nil, 60),
+			malleate:      setPrices,
+			expectedPrice: big.NewFloat(71_500),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := oracle.NewIndexPriceAggregator(logger, newMarketMap(tc.aggregation), metrics.NewNopMetrics())
+			require.NoError(t, err)
+
+			tc.malleate(m)
+			m.AggregatePrices()
+
+			result := m.GetIndexPrices()
+			require.Len(t, result, 1)
+			require.Equal(t, tc.expectedPrice.SetPrec(36), result[BTC_USD.String()].SetPrec(36))
+		})
+	}
+
+	setVolumes := func(aggregator *oracle.IndexPriceAggregator) {
+		aggregator.SetProviderVolumes(coinbase.Name, types.Prices{"BTC-USD": big.NewFloat(1_000)})
+		aggregator.SetProviderVolumes(binance.Name, types.Prices{"BTCUSD": big.NewFloat(100)})
+		aggregator.SetProviderVolumes(okx.Name, types.Prices{"BTC-USD": big.NewFloat(1)})
+	}
+
+	withProviderAggregation := func(
+		marketMap mmtypes.MarketMap,
+		index int,
+		aggregation tickermetadata.Aggregation,
+	) mmtypes.MarketMap {
+		bz, err := tickermetadata.MarshalAggregation(aggregation)
+		require.NoError(t, err)
+
+		market := marketMap.Markets[BTC_USD.String()]
+		market.ProviderConfigs[index].Metadata_JSON = string(bz)
+		marketMap.Markets[BTC_USD.String()] = market
+		return marketMap
+	}
+
+	t.Run("the strategy can be selected in the provider config metadata", func(t *testing.T) {
+		marketMap := withProviderAggregation(
+			newMarketMap(tickermetadata.Aggregation{}),
+			1,
+			tickermetadata.NewAggregation(tickermetadata.AggregationStrategyVolumeWeightedMedian, nil, 0),
+		)
+		m, err := oracle.NewIndexPriceAggregator(logger, marketMap, metrics.NewNopMetrics())
+		require.NoError(t, err)
+
+		setPrices(m)
+		setVolumes(m)
+		m.AggregatePrices()
+
+		require.Equal(t, big.NewFloat(70_000).SetPrec(36), m.GetIndexPrices()[BTC_USD.String()].SetPrec(36))
+	})
+
+	t.Run("the ticker metadata takes precedence over the provider config metadata", func(t *testing.T) {
+		marketMap := withProviderAggregation(
+			newMarketMap(tickermetadata.NewAggregation(tickermetadata.AggregationStrategyTrimmedMean, &trimPercent, 0)),
+			0,
+			tickermetadata.NewAggregation(tickermetadata.AggregationStrategyVolumeWeightedMedian, nil, 0),
+		)
+		m, err := oracle.NewIndexPriceAggregator(logger, marketMap, metrics.NewNopMetrics())
+		require.NoError(t, err)
+
+		setPrices(m)
+		setVolumes(m)
+		m.AggregatePrices()
+
+		require.Equal(t, big.NewFloat(71_500).SetPrec(36), m.GetIndexPrices()[BTC_USD.String()].SetPrec(36))
+	})
+
+	t.Run("twap averages the medians over the window", func(t *testing.T) {
+		m, err := oracle.NewIndexPriceAggregator(
+			logger,
+			newMarketMap(tickermetadata.NewAggregation(tickermetadata.AggregationStrategyTWAP, nil, 60)),
+			metrics.NewNopMetrics(),
+		)
+		require.NoError(t, err)
+
+		setPrices(m)
+		m.AggregatePrices()
+
+		time.Sleep(10 * time.Millisecond)
+		m.SetProviderPrices(okx.Name, types.Prices{"BTC-USD": big.NewFloat(100_000)})
+		m.SetProviderPrices(kucoin.Name, types.Prices{"BTC-USD": big.NewFloat(100_000)})
+		m.AggregatePrices()
+
+		// The TWAP only includes the latest median (85_500) since the first sample has no
+		// elapsed time before it.
+		price := m.GetIndexPrices()[BTC_USD.String()]
+		require.Equal(t, big.NewFloat(85_500).SetPrec(36), price.SetPrec(36))
+
+		time.Sleep(10 * time.Millisecond)
+		setPrices(m)
+		m.AggregatePrices()
+
+		// The TWAP lies strictly between the two medians.
+		price = m.GetIndexPrices()[BTC_USD.String()]
+		require.Equal(t, 1, price.Cmp(big.NewFloat(71_500)))
+		require.Equal(t, -1, price.Cmp(big.NewFloat(85_500)))
+	})
+}
+
 func TestCalculateConvertedPrices(t *testing.T) {
 	testCases := []struct {
 		name           string
@@ -362,7 +585,7 @@ func TestCalculateConvertedPrices(t *testing.T) {
 
 			// Ensure that the prices are as expected.
 			for i, price := range prices {
-				require.Equal(t, tc.expectedPrices[i].SetPrec(36), price.SetPrec(36))
+				require.Equal(t, tc.expectedPrices[i].SetPrec(36), price.Price.SetPrec(36))
 			}
 		})
 	}
@@ -592,6 +815,57 @@ func TestCalculateAdjustedPrice(t *testing.T) {
 			expectedPrice: big.NewFloat(0.1e-18),
 			expectedErr:   false,
 		},
+		{
+			name:   "price is converted through a path of index prices (PEPE/ETH * ETH/BTC * BTC/USD = PEPE/USD)",
+			target: BTC_USD,
+			cfg: mmtypes.ProviderConfig{
+				Name:           coinbase.Name,
+				OffChainTicker: "PEPE-ETH",
+				ConversionPath: []pkgtypes.CurrencyPair{
+					{Base: "ETH", Quote: "BTC"},
+					{Base: "BTC", Quote: "USD"},
+				},
+			},
+			malleate: func(aggregator *oracle.IndexPriceAggregator) {
+				prices := types.Prices{
+					"PEPE-ETH": big.NewFloat(0.5),
+				}
+				aggregator.SetProviderPrices(coinbase.Name, prices)
+
+				indexPrices := types.Prices{
+					"ETH/BTC":         big.NewFloat(0.25),
+					btcusdCP.String(): big.NewFloat(40_000),
+				}
+				aggregator.SetIndexPrices(indexPrices)
+			},
+			expectedPrice: big.NewFloat(5_000),
+			expectedErr:   false,
+		},
+		{
+			name:   "price cannot be converted if an index price of the path does not exist (PEPE/ETH * ETH/BTC * BTC/USD = PEPE/USD)",
+			target: BTC_USD,
+			cfg: mmtypes.ProviderConfig{
+				Name:           coinbase.Name,
+				OffChainTicker: "PEPE-ETH",
+				ConversionPath: []pkgtypes.CurrencyPair{
+					{Base: "ETH", Quote: "BTC"},
+					{Base: "BTC", Quote: "USD"},
+				},
+			},
+			malleate: func(aggregator *oracle.IndexPriceAggregator) {
+				prices := types.Prices{
+					"PEPE-ETH": big.NewFloat(0.5),
+				}
+				aggregator.SetProviderPrices(coinbase.Name, prices)
+
+				indexPrices := types.Prices{
+					btcusdCP.String(): big.NewFloat(40_000),
+				}
+				aggregator.SetIndexPrices(indexPrices)
+			},
+			expectedPrice: nil,
+			expectedErr:   true,
+		},
 		{
 			name:   "can make a adjusted conversion with a sufficiently small number (BTC/USDT * USDT/USD = BTC/USD)",
 			target: BTC_USD,
@@ -617,6 +891,137 @@ func TestCalculateAdjustedPrice(t *testing.T) {
 			expectedPrice: big.NewFloat(0.1e-18),
 			expectedErr:   false,
 		},
+		{
+			name:   "price is multiplied by the redemption rate (ETH/USD * STETH/ETH = STETH/USD)",
+			target: ETH_USD,
+			cfg: mmtypes.ProviderConfig{
+				Name:           coinbase.Name,
+				OffChainTicker: "ETH-USD",
+				RedemptionRate: &mmtypes.RedemptionRate{
+					Rate: stethRedemptionRate.Rate,
+				},
+			},
+			malleate: func(aggregator *oracle.IndexPriceAggregator) {
+				aggregator.SetProviderPrices(coinbase.Name, types.Prices{
+					"ETH-USD": big.NewFloat(4_000),
+				})
+				aggregator.SetProviderPrices(erc4626.ProviderNames[constants.ETHEREUM], types.Prices{
+					"STETH/ETH": big.NewFloat(1.1),
+				})
+			},
+			expectedPrice: big.NewFloat(4_400),
+			expectedErr:   false,
+		},
+		{
+			name:   "converted price is multiplied by the redemption rate (ETH/USDT * USDT/USD * STETH/ETH = STETH/USD)",
+			target: ETH_USD,
+			cfg: mmtypes.ProviderConfig{
+				Name:           coinbase.Name,
+				OffChainTicker: "ETH-USDT",
+				NormalizeByPair: &pkgtypes.CurrencyPair{
+					Base:  "USDT",
+					Quote: "USD",
+				},
+				RedemptionRate: stethRedemptionRate,
+			},
+			malleate: func(aggregator *oracle.IndexPriceAggregator) {
+				aggregator.SetProviderPrices(coinbase.Name, types.Prices{
+					"ETH-USDT": big.NewFloat(4_000),
+				})
+				aggregator.SetProviderPrices(erc4626.ProviderNames[constants.ETHEREUM], types.Prices{
+					"STETH/ETH": big.NewFloat(1.1),
+				})
+				aggregator.SetProviderPrices(uniswapv3.ProviderNames[constants.ETHEREUM], types.Prices{
+					"STETH/WETH": big.NewFloat(1.08),
+				})
+				aggregator.SetIndexPrices(types.Prices{
+					usdtusdCP.String(): big.NewFloat(0.5),
+				})
+			},
+			expectedPrice: big.NewFloat(2_200),
+			expectedErr:   false,
+		},
+		{
+			name:   "redemption rate does not exist",
+			target: ETH_USD,
+			cfg: mmtypes.ProviderConfig{
+				Name:           coinbase.Name,
+				OffChainTicker: "ETH-USD",
+				RedemptionRate: stethRedemptionRate,
+			},
+			malleate: func(aggregator *oracle.IndexPriceAggregator) {
+				aggregator.SetProviderPrices(coinbase.Name, types.Prices{
+					"ETH-USD": big.NewFloat(4_000),
+				})
+				aggregator.SetProviderPrices(uniswapv3.ProviderNames[constants.ETHEREUM], types.Prices{
+					"STETH/WETH": big.NewFloat(1.1),
+				})
+			},
+			expectedPrice: nil,
+			expectedErr:   true,
+		},
+		{
+			name:   "market rate for the sanity check does not exist",
+			target: ETH_USD,
+			cfg: mmtypes.ProviderConfig{
+				Name:           coinbase.Name,
+				OffChainTicker: "ETH-USD",
+				RedemptionRate: stethRedemptionRate,
+			},
+			malleate: func(aggregator *oracle.IndexPriceAggregator) {
+				aggregator.SetProviderPrices(coinbase.Name, types.Prices{
+					"ETH-USD": big.NewFloat(4_000),
+				})
+				aggregator.SetProviderPrices(erc4626.ProviderNames[constants.ETHEREUM], types.Prices{
+					"STETH/ETH": big.NewFloat(1.1),
+				})
+			},
+			expectedPrice: nil,
+			expectedErr:   true,
+		},
+		{
+			name:   "market rate deviates from the redemption rate by more than the max deviation",
+			target: ETH_USD,
+			cfg: mmtypes.ProviderConfig{
+				Name:           coinbase.Name,
+				OffChainTicker: "ETH-USD",
+				RedemptionRate: stethRedemptionRate,
+			},
+			malleate: func(aggregator *oracle.IndexPriceAggregator) {
+				aggregator.SetProviderPrices(coinbase.Name, types.Prices{
+					"ETH-USD": big.NewFloat(4_000),
+				})
+				aggregator.SetProviderPrices(erc4626.ProviderNames[constants.ETHEREUM], types.Prices{
+					"STETH/ETH": big.NewFloat(1.1),
+				})
+				aggregator.SetProviderPrices(uniswapv3.ProviderNames[constants.ETHEREUM], types.Prices{
+					"STETH/WETH": big.NewFloat(0.99),
+				})
+			},
+			expectedPrice: nil,
+			expectedErr:   true,
+		},
+		{
+			name:   "redemption rate is zero",
+			target: ETH_USD,
+			cfg: mmtypes.ProviderConfig{
+				Name:           coinbase.Name,
+				OffChainTicker: "ETH-USD",
+				RedemptionRate: &mmtypes.RedemptionRate{
+					Rate: stethRedemptionRate.Rate,
+				},
+			},
+			malleate: func(aggregator *oracle.IndexPriceAggregator) {
+				aggregator.SetProviderPrices(coinbase.Name, types.Prices{
+					"ETH-USD": big.NewFloat(4_000),
+				})
+				aggregator.SetProviderPrices(erc4626.ProviderNames[constants.ETHEREUM], types.Prices{
+					"STETH/ETH": big.NewFloat(0),
+				})
+			},
+			expectedPrice: nil,
+			expectedErr:   true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,26 @@
+package oracle
+
+import (
+	"time"
+
+	"github.com/zoguxprotocol/slinky/oracle/config"
+)
+
+// Option is a function that can be used to configure the IndexPriceAggregator.
+type Option func(*IndexPriceAggregator)
+
+// WithOutlierFilter sets the outlier filter configuration of the aggregator. By default,
+// the outlier filter is disabled.
+func WithOutlierFilter(cfg config.OutlierFilterConfig) Option {
+	return func(m *IndexPriceAggregator) {
+		m.outlierCfg = cfg
+	}
+}
+
+// WithClock sets the function used by the aggregator to tell the current time, e.g. to replay prices
+// recorded in the past. By default, the aggregator uses the wall clock.
+func WithClock(now func() time.Time) Option {
+	return func(m *IndexPriceAggregator) {
+		m.now = now
+	}
+}
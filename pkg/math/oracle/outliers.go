@@ -0,0 +1,152 @@
+package oracle
+
+import (
+	"math/big"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/zoguxprotocol/slinky/pkg/math"
+	mmtypes "github.com/zoguxprotocol/slinky/x/marketmap/types"
+)
+
+// bpsPrecision is the number of basis points in a unit.
+const bpsPrecision = 10_000
+
+// FilterOutliers removes the converted prices that deviate too far from the median of all
+// converted prices of the market, as configured by the outlier filter. Every price that is
+// checked updates the rolling deviation score of its provider, and providers whose score
+// exceeds the quarantine threshold are quarantined. The input is returned unchanged if the
+// filter is disabled or the market does not have enough prices to form a consensus.
+func (m *IndexPriceAggregator) FilterOutliers(
+	market mmtypes.Market,
+	convertedPrices []ConvertedPrice,
+) []ConvertedPrice {
+	if !m.outlierCfg.Enabled || len(convertedPrices) < m.outlierCfg.MinPrices {
+		return convertedPrices
+	}
+
+	values := make([]*big.Float, len(convertedPrices))
+	for i, price := range convertedPrices {
+		values[i] = price.Price
+	}
+	median := math.CalculateMedian(values)
+
+	deviations := make([]*big.Float, len(convertedPrices))
+	for i, price := range convertedPrices {
+		deviations[i] = new(big.Float).Abs(new(big.Float).Sub(price.Price, median))
+	}
+	mad := math.CalculateMedian(append([]*big.Float(nil), deviations...))
+
+	filtered := make([]ConvertedPrice, 0, len(convertedPrices))
+	for i, price := range convertedPrices {
+		outlier := m.isOutlier(deviations[i], median, mad)
+		m.updateDeviationScore(price.Provider, outlier)
+
+		if !outlier {
+			filtered = append(filtered, price)
+			continue
+		}
+
+		m.logger.Warn(
+			"rejected outlier price",
+			zap.String("target_ticker", market.Ticker.String()),
+			zap.String("provider", price.Provider),
+			zap.String("price", price.Price.String()),
+			zap.String("median", median.String()),
+			zap.String("median_absolute_deviation", mad.String()),
+		)
+		m.metrics.AddProviderOutlier(price.Provider, market.Ticker.String())
+	}
+
+	return filtered
+}
+
+// isOutlier returns true if the given deviation from the median exceeds either the
+// configured number of median absolute deviations or the configured basis points.
+func (m *IndexPriceAggregator) isOutlier(deviation, median, mad *big.Float) bool {
+	if m.outlierCfg.MaxMADs > 0 && mad.Sign() > 0 {
+		limit := new(big.Float).Mul(mad, big.NewFloat(m.outlierCfg.MaxMADs))
+		if deviation.Cmp(limit) > 0 {
+			return true
+		}
+	}
+
+	if m.outlierCfg.MaxDeviationBps > 0 && median.Sign() > 0 {
+		// deviation / median > bps / 10_000 <=> deviation * 10_000 > bps * median
+		lhs := new(big.Float).Mul(deviation, new(big.Float).SetUint64(bpsPrecision))
+		rhs := new(big.Float).Mul(median, new(big.Float).SetUint64(m.outlierCfg.MaxDeviationBps))
+		if lhs.Cmp(rhs) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// updateDeviationScore updates the rolling deviation score of the given provider and
+// quarantines the provider if its score exceeds the quarantine threshold.
+func (m *IndexPriceAggregator) updateDeviationScore(provider string, outlier bool) {
+	var observation float64
+	if outlier {
+		observation = 1
+	}
+
+	decay := m.outlierCfg.ScoreDecay
+	score := (1-decay)*m.deviationScores[provider] + decay*observation
+	m.deviationScores[provider] = score
+	m.metrics.UpdateProviderDeviationScore(provider, score)
+
+	threshold := m.outlierCfg.QuarantineThreshold
+	if threshold <= 0 || score <= threshold {
+		return
+	}
+
+	if _, ok := m.quarantinedUntil[provider]; ok {
+		return
+	}
+
+	until := m.now().Add(m.outlierCfg.QuarantineDuration)
+	m.quarantinedUntil[provider] = until
+	m.metrics.SetProviderQuarantined(provider, true)
+	m.logger.Warn(
+		"quarantined provider",
+		zap.String("provider", provider),
+		zap.Float64("deviation_score", score),
+		zap.Time("until", until),
+	)
+}
+
+// releaseQuarantinedProviders releases every provider whose quarantine has expired. The
+// deviation score of a released provider is reset.
+func (m *IndexPriceAggregator) releaseQuarantinedProviders(now time.Time) {
+	for provider, until := range m.quarantinedUntil {
+		if now.Before(until) {
+			continue
+		}
+
+		delete(m.quarantinedUntil, provider)
+		m.deviationScores[provider] = 0
+		m.metrics.UpdateProviderDeviationScore(provider, 0)
+		m.metrics.SetProviderQuarantined(provider, false)
+		m.logger.Info("released provider from quarantine", zap.String("provider", provider))
+	}
+}
+
+// IsProviderQuarantined returns true if the given provider is currently quarantined by the
+// outlier filter.
+func (m *IndexPriceAggregator) IsProviderQuarantined(provider string) bool {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	_, ok := m.quarantinedUntil[provider]
+	return ok
+}
+
+// GetProviderDeviationScore returns the rolling deviation score of the given provider.
+func (m *IndexPriceAggregator) GetProviderDeviationScore(provider string) float64 {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return m.deviationScores[provider]
+}
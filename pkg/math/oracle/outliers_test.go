@@ -0,0 +1,223 @@
+package oracle_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zoguxprotocol/slinky/oracle/config"
+	"github.com/zoguxprotocol/slinky/oracle/metrics"
+	metricsmocks "github.com/zoguxprotocol/slinky/oracle/metrics/mocks"
+	"github.com/zoguxprotocol/slinky/oracle/types"
+	"github.com/zoguxprotocol/slinky/pkg/math/oracle"
+	"github.com/zoguxprotocol/slinky/providers/apis/binance"
+	"github.com/zoguxprotocol/slinky/providers/apis/coinbase"
+	"github.com/zoguxprotocol/slinky/providers/websockets/kucoin"
+	"github.com/zoguxprotocol/slinky/providers/websockets/okx"
+	mmtypes "github.com/zoguxprotocol/slinky/x/marketmap/types"
+)
+
+var outlierMarketMap = mmtypes.MarketMap{
+	Markets: map[string]mmtypes.Market{
+		BTC_USD.String(): {
+			Ticker: BTC_USD,
+			ProviderConfigs: []mmtypes.ProviderConfig{
+				{
+					Name:           coinbase.Name,
+					OffChainTicker: "BTC-USD",
+				},
+				{
+					Name:           binance.Name,
+					OffChainTicker: "BTCUSD",
+				},
+				{
+					Name:           kucoin.Name,
+					OffChainTicker: "BTC-USD",
+				},
+				{
+					Name:           okx.Name,
+					OffChainTicker: "BTC-USD",
+				},
+			},
+		},
+	},
+}
+
+func setOutlierPrices(aggregator *oracle.IndexPriceAggregator, okxPrice int64) {
+	aggregator.SetProviderPrices(coinbase.Name, types.Prices{"BTC-USD": big.NewFloat(70_000)})
+	aggregator.SetProviderPrices(binance.Name, types.Prices{"BTCUSD": big.NewFloat(71_000)})
+	aggregator.SetProviderPrices(kucoin.Name, types.Prices{"BTC-USD": big.NewFloat(72_000)})
+	aggregator.SetProviderPrices(okx.Name, types.Prices{"BTC-USD": big.NewFloat(float64(okxPrice))})
+}
+
+func TestFilterOutliers(t *testing.T) {
+	testCases := []struct {
+		name          string
+		cfg           config.OutlierFilterConfig
+		expectedPrice *big.Float
+	}{
+		{
+			name:          "filter disabled",
+			cfg:           config.OutlierFilterConfig{},
+			expectedPrice: big.NewFloat(71_500),
+		},
+		{
+			name: "rejects prices too many MADs from the median",
+			cfg: config.OutlierFilterConfig{
+				Enabled:    true,
+				MaxMADs:    5,
+				MinPrices:  3,
+				ScoreDecay: 0.1,
+			},
+			expectedPrice: big.NewFloat(71_000),
+		},
+		{
+			name: "rejects prices too many bps from the median",
+			cfg: config.OutlierFilterConfig{
+				Enabled:         true,
+				MaxDeviationBps: 500,
+				MinPrices:       3,
+				ScoreDecay:      0.1,
+			},
+			expectedPrice: big.NewFloat(71_000),
+		},
+		{
+			name: "does not filter markets with too few prices",
+			cfg: config.OutlierFilterConfig{
+				Enabled:         true,
+				MaxDeviationBps: 500,
+				MinPrices:       5,
+				ScoreDecay:      0.1,
+			},
+			expectedPrice: big.NewFloat(71_500),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := oracle.NewIndexPriceAggregator(
+				logger,
+				outlierMarketMap,
+				metrics.NewNopMetrics(),
+				oracle.WithOutlierFilter(tc.cfg),
+			)
+			require.NoError(t, err)
+
+			setOutlierPrices(m, 90_000)
+			m.AggregatePrices()
+
+			price := m.GetIndexPrices()[BTC_USD.String()]
+			require.Equal(t, tc.expectedPrice.SetPrec(40), price.SetPrec(40))
+		})
+	}
+
+	t.Run("invalid config", func(t *testing.T) {
+		_, err := oracle.NewIndexPriceAggregator(
+			logger,
+			outlierMarketMap,
+			metrics.NewNopMetrics(),
+			oracle.WithOutlierFilter(config.OutlierFilterConfig{Enabled: true}),
+		)
+		require.Error(t, err)
+	})
+}
+
+func TestOutlierDeviationScoreAndQuarantine(t *testing.T) {
+	mockMetrics := metricsmocks.NewMetrics(t)
+	mockMetrics.On("AddProviderOutlier", okx.Name, BTC_USD.String()).Once()
+	mockMetrics.On("SetProviderQuarantined", okx.Name, true).Once()
+	mockMetrics.On("SetProviderQuarantined", okx.Name, false).Once()
+	mockMetrics.On("UpdateProviderDeviationScore", mock.Anything, mock.Anything).Maybe()
+	mockMetrics.On("AddProviderTick", mock.Anything, mock.Anything, mock.Anything).Maybe()
+	mockMetrics.On("AddProviderCountForMarket", mock.Anything, mock.Anything).Maybe()
+	mockMetrics.On("UpdatePrice", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+	mockMetrics.On("UpdateAggregatePrice", mock.Anything, mock.Anything, mock.Anything).Maybe()
+	mockMetrics.On("AddTickerTick", mock.Anything).Maybe()
+
+	m, err := oracle.NewIndexPriceAggregator(
+		logger,
+		outlierMarketMap,
+		mockMetrics,
+		oracle.WithOutlierFilter(config.OutlierFilterConfig{
+			Enabled:             true,
+			MaxDeviationBps:     500,
+			MinPrices:           3,
+			ScoreDecay:          0.6,
+			QuarantineThreshold: 0.5,
+			QuarantineDuration:  100 * time.Millisecond,
+		}),
+	)
+	require.NoError(t, err)
+
+	// okx reports a garbage price and is rejected and quarantined.
+	setOutlierPrices(m, 90_000)
+	m.AggregatePrices()
+	require.InDelta(t, 0.6, m.GetProviderDeviationScore(okx.Name), 1e-9)
+	require.Zero(t, m.GetProviderDeviationScore(coinbase.Name))
+	require.True(t, m.IsProviderQuarantined(okx.Name))
+
+	// okx is ignored while quarantined, even if its price is valid.
+	setOutlierPrices(m, 100_000)
+	m.AggregatePrices()
+	price := m.GetIndexPrices()[BTC_USD.String()]
+	require.Equal(t, big.NewFloat(71_000).SetPrec(40), price.SetPrec(40))
+
+	// okx is released once the cooldown expires and its score is reset.
+	time.Sleep(150 * time.Millisecond)
+	setOutlierPrices(m, 71_500)
+	m.AggregatePrices()
+	require.False(t, m.IsProviderQuarantined(okx.Name))
+	require.Zero(t, m.GetProviderDeviationScore(okx.Name))
+
+	price = m.GetIndexPrices()[BTC_USD.String()]
+	require.Equal(t, big.NewFloat(71_250).SetPrec(40), price.SetPrec(40))
+}
+
+func TestOutlierQuarantineWithClock(t *testing.T) {
+	mockMetrics := metricsmocks.NewMetrics(t)
+	mockMetrics.On("AddProviderOutlier", okx.Name, BTC_USD.String()).Once()
+	mockMetrics.On("SetProviderQuarantined", okx.Name, true).Once()
+	mockMetrics.On("SetProviderQuarantined", okx.Name, false).Once()
+	mockMetrics.On("UpdateProviderDeviationScore", mock.Anything, mock.Anything).Maybe()
+	mockMetrics.On("AddProviderTick", mock.Anything, mock.Anything, mock.Anything).Maybe()
+	mockMetrics.On("AddProviderCountForMarket", mock.Anything, mock.Anything).Maybe()
+	mockMetrics.On("UpdatePrice", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+	mockMetrics.On("UpdateAggregatePrice", mock.Anything, mock.Anything, mock.Anything).Maybe()
+	mockMetrics.On("AddTickerTick", mock.Anything).Maybe()
+
+	// the quarantine is measured with the given clock, so it can expire without waiting for it.
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	m, err := oracle.NewIndexPriceAggregator(
+		logger,
+		outlierMarketMap,
+		mockMetrics,
+		oracle.WithOutlierFilter(config.OutlierFilterConfig{
+			Enabled:             true,
+			MaxDeviationBps:     500,
+			MinPrices:           3,
+			ScoreDecay:          0.6,
+			QuarantineThreshold: 0.5,
+			QuarantineDuration:  time.Hour,
+		}),
+		oracle.WithClock(func() time.Time { return now }),
+	)
+	require.NoError(t, err)
+
+	setOutlierPrices(m, 90_000)
+	m.AggregatePrices()
+	require.True(t, m.IsProviderQuarantined(okx.Name))
+
+	// okx stays quarantined until the clock passes the end of the quarantine.
+	now = now.Add(59 * time.Minute)
+	setOutlierPrices(m, 71_500)
+	m.AggregatePrices()
+	require.True(t, m.IsProviderQuarantined(okx.Name))
+
+	now = now.Add(2 * time.Minute)
+	setOutlierPrices(m, 71_500)
+	m.AggregatePrices()
+	require.False(t, m.IsProviderQuarantined(okx.Name))
+}
@@ -0,0 +1,127 @@
+package oracle
+
+import (
+	"math/big"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/zoguxprotocol/slinky/pkg/math"
+	mmtypes "github.com/zoguxprotocol/slinky/x/marketmap/types"
+	"github.com/zoguxprotocol/slinky/x/marketmap/types/tickermetadata"
+)
+
+// timedPrice is a price sample along with the time at which it was calculated.
+type timedPrice struct {
+	price     *big.Float
+	timestamp time.Time
+}
+
+// CalculateAggregatedPrice aggregates the converted prices of a market into a single price using
+// the aggregation strategy configured in the market's ticker or provider config metadata. The strategies
+// supported are:
+//
+//  1. Median (default): the median of the converted prices.
+//  2. Volume weighted median: the median of the converted prices weighted by the volume each
+//     provider reported. Prices without a positive reported volume are ignored. If fewer providers
+//     than the market's MinProviderCount reported a volume, this falls back to the median.
+//  3. Trimmed mean: the mean of the converted prices after trimming the highest and lowest prices.
+//  4. TWAP: the time-weighted average of the medians calculated over the configured window.
+//
+// The converted prices must be non-empty.
+func (m *IndexPriceAggregator) CalculateAggregatedPrice(
+	market mmtypes.Market,
+	convertedPrices []ConvertedPrice,
+) *big.Float {
+	ticker := market.Ticker.String()
+	aggregation := m.aggregations[ticker]
+
+	prices := make([]*big.Float, len(convertedPrices))
+	for i, convertedPrice := range convertedPrices {
+		prices[i] = convertedPrice.Price
+	}
+
+	switch aggregation.GetStrategy() {
+	case tickermetadata.AggregationStrategyVolumeWeightedMedian:
+		var (
+			weightedPrices = make([]*big.Float, 0, len(convertedPrices))
+			weights        = make([]*big.Float, 0, len(convertedPrices))
+		)
+		for _, convertedPrice := range convertedPrices {
+			if convertedPrice.Volume == nil || convertedPrice.Volume.Sign() <= 0 {
+				continue
+			}
+
+			weightedPrices = append(weightedPrices, convertedPrice.Price)
+			weights = append(weights, convertedPrice.Volume)
+		}
+
+		// A handful of providers reporting volume must not be able to determine the price on
+		// their own, so the weighted median requires as many providers as the market does.
+		if uint64(len(weights)) >= max(market.Ticker.MinProviderCount, 1) {
+			if price := math.CalculateWeightedMedian(weightedPrices, weights); price != nil {
+				return price
+			}
+		}
+
+		m.logger.Debug(
+			"not enough provider volumes available for volume weighted median; using the median",
+			zap.String("target_ticker", ticker),
+			zap.Int("num_volumes", len(weights)),
+			zap.Uint64("min_provider_count", market.Ticker.MinProviderCount),
+		)
+		return math.CalculateMedian(prices)
+	case tickermetadata.AggregationStrategyTrimmedMean:
+		return math.CalculateTrimmedMean(prices, aggregation.GetTrimPercent())
+	case tickermetadata.AggregationStrategyTWAP:
+		window := time.Duration(aggregation.GetTWAPWindowSeconds()) * time.Second //nolint:gosec
+		return m.calculateTWAP(ticker, math.CalculateMedian(prices), window, m.now().UTC())
+	default:
+		// Take the median of the converted prices. This takes the average of the middle two
+		// prices if the number of prices is even.
+		return math.CalculateMedian(prices)
+	}
+}
+
+// calculateTWAP records the latest median price for the ticker and returns the time-weighted
+// average of the samples within the window. Each sample is weighted by the time elapsed since
+// the previous sample, so the oldest sample in the window only contributes if it is the only one.
+func (m *IndexPriceAggregator) calculateTWAP(
+	ticker string,
+	price *big.Float,
+	window time.Duration,
+	now time.Time,
+) *big.Float {
+	samples := append(m.twapSamples[ticker], timedPrice{
+		price:     new(big.Float).Copy(price),
+		timestamp: now,
+	})
+
+	// Prune all samples that have fallen out of the window.
+	start := 0
+	for start < len(samples)-1 && now.Sub(samples[start].timestamp) > window {
+		start++
+	}
+	samples = samples[start:]
+	m.twapSamples[ticker] = samples
+
+	if len(samples) == 1 {
+		return price
+	}
+
+	var (
+		weightedSum = new(big.Float)
+		totalWeight = new(big.Float)
+	)
+	for i := 1; i < len(samples); i++ {
+		elapsed := new(big.Float).SetInt64(samples[i].timestamp.Sub(samples[i-1].timestamp).Nanoseconds())
+		weightedSum.Add(weightedSum, new(big.Float).Mul(samples[i].price, elapsed))
+		totalWeight.Add(totalWeight, elapsed)
+	}
+
+	if totalWeight.Sign() == 0 {
+		return price
+	}
+
+	return weightedSum.Quo(weightedSum, totalWeight)
+}
@@ -0,0 +1,192 @@
+package oracle
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/zoguxprotocol/slinky/oracle/types"
+	"github.com/zoguxprotocol/slinky/pkg/math"
+	"github.com/zoguxprotocol/slinky/x/marketmap/types/tickermetadata"
+)
+
+// calculateSyntheticPrices calculates the prices of the enabled synthetic markets from the given index
+// prices, i.e. the index prices calculated in the current round, and adds them to the given index and
+// scaled prices. Synthetic markets can be components of other synthetic markets, so each synthetic market
+// is only priced once all of its synthetic components have been. The tickers of the synthetic markets that
+// could not be priced are returned.
+func (m *IndexPriceAggregator) calculateSyntheticPrices(indexPrices, scaledPrices types.Prices) []string {
+	pending := make(map[string]struct{}, len(m.synthetics))
+	for ticker := range m.synthetics {
+		if market, ok := m.cfg.Markets[ticker]; ok && market.Ticker.Enabled {
+			pending[ticker] = struct{}{}
+		}
+	}
+
+	var missingPrices []string
+	for len(pending) > 0 {
+		// Price the synthetic markets that do not depend on a synthetic market that is yet to be priced.
+		var ready []string
+		for ticker := range pending {
+			if !m.dependsOnPending(ticker, pending) {
+				ready = append(ready, ticker)
+			}
+		}
+
+		// This can only happen if the synthetic markets form a cycle, which the market map disallows.
+		if len(ready) == 0 {
+			for ticker := range pending {
+				missingPrices = append(missingPrices, ticker)
+			}
+			break
+		}
+		sort.Strings(ready)
+
+		for _, ticker := range ready {
+			delete(pending, ticker)
+
+			target := m.cfg.Markets[ticker].Ticker
+			price, err := m.calculateSyntheticPrice(ticker, indexPrices)
+			if err != nil {
+				missingPrices = append(missingPrices, ticker)
+				m.logger.Debug(
+					"failed to calculate synthetic price",
+					zap.String("target_ticker", ticker),
+					zap.Error(err),
+				)
+
+				continue
+			}
+
+			indexPrices[ticker] = new(big.Float).Copy(price)
+			scaledPrices[ticker] = math.ScaleBigFloat(new(big.Float).Copy(price), target.Decimals)
+
+			m.logger.Debug(
+				"calculated synthetic price",
+				zap.String("target_ticker", ticker),
+				zap.String("unscaled_price", indexPrices[ticker].String()),
+				zap.String("scaled_price", scaledPrices[ticker].String()),
+			)
+			floatPrice, _ := price.Float64()
+			m.metrics.AddTickerTick(ticker)
+			m.metrics.UpdateAggregatePrice(ticker, target.GetDecimals(), floatPrice)
+		}
+	}
+
+	return missingPrices
+}
+
+// calculateSyntheticPrice calculates the price of the synthetic market from the given index prices. Index
+// markets are priced using the rebalance that applies at the current time.
+func (m *IndexPriceAggregator) calculateSyntheticPrice(ticker string, indexPrices types.Prices) (*big.Float, error) {
+	synthetic := m.synthetics[ticker]
+	if synthetic.Type == tickermetadata.SyntheticTypeIndex {
+		return CalculateIndexPrice(m.indices[ticker], indexPrices, m.now().UTC())
+	}
+
+	return CalculateSyntheticPrice(synthetic, indexPrices)
+}
+
+// dependsOnPending returns true iff any component of the synthetic market is a pending synthetic market.
+func (m *IndexPriceAggregator) dependsOnPending(ticker string, pending map[string]struct{}) bool {
+	for _, component := range m.synthetics[ticker].Components {
+		if _, ok := pending[component.CurrencyPair]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CalculateSyntheticPrice calculates the price of a synthetic market from the index prices of its components.
+// In particular, the price of a
+//
+//  1. Cross market is the product of the index prices of its components, each of which can be inverted i.e.
+//     ETH/BTC = ETH/USD * (BTC/USD)^-1.
+//  2. Basket market is the sum of the index prices of its components, each multiplied by its quantity i.e.
+//     1 ETH + 0.05 BTC in USD = 1 * ETH/USD + 0.05 * BTC/USD.
+//
+// Index markets are priced with CalculateIndexPrice. If the index price of any component is not available, an
+// error is returned.
+func CalculateSyntheticPrice(synthetic tickermetadata.Synthetic, indexPrices types.Prices) (*big.Float, error) {
+	var price *big.Float
+	switch synthetic.Type {
+	case tickermetadata.SyntheticTypeCross:
+		price = big.NewFloat(1)
+	case tickermetadata.SyntheticTypeBasket:
+		price = new(big.Float)
+	case tickermetadata.SyntheticTypeIndex:
+		return nil, fmt.Errorf("index markets must be priced with their index weighting")
+	default:
+		return nil, fmt.Errorf("unknown synthetic type %q", synthetic.Type)
+	}
+
+	for _, component := range synthetic.Components {
+		indexPrice, ok := indexPrices[component.CurrencyPair]
+		if !ok || indexPrice == nil {
+			return nil, fmt.Errorf("missing index price for synthetic component: %s", component.CurrencyPair)
+		}
+
+		switch synthetic.Type {
+		case tickermetadata.SyntheticTypeCross:
+			if !component.Invert {
+				price = new(big.Float).Mul(price, indexPrice)
+				continue
+			}
+
+			if indexPrice.Sign() == 0 {
+				return nil, fmt.Errorf("cannot invert zero index price of synthetic component: %s", component.CurrencyPair)
+			}
+			price = new(big.Float).Quo(price, indexPrice)
+		case tickermetadata.SyntheticTypeBasket:
+			quantity, err := component.GetQuantity()
+			if err != nil {
+				return nil, err
+			}
+			price = new(big.Float).Add(price, new(big.Float).Mul(quantity, indexPrice))
+		}
+	}
+
+	return price, nil
+}
+
+// CalculateIndexPrice calculates the price of an index market from the index prices of its components, using
+// the latest rebalance that applies at the given time. The price is the sum of the index prices of the weighted
+// components, each multiplied by its weight, divided by the divisor of the rebalance i.e.
+//
+//	(2 * ETH/USD + 0.1 * BTC/USD) / 10
+//
+// If no rebalance applies yet, or the index price of any weighted component is not available, an error is returned.
+func CalculateIndexPrice(index tickermetadata.Index, indexPrices types.Prices, now time.Time) (*big.Float, error) {
+	rebalance, ok := index.GetRebalance(now)
+	if !ok {
+		return nil, fmt.Errorf("no index rebalance applies at %s", now)
+	}
+
+	divisor, err := rebalance.GetDivisor()
+	if err != nil {
+		return nil, err
+	}
+	if divisor.Sign() == 0 {
+		return nil, fmt.Errorf("divisor of index rebalance at %d is zero", rebalance.Timestamp)
+	}
+
+	sum := new(big.Float)
+	for _, weight := range rebalance.Weights {
+		indexPrice, ok := indexPrices[weight.CurrencyPair]
+		if !ok || indexPrice == nil {
+			return nil, fmt.Errorf("missing index price for index component: %s", weight.CurrencyPair)
+		}
+
+		value, err := weight.GetWeight()
+		if err != nil {
+			return nil, err
+		}
+		sum = new(big.Float).Add(sum, new(big.Float).Mul(value, indexPrice))
+	}
+
+	return new(big.Float).Quo(sum, divisor), nil
+}
@@ -0,0 +1,352 @@
+package oracle_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zoguxprotocol/slinky/oracle/metrics"
+	"github.com/zoguxprotocol/slinky/oracle/types"
+	"github.com/zoguxprotocol/slinky/pkg/math/oracle"
+	pkgtypes "github.com/zoguxprotocol/slinky/pkg/types"
+	"github.com/zoguxprotocol/slinky/providers/apis/coinbase"
+	mmtypes "github.com/zoguxprotocol/slinky/x/marketmap/types"
+	"github.com/zoguxprotocol/slinky/x/marketmap/types/tickermetadata"
+)
+
+func TestAggregateSyntheticPrices(t *testing.T) {
+	newSyntheticMarket := func(cp pkgtypes.CurrencyPair, decimals uint64, synthetic tickermetadata.Synthetic) mmtypes.Market {
+		bz, err := tickermetadata.MarshalSynthetic(synthetic)
+		require.NoError(t, err)
+
+		return mmtypes.Market{
+			Ticker: mmtypes.Ticker{
+				CurrencyPair:     cp,
+				Decimals:         decimals,
+				MinProviderCount: 1,
+				Enabled:          true,
+				Metadata_JSON:    string(bz),
+			},
+		}
+	}
+
+	var (
+		ethbtc    = pkgtypes.NewCurrencyPair("ETH", "BTC")
+		btceth    = pkgtypes.NewCurrencyPair("BTC", "ETH")
+		basketusd = pkgtypes.NewCurrencyPair("BASKET", "USD")
+	)
+
+	marketMap := mmtypes.MarketMap{
+		Markets: map[string]mmtypes.Market{
+			BTC_USD.String(): {
+				Ticker: mmtypes.Ticker{
+					CurrencyPair:     BTC_USD.CurrencyPair,
+					Decimals:         BTC_USD.Decimals,
+					MinProviderCount: 1,
+					Enabled:          true,
+				},
+				ProviderConfigs: []mmtypes.ProviderConfig{
+					{
+						Name:           coinbase.Name,
+						OffChainTicker: "BTC-USD",
+					},
+				},
+			},
+			ETH_USD.String(): {
+				Ticker: mmtypes.Ticker{
+					CurrencyPair:     ETH_USD.CurrencyPair,
+					Decimals:         ETH_USD.Decimals,
+					MinProviderCount: 1,
+					Enabled:          true,
+				},
+				ProviderConfigs: []mmtypes.ProviderConfig{
+					{
+						Name:           coinbase.Name,
+						OffChainTicker: "ETH-USD",
+					},
+				},
+			},
+			// ETH/BTC = ETH/USD * (BTC/USD)^-1
+			ethbtc.String(): newSyntheticMarket(ethbtc, 8, tickermetadata.NewSynthetic(
+				tickermetadata.SyntheticTypeCross,
+				tickermetadata.NewSyntheticComponent(ETH_USD.CurrencyPair, false, ""),
+				tickermetadata.NewSyntheticComponent(BTC_USD.CurrencyPair, true, ""),
+			)),
+			// BTC/ETH = (ETH/BTC)^-1, which is itself synthetic.
+			btceth.String(): newSyntheticMarket(btceth, 6, tickermetadata.NewSynthetic(
+				tickermetadata.SyntheticTypeCross,
+				tickermetadata.NewSyntheticComponent(ethbtc, true, ""),
+			)),
+			// 1 ETH + 0.05 BTC in USD
+			basketusd.String(): newSyntheticMarket(basketusd, 2, tickermetadata.NewSynthetic(
+				tickermetadata.SyntheticTypeBasket,
+				tickermetadata.NewSyntheticComponent(ETH_USD.CurrencyPair, false, "1"),
+				tickermetadata.NewSyntheticComponent(BTC_USD.CurrencyPair, false, "0.05"),
+			)),
+		},
+	}
+	require.NoError(t, marketMap.ValidateBasic())
+
+	testCases := []struct {
+		name           string
+		providerPrices types.Prices
+		expectedPrices types.Prices
+		expectedScaled types.Prices
+	}{
+		{
+			name: "synthetic prices are derived from the index prices of the same round",
+			providerPrices: types.Prices{
+				"BTC-USD": big.NewFloat(80_000),
+				"ETH-USD": big.NewFloat(4_000),
+			},
+			expectedPrices: types.Prices{
+				BTC_USD.String():   big.NewFloat(80_000),
+				ETH_USD.String():   big.NewFloat(4_000),
+				ethbtc.String():    big.NewFloat(0.05),
+				btceth.String():    big.NewFloat(20),
+				basketusd.String(): big.NewFloat(8_000),
+			},
+			expectedScaled: types.Prices{
+				ethbtc.String():    big.NewFloat(5_000_000),
+				btceth.String():    big.NewFloat(20_000_000),
+				basketusd.String(): big.NewFloat(800_000),
+			},
+		},
+		{
+			name: "synthetic prices are missing if a component price is missing",
+			providerPrices: types.Prices{
+				"BTC-USD": big.NewFloat(80_000),
+			},
+			expectedPrices: types.Prices{
+				BTC_USD.String(): big.NewFloat(80_000),
+			},
+			expectedScaled: types.Prices{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := oracle.NewIndexPriceAggregator(logger, marketMap, metrics.NewNopMetrics())
+			require.NoError(t, err)
+
+			m.SetProviderPrices(coinbase.Name, tc.providerPrices)
+			m.AggregatePrices()
+
+			result := m.GetIndexPrices()
+			require.Equal(t, len(tc.expectedPrices), len(result))
+			for ticker, expectedPrice := range tc.expectedPrices {
+				price, ok := result[ticker]
+				require.True(t, ok, ticker)
+				require.Equal(t, expectedPrice.SetPrec(36).String(), price.SetPrec(36).String(), ticker)
+			}
+
+			scaled := m.GetPrices()
+			for ticker, expectedPrice := range tc.expectedScaled {
+				price, ok := scaled[ticker]
+				require.True(t, ok, ticker)
+				require.Equal(t, expectedPrice.SetPrec(36).String(), price.SetPrec(36).String(), ticker)
+			}
+		})
+	}
+}
+
+func TestAggregateIndexPrices(t *testing.T) {
+	indexusd := pkgtypes.NewCurrencyPair("INDEX", "USD")
+	index := tickermetadata.NewIndex(
+		tickermetadata.NewIndexRebalance(
+			100,
+			"10",
+			tickermetadata.NewIndexWeight(ETH_USD.String(), "2"),
+			tickermetadata.NewIndexWeight(BTC_USD.String(), "0.1"),
+		),
+		tickermetadata.NewIndexRebalance(200, "5", tickermetadata.NewIndexWeight(BTC_USD.String(), "0.2")),
+	)
+
+	// The index configuration is stored alongside the synthetic configuration in the ticker metadata.
+	metadata := fmt.Sprintf(
+		`{"synthetic_type":"index","synthetic_components":[{"currency_pair":%q},{"currency_pair":%q}],"index_rebalances":%s}`,
+		ETH_USD.String(),
+		BTC_USD.String(),
+		mustMarshalRebalances(t, index),
+	)
+
+	marketMap := mmtypes.MarketMap{
+		Markets: map[string]mmtypes.Market{
+			BTC_USD.String(): {
+				Ticker: mmtypes.Ticker{
+					CurrencyPair:     BTC_USD.CurrencyPair,
+					Decimals:         BTC_USD.Decimals,
+					MinProviderCount: 1,
+					Enabled:          true,
+				},
+				ProviderConfigs: []mmtypes.ProviderConfig{
+					{
+						Name:           coinbase.Name,
+						OffChainTicker: "BTC-USD",
+					},
+				},
+			},
+			ETH_USD.String(): {
+				Ticker: mmtypes.Ticker{
+					CurrencyPair:     ETH_USD.CurrencyPair,
+					Decimals:         ETH_USD.Decimals,
+					MinProviderCount: 1,
+					Enabled:          true,
+				},
+				ProviderConfigs: []mmtypes.ProviderConfig{
+					{
+						Name:           coinbase.Name,
+						OffChainTicker: "ETH-USD",
+					},
+				},
+			},
+			indexusd.String(): {
+				Ticker: mmtypes.Ticker{
+					CurrencyPair:     indexusd,
+					Decimals:         2,
+					MinProviderCount: 1,
+					Enabled:          true,
+					Metadata_JSON:    metadata,
+				},
+			},
+		},
+	}
+	require.NoError(t, marketMap.ValidateBasic())
+
+	testCases := []struct {
+		name          string
+		now           time.Time
+		expectedPrice *big.Float
+	}{
+		{
+			name:          "no rebalance applies yet",
+			now:           time.Unix(99, 0),
+			expectedPrice: nil,
+		},
+		{
+			name:          "first rebalance",
+			now:           time.Unix(150, 0),
+			expectedPrice: big.NewFloat(1_600), // (2 * 4_000 + 0.1 * 80_000) / 10
+		},
+		{
+			name:          "second rebalance",
+			now:           time.Unix(200, 0),
+			expectedPrice: big.NewFloat(3_200), // (0.2 * 80_000) / 5
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := oracle.NewIndexPriceAggregator(
+				logger,
+				marketMap,
+				metrics.NewNopMetrics(),
+				oracle.WithClock(func() time.Time { return tc.now }),
+			)
+			require.NoError(t, err)
+
+			m.SetProviderPrices(coinbase.Name, types.Prices{
+				"BTC-USD": big.NewFloat(80_000),
+				"ETH-USD": big.NewFloat(4_000),
+			})
+			m.AggregatePrices()
+
+			price, ok := m.GetIndexPrices()[indexusd.String()]
+			if tc.expectedPrice == nil {
+				require.False(t, ok)
+				return
+			}
+
+			require.True(t, ok)
+			require.Equal(t, tc.expectedPrice.SetPrec(36).String(), price.SetPrec(36).String())
+		})
+	}
+}
+
+func mustMarshalRebalances(t *testing.T, index tickermetadata.Index) string {
+	t.Helper()
+
+	bz, err := json.Marshal(index.Rebalances)
+	require.NoError(t, err)
+
+	return string(bz)
+}
+
+func TestCalculateSyntheticPrice(t *testing.T) {
+	ethbtc := pkgtypes.NewCurrencyPair("ETH", "BTC")
+
+	testCases := []struct {
+		name          string
+		synthetic     tickermetadata.Synthetic
+		indexPrices   types.Prices
+		expectedPrice *big.Float
+		expectErr     bool
+	}{
+		{
+			name: "cross",
+			synthetic: tickermetadata.NewSynthetic(
+				tickermetadata.SyntheticTypeCross,
+				tickermetadata.NewSyntheticComponent(ETH_USD.CurrencyPair, false, ""),
+				tickermetadata.NewSyntheticComponent(BTC_USD.CurrencyPair, true, ""),
+			),
+			indexPrices: types.Prices{
+				ETH_USD.String(): big.NewFloat(3_000),
+				BTC_USD.String(): big.NewFloat(60_000),
+			},
+			expectedPrice: big.NewFloat(0.05),
+		},
+		{
+			name: "basket",
+			synthetic: tickermetadata.NewSynthetic(
+				tickermetadata.SyntheticTypeBasket,
+				tickermetadata.NewSyntheticComponent(ETH_USD.CurrencyPair, false, "2"),
+				tickermetadata.NewSyntheticComponent(BTC_USD.CurrencyPair, false, "0.5"),
+			),
+			indexPrices: types.Prices{
+				ETH_USD.String(): big.NewFloat(3_000),
+				BTC_USD.String(): big.NewFloat(60_000),
+			},
+			expectedPrice: big.NewFloat(36_000),
+		},
+		{
+			name: "missing component index price",
+			synthetic: tickermetadata.NewSynthetic(
+				tickermetadata.SyntheticTypeCross,
+				tickermetadata.NewSyntheticComponent(ethbtc, false, ""),
+				tickermetadata.NewSyntheticComponent(BTC_USD.CurrencyPair, false, ""),
+			),
+			indexPrices: types.Prices{
+				BTC_USD.String(): big.NewFloat(60_000),
+			},
+			expectErr: true,
+		},
+		{
+			name: "inverted zero index price",
+			synthetic: tickermetadata.NewSynthetic(
+				tickermetadata.SyntheticTypeCross,
+				tickermetadata.NewSyntheticComponent(ethbtc, true, ""),
+			),
+			indexPrices: types.Prices{
+				ethbtc.String(): big.NewFloat(0),
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			price, err := oracle.CalculateSyntheticPrice(tc.synthetic, tc.indexPrices)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedPrice.SetPrec(36).String(), price.SetPrec(36).String())
+		})
+	}
+}
@@ -4,10 +4,15 @@ import (
 	"fmt"
 	"maps"
 	"math/big"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
 
 	"github.com/zoguxprotocol/slinky/oracle/types"
 	pkgtypes "github.com/zoguxprotocol/slinky/pkg/types"
 	mmtypes "github.com/zoguxprotocol/slinky/x/marketmap/types"
+	"github.com/zoguxprotocol/slinky/x/marketmap/types/tickermetadata"
 )
 
 // GetProviderPrice returns the relevant provider price. Note that the aggregator's
@@ -36,6 +41,63 @@ func (m *IndexPriceAggregator) GetProviderPrice(
 	return price, nil
 }
 
+// GetRedemptionRate returns the redemption rate reported by the rate feed of the given redemption
+// rate. If a market rate is configured, the redemption rate is only returned if the market rate does
+// not deviate from it by more than the max deviation; this guards against a broken or manipulated
+// rate feed, as well as against pricing the token at its redemption value while it trades at a discount.
+func (m *IndexPriceAggregator) GetRedemptionRate(
+	rr mmtypes.RedemptionRate,
+) (*big.Float, error) {
+	rate, err := m.GetProviderPrice(rr.Rate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get redemption rate: %w", err)
+	}
+
+	if rate.Sign() <= 0 || rate.IsInf() {
+		return nil, fmt.Errorf("redemption rate for %s ticker %s must be positive; got %s", rr.Rate.Name, rr.Rate.OffChainTicker, rate)
+	}
+
+	if rr.MarketRate == nil {
+		return rate, nil
+	}
+
+	marketRate, err := m.GetProviderPrice(*rr.MarketRate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get market rate: %w", err)
+	}
+
+	maxDeviation, err := rr.ParseMaxDeviation()
+	if err != nil {
+		return nil, err
+	}
+
+	deviation := new(big.Float).Sub(marketRate, rate)
+	deviation = new(big.Float).Quo(deviation.Abs(deviation), rate)
+	if deviation.Cmp(maxDeviation) > 0 {
+		return nil, fmt.Errorf(
+			"market rate %s deviates from redemption rate %s by more than %s",
+			marketRate,
+			rate,
+			rr.MaxDeviation,
+		)
+	}
+
+	return rate, nil
+}
+
+// GetProviderVolume returns the volume the provider reported alongside its price for the
+// given provider config, or nil if no volume was reported.
+func (m *IndexPriceAggregator) GetProviderVolume(
+	cfg mmtypes.ProviderConfig,
+) *big.Float {
+	cache, ok := m.providerVolumes[cfg.Name]
+	if !ok {
+		return nil
+	}
+
+	return cache[cfg.OffChainTicker]
+}
+
 // GetIndexPrice returns the relevant index price. Note that the aggregator's
 // index price cache stores prices in the form of ticker -> price.
 func (m *IndexPriceAggregator) GetIndexPrice(
@@ -79,7 +141,84 @@ func (m *IndexPriceAggregator) UpdateMarketMap(marketMap mmtypes.MarketMap) {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
+	m.setMarketMap(marketMap)
+}
+
+// setMarketMap sets the market map and refreshes the per-market aggregation, synthetic and index configurations.
+// TWAP samples of markets that no longer use the TWAP strategy are dropped.
+func (m *IndexPriceAggregator) setMarketMap(marketMap mmtypes.MarketMap) {
 	m.cfg = marketMap
+	m.aggregations = make(map[string]tickermetadata.Aggregation, len(marketMap.Markets))
+	m.synthetics = make(map[string]tickermetadata.Synthetic)
+	m.indices = make(map[string]tickermetadata.Index)
+
+	for ticker, market := range marketMap.Markets {
+		aggregation, err := aggregationFromMarket(market)
+		if err == nil {
+			err = aggregation.ValidateBasic()
+		}
+		if err != nil {
+			m.logger.Warn(
+				"invalid aggregation configuration in ticker metadata; using the median",
+				zap.String("ticker", ticker),
+				zap.Error(err),
+			)
+
+			aggregation = tickermetadata.Aggregation{}
+		}
+
+		m.aggregations[ticker] = aggregation
+
+		synthetic := market.GetSynthetic()
+		if !synthetic.IsSynthetic() {
+			continue
+		}
+		if err := market.ValidateBasic(); err != nil {
+			m.logger.Warn(
+				"invalid synthetic configuration in ticker metadata; skipping",
+				zap.String("ticker", ticker),
+				zap.Error(err),
+			)
+
+			continue
+		}
+		m.synthetics[ticker] = synthetic
+
+		if synthetic.Type == tickermetadata.SyntheticTypeIndex {
+			// The index configuration is valid, as it is validated along with the market.
+			index, _ := tickermetadata.IndexFromJSONString(market.Ticker.Metadata_JSON)
+			m.indices[ticker] = index
+		}
+	}
+
+	for ticker := range m.twapSamples {
+		if m.aggregations[ticker].GetStrategy() != tickermetadata.AggregationStrategyTWAP {
+			delete(m.twapSamples, ticker)
+		}
+	}
+}
+
+// aggregationFromMarket returns the aggregation configuration of a market. The configuration in the
+// Ticker.Metadata_JSON takes precedence. If the ticker does not select a strategy, the configuration
+// of the first ProviderConfig (in the order of the market's provider configs) whose Metadata_JSON
+// selects a strategy is used. Provider metadata that cannot be decoded as an aggregation configuration
+// is ignored, as it is provider specific.
+func aggregationFromMarket(market mmtypes.Market) (tickermetadata.Aggregation, error) {
+	aggregation, err := tickermetadata.AggregationFromJSONString(market.Ticker.Metadata_JSON)
+	if err != nil || aggregation.Strategy != "" {
+		return aggregation, err
+	}
+
+	for _, providerConfig := range market.ProviderConfigs {
+		providerAggregation, err := tickermetadata.AggregationFromJSONString(providerConfig.Metadata_JSON)
+		if err != nil || providerAggregation.Strategy == "" {
+			continue
+		}
+
+		return providerAggregation, nil
+	}
+
+	return aggregation, nil
 }
 
 // GetMarketMap returns the market map for the oracle.
@@ -102,12 +241,83 @@ func (m *IndexPriceAggregator) SetProviderPrices(provider string, data types.Pri
 	m.providerPrices[provider] = data
 }
 
+// SetProviderVolumes updates the data aggregator with the volumes reported by the given
+// provider alongside its prices.
+func (m *IndexPriceAggregator) SetProviderVolumes(provider string, data types.Prices) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if data == nil {
+		data = make(types.Prices)
+	}
+
+	m.providerVolumes[provider] = data
+}
+
+// SetProviderTimestamps updates the data aggregator with the times at which the given provider
+// reported its prices.
+func (m *IndexPriceAggregator) SetProviderTimestamps(provider string, timestamps map[string]time.Time) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if timestamps == nil {
+		timestamps = make(map[string]time.Time)
+	}
+
+	m.providerTimestamps[provider] = timestamps
+}
+
+// GetPriceBreakdowns returns the breakdown of each provider's price for each market, as of the
+// latest aggregation.
+func (m *IndexPriceAggregator) GetPriceBreakdowns() types.PriceBreakdowns {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	cpy := make(types.PriceBreakdowns, len(m.breakdowns))
+	for ticker, breakdowns := range m.breakdowns {
+		cpy[ticker] = slices.Clone(breakdowns)
+	}
+
+	return cpy
+}
+
+// newProviderPriceBreakdown returns the breakdown of the raw price the provider most recently
+// reported for the given provider config.
+func (m *IndexPriceAggregator) newProviderPriceBreakdown(cfg mmtypes.ProviderConfig) types.ProviderPriceBreakdown {
+	breakdown := types.ProviderPriceBreakdown{
+		Provider:       cfg.Name,
+		OffChainTicker: cfg.OffChainTicker,
+	}
+
+	if price, ok := m.providerPrices[cfg.Name][cfg.OffChainTicker]; ok && price != nil {
+		breakdown.RawPrice = new(big.Float).Copy(price)
+	}
+
+	if timestamp, ok := m.providerTimestamps[cfg.Name][cfg.OffChainTicker]; ok {
+		breakdown.Timestamp = timestamp
+	}
+
+	return breakdown
+}
+
+// excludeAllPrices marks all provider prices of the given market as not used, for the given reason.
+func (m *IndexPriceAggregator) excludeAllPrices(ticker, reason string) {
+	for i := range m.breakdowns[ticker] {
+		if m.breakdowns[ticker][i].Used {
+			m.breakdowns[ticker][i].Used = false
+			m.breakdowns[ticker][i].Reason = reason
+		}
+	}
+}
+
 // Reset resets the data aggregator for all providers.
 func (m *IndexPriceAggregator) Reset() {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
 	m.providerPrices = make(map[string]types.Prices)
+	m.providerVolumes = make(map[string]types.Prices)
+	m.providerTimestamps = make(map[string]map[string]time.Time)
 }
 
 // GetPrices returns the aggregated data the aggregator has. Specifically, the
@@ -3,11 +3,17 @@ package oracle_test
 import (
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/zoguxprotocol/slinky/oracle/config"
+	"github.com/zoguxprotocol/slinky/oracle/metrics"
 	"github.com/zoguxprotocol/slinky/oracle/types"
 	"github.com/zoguxprotocol/slinky/pkg/math/oracle"
+	"github.com/zoguxprotocol/slinky/providers/apis/binance"
+	"github.com/zoguxprotocol/slinky/providers/apis/coinbase"
+	"github.com/zoguxprotocol/slinky/providers/websockets/okx"
 	mmtypes "github.com/zoguxprotocol/slinky/x/marketmap/types"
 )
 
@@ -132,3 +138,67 @@ func TestGetIndexPrice(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestGetPriceBreakdowns(t *testing.T) {
+	now := time.Now().UTC()
+
+	t.Run("records used prices and outliers", func(t *testing.T) {
+		m, err := oracle.NewIndexPriceAggregator(
+			logger,
+			outlierMarketMap,
+			metrics.NewNopMetrics(),
+			oracle.WithOutlierFilter(config.OutlierFilterConfig{
+				Enabled:         true,
+				MaxDeviationBps: 500,
+				MinPrices:       3,
+				ScoreDecay:      0.1,
+			}),
+		)
+		require.NoError(t, err)
+
+		setOutlierPrices(m, 90_000)
+		m.SetProviderTimestamps(okx.Name, map[string]time.Time{"BTC-USD": now})
+		m.AggregatePrices()
+
+		breakdowns := m.GetPriceBreakdowns()[BTC_USD.String()]
+		require.Len(t, breakdowns, 4)
+		for _, breakdown := range breakdowns[:3] {
+			require.True(t, breakdown.Used)
+			require.Empty(t, breakdown.Reason)
+			require.NotNil(t, breakdown.RawPrice)
+			require.NotNil(t, breakdown.ConvertedPrice)
+		}
+
+		outlier := breakdowns[3]
+		require.Equal(t, okx.Name, outlier.Provider)
+		require.Equal(t, "BTC-USD", outlier.OffChainTicker)
+		require.Equal(t, now, outlier.Timestamp)
+		require.Equal(t, big.NewFloat(90_000).SetPrec(40), outlier.RawPrice.SetPrec(40))
+		require.Equal(t, big.NewFloat(90_000).SetPrec(40), outlier.ConvertedPrice.SetPrec(40))
+		require.False(t, outlier.Used)
+		require.Equal(t, types.ExclusionReasonOutlier, outlier.Reason)
+	})
+
+	t.Run("records missing prices and markets with insufficient prices", func(t *testing.T) {
+		m, err := oracle.NewIndexPriceAggregator(logger, outlierMarketMap, metrics.NewNopMetrics())
+		require.NoError(t, err)
+
+		m.SetProviderPrices(coinbase.Name, types.Prices{"BTC-USD": big.NewFloat(70_000)})
+		m.SetProviderPrices(binance.Name, types.Prices{"BTCUSD": big.NewFloat(71_000)})
+		m.AggregatePrices()
+
+		breakdowns := m.GetPriceBreakdowns()[BTC_USD.String()]
+		require.Len(t, breakdowns, 4)
+		for _, breakdown := range breakdowns[:2] {
+			require.False(t, breakdown.Used)
+			require.NotNil(t, breakdown.ConvertedPrice)
+			require.Equal(t, types.ExclusionReasonInsufficientProviders, breakdown.Reason)
+		}
+		for _, breakdown := range breakdowns[2:] {
+			require.False(t, breakdown.Used)
+			require.Nil(t, breakdown.RawPrice)
+			require.Nil(t, breakdown.ConvertedPrice)
+			require.NotEmpty(t, breakdown.Reason)
+		}
+	})
+}
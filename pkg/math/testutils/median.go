@@ -3,6 +3,7 @@ package testutils
 import (
 	"math/big"
 	"sync"
+	"time"
 
 	"github.com/zoguxprotocol/slinky/oracle"
 	"github.com/zoguxprotocol/slinky/oracle/types"
@@ -39,6 +40,12 @@ func (m *MedianAggregator) SetProviderPrices(provider string, data types.Prices)
 	m.providerPrices[provider] = data
 }
 
+// SetProviderVolumes is a no-op since the median aggregator does not weight prices.
+func (m *MedianAggregator) SetProviderVolumes(_ string, _ types.Prices) {}
+
+// SetProviderTimestamps is a no-op since the median aggregator does not track price breakdowns.
+func (m *MedianAggregator) SetProviderTimestamps(_ string, _ map[string]time.Time) {}
+
 func (m *MedianAggregator) UpdateMarketMap(_ mmtypes.MarketMap) {}
 
 // AggregatePrices inputs the aggregated prices from all providers and computes
@@ -85,6 +92,11 @@ func (m *MedianAggregator) GetPrices() types.Prices {
 	return m.finalPrices
 }
 
+// GetPriceBreakdowns returns no breakdowns since the median aggregator does not track them.
+func (m *MedianAggregator) GetPriceBreakdowns() types.PriceBreakdowns {
+	return types.PriceBreakdowns{}
+}
+
 // Reset resets the data aggregator for all providers.
 func (m *MedianAggregator) Reset() {
 	m.mtx.Lock()
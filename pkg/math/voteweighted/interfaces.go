@@ -7,6 +7,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/interchain-security/v5/x/ccv/consumer/types"
+
+	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
 )
 
 // ValidatorStore defines the interface contract required for calculating stake-weighted median
@@ -19,6 +21,17 @@ type ValidatorStore interface {
 	TotalBondedTokens(ctx context.Context) (math.Int, error)
 }
 
+// QuorumStore defines the interface contract required for retrieving the per currency pair requirements
+// on the validators reporting a price. Typically, this is implemented by the x/oracle keeper.
+//
+//go:generate mockery --name QuorumStore --filename mock_quorum_store.go
+type QuorumStore interface {
+	// GetPriceQuorum returns the minimum voting power, in parts-per-million of the total bonded stake, and
+	// the minimum number of validators that must report a price for the currency pair. Zero values mean
+	// that the currency pair has no such requirement.
+	GetPriceQuorum(ctx sdk.Context, cp slinkytypes.CurrencyPair) (minVotingPowerPpm uint64, minValidators uint64, err error)
+}
+
 // CCValidatorStore defines the interface contract required for the cross chain validator consumer store.
 //
 //go:generate mockery --name CCValidatorStore --filename mock_cc_validator_store.go
@@ -2,6 +2,7 @@ package voteweighted_test
 
 import (
 	"crypto"
+	"fmt"
 	"math/big"
 	"testing"
 
@@ -20,6 +21,7 @@ import (
 	"github.com/zoguxprotocol/slinky/pkg/math/voteweighted"
 	"github.com/zoguxprotocol/slinky/pkg/math/voteweighted/mocks"
 	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
+	oraclekeeper "github.com/zoguxprotocol/slinky/x/oracle/keeper"
 )
 
 type MathTestSuite struct {
@@ -33,6 +35,8 @@ type validator struct {
 	consAddr sdk.ConsAddress
 }
 
+var _ voteweighted.QuorumStore = (*oraclekeeper.Keeper)(nil)
+
 var (
 	validator1 = sdk.ConsAddress("validator1")
 	validator2 = sdk.ConsAddress("validator2")
@@ -306,6 +310,133 @@ func (s *MathTestSuite) TestMedian() {
 	}
 }
 
+func (s *MathTestSuite) TestMedianWithQuorums() {
+	btcUSD := slinkytypes.NewCurrencyPair("BTC", "USD")
+	ethUSD := slinkytypes.NewCurrencyPair("ETH", "USD")
+
+	// validator1 and validator2 report both prices with 80% of the stake
+	providerPrices := aggregator.AggregatedProviderData[string, map[slinkytypes.CurrencyPair]*big.Int]{
+		validator1.String(): {
+			btcUSD: big.NewInt(100),
+			ethUSD: big.NewInt(10),
+		},
+		validator2.String(): {
+			btcUSD: big.NewInt(200),
+			ethUSD: big.NewInt(20),
+		},
+	}
+	validators := []validator{
+		{stake: sdkmath.NewInt(40), consAddr: validator1},
+		{stake: sdkmath.NewInt(40), consAddr: validator2},
+		{stake: sdkmath.NewInt(20), consAddr: validator3},
+	}
+
+	type quorum struct {
+		minVotingPowerPpm uint64
+		minValidators     uint64
+		err               error
+	}
+
+	cases := []struct {
+		name           string
+		quorums        map[slinkytypes.CurrencyPair]quorum
+		expectedPrices map[slinkytypes.CurrencyPair]*big.Int
+	}{
+		{
+			name: "no quorums",
+			quorums: map[slinkytypes.CurrencyPair]quorum{
+				btcUSD: {},
+				ethUSD: {},
+			},
+			expectedPrices: map[slinkytypes.CurrencyPair]*big.Int{
+				btcUSD: big.NewInt(100),
+				ethUSD: big.NewInt(10),
+			},
+		},
+		{
+			name: "min voting power met",
+			quorums: map[slinkytypes.CurrencyPair]quorum{
+				btcUSD: {minVotingPowerPpm: 800_000},
+				ethUSD: {},
+			},
+			expectedPrices: map[slinkytypes.CurrencyPair]*big.Int{
+				btcUSD: big.NewInt(100),
+				ethUSD: big.NewInt(10),
+			},
+		},
+		{
+			name: "min voting power not met",
+			quorums: map[slinkytypes.CurrencyPair]quorum{
+				btcUSD: {minVotingPowerPpm: 900_000},
+				ethUSD: {},
+			},
+			expectedPrices: map[slinkytypes.CurrencyPair]*big.Int{
+				ethUSD: big.NewInt(10),
+			},
+		},
+		{
+			name: "min voting power below the default threshold does not relax it",
+			quorums: map[slinkytypes.CurrencyPair]quorum{
+				btcUSD: {minVotingPowerPpm: 100_000},
+				ethUSD: {},
+			},
+			expectedPrices: map[slinkytypes.CurrencyPair]*big.Int{
+				btcUSD: big.NewInt(100),
+				ethUSD: big.NewInt(10),
+			},
+		},
+		{
+			name: "min validators met",
+			quorums: map[slinkytypes.CurrencyPair]quorum{
+				btcUSD: {minValidators: 2},
+				ethUSD: {},
+			},
+			expectedPrices: map[slinkytypes.CurrencyPair]*big.Int{
+				btcUSD: big.NewInt(100),
+				ethUSD: big.NewInt(10),
+			},
+		},
+		{
+			name: "min validators not met",
+			quorums: map[slinkytypes.CurrencyPair]quorum{
+				btcUSD: {},
+				ethUSD: {minValidators: 3},
+			},
+			expectedPrices: map[slinkytypes.CurrencyPair]*big.Int{
+				btcUSD: big.NewInt(100),
+			},
+		},
+		{
+			name: "quorum cannot be retrieved",
+			quorums: map[slinkytypes.CurrencyPair]quorum{
+				btcUSD: {err: fmt.Errorf("store error")},
+				ethUSD: {},
+			},
+			expectedPrices: map[slinkytypes.CurrencyPair]*big.Int{
+				ethUSD: big.NewInt(10),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			quorums := mocks.NewQuorumStore(s.T())
+			for cp, q := range tc.quorums {
+				quorums.On("GetPriceQuorum", s.ctx, cp).Return(q.minVotingPowerPpm, q.minValidators, q.err)
+			}
+
+			aggregateFn := voteweighted.MedianWithQuorums(
+				s.ctx,
+				log.NewTestLogger(s.T()),
+				s.createMockValidatorStore(validators, sdkmath.NewInt(100)),
+				voteweighted.DefaultPowerThreshold,
+				quorums,
+			)
+			s.Require().Equal(tc.expectedPrices, aggregateFn(providerPrices))
+		})
+	}
+}
+
 func (s *MathTestSuite) TestComputeMedian() {
 	cases := []struct {
 		name      string
@@ -44,6 +44,19 @@ func MedianFromContext(
 	}
 }
 
+// MedianFromContextWithQuorums returns a new Median aggregate function that is parametrized by the
+// latest state of the application, and that enforces the per currency pair quorums of the given store.
+func MedianFromContextWithQuorums(
+	logger log.Logger,
+	validatorStore ValidatorStore,
+	threshold math.LegacyDec,
+	quorums QuorumStore,
+) aggregator.AggregateFnFromContext[string, map[slinkytypes.CurrencyPair]*big.Int] {
+	return func(ctx sdk.Context) aggregator.AggregateFn[string, map[slinkytypes.CurrencyPair]*big.Int] {
+		return MedianWithQuorums(ctx, logger, validatorStore, threshold, quorums)
+	}
+}
+
 // Median returns an aggregation function that computes the stake weighted median price as the
 // final deterministic oracle price for any qualifying currency pair (base, quote). There are a few things to
 // note about the implementation:
@@ -61,6 +74,25 @@ func Median(
 	logger log.Logger,
 	validatorStore ValidatorStore,
 	threshold math.LegacyDec,
+) aggregator.AggregateFn[string, map[slinkytypes.CurrencyPair]*big.Int] {
+	return MedianWithQuorums(ctx, logger, validatorStore, threshold, nil)
+}
+
+// MedianWithQuorums returns the Median aggregation function, additionally requiring the prices of each
+// currency pair to meet the quorum of the currency pair in the given store (if not nil):
+//
+//  1. The voting power % of the validators that submitted a price update must also be at least
+//     the minimum voting power of the currency pair, which can only make the threshold stricter.
+//  2. The number of validators that submitted a price update must be at least the minimum number of
+//     validators of the currency pair.
+//
+// Currency pairs whose quorum cannot be retrieved are excluded from the final set of oracle prices.
+func MedianWithQuorums(
+	ctx sdk.Context,
+	logger log.Logger,
+	validatorStore ValidatorStore,
+	threshold math.LegacyDec,
+	quorums QuorumStore,
 ) aggregator.AggregateFn[string, map[slinkytypes.CurrencyPair]*big.Int] {
 	return func(providers aggregator.AggregatedProviderData[string, map[slinkytypes.CurrencyPair]*big.Int]) map[slinkytypes.CurrencyPair]*big.Int {
 		priceInfo := make(map[slinkytypes.CurrencyPair]PriceInfo)
@@ -134,16 +166,40 @@ func Median(
 		}
 
 		for currencyPair, info := range priceInfo {
+			cpThreshold, minValidators, err := quorumFor(ctx, quorums, currencyPair, threshold)
+			if err != nil {
+				logger.Error(
+					"failed to retrieve quorum for currency pair; skipping currency pair",
+					"currency_pair", currencyPair.String(),
+					"err", err,
+				)
+
+				continue
+			}
+
+			// The number of validators that submitted a price update for the given currency pair must be
+			// at least the minimum number of validators of the currency pair.
+			if uint64(len(info.Prices)) < minValidators {
+				logger.Debug(
+					"not enough validators to compute stake-weighted median price for currency pair",
+					"currency_pair", currencyPair.String(),
+					"min_validators", minValidators,
+					"num_validators", len(info.Prices),
+				)
+
+				continue
+			}
+
 			// The total voting power % that submitted a price update for the given currency pair must be
 			// greater than the threshold to be included in the final oracle price.
-			if percentSubmitted := math.LegacyNewDecFromInt(info.TotalWeight).Quo(math.LegacyNewDecFromInt(totalBondedTokens)); percentSubmitted.GTE(threshold) {
+			if percentSubmitted := math.LegacyNewDecFromInt(info.TotalWeight).Quo(math.LegacyNewDecFromInt(totalBondedTokens)); percentSubmitted.GTE(cpThreshold) {
 				prices[currencyPair] = ComputeMedian(info)
 
 				logger.Debug(
 					"computed stake-weighted median price for currency pair",
 					"currency_pair", currencyPair.String(),
 					"percent_submitted", percentSubmitted.String(),
-					"threshold", threshold.String(),
+					"threshold", cpThreshold.String(),
 					"final_price", prices[currencyPair].String(),
 					"num_validators", len(info.Prices),
 				)
@@ -151,7 +207,7 @@ func Median(
 				logger.Debug(
 					"not enough voting power to compute stake-weighted median price price for currency pair",
 					"currency_pair", currencyPair.String(),
-					"threshold", threshold.String(),
+					"threshold", cpThreshold.String(),
 					"percent_submitted", percentSubmitted.String(),
 					"num_validators", len(info.Prices),
 				)
@@ -162,6 +218,31 @@ func Median(
 	}
 }
 
+// quorumFor returns the power threshold and the minimum number of validators of a currency pair. The
+// threshold is the stricter of the given threshold and the minimum voting power of the currency pair.
+func quorumFor(
+	ctx sdk.Context,
+	quorums QuorumStore,
+	cp slinkytypes.CurrencyPair,
+	threshold math.LegacyDec,
+) (math.LegacyDec, uint64, error) {
+	if quorums == nil {
+		return threshold, 0, nil
+	}
+
+	minVotingPowerPpm, minValidators, err := quorums.GetPriceQuorum(ctx, cp)
+	if err != nil {
+		return math.LegacyDec{}, 0, err
+	}
+
+	minVotingPower := math.LegacyNewDecFromInt(math.NewIntFromUint64(minVotingPowerPpm)).QuoInt64(1_000_000)
+	if minVotingPower.GT(threshold) {
+		threshold = minVotingPower
+	}
+
+	return threshold, minValidators, nil
+}
+
 // ComputeMedian computes the stake-weighted median price for a given asset.
 func ComputeMedian(priceInfo PriceInfo) *big.Int {
 	// Sort the prices by price.
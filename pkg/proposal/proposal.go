@@ -0,0 +1,156 @@
+package proposal
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// FlagProposal is the flag that makes a tx command output an unsigned governance proposal instead of
+	// signing and broadcasting a transaction.
+	FlagProposal = "proposal"
+	// FlagAuthority is the flag that overrides the authority (signer) set on the generated messages.
+	FlagAuthority = "authority"
+	// FlagTitle is the title of the generated governance proposal.
+	FlagTitle = "title"
+	// FlagSummary is the summary of the generated governance proposal.
+	FlagSummary = "summary"
+	// FlagMetadata is the metadata of the generated governance proposal.
+	FlagMetadata = "metadata"
+	// FlagDeposit is the deposit of the generated governance proposal.
+	FlagDeposit = "deposit"
+	// FlagExpedited marks the generated governance proposal as expedited.
+	FlagExpedited = "expedited"
+)
+
+// Proposal is the JSON format accepted by `tx gov submit-proposal`. Messages are proto-JSON encoded Anys.
+type Proposal struct {
+	Messages  []json.RawMessage `json:"messages,omitempty"`
+	Metadata  string            `json:"metadata"`
+	Deposit   string            `json:"deposit"`
+	Title     string            `json:"title"`
+	Summary   string            `json:"summary"`
+	Expedited bool              `json:"expedited"`
+}
+
+// AddProposalFlagsToCmd adds the flags used to generate governance proposals, and to override the
+// authority of the generated messages, to the given tx command.
+func AddProposalFlagsToCmd(cmd *cobra.Command) {
+	cmd.Flags().Bool(FlagProposal, false, "Print an unsigned governance proposal (for tx gov submit-proposal) instead of broadcasting a transaction")
+	cmd.Flags().String(FlagAuthority, "", "The authority signing the messages (defaults to the gov module account with --proposal, and the --from address otherwise)")
+	cmd.Flags().String(FlagTitle, "", "The title of the governance proposal")
+	cmd.Flags().String(FlagSummary, "", "The summary of the governance proposal")
+	cmd.Flags().String(FlagMetadata, "", "The metadata of the governance proposal")
+	cmd.Flags().String(FlagDeposit, "", "The deposit of the governance proposal")
+	cmd.Flags().Bool(FlagExpedited, false, "Whether the governance proposal is expedited")
+}
+
+// GetAuthority returns the authority that should sign the messages built by a tx command. The --authority
+// flag takes precedence. Otherwise, the gov module account is used when generating a proposal, and the
+// --from address is used when broadcasting a transaction.
+func GetAuthority(cmd *cobra.Command, clientCtx client.Context) (string, error) {
+	authority, err := cmd.Flags().GetString(FlagAuthority)
+	if err != nil {
+		return "", err
+	}
+	if authority != "" {
+		return authority, nil
+	}
+
+	isProposal, err := cmd.Flags().GetBool(FlagProposal)
+	if err != nil {
+		return "", err
+	}
+	if isProposal {
+		return authtypes.NewModuleAddress(govtypes.ModuleName).String(), nil
+	}
+
+	if from := clientCtx.GetFromAddress(); !from.Empty() {
+		return from.String(), nil
+	}
+
+	return "", fmt.Errorf("either --%s or --from must be set", FlagAuthority)
+}
+
+// GenerateOrBroadcastMsgs validates the given messages, and either prints them as an unsigned governance
+// proposal (if --proposal is set) or generates / broadcasts them as a transaction.
+func GenerateOrBroadcastMsgs(cmd *cobra.Command, clientCtx client.Context, msgs ...sdk.Msg) error {
+	isProposal, err := cmd.Flags().GetBool(FlagProposal)
+	if err != nil {
+		return err
+	}
+	if !isProposal {
+		return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+	}
+
+	p, err := NewProposal(cmd, clientCtx, msgs...)
+	if err != nil {
+		return err
+	}
+
+	bz, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintBytes(bz)
+}
+
+// NewProposal validates the given messages and wraps them in a Proposal using the proposal flags set on
+// the command.
+func NewProposal(cmd *cobra.Command, clientCtx client.Context, msgs ...sdk.Msg) (Proposal, error) {
+	var (
+		p   Proposal
+		err error
+	)
+
+	if p.Title, err = cmd.Flags().GetString(FlagTitle); err != nil {
+		return p, err
+	}
+	if p.Summary, err = cmd.Flags().GetString(FlagSummary); err != nil {
+		return p, err
+	}
+	if p.Metadata, err = cmd.Flags().GetString(FlagMetadata); err != nil {
+		return p, err
+	}
+	if p.Deposit, err = cmd.Flags().GetString(FlagDeposit); err != nil {
+		return p, err
+	}
+	if p.Expedited, err = cmd.Flags().GetBool(FlagExpedited); err != nil {
+		return p, err
+	}
+
+	if p.Deposit != "" {
+		if _, err := sdk.ParseCoinsNormalized(p.Deposit); err != nil {
+			return p, fmt.Errorf("invalid deposit %q: %w", p.Deposit, err)
+		}
+	}
+
+	if clientCtx.Codec == nil {
+		return p, fmt.Errorf("client context has no codec set")
+	}
+
+	for _, msg := range msgs {
+		if m, ok := msg.(sdk.HasValidateBasic); ok {
+			if err := m.ValidateBasic(); err != nil {
+				return p, err
+			}
+		}
+
+		bz, err := clientCtx.Codec.MarshalInterfaceJSON(msg)
+		if err != nil {
+			return p, fmt.Errorf("failed to marshal message %T: %w", msg, err)
+		}
+
+		p.Messages = append(p.Messages, bz)
+	}
+
+	return p, nil
+}
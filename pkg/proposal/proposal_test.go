@@ -0,0 +1,65 @@
+package proposal_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zoguxprotocol/slinky/pkg/proposal"
+)
+
+func TestGetAuthority(t *testing.T) {
+	from := sdk.AccAddress("from________________")
+	override := sdk.AccAddress("override____________").String()
+
+	testCases := []struct {
+		name      string
+		args      []string
+		clientCtx client.Context
+		expected  string
+		err       bool
+	}{
+		{
+			name:     "proposals default to the gov module account",
+			args:     []string{"--proposal"},
+			expected: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		},
+		{
+			name:      "transactions default to the from address",
+			clientCtx: client.Context{}.WithFromAddress(from),
+			expected:  from.String(),
+		},
+		{
+			name:      "the authority flag takes precedence",
+			args:      []string{"--proposal", "--authority", override},
+			clientCtx: client.Context{}.WithFromAddress(from),
+			expected:  override,
+		},
+		{
+			name: "transactions without a from address fail",
+			err:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			proposal.AddProposalFlagsToCmd(cmd)
+			require.NoError(t, cmd.ParseFlags(tc.args))
+
+			authority, err := proposal.GetAuthority(cmd, tc.clientCtx)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, authority)
+		})
+	}
+}
@@ -0,0 +1,320 @@
+package erc4626
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/zoguxprotocol/slinky/oracle/config"
+	"github.com/zoguxprotocol/slinky/oracle/types"
+	"github.com/zoguxprotocol/slinky/pkg/slices"
+	"github.com/zoguxprotocol/slinky/providers/apis/defi/ethmulticlient"
+	"github.com/zoguxprotocol/slinky/providers/base/api/metrics"
+	providertypes "github.com/zoguxprotocol/slinky/providers/types"
+)
+
+var _ types.PriceAPIFetcher = (*PriceFetcher)(nil)
+
+// PriceFetcher is the ERC-4626 price fetcher. This fetcher is responsible for querying ERC-4626
+// vault contracts and returning the exchange rate of a given ticker, i.e. the amount of the
+// underlying asset that one share of the vault can be redeemed for. The exchange rate is derived
+// from the convertToAssets method of the vault contract.
+//
+// The exchange rate is meant to be used as the redemption rate of a liquid staking token, which
+// multiplies the price of the underlying asset in the market map.
+//
+// To read more about the ERC-4626 standard, see https://eips.ethereum.org/EIPS/eip-4626.
+type PriceFetcher struct {
+	logger *zap.Logger
+	api    config.APIConfig
+
+	// client is the EVM client implementation. This is used to interact with the ethereum network.
+	client ethmulticlient.EVMClient
+	// abi is the ERC-4626 convertToAssets abi. This is used to pack the convertToAssets call to the
+	// vault contract and parse the result.
+	abi *abi.ABI
+	// vaultCache is a cache of the tickers to vault configs. This is used to avoid unmarshalling
+	// the metadata for each ticker.
+	vaultCache map[types.ProviderTicker]VaultConfig
+}
+
+// NewPriceFetcher returns a new ERC-4626 price fetcher.
+func NewPriceFetcher(
+	ctx context.Context,
+	logger *zap.Logger,
+	apiMetrics metrics.APIMetrics,
+	api config.APIConfig,
+) (*PriceFetcher, error) {
+	if ctx == nil {
+		return nil, fmt.Errorf("context cannot be nil")
+	}
+
+	if logger == nil {
+		return nil, fmt.Errorf("logger cannot be nil")
+	}
+
+	if apiMetrics == nil {
+		return nil, fmt.Errorf("api metrics is nil")
+	}
+
+	if err := api.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid api config: %w", err)
+	}
+
+	if !IsValidProviderName(api.Name) {
+		return nil, fmt.Errorf("invalid api config name %s", api.Name)
+	}
+
+	if !api.Enabled {
+		return nil, fmt.Errorf("api config for %s is not enabled", api.Name)
+	}
+
+	var (
+		client ethmulticlient.EVMClient
+		err    error
+	)
+	switch {
+	case len(api.Endpoints) > 1:
+		client, err = ethmulticlient.NewMultiRPCClientFromEndpoints(
+			ctx,
+			logger,
+			api,
+			apiMetrics,
+		)
+	case len(api.Endpoints) == 1:
+		client, err = ethmulticlient.NewGoEthereumClientImpl(
+			ctx,
+			apiMetrics,
+			api,
+			0,
+		)
+	default:
+		err = fmt.Errorf("no endpoints were provided")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPriceFetcherWithClient(
+		logger,
+		api,
+		client,
+	)
+}
+
+// NewPriceFetcherWithClient returns a new PriceFetcher.
+// It requires a pre-validated config, and initialized client.
+func NewPriceFetcherWithClient(
+	logger *zap.Logger,
+	api config.APIConfig,
+	client ethmulticlient.EVMClient,
+) (*PriceFetcher, error) {
+	abi, err := abi.JSON(strings.NewReader(VaultABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get erc4626 abi: %w", err)
+	}
+
+	return &PriceFetcher{
+		logger:     logger.With(zap.String("fetcher", api.Name)),
+		api:        api,
+		client:     client,
+		abi:        &abi,
+		vaultCache: make(map[types.ProviderTicker]VaultConfig),
+	}, nil
+}
+
+// Fetch returns the exchange rate of a given set of tickers. This fetch utilizes the batch call to
+// lower overhead of making individual RPC calls for each ticker. The fetcher will query the vault
+// contract for the amount of assets that one whole share converts to.
+func (f *PriceFetcher) Fetch(
+	ctx context.Context,
+	tickers []types.ProviderTicker,
+) types.PriceResponse {
+	var (
+		resolved   = make(types.ResolvedPrices)
+		unResolved = make(types.UnResolvedPrices)
+	)
+
+	// Create a batch element for each ticker and vault.
+	batchElems := make([]rpc.BatchElem, len(tickers))
+	vaults := make([]VaultConfig, len(tickers))
+
+	for i, ticker := range tickers {
+		vault, err := f.GetVault(ticker)
+		if err != nil {
+			f.logger.Debug(
+				"failed to get vault for ticker",
+				zap.String("ticker", ticker.String()),
+				zap.Error(err),
+			)
+
+			return types.NewPriceResponseWithErr(
+				tickers,
+				providertypes.NewErrorWithCode(
+					fmt.Errorf("failed to get vault: %w", err),
+					providertypes.ErrorFailedToDecode,
+				),
+			)
+		}
+
+		// The payload depends on the share decimals of the vault, as it converts one whole share.
+		payload, err := f.abi.Pack(ContractMethod, exp10(vault.ShareDecimals))
+		if err != nil {
+			return types.NewPriceResponseWithErr(
+				tickers,
+				providertypes.NewErrorWithCode(
+					fmt.Errorf("failed to pack %s: %w", ContractMethod, err),
+					providertypes.ErrorUnknown,
+				),
+			)
+		}
+
+		// Create a batch element for the ticker and vault.
+		var result string
+		batchElems[i] = rpc.BatchElem{
+			Method: "eth_call",
+			Args: []interface{}{
+				map[string]interface{}{
+					"to":   common.HexToAddress(vault.Address),
+					"data": hexutil.Bytes(payload), // convertToAssets call to the vault contract.
+				},
+				"latest", // latest signifies the latest block.
+			},
+			Result: &result,
+		}
+		vaults[i] = vault
+	}
+
+	// process 10 tickers at a time
+	const batchSize = 10
+	batchChunks := slices.Chunk(batchElems, batchSize)
+
+	for _, chunk := range batchChunks {
+		// Batch call to the EVM.
+		if err := f.client.BatchCallContext(ctx, chunk); err != nil {
+			f.logger.Debug(
+				"failed to batch call to ethereum network for all tickers",
+				zap.Error(err),
+			)
+
+			return types.NewPriceResponseWithErr(
+				tickers,
+				providertypes.NewErrorWithCode(err, providertypes.ErrorAPIGeneral),
+			)
+		}
+	}
+
+	// Parse the result from the batch call for each ticker.
+	for i, ticker := range tickers {
+		result := batchElems[i]
+		if result.Error != nil {
+			f.logger.Debug(
+				"failed to batch call to ethereum network for ticker",
+				zap.String("ticker", ticker.String()),
+				zap.Error(result.Error),
+			)
+
+			unResolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(
+					result.Error,
+					providertypes.ErrorUnknown,
+				),
+			}
+
+			continue
+		}
+
+		// Parse the amount of assets from the result.
+		assets, err := f.ParseAssets(result.Result)
+		if err != nil {
+			f.logger.Debug(
+				"failed to parse assets",
+				zap.String("ticker", ticker.String()),
+				zap.Error(err),
+			)
+
+			unResolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(
+					err,
+					providertypes.ErrorFailedToParsePrice,
+				),
+			}
+
+			continue
+		}
+
+		// Scale the amount of assets to the asset decimals to get the exchange rate.
+		rate := new(big.Float).Quo(
+			new(big.Float).SetInt(assets),
+			new(big.Float).SetInt(exp10(vaults[i].AssetDecimals)),
+		)
+		resolved[ticker] = types.NewPriceResult(rate, time.Now().UTC())
+	}
+
+	// Add the price to the resolved prices.
+	return types.NewPriceResponse(resolved, unResolved)
+}
+
+// GetVault returns the ERC-4626 vault for the given ticker. This will unmarshal the metadata
+// and validate the vault config which contains all required information to query the EVM.
+func (f *PriceFetcher) GetVault(
+	ticker types.ProviderTicker,
+) (VaultConfig, error) {
+	if vault, ok := f.vaultCache[ticker]; ok {
+		return vault, nil
+	}
+
+	var cfg VaultConfig
+	if err := json.Unmarshal([]byte(ticker.GetJSON()), &cfg); err != nil {
+		return cfg, fmt.Errorf("failed to unmarshal vault config on ticker: %w", err)
+	}
+	if err := cfg.ValidateBasic(); err != nil {
+		return cfg, fmt.Errorf("invalid ticker vault config: %w", err)
+	}
+
+	f.vaultCache[ticker] = cfg
+	return cfg, nil
+}
+
+// ParseAssets parses the amount of assets from the result of the batch call.
+func (f *PriceFetcher) ParseAssets(
+	result interface{},
+) (*big.Int, error) {
+	r, ok := result.(*string)
+	if !ok {
+		return nil, fmt.Errorf("expected result to be a string, got %T", result)
+	}
+
+	if r == nil {
+		return nil, fmt.Errorf("result is nil")
+	}
+
+	bz, err := hexutil.Decode(*r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode hex result: %w", err)
+	}
+
+	out, err := f.abi.Methods[ContractMethod].Outputs.UnpackValues(bz)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack values: %w", err)
+	}
+
+	// Parse the assets from the result.
+	assets := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	return assets, nil
+}
+
+// exp10 returns 10^decimals.
+func exp10(decimals int64) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(decimals), nil)
+}
@@ -0,0 +1,283 @@
+package erc4626_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/zoguxprotocol/slinky/oracle/config"
+	"github.com/zoguxprotocol/slinky/oracle/types"
+	"github.com/zoguxprotocol/slinky/providers/apis/defi/erc4626"
+	"github.com/zoguxprotocol/slinky/providers/apis/defi/ethmulticlient"
+	"github.com/zoguxprotocol/slinky/providers/apis/defi/ethmulticlient/mocks"
+	"github.com/zoguxprotocol/slinky/providers/base/api/metrics"
+	providertypes "github.com/zoguxprotocol/slinky/providers/types"
+)
+
+func TestFetch(t *testing.T) {
+	testCases := []struct {
+		name     string
+		tickers  []types.ProviderTicker
+		client   func() ethmulticlient.EVMClient
+		expected types.PriceResponse
+	}{
+		{
+			name:    "no tickers",
+			tickers: []types.ProviderTicker{},
+			client: func() ethmulticlient.EVMClient {
+				c := mocks.NewEVMClient(t)
+				c.On("BatchCallContext", context.Background(), []rpc.BatchElem{}).Return(nil)
+				return c
+			},
+			expected: types.PriceResponse{
+				Resolved:   map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{},
+			},
+		},
+		{
+			name: "fails to retrieve vault for an empty ticker",
+			tickers: []types.ProviderTicker{
+				types.NewProviderTicker("SFRXETH/FRXETH", ""),
+			},
+			client: func() ethmulticlient.EVMClient {
+				return mocks.NewEVMClient(t)
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{
+					types.NewProviderTicker("SFRXETH/FRXETH", ""): {},
+				},
+			},
+		},
+		{
+			name: "fails to make a batch call",
+			tickers: []types.ProviderTicker{
+				sfrxethTicker,
+			},
+			client: func() ethmulticlient.EVMClient {
+				return createEVMClientWithResponse(t, fmt.Errorf("failed to make a batch call"), nil, nil)
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{
+					sfrxethTicker: {},
+				},
+			},
+		},
+		{
+			name: "batch request has an error for a single ticker",
+			tickers: []types.ProviderTicker{
+				sfrxethTicker,
+			},
+			client: func() ethmulticlient.EVMClient {
+				return createEVMClientWithResponse(
+					t,
+					nil,
+					[]string{""},
+					[]error{fmt.Errorf("request for ticker did not return a result")},
+				)
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{
+					sfrxethTicker: {},
+				},
+			},
+		},
+		{
+			name: "batch request returns a result that cannot be parsed",
+			tickers: []types.ProviderTicker{
+				sfrxethTicker,
+			},
+			client: func() ethmulticlient.EVMClient {
+				return createEVMClientWithResponse(t, nil, []string{"not a valid result"}, []error{nil})
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{
+					sfrxethTicker: {},
+				},
+			},
+		},
+		{
+			name: "exchange rates are scaled by the asset decimals",
+			tickers: []types.ProviderTicker{
+				sfrxethTicker,
+				usdcVaultTicker,
+			},
+			client: func() ethmulticlient.EVMClient {
+				responses := []string{
+					"0x0000000000000000000000000000000000000000000000000f43fc2c04ee0000", // 1.1e18
+					"0x0000000000000000000000000000000000000000000000000000000000100590", // 1.05e6
+				}
+				return createEVMClientWithResponse(t, nil, responses, []error{nil, nil})
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{
+					sfrxethTicker: {
+						Value: big.NewFloat(1.1),
+					},
+					usdcVaultTicker: {
+						Value: big.NewFloat(1.05),
+					},
+				},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fetcher := createPriceFetcherWithClient(t, tc.client())
+
+			response := fetcher.Fetch(context.Background(), tc.tickers)
+			require.Equal(t, len(tc.expected.Resolved), len(response.Resolved))
+			require.Equal(t, len(tc.expected.UnResolved), len(response.UnResolved))
+
+			for ticker, result := range tc.expected.Resolved {
+				require.Contains(t, response.Resolved, ticker)
+				require.Equal(t, result.Value.SetPrec(40), response.Resolved[ticker].Value.SetPrec(40))
+			}
+
+			for ticker := range tc.expected.UnResolved {
+				require.Contains(t, response.UnResolved, ticker)
+			}
+		})
+	}
+}
+
+func TestGetVault(t *testing.T) {
+	fetcher := createPriceFetcher(t)
+
+	t.Run("ticker is empty", func(t *testing.T) {
+		ticker := types.NewProviderTicker("", "")
+		_, err := fetcher.GetVault(ticker)
+		require.Error(t, err)
+	})
+
+	t.Run("ticker does not have valid metadata", func(t *testing.T) {
+		expected := erc4626.VaultConfig{
+			Address: "0x1234",
+		}
+		ticker := types.NewProviderTicker("SFRXETH/FRXETH", expected.MustToJSON())
+		_, err := fetcher.GetVault(ticker)
+		require.Error(t, err)
+	})
+
+	t.Run("ticker is not json formatted", func(t *testing.T) {
+		ticker := types.NewProviderTicker("SFRXETH/FRXETH", "not json, something else")
+		_, err := fetcher.GetVault(ticker)
+		require.Error(t, err)
+	})
+
+	t.Run("ticker has valid metadata", func(t *testing.T) {
+		vault, err := fetcher.GetVault(sfrxethTicker)
+		require.NoError(t, err)
+		require.Equal(t, sfrxethCfg, vault)
+	})
+}
+
+func TestParseAssets(t *testing.T) {
+	fetcher := createPriceFetcher(t)
+
+	t.Run("result does not map to a string pointer", func(t *testing.T) {
+		_, err := fetcher.ParseAssets(42)
+		require.Error(t, err)
+	})
+
+	t.Run("result is a nil string pointer", func(t *testing.T) {
+		_, err := fetcher.ParseAssets((*string)(nil))
+		require.Error(t, err)
+	})
+
+	t.Run("result cannot be unpacked by the erc4626 abi", func(t *testing.T) {
+		result := new(string)
+		*result = "0x1234"
+		_, err := fetcher.ParseAssets(result)
+		require.Error(t, err)
+	})
+
+	t.Run("valid result", func(t *testing.T) {
+		result := new(string)
+		*result = "0x0000000000000000000000000000000000000000000000000f43fc2c04ee0000"
+		assets, err := fetcher.ParseAssets(result)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(1_100_000_000_000_000_000), assets)
+	})
+}
+
+func TestNewPriceFetcher(t *testing.T) {
+	ctx := context.TODO()
+
+	testcases := []struct {
+		name   string
+		logger *zap.Logger
+		api    config.APIConfig
+		err    error
+	}{
+		{
+			name:   "no logger errors",
+			logger: nil,
+			err:    fmt.Errorf("logger cannot be nil"),
+		},
+		{
+			name:   "invalid api config errors",
+			logger: logger,
+			api: config.APIConfig{
+				Enabled: true,
+			},
+			err: fmt.Errorf("invalid api config: "),
+		},
+		{
+			name:   "invalid provider name errors",
+			logger: logger,
+			api: config.APIConfig{
+				Name: "erc4626_api-foobar",
+			},
+			err: fmt.Errorf("invalid api config name erc4626_api-foobar"),
+		},
+		{
+			name:   "disabled api config errors",
+			logger: logger,
+			api: config.APIConfig{
+				Name: "erc4626_api-ethereum",
+			},
+			err: fmt.Errorf("api config for erc4626_api-ethereum is not enabled"),
+		},
+		{
+			name:   "url success",
+			logger: logger,
+			api: config.APIConfig{
+				Enabled:          true,
+				Timeout:          1,
+				ReconnectTimeout: 1,
+				Interval:         1,
+				MaxQueries:       1,
+				Endpoints:        []config.Endpoint{{URL: "http://localhost:0"}},
+				Name:             "erc4626_api-ethereum",
+			},
+			err: nil,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			pf, err := erc4626.NewPriceFetcher(
+				ctx,
+				tc.logger,
+				metrics.NewNopAPIMetrics(),
+				tc.api,
+			)
+			if tc.err != nil {
+				require.ErrorContains(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, pf)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package erc4626_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/zoguxprotocol/slinky/oracle/types"
+	"github.com/zoguxprotocol/slinky/providers/apis/defi/erc4626"
+	"github.com/zoguxprotocol/slinky/providers/apis/defi/ethmulticlient"
+	"github.com/zoguxprotocol/slinky/providers/apis/defi/ethmulticlient/mocks"
+)
+
+var (
+	logger, _ = zap.NewDevelopment()
+
+	// VaultConfigs used for testing.
+	sfrxethCfg = erc4626.VaultConfig{
+		Address:       "0xac3E018457B222d93114458476f3E3416Abbe38F",
+		ShareDecimals: 18,
+		AssetDecimals: 18,
+	}
+	usdcVaultCfg = erc4626.VaultConfig{
+		Address:       "0x8ad599c3A0ff1De082011EFDDc58f1908eb6e6D8",
+		ShareDecimals: 18,
+		AssetDecimals: 6,
+	}
+
+	// Tickers used for testing.
+	sfrxethTicker   = types.NewProviderTicker("SFRXETH/FRXETH", sfrxethCfg.MustToJSON())
+	usdcVaultTicker = types.NewProviderTicker("VAULT/USDC", usdcVaultCfg.MustToJSON())
+)
+
+func createPriceFetcher(
+	t *testing.T,
+) *erc4626.PriceFetcher {
+	t.Helper()
+
+	client := mocks.NewEVMClient(t)
+	fetcher, err := erc4626.NewPriceFetcherWithClient(
+		logger,
+		erc4626.DefaultETHAPIConfig,
+		client,
+	)
+	require.NoError(t, err)
+
+	return fetcher
+}
+
+func createPriceFetcherWithClient(
+	t *testing.T,
+	client ethmulticlient.EVMClient,
+) *erc4626.PriceFetcher {
+	t.Helper()
+
+	fetcher, err := erc4626.NewPriceFetcherWithClient(
+		logger,
+		erc4626.DefaultETHAPIConfig,
+		client,
+	)
+	require.NoError(t, err)
+
+	return fetcher
+}
+
+func createEVMClientWithResponse(
+	t *testing.T,
+	failedRequestErr error,
+	responses []string,
+	errs []error,
+) ethmulticlient.EVMClient {
+	t.Helper()
+
+	c := mocks.NewEVMClient(t)
+	if failedRequestErr != nil {
+		c.On("BatchCallContext", mock.Anything, mock.Anything).Return(failedRequestErr)
+	} else {
+		c.On("BatchCallContext", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			elems, ok := args.Get(1).([]rpc.BatchElem)
+			require.True(t, ok)
+			require.Equal(t, len(elems), len(responses))
+			require.Equal(t, len(elems), len(errs))
+
+			for i, elem := range elems {
+				elem.Result = &responses[i]
+				elem.Error = errs[i]
+				elems[i] = elem
+			}
+		})
+	}
+
+	return c
+}
@@ -0,0 +1,123 @@
+package erc4626
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/zoguxprotocol/slinky/oracle/config"
+	"github.com/zoguxprotocol/slinky/oracle/constants"
+)
+
+const (
+	// BaseName is the name of the ERC-4626 API.
+	BaseName = "erc4626_api"
+
+	// NameSeparator is the character used to separate elements of dynamic naming for the provider.
+	NameSeparator = "-"
+
+	// ContractMethod is the contract method to call for the ERC-4626 API.
+	ContractMethod = "convertToAssets"
+
+	// VaultABI is the ABI of the ERC-4626 convertToAssets method. This is the only method of the
+	// vault contract that the provider calls.
+	VaultABI = `[{"inputs":[{"internalType":"uint256","name":"shares","type":"uint256"}],"name":"convertToAssets","outputs":[{"internalType":"uint256","name":"assets","type":"uint256"}],"stateMutability":"view","type":"function"}]`
+
+	// MaxDecimals is the maximum number of decimals of the share and asset tokens of a vault.
+	MaxDecimals = 36
+
+	// ETH_URL is the URL for the ERC-4626 API. This uses a free public RPC provider on Ethereum Mainnet.
+	ETH_URL = "https://eth.public-rpc.com/"
+
+	// BASE_URL is the URL for the ERC-4626 API. This uses a free public RPC provider on Base Mainnet.
+	BASE_URL = "https://mainnet.base.org"
+)
+
+// ProviderNames is the set of all supported "dynamic" names mapped by chain.
+var ProviderNames = map[string]string{
+	constants.ETHEREUM: strings.Join([]string{BaseName, constants.ETHEREUM}, NameSeparator),
+	constants.BASE:     strings.Join([]string{BaseName, constants.BASE}, NameSeparator),
+}
+
+// IsValidProviderName returns a bool based on the validity of the passed in name.
+// Dynamic provider naming is supported via `BaseName“NameSeparator“SupportedChain`.
+func IsValidProviderName(name string) bool {
+	for _, providerName := range ProviderNames {
+		if name == providerName {
+			return true
+		}
+	}
+	return false
+}
+
+// VaultConfig is the configuration for an ERC-4626 vault. This is specific to each liquid staking
+// or yield bearing token.
+type VaultConfig struct {
+	// Address is the ERC-4626 vault address i.e. the address of the share token.
+	Address string `json:"address"`
+	// ShareDecimals is the number of decimals for the share token. This should be derived from the
+	// vault contract.
+	ShareDecimals int64 `json:"share_decimals"`
+	// AssetDecimals is the number of decimals for the underlying asset token. This should be derived
+	// from the asset contract.
+	AssetDecimals int64 `json:"asset_decimals"`
+}
+
+// ValidateBasic validates the vault configuration.
+func (vc *VaultConfig) ValidateBasic() error {
+	if !common.IsHexAddress(vc.Address) {
+		return fmt.Errorf("vault address is not a valid ethereum address")
+	}
+
+	if vc.ShareDecimals < 0 || vc.ShareDecimals > MaxDecimals {
+		return fmt.Errorf("share decimals must be between 0 and %d", MaxDecimals)
+	}
+
+	if vc.AssetDecimals < 0 || vc.AssetDecimals > MaxDecimals {
+		return fmt.Errorf("asset decimals must be between 0 and %d", MaxDecimals)
+	}
+
+	return nil
+}
+
+// MustToJSON converts the vault configuration to JSON.
+func (vc *VaultConfig) MustToJSON() string {
+	b, err := json.Marshal(vc)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+var (
+	// DefaultETHAPIConfig is the default configuration for the ERC-4626 API. Specifically this is for
+	// Ethereum mainnet.
+	DefaultETHAPIConfig = config.APIConfig{
+		Name:              fmt.Sprintf("%s%s%s", BaseName, NameSeparator, constants.ETHEREUM),
+		Atomic:            true,
+		Enabled:           true,
+		Timeout:           1000 * time.Millisecond,
+		Interval:          10000 * time.Millisecond,
+		ReconnectTimeout:  2000 * time.Millisecond,
+		MaxQueries:        1,
+		Endpoints:         []config.Endpoint{{URL: ETH_URL}},
+		MaxBlockHeightAge: 30 * time.Second,
+	}
+
+	// DefaultBaseAPIConfig is the default configuration for the ERC-4626 API. Specifically this is for
+	// Base mainnet.
+	DefaultBaseAPIConfig = config.APIConfig{
+		Name:              fmt.Sprintf("%s%s%s", BaseName, NameSeparator, constants.BASE),
+		Atomic:            true,
+		Enabled:           true,
+		Timeout:           1000 * time.Millisecond,
+		Interval:          10000 * time.Millisecond,
+		ReconnectTimeout:  2000 * time.Millisecond,
+		MaxQueries:        1,
+		Endpoints:         []config.Endpoint{{URL: BASE_URL}},
+		MaxBlockHeightAge: 30 * time.Second,
+	}
+)
@@ -0,0 +1,57 @@
+package erc4626_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zoguxprotocol/slinky/oracle/constants"
+	"github.com/zoguxprotocol/slinky/providers/apis/defi/erc4626"
+)
+
+func TestVaultConfig(t *testing.T) {
+	t.Run("empty config", func(t *testing.T) {
+		cfg := erc4626.VaultConfig{}
+		require.Error(t, cfg.ValidateBasic())
+	})
+
+	t.Run("invalid address", func(t *testing.T) {
+		cfg := erc4626.VaultConfig{
+			Address: "invalid",
+		}
+		require.Error(t, cfg.ValidateBasic())
+	})
+
+	t.Run("invalid share decimals", func(t *testing.T) {
+		cfg := erc4626.VaultConfig{
+			Address:       "0xac3E018457B222d93114458476f3E3416Abbe38F",
+			ShareDecimals: -1,
+		}
+		require.Error(t, cfg.ValidateBasic())
+	})
+
+	t.Run("too many asset decimals", func(t *testing.T) {
+		cfg := erc4626.VaultConfig{
+			Address:       "0xac3E018457B222d93114458476f3E3416Abbe38F",
+			ShareDecimals: 18,
+			AssetDecimals: erc4626.MaxDecimals + 1,
+		}
+		require.Error(t, cfg.ValidateBasic())
+	})
+
+	t.Run("valid config", func(t *testing.T) {
+		cfg := erc4626.VaultConfig{
+			Address:       "0xac3E018457B222d93114458476f3E3416Abbe38F",
+			ShareDecimals: 18,
+			AssetDecimals: 18,
+		}
+		require.NoError(t, cfg.ValidateBasic())
+	})
+}
+
+func TestIsValidProviderName(t *testing.T) {
+	require.True(t, erc4626.IsValidProviderName(erc4626.ProviderNames[constants.ETHEREUM]))
+	require.True(t, erc4626.IsValidProviderName(erc4626.ProviderNames[constants.BASE]))
+	require.False(t, erc4626.IsValidProviderName(erc4626.BaseName))
+	require.False(t, erc4626.IsValidProviderName("erc4626_api-foobar"))
+}
@@ -0,0 +1,96 @@
+package generic
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/zoguxprotocol/slinky/oracle/config"
+	"github.com/zoguxprotocol/slinky/oracle/types"
+	slinkyjson "github.com/zoguxprotocol/slinky/pkg/json"
+	providertypes "github.com/zoguxprotocol/slinky/providers/types"
+)
+
+var _ types.PriceAPIDataHandler = (*APIHandler)(nil)
+
+// APIHandler implements the PriceAPIDataHandler interface for any REST API that returns prices as JSON. The URL
+// of the requests is the template of the first endpoint of the API config, expanded with the off-chain tickers
+// (see ExpandTemplate). If the template contains {tickers}, the tickers are batched according to the batch size
+// or atomicity of the API config. The price of each ticker is extracted from the response as described by the
+// metadata JSON of its provider config (see TickerMetadata).
+type APIHandler struct {
+	// api is the config for the API.
+	api config.APIConfig
+	// metadata is the cache of the compiled metadata of the tickers.
+	metadata *MetadataCache
+}
+
+// NewAPIHandler returns a new generic PriceAPIDataHandler.
+func NewAPIHandler(
+	api config.APIConfig,
+) (types.PriceAPIDataHandler, error) {
+	if !IsGenericProvider(api.Name) {
+		return nil, fmt.Errorf("expected api config name to start with %s%s, got %s", BaseName, NameSeparator, api.Name)
+	}
+
+	if !api.Enabled {
+		return nil, fmt.Errorf("api config for %s is not enabled", api.Name)
+	}
+
+	if err := api.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid api config for %s: %w", api.Name, err)
+	}
+
+	template := api.Endpoints[0].URL
+	if !HasPlaceholder(template) {
+		return nil, fmt.Errorf("url of %s must contain a {ticker} or {tickers} placeholder", api.Name)
+	}
+	if _, err := ExpandTemplate(template, []string{"TICKER"}); err != nil {
+		return nil, fmt.Errorf("invalid url for %s: %w", api.Name, err)
+	}
+
+	if !IsBatchTemplate(template) && (api.Atomic || api.BatchSize > 1) {
+		return nil, fmt.Errorf("url of %s must use {tickers} instead of {ticker} to batch requests", api.Name)
+	}
+
+	return &APIHandler{
+		api:      api,
+		metadata: NewMetadataCache(),
+	}, nil
+}
+
+// CreateURL returns the URL that is used to fetch the prices of the given tickers.
+func (h *APIHandler) CreateURL(
+	tickers []types.ProviderTicker,
+) (string, error) {
+	offChainTickers := make([]string, len(tickers))
+	for i, ticker := range tickers {
+		offChainTickers[i] = ticker.GetOffChainTicker()
+	}
+
+	return ExpandTemplate(h.api.Endpoints[0].URL, offChainTickers)
+}
+
+// ParseResponse parses the HTTP response and extracts the price of each ticker as described by its metadata.
+func (h *APIHandler) ParseResponse(
+	tickers []types.ProviderTicker,
+	resp *http.Response,
+) types.PriceResponse {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return types.NewPriceResponseWithErr(
+			tickers,
+			providertypes.NewErrorWithCode(err, providertypes.ErrorFailedToDecode),
+		)
+	}
+
+	doc, err := slinkyjson.Decode(body)
+	if err != nil {
+		return types.NewPriceResponseWithErr(
+			tickers,
+			providertypes.NewErrorWithCode(err, providertypes.ErrorFailedToDecode),
+		)
+	}
+
+	return h.metadata.ParsePrices(tickers, doc)
+}
@@ -0,0 +1,277 @@
+package generic_test
+
+import (
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zoguxprotocol/slinky/oracle/config"
+	"github.com/zoguxprotocol/slinky/oracle/types"
+	"github.com/zoguxprotocol/slinky/providers/apis/generic"
+	"github.com/zoguxprotocol/slinky/providers/base/testutils"
+	providertypes "github.com/zoguxprotocol/slinky/providers/types"
+)
+
+const (
+	batchURL  = "https://api.example.com/v1/tickers?symbols=[{tickers|upper|quoted}]"
+	singleURL = "https://api.example.com/v1/ticker/{ticker|lower}"
+)
+
+var (
+	btcusdt = types.NewProviderTicker(
+		"BTCUSDT",
+		`{"price_path":"$.data[?(@.symbol==\"{ticker}\")].last","timestamp_path":"$.data[?(@.symbol==\"{ticker}\")].ts"}`,
+	)
+	ethusdt = types.NewProviderTicker(
+		"ETHUSDT",
+		`{"price_path":"$.data[?(@.symbol==\"{ticker}\")].last"}`,
+	)
+	usdtbtc = types.NewProviderTicker(
+		"BTCUSDT",
+		`{"price_path":"$.data[0].last","invert":true}`,
+	)
+	noMetadata = types.NewProviderTicker("SOLUSDT", "")
+)
+
+func apiConfig(url string, batchSize int) config.APIConfig {
+	return config.APIConfig{
+		Name:             generic.BaseName + generic.NameSeparator + "example",
+		Enabled:          true,
+		Timeout:          3000 * time.Millisecond,
+		Interval:         100 * time.Millisecond,
+		ReconnectTimeout: 2000 * time.Millisecond,
+		MaxQueries:       1,
+		BatchSize:        batchSize,
+		Endpoints:        []config.Endpoint{{URL: url}},
+	}
+}
+
+func TestNewAPIHandler(t *testing.T) {
+	testCases := []struct {
+		name        string
+		cfg         func() config.APIConfig
+		expectedErr bool
+	}{
+		{
+			name: "valid batch",
+			cfg: func() config.APIConfig {
+				return apiConfig(batchURL, 10)
+			},
+		},
+		{
+			name: "valid single",
+			cfg: func() config.APIConfig {
+				return apiConfig(singleURL, 0)
+			},
+		},
+		{
+			name: "invalid name",
+			cfg: func() config.APIConfig {
+				cfg := apiConfig(batchURL, 10)
+				cfg.Name = generic.BaseName
+				return cfg
+			},
+			expectedErr: true,
+		},
+		{
+			name: "disabled",
+			cfg: func() config.APIConfig {
+				cfg := apiConfig(batchURL, 10)
+				cfg.Enabled = false
+				return cfg
+			},
+			expectedErr: true,
+		},
+		{
+			name: "no placeholder",
+			cfg: func() config.APIConfig {
+				return apiConfig("https://api.example.com/v1/tickers", 0)
+			},
+			expectedErr: true,
+		},
+		{
+			name: "unknown modifier",
+			cfg: func() config.APIConfig {
+				return apiConfig("https://api.example.com/v1/ticker/{ticker|title}", 0)
+			},
+			expectedErr: true,
+		},
+		{
+			name: "batching a single ticker template",
+			cfg: func() config.APIConfig {
+				return apiConfig(singleURL, 10)
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := generic.NewAPIHandler(tc.cfg())
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCreateURL(t *testing.T) {
+	testCases := []struct {
+		name        string
+		url         string
+		batchSize   int
+		tickers     []types.ProviderTicker
+		expected    string
+		expectedErr bool
+	}{
+		{
+			name:      "batch",
+			url:       batchURL,
+			batchSize: 10,
+			tickers:   []types.ProviderTicker{btcusdt, ethusdt},
+			expected:  `https://api.example.com/v1/tickers?symbols=["BTCUSDT","ETHUSDT"]`,
+		},
+		{
+			name:     "single",
+			url:      singleURL,
+			tickers:  []types.ProviderTicker{btcusdt},
+			expected: "https://api.example.com/v1/ticker/btcusdt",
+		},
+		{
+			name:        "single with multiple tickers",
+			url:         singleURL,
+			tickers:     []types.ProviderTicker{btcusdt, ethusdt},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, err := generic.NewAPIHandler(apiConfig(tc.url, tc.batchSize))
+			require.NoError(t, err)
+
+			url, err := h.CreateURL(tc.tickers)
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, url)
+			}
+		})
+	}
+}
+
+func TestParseResponse(t *testing.T) {
+	testCases := []struct {
+		name      string
+		tickers   []types.ProviderTicker
+		response  *http.Response
+		expected  types.ResolvedPrices
+		timestamp time.Time
+		codes     map[types.ProviderTicker]providertypes.ErrorCode
+	}{
+		{
+			name:    "batch",
+			tickers: []types.ProviderTicker{btcusdt, ethusdt},
+			response: testutils.CreateResponseFromJSON(`
+{
+	"data": [
+		{"symbol": "ETHUSDT", "last": "2500.5"},
+		{"symbol": "BTCUSDT", "last": 42000.25, "ts": 1704067200000}
+	]
+}
+			`),
+			expected: types.ResolvedPrices{
+				btcusdt: {Value: big.NewFloat(42000.25)},
+				ethusdt: {Value: big.NewFloat(2500.5)},
+			},
+			timestamp: time.UnixMilli(1704067200000).UTC(),
+		},
+		{
+			name:    "inverted",
+			tickers: []types.ProviderTicker{usdtbtc},
+			response: testutils.CreateResponseFromJSON(`
+{"data": [{"symbol": "BTCUSDT", "last": "40000"}]}
+			`),
+			expected: types.ResolvedPrices{
+				usdtbtc: {Value: big.NewFloat(0.000025)},
+			},
+		},
+		{
+			name:    "missing ticker, invalid price and metadata",
+			tickers: []types.ProviderTicker{btcusdt, ethusdt, noMetadata},
+			response: testutils.CreateResponseFromJSON(`
+{"data": [{"symbol": "ETHUSDT", "last": "0"}]}
+			`),
+			expected: types.ResolvedPrices{},
+			codes: map[types.ProviderTicker]providertypes.ErrorCode{
+				btcusdt:    providertypes.ErrorFailedToParsePrice,
+				ethusdt:    providertypes.ErrorFailedToParsePrice,
+				noMetadata: providertypes.ErrorTickerMetadataNotFound,
+			},
+		},
+		{
+			name:    "infinite price and timestamp",
+			tickers: []types.ProviderTicker{btcusdt, ethusdt},
+			response: testutils.CreateResponseFromJSON(`
+{
+	"data": [
+		{"symbol": "ETHUSDT", "last": "Inf"},
+		{"symbol": "BTCUSDT", "last": "42000.25", "ts": "+Inf"}
+	]
+}
+			`),
+			expected: types.ResolvedPrices{},
+			codes: map[types.ProviderTicker]providertypes.ErrorCode{
+				btcusdt: providertypes.ErrorFailedToParsePrice,
+				ethusdt: providertypes.ErrorFailedToParsePrice,
+			},
+		},
+		{
+			name:     "malformed response",
+			tickers:  []types.ProviderTicker{btcusdt},
+			response: testutils.CreateResponseFromJSON(`{"data": [`),
+			expected: types.ResolvedPrices{},
+			codes: map[types.ProviderTicker]providertypes.ErrorCode{
+				btcusdt: providertypes.ErrorFailedToDecode,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, err := generic.NewAPIHandler(apiConfig(batchURL, 10))
+			require.NoError(t, err)
+
+			now := time.Now()
+			resp := h.ParseResponse(tc.tickers, tc.response)
+
+			require.Len(t, resp.Resolved, len(tc.expected))
+			require.Len(t, resp.UnResolved, len(tc.codes))
+
+			for ticker, result := range tc.expected {
+				require.Contains(t, resp.Resolved, ticker)
+				r := resp.Resolved[ticker]
+				expected, _ := result.Value.Float64()
+				actual, _ := r.Value.Float64()
+				require.InEpsilon(t, expected, actual, 1e-12)
+
+				if ticker == btcusdt && !tc.timestamp.IsZero() {
+					require.Equal(t, tc.timestamp, r.Timestamp)
+				} else {
+					require.False(t, r.Timestamp.Before(now))
+				}
+			}
+
+			for ticker, code := range tc.codes {
+				require.Contains(t, resp.UnResolved, ticker)
+				require.Equal(t, code, resp.UnResolved[ticker].Code())
+			}
+		})
+	}
+}
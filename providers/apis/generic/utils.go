@@ -0,0 +1,339 @@
+package generic
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zoguxprotocol/slinky/oracle/types"
+	slinkyjson "github.com/zoguxprotocol/slinky/pkg/json"
+	providertypes "github.com/zoguxprotocol/slinky/providers/types"
+)
+
+// NOTE: The generic API provider queries any REST API that returns prices as JSON. It is configured entirely by
+// the API config of the provider, and the metadata JSON of each of its provider configs in the market map.
+// Multiple generic providers can be configured, e.g. one per exchange, each named `generic_api-<exchange>`.
+
+const (
+	// BaseName is the prefix of the name of every generic API provider.
+	BaseName = "generic_api"
+
+	// NameSeparator is the separator between the base name and the exchange name of a generic API provider.
+	NameSeparator = "-"
+)
+
+// IsGenericProvider returns true if the given provider name is the name of a generic API provider.
+func IsGenericProvider(name string) bool {
+	return strings.HasPrefix(name, BaseName+NameSeparator) && len(name) > len(BaseName+NameSeparator)
+}
+
+// placeholderRegex matches the {ticker} and {tickers} placeholders of a template, along with their modifiers,
+// e.g. {tickers|lower|quoted}.
+var placeholderRegex = regexp.MustCompile(`\{(ticker|tickers)((?:\|[a-z]+)*)\}`)
+
+// ExpandTemplate replaces the placeholders of the template with the off-chain tickers of the given tickers:
+//
+//   - {ticker} is replaced with the off-chain ticker, and requires exactly one ticker.
+//   - {tickers} is replaced with the off-chain tickers, separated by commas.
+//
+// Each placeholder can be followed by modifiers, which are applied to every ticker in order: |lower and |upper
+// change the case of the ticker, and |quoted surrounds it with double quotes. For example, the template
+// `symbols=[{tickers|upper|quoted}]` is expanded to `symbols=["BTCUSDT","ETHUSDT"]`.
+func ExpandTemplate(template string, tickers []string) (string, error) {
+	var err error
+	expanded := placeholderRegex.ReplaceAllStringFunc(template, func(placeholder string) string {
+		match := placeholderRegex.FindStringSubmatch(placeholder)
+		name, modifiers := match[1], strings.Split(strings.TrimPrefix(match[2], "|"), "|")
+
+		if name == "ticker" && len(tickers) != 1 {
+			err = fmt.Errorf("template %q expects a single ticker, got %d", template, len(tickers))
+			return placeholder
+		}
+
+		formatted := make([]string, len(tickers))
+		for i, ticker := range tickers {
+			for _, modifier := range modifiers {
+				switch modifier {
+				case "":
+				case "lower":
+					ticker = strings.ToLower(ticker)
+				case "upper":
+					ticker = strings.ToUpper(ticker)
+				case "quoted":
+					ticker = strconv.Quote(ticker)
+				default:
+					err = fmt.Errorf("unknown modifier %q in template %q", modifier, template)
+				}
+			}
+			formatted[i] = ticker
+		}
+
+		return strings.Join(formatted, ",")
+	})
+
+	return expanded, err
+}
+
+// HasPlaceholder returns true if the template contains a {ticker} or {tickers} placeholder.
+func HasPlaceholder(template string) bool {
+	return placeholderRegex.MatchString(template)
+}
+
+// IsBatchTemplate returns true if the template can be expanded with multiple tickers.
+func IsBatchTemplate(template string) bool {
+	for _, match := range placeholderRegex.FindAllStringSubmatch(template, -1) {
+		if match[1] == "ticker" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ParseTimestamp parses a timestamp selected in a response. Numeric timestamps are interpreted as unix seconds,
+// milliseconds, microseconds or nanoseconds based on their magnitude. Other strings must be RFC3339 timestamps.
+func ParseTimestamp(value any) (time.Time, error) {
+	if s, ok := value.(string); ok {
+		if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+			return t.UTC(), nil
+		}
+	}
+
+	f, err := slinkyjson.ToBigFloat(value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timestamp: %w", err)
+	}
+	if f.IsInf() {
+		return time.Time{}, fmt.Errorf("invalid timestamp %s", f.String())
+	}
+
+	ts, _ := f.Float64()
+	switch {
+	case ts <= 0:
+		return time.Time{}, fmt.Errorf("invalid timestamp %s", f.String())
+	case ts < 1e11:
+		return time.Unix(0, int64(ts*float64(time.Second))).UTC(), nil
+	case ts < 1e14:
+		return time.UnixMilli(int64(ts)).UTC(), nil
+	case ts < 1e17:
+		return time.UnixMicro(int64(ts)).UTC(), nil
+	default:
+		return time.Unix(0, int64(ts)).UTC(), nil
+	}
+}
+
+// TickerMetadata is the metadata JSON of the provider config of a market for a generic provider. It describes
+// where the price of the market is in a response.
+type TickerMetadata struct {
+	// PricePath is the JSONPath-style path to the price in a response, e.g. `$.data[?(@.symbol=="{ticker}")].last`.
+	// The path is expanded like a template with the off-chain ticker. Numbers and numeric strings are supported.
+	PricePath string `json:"price_path"`
+	// TimestampPath is the optional path to the time of the price in a response. If empty, the price is timestamped
+	// with the time at which the response was received.
+	TimestampPath string `json:"timestamp_path,omitempty"`
+	// Invert inverts the price, for exchanges that quote the market the other way around.
+	Invert bool `json:"invert,omitempty"`
+}
+
+// ParseTickerMetadata parses and validates the metadata JSON of the given ticker.
+func ParseTickerMetadata(ticker types.ProviderTicker) (TickerMetadata, error) {
+	var metadata TickerMetadata
+	if err := unmarshalStrict(ticker.GetJSON(), &metadata); err != nil {
+		return metadata, fmt.Errorf("invalid metadata for ticker %s: %w", ticker.GetOffChainTicker(), err)
+	}
+
+	if err := metadata.ValidateBasic(); err != nil {
+		return metadata, fmt.Errorf("invalid metadata for ticker %s: %w", ticker.GetOffChainTicker(), err)
+	}
+
+	return metadata, nil
+}
+
+// ValidateBasic checks that the paths of the metadata are valid.
+func (m TickerMetadata) ValidateBasic() error {
+	if m.PricePath == "" {
+		return fmt.Errorf("price_path cannot be empty")
+	}
+
+	for _, path := range []string{m.PricePath, m.TimestampPath} {
+		if path == "" {
+			continue
+		}
+
+		// placeholders are expanded with a dummy ticker to validate the syntax of the path.
+		expanded, err := ExpandTemplate(path, []string{"TICKER"})
+		if err != nil {
+			return err
+		}
+		if _, err := slinkyjson.CompilePath(expanded); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Compile expands the paths of the metadata with the given off-chain ticker and compiles them.
+func (m TickerMetadata) Compile(offChainTicker string) (CompiledTickerMetadata, error) {
+	pricePath, err := compile(m.PricePath, offChainTicker)
+	if err != nil {
+		return CompiledTickerMetadata{}, err
+	}
+
+	var timestampPath *slinkyjson.Path
+	if m.TimestampPath != "" {
+		path, err := compile(m.TimestampPath, offChainTicker)
+		if err != nil {
+			return CompiledTickerMetadata{}, err
+		}
+
+		timestampPath = &path
+	}
+
+	return CompiledTickerMetadata{
+		pricePath:     pricePath,
+		timestampPath: timestampPath,
+		invert:        m.Invert,
+	}, nil
+}
+
+// CompiledTickerMetadata is the TickerMetadata of a ticker with its paths expanded with the off-chain ticker
+// and compiled, so that the price of the ticker can be extracted from any number of responses.
+type CompiledTickerMetadata struct {
+	// pricePath is the compiled path to the price.
+	pricePath slinkyjson.Path
+	// timestampPath is the compiled path to the time of the price, if any.
+	timestampPath *slinkyjson.Path
+	// invert inverts the price.
+	invert bool
+}
+
+// Extract returns the price of the ticker in the decoded response, along with its timestamp. The timestamp is
+// zero if the metadata has no timestamp path.
+func (m CompiledTickerMetadata) Extract(doc any) (*big.Float, time.Time, error) {
+	value, err := m.pricePath.Lookup(doc)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	price, err := slinkyjson.ToBigFloat(value)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("invalid price: %w", err)
+	}
+	if price.Sign() <= 0 || price.IsInf() {
+		return nil, time.Time{}, fmt.Errorf("price must be positive and finite, got %s", price.String())
+	}
+	if m.invert {
+		price = new(big.Float).Quo(big.NewFloat(1), price)
+	}
+
+	var timestamp time.Time
+	if m.timestampPath != nil {
+		value, err := m.timestampPath.Lookup(doc)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+
+		if timestamp, err = ParseTimestamp(value); err != nil {
+			return nil, time.Time{}, err
+		}
+	}
+
+	return price, timestamp, nil
+}
+
+// MetadataCache is a thread safe cache of the tickers to their compiled metadata. This is used to avoid
+// unmarshalling, validating and compiling the metadata of a ticker for each response.
+type MetadataCache struct {
+	mtx sync.Mutex
+
+	cache map[types.ProviderTicker]CompiledTickerMetadata
+}
+
+// NewMetadataCache returns a new, empty MetadataCache.
+func NewMetadataCache() *MetadataCache {
+	return &MetadataCache{
+		cache: make(map[types.ProviderTicker]CompiledTickerMetadata),
+	}
+}
+
+// Get returns the compiled metadata of the given ticker. The metadata is parsed, validated and compiled the first
+// time a ticker is seen. Invalid metadata is not cached.
+func (c *MetadataCache) Get(ticker types.ProviderTicker) (CompiledTickerMetadata, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if metadata, ok := c.cache[ticker]; ok {
+		return metadata, nil
+	}
+
+	metadata, err := ParseTickerMetadata(ticker)
+	if err != nil {
+		return CompiledTickerMetadata{}, err
+	}
+
+	compiled, err := metadata.Compile(ticker.GetOffChainTicker())
+	if err != nil {
+		return CompiledTickerMetadata{}, fmt.Errorf("invalid metadata for ticker %s: %w", ticker.GetOffChainTicker(), err)
+	}
+
+	c.cache[ticker] = compiled
+	return compiled, nil
+}
+
+// ParsePrices extracts the price of each of the given tickers from the decoded response, as described by its
+// metadata. The prices of tickers that are not in the response are unresolved.
+func (c *MetadataCache) ParsePrices(tickers []types.ProviderTicker, doc any) types.PriceResponse {
+	var (
+		resolved   = make(types.ResolvedPrices)
+		unresolved = make(types.UnResolvedPrices)
+		now        = time.Now().UTC()
+	)
+
+	for _, ticker := range tickers {
+		metadata, err := c.Get(ticker)
+		if err != nil {
+			unresolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(err, providertypes.ErrorTickerMetadataNotFound),
+			}
+			continue
+		}
+
+		price, timestamp, err := metadata.Extract(doc)
+		if err != nil {
+			unresolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(err, providertypes.ErrorFailedToParsePrice),
+			}
+			continue
+		}
+
+		if timestamp.IsZero() {
+			timestamp = now
+		}
+		resolved[ticker] = types.NewPriceResult(price, timestamp)
+	}
+
+	return types.NewPriceResponse(resolved, unresolved)
+}
+
+// unmarshalStrict unmarshals the JSON into v, rejecting unknown fields so that typos in the metadata are caught.
+func unmarshalStrict(data string, v any) error {
+	dec := json.NewDecoder(strings.NewReader(data))
+	dec.DisallowUnknownFields()
+	return dec.Decode(v)
+}
+
+// compile expands the template of a path with the off-chain ticker and compiles it.
+func compile(template, offChainTicker string) (slinkyjson.Path, error) {
+	expanded, err := ExpandTemplate(template, []string{offChainTicker})
+	if err != nil {
+		return slinkyjson.Path{}, err
+	}
+
+	return slinkyjson.CompilePath(expanded)
+}
@@ -0,0 +1,143 @@
+package generic_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zoguxprotocol/slinky/oracle/types"
+	"github.com/zoguxprotocol/slinky/providers/apis/generic"
+)
+
+func TestIsGenericProvider(t *testing.T) {
+	require.True(t, generic.IsGenericProvider("generic_api-binance"))
+	require.False(t, generic.IsGenericProvider("generic_api-"))
+	require.False(t, generic.IsGenericProvider("generic_api"))
+	require.False(t, generic.IsGenericProvider("binance_api"))
+}
+
+func TestExpandTemplate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		template    string
+		tickers     []string
+		expected    string
+		expectedErr bool
+	}{
+		{
+			name:     "single ticker",
+			template: "/ticker/{ticker}",
+			tickers:  []string{"BTC-USD"},
+			expected: "/ticker/BTC-USD",
+		},
+		{
+			name:     "multiple tickers with modifiers",
+			template: "/tickers?symbols={tickers|lower}",
+			tickers:  []string{"BTC-USD", "ETH-USD"},
+			expected: "/tickers?symbols=btc-usd,eth-usd",
+		},
+		{
+			name:     "chained modifiers",
+			template: "[{tickers|upper|quoted}]",
+			tickers:  []string{"btc", "eth"},
+			expected: `["BTC","ETH"]`,
+		},
+		{
+			name:        "single ticker placeholder with multiple tickers",
+			template:    "/ticker/{ticker}",
+			tickers:     []string{"BTC-USD", "ETH-USD"},
+			expectedErr: true,
+		},
+		{
+			name:        "unknown modifier",
+			template:    "/ticker/{ticker|reverse}",
+			tickers:     []string{"BTC-USD"},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			expanded, err := generic.ExpandTemplate(tc.template, tc.tickers)
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, expanded)
+			}
+		})
+	}
+}
+
+func TestParseTimestamp(t *testing.T) {
+	expected := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, value := range []any{
+		"2024-01-01T00:00:00Z",
+		"1704067200",
+		float64(1704067200),
+		"1704067200000",
+		"1704067200000000",
+		"1704067200000000000",
+	} {
+		ts, err := generic.ParseTimestamp(value)
+		require.NoError(t, err, value)
+		require.Equal(t, expected, ts, value)
+	}
+
+	for _, value := range []any{"yesterday", "-1", "Inf", true} {
+		_, err := generic.ParseTimestamp(value)
+		require.Error(t, err, value)
+	}
+}
+
+func TestMetadataCache(t *testing.T) {
+	doc := map[string]any{
+		"data": []any{
+			map[string]any{"symbol": "BTC-USD", "last": "70000"},
+			map[string]any{"symbol": "ETH-USD", "last": "3000"},
+		},
+	}
+
+	t.Run("compiles the metadata of each ticker once", func(t *testing.T) {
+		cache := generic.NewMetadataCache()
+		ticker := types.NewProviderTicker("BTC-USD", `{"price_path":"$.data[?(@.symbol==\"{ticker}\")].last"}`)
+
+		first, err := cache.Get(ticker)
+		require.NoError(t, err)
+		second, err := cache.Get(ticker)
+		require.NoError(t, err)
+		require.Equal(t, first, second)
+
+		price, timestamp, err := second.Extract(doc)
+		require.NoError(t, err)
+		require.Equal(t, big.NewFloat(70_000).String(), price.String())
+		require.True(t, timestamp.IsZero())
+	})
+
+	t.Run("tickers with different metadata are compiled separately", func(t *testing.T) {
+		cache := generic.NewMetadataCache()
+
+		resp := cache.ParsePrices([]types.ProviderTicker{
+			types.NewProviderTicker("BTC-USD", `{"price_path":"$.data[?(@.symbol==\"{ticker}\")].last"}`),
+			types.NewProviderTicker("ETH-USD", `{"price_path":"$.data[?(@.symbol==\"{ticker}\")].last","invert":true}`),
+		}, doc)
+		require.Len(t, resp.Resolved, 2)
+		require.Empty(t, resp.UnResolved)
+	})
+
+	t.Run("invalid metadata is not cached", func(t *testing.T) {
+		cache := generic.NewMetadataCache()
+		ticker := types.NewProviderTicker("BTC-USD", `{"price_path":"$.data["}`)
+
+		_, err := cache.Get(ticker)
+		require.Error(t, err)
+		_, err = cache.Get(ticker)
+		require.Error(t, err)
+
+		resp := cache.ParsePrices([]types.ProviderTicker{ticker}, doc)
+		require.Len(t, resp.UnResolved, 1)
+	})
+}
@@ -0,0 +1,527 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/zoguxprotocol/slinky/oracle/config"
+)
+
+const (
+	// DefaultEndpointBackoff is the default initial amount of time an endpoint is not used
+	// after it rate limits the provider or repeatedly fails.
+	DefaultEndpointBackoff = 5 * time.Second
+	// DefaultMaxEndpointBackoff is the default maximum amount of time an endpoint is not used.
+	DefaultMaxEndpointBackoff = 2 * time.Minute
+	// DefaultEndpointFailureThreshold is the default number of consecutive failures after
+	// which an endpoint is backed off.
+	DefaultEndpointFailureThreshold = 3
+
+	// endpointScoreDecay is the weight of the latest sample in the latency and error rate
+	// moving averages of an endpoint.
+	endpointScoreDecay = 0.3
+	// endpointErrorPenalty scales the latency of an endpoint by its error rate when scoring it.
+	endpointErrorPenalty = 4
+)
+
+// ErrNoAvailableEndpoints is returned by the EndpointPool when all endpoints are backing off.
+var ErrNoAvailableEndpoints = errors.New("all endpoints are backing off")
+
+var _ RequestHandler = (*EndpointPool)(nil)
+
+// EndpointHealth is a snapshot of the health of a single endpoint in an EndpointPool.
+type EndpointHealth struct {
+	// URL is the endpoint's URL.
+	URL string
+	// Sampled is true if a request was made to the endpoint.
+	Sampled bool
+	// Latency is the moving average of the endpoint's response latency.
+	Latency time.Duration
+	// ErrorRate is the moving average of the endpoint's failure rate, in [0, 1].
+	ErrorRate float64
+	// RateLimits is the number of times the endpoint rate limited the provider.
+	RateLimits uint64
+	// BackoffUntil is the time until which the endpoint is not used.
+	BackoffUntil time.Time
+}
+
+// endpointState tracks the health of a single endpoint in an EndpointPool.
+type endpointState struct {
+	// url is the endpoint's URL.
+	url string
+	// prefix is the endpoint's URL up to its first formatting verb. It is used to rewrite
+	// URLs created for one endpoint to another.
+	prefix string
+	// handler makes requests to the endpoint with the endpoint's authentication headers.
+	handler RequestHandler
+
+	latency             time.Duration
+	errorRate           float64
+	sampled             bool
+	rateLimits          uint64
+	consecutiveFailures int
+	backoffs            int
+	backoffUntil        time.Time
+	lastUsed            time.Time
+}
+
+// score returns the score of the endpoint. Lower is better.
+func (e *endpointState) score() float64 {
+	return float64(e.latency) * (1 + endpointErrorPenalty*e.errorRate)
+}
+
+// EndpointPool is a RequestHandler that spreads requests across all of the endpoints
+// configured for an API provider. It tracks the latency, error rate and rate limits of
+// each endpoint, and sends each request to the healthiest endpoint. Endpoints that rate
+// limit the provider (HTTP 429) or repeatedly fail are backed off exponentially. Failed
+// requests are retried on the next healthiest endpoint, and slow requests can be hedged
+// by sending them to a second endpoint.
+//
+// APIDataHandlers create URLs from the first configured endpoint. The pool rewrites each
+// URL onto the selected endpoint by replacing the prefix of the first endpoint's URL (up
+// to its first formatting verb, if any) with the selected endpoint's. As such, all
+// endpoints must expose the same API.
+type EndpointPool struct {
+	mtx    sync.Mutex
+	logger *zap.Logger
+
+	endpoints []*endpointState
+	method    string
+
+	hedgeDelay       time.Duration
+	backoff          time.Duration
+	maxBackoff       time.Duration
+	failureThreshold int
+	requestOpts      []Option
+}
+
+// EndpointPoolOption is a function that is used to configure an EndpointPool.
+type EndpointPoolOption func(*EndpointPool)
+
+// WithHedgeDelay configures the pool to send a request to the next healthiest endpoint if
+// the current endpoint has not responded within the delay. The first successful response
+// is used. Hedging is disabled if the delay is zero (the default).
+func WithHedgeDelay(delay time.Duration) EndpointPoolOption {
+	return func(p *EndpointPool) {
+		p.hedgeDelay = delay
+	}
+}
+
+// WithEndpointBackoff configures the initial and maximum amount of time an endpoint is not
+// used after it rate limits the provider or repeatedly fails.
+func WithEndpointBackoff(backoff, maxBackoff time.Duration) EndpointPoolOption {
+	return func(p *EndpointPool) {
+		p.backoff = backoff
+		p.maxBackoff = maxBackoff
+	}
+}
+
+// WithEndpointFailureThreshold configures the number of consecutive failures after which
+// an endpoint is backed off.
+func WithEndpointFailureThreshold(threshold int) EndpointPoolOption {
+	return func(p *EndpointPool) {
+		p.failureThreshold = threshold
+	}
+}
+
+// WithRequestOptions configures the options used to create the request handler of each
+// endpoint, e.g. the HTTP method and headers.
+func WithRequestOptions(opts ...Option) EndpointPoolOption {
+	return func(p *EndpointPool) {
+		p.requestOpts = append(p.requestOpts, opts...)
+	}
+}
+
+// NewEndpointPool creates a new EndpointPool for the endpoints of the given API config.
+// Requests to each endpoint are authenticated with the endpoint's authentication.
+func NewEndpointPool(
+	logger *zap.Logger,
+	client *http.Client,
+	cfg config.APIConfig,
+	opts ...EndpointPoolOption,
+) (*EndpointPool, error) {
+	if logger == nil {
+		return nil, fmt.Errorf("logger cannot be nil")
+	}
+
+	if client == nil {
+		return nil, fmt.Errorf("http client cannot be nil")
+	}
+
+	if len(cfg.Endpoints) == 0 {
+		return nil, fmt.Errorf("endpoints cannot be empty")
+	}
+
+	p := &EndpointPool{
+		logger:           logger.With(zap.String("endpoint_pool", cfg.Name)),
+		backoff:          DefaultEndpointBackoff,
+		maxBackoff:       DefaultMaxEndpointBackoff,
+		failureThreshold: DefaultEndpointFailureThreshold,
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	if p.hedgeDelay < 0 {
+		return nil, fmt.Errorf("hedge delay cannot be negative")
+	}
+
+	if p.backoff <= 0 || p.maxBackoff < p.backoff {
+		return nil, fmt.Errorf("endpoint backoff must be positive and at most the max backoff")
+	}
+
+	if p.failureThreshold < 1 {
+		return nil, fmt.Errorf("endpoint failure threshold must be at least 1")
+	}
+
+	p.endpoints = make([]*endpointState, len(cfg.Endpoints))
+	for i, endpoint := range cfg.Endpoints {
+		if err := endpoint.ValidateBasic(); err != nil {
+			return nil, err
+		}
+
+		reqOpts := p.requestOpts
+		if endpoint.Authentication.Enabled() {
+			reqOpts = append(reqOpts[:len(reqOpts):len(reqOpts)], withAuthentication(endpoint.Authentication))
+		}
+
+		handler, err := NewRequestHandlerImpl(client, reqOpts...)
+		if err != nil {
+			return nil, err
+		}
+
+		prefix, _, _ := strings.Cut(endpoint.URL, "%")
+		p.endpoints[i] = &endpointState{
+			url:     endpoint.URL,
+			prefix:  prefix,
+			handler: handler,
+		}
+		p.method = handler.Type()
+	}
+
+	return p, nil
+}
+
+// withAuthentication adds the endpoint's API key header to the request headers.
+func withAuthentication(auth config.Authentication) Option {
+	return func(r *RequestHandlerImpl) {
+		headers := maps.Clone(r.headers)
+		if headers == nil {
+			headers = make(map[string]string)
+		}
+
+		headers[auth.APIKeyHeader] = auth.APIKey
+		r.headers = headers
+	}
+}
+
+// Do sends the request to the healthiest endpoint that is not backing off. If the request
+// fails, is rate limited or the endpoint returns a server error, the request is retried on
+// the next healthiest endpoint until the context is cancelled. If all endpoints fail, the
+// last response (or error) is returned.
+func (p *EndpointPool) Do(ctx context.Context, url string) (*http.Response, error) {
+	candidates := p.candidates()
+	if len(candidates) == 0 {
+		return nil, ErrNoAvailableEndpoints
+	}
+
+	var (
+		results  = make(chan endpointResult, len(candidates))
+		cancels  = make([]context.CancelFunc, 0, len(candidates))
+		pending  int
+		hedge    <-chan time.Time
+		lastResp endpointResult
+	)
+
+	launch := func() {
+		attempt := len(cancels)
+		idx := candidates[attempt]
+		attemptCtx, cancel := context.WithCancel(ctx)
+		cancels = append(cancels, cancel)
+		pending++
+
+		go func() {
+			resp, err := p.attempt(ctx, attemptCtx, idx, p.rewrite(url, idx))
+			results <- endpointResult{attempt: attempt, resp: resp, err: err}
+		}()
+
+		if p.hedgeDelay > 0 && len(cancels) < len(candidates) {
+			hedge = time.After(p.hedgeDelay)
+		} else {
+			hedge = nil
+		}
+	}
+
+	launch()
+	for pending > 0 {
+		select {
+		case res := <-results:
+			pending--
+			if res.ok() {
+				// Cancel the outstanding attempts and release their responses. The winning
+				// attempt is cancelled once its response body is closed.
+				for i, cancel := range cancels {
+					if i != res.attempt {
+						cancel()
+					}
+				}
+				go drain(results, pending)
+
+				res.resp.Body = &cancelOnClose{ReadCloser: res.resp.Body, cancel: cancels[res.attempt]}
+				return res.resp, nil
+			}
+
+			lastResp.close()
+			lastResp = res
+			if len(cancels) < len(candidates) && ctx.Err() == nil {
+				launch()
+			}
+		case <-hedge:
+			p.logger.Debug("hedging request", zap.Int("attempt", len(cancels)))
+			launch()
+		}
+	}
+
+	if lastResp.resp != nil {
+		lastResp.resp.Body = &cancelOnClose{ReadCloser: lastResp.resp.Body, cancel: cancels[lastResp.attempt]}
+	} else {
+		cancels[lastResp.attempt]()
+	}
+
+	return lastResp.resp, lastResp.err
+}
+
+// Type returns the HTTP method used to send requests.
+func (p *EndpointPool) Type() string {
+	return p.method
+}
+
+// Health returns a snapshot of the health of each endpoint, in the order they are configured.
+func (p *EndpointPool) Health() []EndpointHealth {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	health := make([]EndpointHealth, len(p.endpoints))
+	for i, e := range p.endpoints {
+		health[i] = EndpointHealth{
+			URL:          e.url,
+			Sampled:      e.sampled,
+			Latency:      e.latency,
+			ErrorRate:    e.errorRate,
+			RateLimits:   e.rateLimits,
+			BackoffUntil: e.backoffUntil,
+		}
+	}
+
+	return health
+}
+
+// candidates returns the indices of the endpoints that are not backing off, from healthiest
+// to least healthy. Endpoints that have not been used yet are preferred, so that every
+// endpoint is scored, and ties are broken by using the least recently used endpoint.
+func (p *EndpointPool) candidates() []int {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	now := time.Now()
+	candidates := make([]int, 0, len(p.endpoints))
+	for i, e := range p.endpoints {
+		if now.Before(e.backoffUntil) {
+			continue
+		}
+
+		candidates = append(candidates, i)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := p.endpoints[candidates[i]], p.endpoints[candidates[j]]
+		if a.sampled != b.sampled {
+			return !a.sampled
+		}
+
+		if a.score() != b.score() {
+			return a.score() < b.score()
+		}
+
+		return a.lastUsed.Before(b.lastUsed)
+	})
+
+	if len(candidates) > 0 {
+		p.endpoints[candidates[0]].lastUsed = now
+	}
+
+	return candidates
+}
+
+// rewrite returns the given URL with the prefix of the endpoint it was created for replaced
+// by the prefix of the endpoint at idx. The URL is returned unchanged if it was not created
+// for any of the pool's endpoints.
+func (p *EndpointPool) rewrite(url string, idx int) string {
+	var from string
+	for _, e := range p.endpoints {
+		if strings.HasPrefix(url, e.prefix) && len(e.prefix) > len(from) {
+			from = e.prefix
+		}
+	}
+
+	if len(from) == 0 {
+		return url
+	}
+
+	return p.endpoints[idx].prefix + strings.TrimPrefix(url, from)
+}
+
+// endpointResult is the result of a request to a single endpoint.
+type endpointResult struct {
+	// attempt is the index of the attempt within a single call to Do.
+	attempt int
+	resp    *http.Response
+	err     error
+}
+
+// ok returns true if the request succeeded and should not be retried.
+func (r endpointResult) ok() bool {
+	return r.err == nil && !isRetryableStatus(r.resp.StatusCode)
+}
+
+// close releases the result's response, if any.
+func (r endpointResult) close() {
+	if r.resp != nil {
+		r.resp.Body.Close()
+	}
+}
+
+// attempt sends the request to the endpoint at idx and records the outcome.
+func (p *EndpointPool) attempt(ctx, attemptCtx context.Context, idx int, url string) (*http.Response, error) {
+	start := time.Now()
+	resp, err := p.endpoints[idx].handler.Do(attemptCtx, url)
+	latency := time.Now().Sub(start)
+
+	switch {
+	case errors.Is(ctx.Err(), context.Canceled):
+		// The caller gave up on the request, so the outcome says nothing about the endpoint.
+	case ctx.Err() == nil && attemptCtx.Err() != nil:
+		// The attempt was cancelled by the pool, as another attempt succeeded first.
+	default:
+		p.record(idx, latency, resp, err)
+	}
+
+	return resp, err
+}
+
+// record updates the health of the endpoint at idx with the outcome of a request.
+func (p *EndpointPool) record(idx int, latency time.Duration, resp *http.Response, err error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	e := p.endpoints[idx]
+	failed := err != nil || isRetryableStatus(resp.StatusCode)
+
+	if !e.sampled {
+		e.latency = latency
+		e.sampled = true
+	} else {
+		e.latency = time.Duration((1-endpointScoreDecay)*float64(e.latency) + endpointScoreDecay*float64(latency))
+	}
+
+	sample := 0.0
+	if failed {
+		sample = 1
+	}
+	e.errorRate = (1-endpointScoreDecay)*e.errorRate + endpointScoreDecay*sample
+
+	switch {
+	case !failed:
+		e.consecutiveFailures = 0
+		e.backoffs = 0
+	case resp != nil && resp.StatusCode == http.StatusTooManyRequests:
+		e.rateLimits++
+		e.consecutiveFailures++
+		p.backOff(idx, retryAfter(resp, time.Now()))
+	default:
+		e.consecutiveFailures++
+		if e.consecutiveFailures >= p.failureThreshold {
+			p.backOff(idx, 0)
+		}
+	}
+}
+
+// backOff stops the endpoint at idx from being used for an exponentially increasing amount
+// of time, or at least until the given time if it is later.
+func (p *EndpointPool) backOff(idx int, minimum time.Duration) {
+	e := p.endpoints[idx]
+
+	backoff := p.backoff
+	for i := 0; i < e.backoffs && backoff < p.maxBackoff; i++ {
+		backoff *= 2
+	}
+	backoff = min(backoff, p.maxBackoff)
+	backoff = max(backoff, minimum)
+
+	e.backoffs++
+	e.backoffUntil = time.Now().Add(backoff)
+
+	p.logger.Info(
+		"backing off endpoint",
+		zap.Int("endpoint", idx),
+		zap.Duration("backoff", backoff),
+		zap.Int("consecutive_failures", e.consecutiveFailures),
+	)
+}
+
+// isRetryableStatus returns true if a request that received the given status code should be
+// retried on another endpoint.
+func isRetryableStatus(status int) bool {
+	return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
+
+// retryAfter returns the duration from the response's Retry-After header, if any.
+func retryAfter(resp *http.Response, now time.Time) time.Duration {
+	header := resp.Header.Get("Retry-After")
+	if header == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if t, err := http.ParseTime(header); err == nil {
+		return t.Sub(now)
+	}
+
+	return 0
+}
+
+// drain releases the responses of the given number of outstanding attempts.
+func drain(results <-chan endpointResult, pending int) {
+	for i := 0; i < pending; i++ {
+		res := <-results
+		res.close()
+	}
+}
+
+// cancelOnClose cancels the context of a request once its response body is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close closes the response body and cancels the request's context.
+func (c *cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
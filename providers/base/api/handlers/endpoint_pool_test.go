@@ -0,0 +1,278 @@
+package handlers_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/zoguxprotocol/slinky/oracle/config"
+	"github.com/zoguxprotocol/slinky/providers/base/api/handlers"
+)
+
+// testEndpoint is an HTTP server that responds with the given status and counts its requests.
+type testEndpoint struct {
+	*httptest.Server
+	requests atomic.Int64
+	lastPath atomic.Value
+	apiKey   atomic.Value
+}
+
+func newTestEndpoint(t *testing.T, name string, status int, delay time.Duration) *testEndpoint {
+	t.Helper()
+
+	e := &testEndpoint{}
+	e.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		e.requests.Add(1)
+		e.lastPath.Store(r.URL.Path)
+		e.apiKey.Store(r.Header.Get("X-Api-Key"))
+
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+
+		if status == http.StatusTooManyRequests {
+			w.Header().Set("Retry-After", "60")
+		}
+		w.WriteHeader(status)
+		fmt.Fprint(w, name)
+	}))
+	t.Cleanup(e.Close)
+
+	return e
+}
+
+func poolConfig(endpoints ...config.Endpoint) config.APIConfig {
+	return config.APIConfig{
+		Enabled:          true,
+		Timeout:          time.Second,
+		Interval:         time.Second,
+		ReconnectTimeout: time.Second,
+		MaxQueries:       1,
+		Endpoints:        endpoints,
+		Name:             "test",
+	}
+}
+
+func doPoolRequest(t *testing.T, pool *handlers.EndpointPool, url string) (int, string) {
+	t.Helper()
+
+	resp, err := pool.Do(context.Background(), url)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	return resp.StatusCode, string(body)
+}
+
+func TestNewEndpointPool(t *testing.T) {
+	cfg := poolConfig(config.Endpoint{URL: "http://localhost"})
+
+	testCases := []struct {
+		name string
+		cfg  config.APIConfig
+		opts []handlers.EndpointPoolOption
+		err  bool
+	}{
+		{
+			name: "valid pool",
+			cfg:  cfg,
+		},
+		{
+			name: "no endpoints",
+			cfg:  poolConfig(),
+			err:  true,
+		},
+		{
+			name: "negative hedge delay",
+			cfg:  cfg,
+			opts: []handlers.EndpointPoolOption{handlers.WithHedgeDelay(-time.Second)},
+			err:  true,
+		},
+		{
+			name: "max backoff smaller than backoff",
+			cfg:  cfg,
+			opts: []handlers.EndpointPoolOption{handlers.WithEndpointBackoff(time.Minute, time.Second)},
+			err:  true,
+		},
+		{
+			name: "zero failure threshold",
+			cfg:  cfg,
+			opts: []handlers.EndpointPoolOption{handlers.WithEndpointFailureThreshold(0)},
+			err:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := handlers.NewEndpointPool(zap.NewNop(), http.DefaultClient, tc.cfg, tc.opts...)
+			if tc.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEndpointPool(t *testing.T) {
+	t.Run("rewrites urls onto each endpoint and authenticates per endpoint", func(t *testing.T) {
+		a := newTestEndpoint(t, "a", http.StatusOK, 0)
+		b := newTestEndpoint(t, "b", http.StatusOK, 0)
+
+		pool, err := handlers.NewEndpointPool(zap.NewNop(), http.DefaultClient, poolConfig(
+			config.Endpoint{URL: a.URL + "/prices/%s"},
+			config.Endpoint{
+				URL: b.URL + "/prices/%s",
+				Authentication: config.Authentication{
+					APIKey:       "secret",
+					APIKeyHeader: "X-Api-Key",
+				},
+			},
+		))
+		require.NoError(t, err)
+
+		// Unused endpoints are tried first, so both endpoints are used.
+		url := fmt.Sprintf(a.URL+"/prices/%s", "BTC")
+		for i := 0; i < 2; i++ {
+			status, _ := doPoolRequest(t, pool, url)
+			require.Equal(t, http.StatusOK, status)
+		}
+
+		require.Equal(t, int64(1), a.requests.Load())
+		require.Equal(t, int64(1), b.requests.Load())
+		require.Equal(t, "/prices/BTC", a.lastPath.Load())
+		require.Equal(t, "/prices/BTC", b.lastPath.Load())
+		require.Equal(t, "", a.apiKey.Load())
+		require.Equal(t, "secret", b.apiKey.Load())
+
+		for _, health := range pool.Health() {
+			require.True(t, health.Sampled)
+			require.Zero(t, health.ErrorRate)
+		}
+	})
+
+	t.Run("fails over and backs off rate limited endpoints", func(t *testing.T) {
+		a := newTestEndpoint(t, "a", http.StatusTooManyRequests, 0)
+		b := newTestEndpoint(t, "b", http.StatusOK, 0)
+
+		pool, err := handlers.NewEndpointPool(zap.NewNop(), http.DefaultClient, poolConfig(
+			config.Endpoint{URL: a.URL},
+			config.Endpoint{URL: b.URL},
+		))
+		require.NoError(t, err)
+
+		for i := 0; i < 3; i++ {
+			status, body := doPoolRequest(t, pool, a.URL)
+			require.Equal(t, http.StatusOK, status)
+			require.Equal(t, "b", body)
+		}
+
+		// The rate limited endpoint is only tried once, and is backed off for the Retry-After duration.
+		require.Equal(t, int64(1), a.requests.Load())
+		health := pool.Health()
+		require.Equal(t, uint64(1), health[0].RateLimits)
+		require.True(t, health[0].BackoffUntil.After(time.Now().Add(55*time.Second)))
+		require.True(t, health[1].BackoffUntil.IsZero())
+	})
+
+	t.Run("backs off endpoints after consecutive failures", func(t *testing.T) {
+		a := newTestEndpoint(t, "a", http.StatusInternalServerError, 0)
+		b := newTestEndpoint(t, "b", http.StatusBadGateway, 0)
+
+		pool, err := handlers.NewEndpointPool(
+			zap.NewNop(),
+			http.DefaultClient,
+			poolConfig(config.Endpoint{URL: a.URL}, config.Endpoint{URL: b.URL}),
+			handlers.WithEndpointFailureThreshold(2),
+		)
+		require.NoError(t, err)
+
+		// Each request is tried on both endpoints, and the last failure is returned.
+		for i := 0; i < 2; i++ {
+			status, _ := doPoolRequest(t, pool, a.URL)
+			require.Contains(t, []int{http.StatusInternalServerError, http.StatusBadGateway}, status)
+		}
+
+		require.Equal(t, int64(2), a.requests.Load())
+		require.Equal(t, int64(2), b.requests.Load())
+		for _, health := range pool.Health() {
+			require.Greater(t, health.ErrorRate, 0.0)
+			require.True(t, health.BackoffUntil.After(time.Now()))
+		}
+
+		_, err = pool.Do(context.Background(), a.URL)
+		require.ErrorIs(t, err, handlers.ErrNoAvailableEndpoints)
+	})
+
+	t.Run("returns the last response if all endpoints fail", func(t *testing.T) {
+		a := newTestEndpoint(t, "a", http.StatusTooManyRequests, 0)
+		b := newTestEndpoint(t, "b", http.StatusTooManyRequests, 0)
+
+		pool, err := handlers.NewEndpointPool(zap.NewNop(), http.DefaultClient, poolConfig(
+			config.Endpoint{URL: a.URL},
+			config.Endpoint{URL: b.URL},
+		))
+		require.NoError(t, err)
+
+		status, _ := doPoolRequest(t, pool, a.URL)
+		require.Equal(t, http.StatusTooManyRequests, status)
+
+		// All endpoints are now backing off.
+		_, err = pool.Do(context.Background(), a.URL)
+		require.ErrorIs(t, err, handlers.ErrNoAvailableEndpoints)
+	})
+
+	t.Run("fails over when an endpoint is unreachable", func(t *testing.T) {
+		a := newTestEndpoint(t, "a", http.StatusOK, 0)
+		a.Close()
+		b := newTestEndpoint(t, "b", http.StatusOK, 0)
+
+		pool, err := handlers.NewEndpointPool(zap.NewNop(), http.DefaultClient, poolConfig(
+			config.Endpoint{URL: a.URL},
+			config.Endpoint{URL: b.URL},
+		))
+		require.NoError(t, err)
+
+		status, body := doPoolRequest(t, pool, a.URL)
+		require.Equal(t, http.StatusOK, status)
+		require.Equal(t, "b", body)
+		require.InDelta(t, 0.3, pool.Health()[0].ErrorRate, 1e-9)
+	})
+
+	t.Run("hedges slow requests", func(t *testing.T) {
+		a := newTestEndpoint(t, "a", http.StatusOK, 2*time.Second)
+		b := newTestEndpoint(t, "b", http.StatusOK, 0)
+
+		pool, err := handlers.NewEndpointPool(
+			zap.NewNop(),
+			http.DefaultClient,
+			poolConfig(config.Endpoint{URL: a.URL}, config.Endpoint{URL: b.URL}),
+			handlers.WithHedgeDelay(50*time.Millisecond),
+		)
+		require.NoError(t, err)
+
+		start := time.Now()
+		status, body := doPoolRequest(t, pool, a.URL)
+		require.Equal(t, http.StatusOK, status)
+		require.Equal(t, "b", body)
+		require.Less(t, time.Since(start), time.Second)
+
+		// The slow attempt is cancelled, and is not held against the endpoint.
+		require.Eventually(t, func() bool {
+			return a.requests.Load() == 1
+		}, time.Second, 10*time.Millisecond)
+		require.Zero(t, pool.Health()[0].ErrorRate)
+	})
+}
@@ -37,14 +37,27 @@ func WithNewWebSocketHandler[K providertypes.ResponseKey, V providertypes.Respon
 	}
 }
 
-// Update updates the provider with the given options.
+// Update updates the provider with the given options. If only the IDs of a websocket provider
+// are updated, the subscriptions of its connections are updated in place when possible. Otherwise,
+// the provider is restarted.
 func (p *Provider[K, V]) Update(opts ...UpdateOption[K, V]) {
 	p.logger.Debug("updating provider")
+	p.setHandlerUpdated(false)
 	for _, opt := range opts {
 		opt(p)
 	}
 	p.logger.Debug("provider updated")
 
+	if p.Type() == providertypes.WebSockets && !p.getHandlerUpdated() {
+		err := p.updateWebSocketSubscriptions()
+		if err == nil {
+			p.logger.Debug("updated websocket subscriptions in place")
+			return
+		}
+
+		p.logger.Debug("failed to update websocket subscriptions in place", zap.Error(err))
+	}
+
 	if _, cancel := p.getFetchCtx(); cancel != nil {
 		p.logger.Debug("canceling fetch context; restarting provider")
 		cancel()
@@ -79,6 +92,7 @@ func (p *Provider[K, V]) setAPIHandler(apiHandler apihandler.APIQueryHandler[K,
 
 	p.mu.Lock()
 	p.api = apiHandler
+	p.handlerUpdated = true
 	p.mu.Unlock()
 
 	p.logger.Debug("set api query handler")
@@ -104,6 +118,7 @@ func (p *Provider[K, V]) setWebSocketHandler(wsHandler wshandlers.WebSocketQuery
 
 	p.mu.Lock()
 	p.ws = wsHandler
+	p.handlerUpdated = true
 	p.mu.Unlock()
 
 	p.logger.Debug("set websocket query handler")
@@ -121,6 +136,22 @@ func (p *Provider[K, V]) GetWebSocketHandler() wshandlers.WebSocketQueryHandler[
 	return p.ws
 }
 
+// setHandlerUpdated sets whether an update replaced the query handler of the provider.
+func (p *Provider[K, V]) setHandlerUpdated(updated bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.handlerUpdated = updated
+}
+
+// getHandlerUpdated returns whether an update replaced the query handler of the provider.
+func (p *Provider[K, V]) getHandlerUpdated() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.handlerUpdated
+}
+
 // GetAPIConfig returns the API configuration for the provider.
 func (p *Provider[K, V]) GetAPIConfig() config.APIConfig {
 	return p.apiCfg
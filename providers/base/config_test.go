@@ -2,15 +2,19 @@ package base_test
 
 import (
 	"context"
+	"fmt"
 	"math/big"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/zoguxprotocol/slinky/oracle/config"
 	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
 	"github.com/zoguxprotocol/slinky/providers/base"
 	"github.com/zoguxprotocol/slinky/providers/base/testutils"
+	wshandlers "github.com/zoguxprotocol/slinky/providers/base/websocket/handlers"
 	providertypes "github.com/zoguxprotocol/slinky/providers/types"
 )
 
@@ -240,3 +244,174 @@ func TestConfigUpdater(t *testing.T) {
 		require.Eventually(t, func() bool { return !provider.IsRunning() }, 2*time.Second, 100*time.Millisecond)
 	})
 }
+
+// subscriptionQueryHandler is a websocket query handler that holds its connection open until the
+// context is cancelled, and records the starts and subscription updates of its connections.
+type subscriptionQueryHandler struct {
+	mu sync.Mutex
+
+	fail         bool
+	starts       int
+	subscribed   []slinkytypes.CurrencyPair
+	unsubscribed []slinkytypes.CurrencyPair
+}
+
+func (h *subscriptionQueryHandler) Start(
+	ctx context.Context,
+	_ []slinkytypes.CurrencyPair,
+	_ chan<- providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int],
+) error {
+	h.mu.Lock()
+	h.starts++
+	h.mu.Unlock()
+
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (h *subscriptionQueryHandler) Copy() wshandlers.WebSocketQueryHandler[slinkytypes.CurrencyPair, *big.Int] {
+	return h
+}
+
+func (h *subscriptionQueryHandler) UpdateSubscriptions(subscribe, unsubscribe []slinkytypes.CurrencyPair) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.fail {
+		return fmt.Errorf("failed to update subscriptions")
+	}
+
+	h.subscribed = append(h.subscribed, subscribe...)
+	h.unsubscribed = append(h.unsubscribed, unsubscribe...)
+	return nil
+}
+
+func (h *subscriptionQueryHandler) getStarts() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.starts
+}
+
+func TestWebSocketSubscriptionUpdates(t *testing.T) {
+	start := func(
+		t *testing.T,
+		handler *subscriptionQueryHandler,
+		cfg config.WebSocketConfig,
+		ids []slinkytypes.CurrencyPair,
+	) *base.Provider[slinkytypes.CurrencyPair, *big.Int] {
+		t.Helper()
+
+		provider, err := base.NewProvider[slinkytypes.CurrencyPair, *big.Int](
+			base.WithName[slinkytypes.CurrencyPair, *big.Int](cfg.Name),
+			base.WithWebSocketQueryHandler[slinkytypes.CurrencyPair, *big.Int](handler),
+			base.WithWebSocketConfig[slinkytypes.CurrencyPair, *big.Int](cfg),
+			base.WithLogger[slinkytypes.CurrencyPair, *big.Int](logger),
+			base.WithIDs[slinkytypes.CurrencyPair, *big.Int](ids),
+		)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 8*time.Second)
+		t.Cleanup(cancel)
+
+		go func() {
+			provider.Start(ctx)
+		}()
+
+		// Wait for all the connections to start.
+		numConns := 1
+		if cfg.MaxSubscriptionsPerConnection > 0 {
+			numConns = (len(ids) + cfg.MaxSubscriptionsPerConnection - 1) / cfg.MaxSubscriptionsPerConnection
+		}
+		require.Eventually(t, func() bool { return handler.getStarts() == numConns }, 2*time.Second, 10*time.Millisecond)
+
+		return provider
+	}
+
+	t.Run("updates the subscriptions in place", func(t *testing.T) {
+		handler := &subscriptionQueryHandler{}
+		provider := start(t, handler, wsCfg, []slinkytypes.CurrencyPair{btcusd, ethusd})
+
+		updated := []slinkytypes.CurrencyPair{ethusd, solusd}
+		provider.Update(base.WithNewIDs[slinkytypes.CurrencyPair, *big.Int](updated))
+		require.Equal(t, updated, provider.GetIDs())
+
+		// The connection is not restarted.
+		time.Sleep(500 * time.Millisecond)
+		require.Equal(t, 1, handler.getStarts())
+
+		handler.mu.Lock()
+		require.Equal(t, []slinkytypes.CurrencyPair{solusd}, handler.subscribed)
+		require.Equal(t, []slinkytypes.CurrencyPair{btcusd}, handler.unsubscribed)
+		handler.mu.Unlock()
+
+		provider.Stop()
+		require.Eventually(t, func() bool { return !provider.IsRunning() }, 2*time.Second, 100*time.Millisecond)
+	})
+
+	t.Run("updates the subscriptions of connections with spare capacity", func(t *testing.T) {
+		cfg := wsCfgMultiplex
+		cfg.MaxSubscriptionsPerConnection = 2
+
+		handler := &subscriptionQueryHandler{}
+		provider := start(t, handler, cfg, []slinkytypes.CurrencyPair{btcusd, ethusd, solusd})
+
+		// The first connection unsubscribes from ETH/USD and has room for the added pair.
+		updated := []slinkytypes.CurrencyPair{btcusd, solusd, slinkytypes.NewCurrencyPair("ATOM", "USD")}
+		provider.Update(base.WithNewIDs[slinkytypes.CurrencyPair, *big.Int](updated))
+
+		time.Sleep(500 * time.Millisecond)
+		require.Equal(t, 2, handler.getStarts())
+
+		handler.mu.Lock()
+		require.Equal(t, updated[2:], handler.subscribed)
+		require.Equal(t, []slinkytypes.CurrencyPair{ethusd}, handler.unsubscribed)
+		handler.mu.Unlock()
+
+		provider.Stop()
+		require.Eventually(t, func() bool { return !provider.IsRunning() }, 2*time.Second, 100*time.Millisecond)
+	})
+
+	t.Run("reshards when the connections have no spare capacity", func(t *testing.T) {
+		handler := &subscriptionQueryHandler{}
+		provider := start(t, handler, wsCfgMultiplex, []slinkytypes.CurrencyPair{btcusd})
+
+		provider.Update(base.WithNewIDs[slinkytypes.CurrencyPair, *big.Int]([]slinkytypes.CurrencyPair{btcusd, ethusd}))
+
+		// The provider is restarted with two connections.
+		require.Eventually(t, func() bool { return handler.getStarts() == 3 }, 2*time.Second, 10*time.Millisecond)
+
+		handler.mu.Lock()
+		require.Empty(t, handler.subscribed)
+		require.Empty(t, handler.unsubscribed)
+		handler.mu.Unlock()
+
+		provider.Stop()
+		require.Eventually(t, func() bool { return !provider.IsRunning() }, 2*time.Second, 100*time.Millisecond)
+	})
+
+	t.Run("restarts when a connection would have no subscriptions", func(t *testing.T) {
+		handler := &subscriptionQueryHandler{}
+		provider := start(t, handler, wsCfgMultiplex, []slinkytypes.CurrencyPair{btcusd, ethusd})
+
+		provider.Update(base.WithNewIDs[slinkytypes.CurrencyPair, *big.Int]([]slinkytypes.CurrencyPair{btcusd}))
+
+		// The provider is restarted with a single connection.
+		require.Eventually(t, func() bool { return handler.getStarts() == 3 }, 2*time.Second, 10*time.Millisecond)
+
+		provider.Stop()
+		require.Eventually(t, func() bool { return !provider.IsRunning() }, 2*time.Second, 100*time.Millisecond)
+	})
+
+	t.Run("restarts when the subscriptions cannot be updated", func(t *testing.T) {
+		handler := &subscriptionQueryHandler{fail: true}
+		provider := start(t, handler, wsCfg, []slinkytypes.CurrencyPair{btcusd})
+
+		provider.Update(base.WithNewIDs[slinkytypes.CurrencyPair, *big.Int]([]slinkytypes.CurrencyPair{btcusd, ethusd}))
+
+		require.Eventually(t, func() bool { return handler.getStarts() == 2 }, 2*time.Second, 10*time.Millisecond)
+
+		provider.Stop()
+		require.Eventually(t, func() bool { return !provider.IsRunning() }, 2*time.Second, 100*time.Millisecond)
+	})
+}
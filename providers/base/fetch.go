@@ -79,6 +79,10 @@ func (p *Provider[K, V]) startMultiplexWebsocket(ctx context.Context) error {
 		wg             = errgroup.Group{}
 	)
 
+	// The connections are registered while holding the lock, so that an update of the IDs is
+	// either applied to the registered connections or reflected in the IDs read here.
+	p.wsMu.Lock()
+
 	// create sub handlers
 	// if len(ids) == 30 and MaxSubscriptionsPerConnection == 45
 	// 30 / 45 = 0 -> need one sub handler
@@ -95,8 +99,25 @@ func (p *Provider[K, V]) startMultiplexWebsocket(ctx context.Context) error {
 		wg.SetLimit(1)
 	}
 
-	for _, subIDs := range subTasks {
-		wg.Go(p.startWebSocket(ctx, subIDs))
+	handler := p.GetWebSocketHandler()
+	conns := make([]*wsConnection[K, V], len(subTasks))
+	for i, subIDs := range subTasks {
+		conns[i] = &wsConnection[K, V]{
+			handler: handler.Copy(),
+			ids:     subIDs,
+		}
+	}
+	p.wsConns = conns
+	p.wsMu.Unlock()
+
+	defer func() {
+		p.wsMu.Lock()
+		p.wsConns = nil
+		p.wsMu.Unlock()
+	}()
+
+	for _, conn := range conns {
+		wg.Go(p.startWebSocket(ctx, conn))
 	}
 
 	// Wait for all the sub handlers to finish.
@@ -104,13 +125,11 @@ func (p *Provider[K, V]) startMultiplexWebsocket(ctx context.Context) error {
 }
 
 // startWebSocket starts a connection to the websocket and handles the incoming messages.
-func (p *Provider[K, V]) startWebSocket(ctx context.Context, subIDs []K) func() error {
+func (p *Provider[K, V]) startWebSocket(ctx context.Context, conn *wsConnection[K, V]) func() error {
 	return func() error {
 		// Start the websocket query handler. If the connection fails to start, then the query handler
 		// will be restarted after a timeout.
 		restarts := 0
-		handler := p.GetWebSocketHandler()
-		handler = handler.Copy()
 		for {
 			select {
 			case <-ctx.Done():
@@ -125,8 +144,10 @@ func (p *Provider[K, V]) startWebSocket(ctx context.Context, subIDs []K) func()
 					time.Sleep(p.wsCfg.ReconnectionTimeout)
 				}
 
+				// The IDs of the connection may have been updated in place since the last start.
+				subIDs := p.getConnectionIDs(conn)
 				p.logger.Debug("starting websocket query handler", zap.Int("num_ids", len(subIDs)), zap.Any("ids", subIDs))
-				if err := handler.Start(ctx, subIDs, p.responseCh); err != nil {
+				if err := conn.handler.Start(ctx, subIDs, p.responseCh); err != nil {
 					p.logger.Error("websocket query handler returned error", zap.Error(err))
 				}
 				restarts++
@@ -229,12 +250,16 @@ func (p *Provider[K, V]) updateData(id K, result providertypes.ResolvedResult[V]
 		current.Timestamp = result.Timestamp
 		p.data[id] = current
 	default:
-		// Otherwise, update the data.
+		// Otherwise, update the data. Providers that report volume on a subset of their
+		// messages (e.g. ticker but not trade updates) keep the last reported volume.
 		p.logger.Debug(
 			"updating base provider data",
 			zap.String("id", fmt.Sprint(id)),
 			zap.String("result", result.String()),
 		)
+		if result.Volume == nil {
+			result.Volume = current.Volume
+		}
 		p.data[id] = result
 	}
 }
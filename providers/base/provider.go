@@ -65,6 +65,20 @@ type Provider[K providertypes.ResponseKey, V providertypes.ResponseValue] struct
 
 	// responseCh is the channel that is used to receive the response(s) from the query handler.
 	responseCh chan providertypes.GetResponse[K, V]
+
+	// handlerUpdated is set when an update replaces the query handler of the provider.
+	handlerUpdated bool
+
+	// wsMu guards the websocket connections of the provider.
+	wsMu sync.Mutex
+
+	// wsUpdateMu serializes the updates of the subscriptions of the websocket connections, which
+	// are sent without holding wsMu.
+	wsUpdateMu sync.Mutex
+
+	// wsConns are the websocket connections of the running provider. When the IDs of the
+	// provider change, the subscriptions of the connections are updated in place if possible.
+	wsConns []*wsConnection[K, V]
 }
 
 // NewProvider returns a new Base provider.
@@ -0,0 +1,149 @@
+package base
+
+import (
+	"fmt"
+
+	wshandlers "github.com/zoguxprotocol/slinky/providers/base/websocket/handlers"
+	providertypes "github.com/zoguxprotocol/slinky/providers/types"
+)
+
+// wsConnection is a websocket connection of a running provider i.e. the copy of the websocket
+// query handler that manages the connection, and the IDs the connection is subscribed to.
+type wsConnection[K providertypes.ResponseKey, V providertypes.ResponseValue] struct {
+	handler wshandlers.WebSocketQueryHandler[K, V]
+	ids     []K
+}
+
+// wsSubscriptionUpdate is the update of the subscriptions of a websocket connection.
+type wsSubscriptionUpdate[K providertypes.ResponseKey] struct {
+	updater     wshandlers.WebSocketSubscriptionUpdater[K]
+	subscribe   []K
+	unsubscribe []K
+}
+
+// getConnectionIDs returns the IDs the websocket connection is subscribed to.
+func (p *Provider[K, V]) getConnectionIDs(conn *wsConnection[K, V]) []K {
+	p.wsMu.Lock()
+	defer p.wsMu.Unlock()
+
+	ids := make([]K, len(conn.ids))
+	copy(ids, conn.ids)
+
+	return ids
+}
+
+// updateWebSocketSubscriptions updates the subscriptions of the websocket connections of the running
+// provider to the current set of IDs. Each removed ID is unsubscribed from the connection it was assigned
+// to, and the added IDs are subscribed to on the connections with spare capacity, as determined by
+// MaxSubscriptionsPerConnection. An error is returned if the connections cannot be updated in place, in
+// which case the provider must be restarted so that the IDs are resharded across new connections. This
+// is the case if:
+//
+//  1. The provider has no running websocket connections.
+//  2. The websocket query handler does not support updating subscriptions.
+//  3. The added IDs do not fit in the spare capacity of the connections.
+//  4. A connection would be left without any subscriptions.
+//  5. A connection fails to update its subscriptions.
+func (p *Provider[K, V]) updateWebSocketSubscriptions() error {
+	p.wsUpdateMu.Lock()
+	defer p.wsUpdateMu.Unlock()
+
+	updates, err := p.planWebSocketSubscriptions()
+	if err != nil {
+		return err
+	}
+
+	// The connections pace their messages by the write interval, so the updates are sent without
+	// holding wsMu.
+	for i, update := range updates {
+		if len(update.subscribe) == 0 && len(update.unsubscribe) == 0 {
+			continue
+		}
+
+		if err := update.updater.UpdateSubscriptions(update.subscribe, update.unsubscribe); err != nil {
+			return fmt.Errorf("failed to update subscriptions of websocket connection %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// planWebSocketSubscriptions determines the update of the subscriptions of each websocket connection, and
+// assigns the updated IDs to the connections. If an update then fails to be sent, the provider is restarted.
+func (p *Provider[K, V]) planWebSocketSubscriptions() ([]wsSubscriptionUpdate[K], error) {
+	p.wsMu.Lock()
+	defer p.wsMu.Unlock()
+
+	if len(p.wsConns) == 0 {
+		return nil, fmt.Errorf("no websocket connections are running")
+	}
+
+	ids := p.GetIDs()
+	wanted := make(map[K]struct{}, len(ids))
+	for _, id := range ids {
+		wanted[id] = struct{}{}
+	}
+
+	// Determine the IDs each connection keeps and the IDs each connection unsubscribes from.
+	var (
+		assigned    = make(map[K]struct{})
+		updated     = make([][]K, len(p.wsConns))
+		subscribe   = make([][]K, len(p.wsConns))
+		unsubscribe = make([][]K, len(p.wsConns))
+	)
+	for i, conn := range p.wsConns {
+		for _, id := range conn.ids {
+			assigned[id] = struct{}{}
+			if _, ok := wanted[id]; ok {
+				updated[i] = append(updated[i], id)
+			} else {
+				unsubscribe[i] = append(unsubscribe[i], id)
+			}
+		}
+	}
+
+	// Assign the added IDs to the first connections with spare capacity.
+	maxSubsPerConn := p.wsCfg.MaxSubscriptionsPerConnection
+	index := 0
+	for _, id := range ids {
+		if _, ok := assigned[id]; ok {
+			continue
+		}
+		assigned[id] = struct{}{}
+
+		for maxSubsPerConn > 0 && index < len(updated) && len(updated[index]) >= maxSubsPerConn {
+			index++
+		}
+		if index == len(updated) {
+			return nil, fmt.Errorf("added ids exceed the capacity of the websocket connections")
+		}
+
+		updated[index] = append(updated[index], id)
+		subscribe[index] = append(subscribe[index], id)
+	}
+
+	for i := range updated {
+		if len(updated[i]) == 0 {
+			return nil, fmt.Errorf("websocket connection %d would be left without subscriptions", i)
+		}
+	}
+
+	updates := make([]wsSubscriptionUpdate[K], len(p.wsConns))
+	for i, conn := range p.wsConns {
+		updater, ok := conn.handler.(wshandlers.WebSocketSubscriptionUpdater[K])
+		if !ok {
+			return nil, fmt.Errorf("websocket query handler does not support updating subscriptions")
+		}
+		updates[i] = wsSubscriptionUpdate[K]{
+			updater:     updater,
+			subscribe:   subscribe[i],
+			unsubscribe: unsubscribe[i],
+		}
+	}
+
+	for i, conn := range p.wsConns {
+		conn.ids = updated[i]
+	}
+
+	return updates, nil
+}
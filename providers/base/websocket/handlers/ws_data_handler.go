@@ -30,3 +30,15 @@ type WebSocketDataHandler[K providertypes.ResponseKey, V providertypes.ResponseV
 	// to the same data provider. Stateful information can be managed independently for each connection.
 	Copy() WebSocketDataHandler[K, V]
 }
+
+// WebSocketSubscriptionHandler is an optional capability of a WebSocketDataHandler. Data handlers
+// that implement it can update the subscriptions of a live connection: CreateMessages is used to
+// subscribe to the added IDs, and CreateUnsubscribeMessages to unsubscribe from the removed IDs.
+// Since the subscriptions are updated while messages are being handled, the data handler must be
+// safe for concurrent use. Data handlers that do not implement it are restarted whenever their
+// IDs change.
+type WebSocketSubscriptionHandler[K providertypes.ResponseKey] interface {
+	// CreateUnsubscribeMessages is used to create the messages that unsubscribe the connection
+	// from the events of the given IDs.
+	CreateUnsubscribeMessages(ids []K) ([]WebsocketEncodedMessage, error)
+}
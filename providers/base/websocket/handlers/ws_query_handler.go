@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
@@ -30,11 +31,24 @@ type WebSocketQueryHandler[K providertypes.ResponseKey, V providertypes.Response
 	Copy() WebSocketQueryHandler[K, V]
 }
 
+// WebSocketSubscriptionUpdater is an optional capability of a WebSocketQueryHandler. Query handlers
+// that implement it can update the subscriptions of their live connection when the set of IDs
+// changes, instead of being restarted.
+type WebSocketSubscriptionUpdater[K providertypes.ResponseKey] interface {
+	// UpdateSubscriptions subscribes the live connection to the events of the subscribe IDs, and
+	// unsubscribes it from the events of the unsubscribe IDs. An error is returned if the connection
+	// is not established or cannot be updated in place, in which case the handler must be restarted
+	// with the new set of IDs.
+	UpdateSubscriptions(subscribe, unsubscribe []K) error
+}
+
 // WebSocketQueryHandlerImpl is the default websocket implementation of the
 // WebSocketQueryHandler interface. This is used to establish a connection to the data
 // provider and subscribe to events for a given set of IDs. It runs in a separate go
 // routine and will send all responses to the response channel as they are received.
 type WebSocketQueryHandlerImpl[K providertypes.ResponseKey, V providertypes.ResponseValue] struct {
+	mu sync.Mutex
+
 	logger  *zap.Logger
 	metrics metrics.WebSocketMetrics
 	config  config.WebSocketConfig
@@ -49,6 +63,10 @@ type WebSocketQueryHandlerImpl[K providertypes.ResponseKey, V providertypes.Resp
 
 	// ids is the set of IDs that the provider will fetch data for.
 	ids []K
+
+	// connected is true while the connection is established and the initial payload(s) have
+	// been sent, i.e. while the subscriptions can be updated in place.
+	connected bool
 }
 
 // NewWebSocketQueryHandler creates a new websocket query handler.
@@ -110,6 +128,7 @@ func (h *WebSocketQueryHandlerImpl[K, V]) Start(
 		if err := recover(); err != nil {
 			h.logger.Error("panic occurred", zap.Any("err", err))
 		}
+		h.setConnected(false)
 		h.metrics.AddWebSocketConnectionStatus(h.config.Name, metrics.Unhealthy)
 	}()
 
@@ -118,8 +137,11 @@ func (h *WebSocketQueryHandlerImpl[K, V]) Start(
 		return fmt.Errorf("response channel is nil")
 	}
 
+	h.mu.Lock()
 	h.ids = ids
-	if len(h.ids) == 0 {
+	h.mu.Unlock()
+
+	if len(ids) == 0 {
 		h.logger.Debug("no ids to query; exiting")
 		return nil
 	}
@@ -145,6 +167,7 @@ func (h *WebSocketQueryHandlerImpl[K, V]) Start(
 	}
 
 	// Start receiving messages from the data provider.
+	h.setConnected(true)
 	h.metrics.AddWebSocketConnectionStatus(h.config.Name, metrics.Healthy)
 	return h.recv(ctx, responseCh)
 }
@@ -200,6 +223,104 @@ func (h *WebSocketQueryHandlerImpl[K, V]) start(ctx context.Context) error {
 	return nil
 }
 
+// UpdateSubscriptions is used to update the subscriptions of the live connection to the data
+// provider. The data handler must implement the WebSocketSubscriptionHandler capability; the
+// unsubscribe messages are created by CreateUnsubscribeMessages and the subscribe messages by
+// CreateMessages. The messages are sent the same way as the initial payload(s), without holding
+// the lock of the handler while waiting for the write interval.
+func (h *WebSocketQueryHandlerImpl[K, V]) UpdateSubscriptions(subscribe, unsubscribe []K) error {
+	messages, err := h.createSubscriptionMessages(subscribe, unsubscribe)
+	if err != nil {
+		return err
+	}
+
+	h.logger.Debug(
+		"updating subscriptions",
+		zap.Int("num_subscribe", len(subscribe)),
+		zap.Int("num_unsubscribe", len(unsubscribe)),
+	)
+	for index, message := range messages {
+		h.logger.Debug("sending payload", zap.String("payload", string(message)))
+
+		if err := h.connHandler.Write(message); err != nil {
+			h.logger.Debug("failed to write message to websocket connection handler", zap.Error(err))
+			h.metrics.AddWebSocketConnectionStatus(h.config.Name, metrics.WriteErr)
+			return errors.ErrWriteWithErr(err)
+		}
+		h.metrics.AddWebSocketConnectionStatus(h.config.Name, metrics.WriteSuccess)
+
+		// Wait for the write interval before sending the next message.
+		if index != len(messages)-1 {
+			time.Sleep(h.config.WriteInterval)
+		}
+	}
+
+	h.logger.Debug("subscriptions updated", zap.Int("num_messages", len(messages)))
+	return nil
+}
+
+// createSubscriptionMessages creates the messages that update the subscriptions of the live connection,
+// and updates the set of IDs of the handler accordingly. If the messages fail to be sent, the handler
+// must be restarted with the new set of IDs.
+func (h *WebSocketQueryHandlerImpl[K, V]) createSubscriptionMessages(subscribe, unsubscribe []K) ([]WebsocketEncodedMessage, error) {
+	subscriptionHandler, ok := h.dataHandler.(WebSocketSubscriptionHandler[K])
+	if !ok {
+		return nil, fmt.Errorf("data handler does not support updating subscriptions")
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if !h.connected {
+		return nil, fmt.Errorf("connection is not established")
+	}
+
+	messages := make([]WebsocketEncodedMessage, 0)
+	if len(unsubscribe) > 0 {
+		msgs, err := subscriptionHandler.CreateUnsubscribeMessages(unsubscribe)
+		if err != nil {
+			h.logger.Debug("failed to create unsubscribe messages", zap.Error(err))
+			h.metrics.AddWebSocketDataHandlerStatus(h.config.Name, metrics.CreateMessageErr)
+			return nil, errors.ErrCreateMessageWithErr(err)
+		}
+		messages = append(messages, msgs...)
+	}
+
+	if len(subscribe) > 0 {
+		msgs, err := h.dataHandler.CreateMessages(subscribe)
+		if err != nil {
+			h.logger.Debug("failed to create subscription messages", zap.Error(err))
+			h.metrics.AddWebSocketDataHandlerStatus(h.config.Name, metrics.CreateMessageErr)
+			return nil, errors.ErrCreateMessageWithErr(err)
+		}
+		messages = append(messages, msgs...)
+	}
+	h.metrics.AddWebSocketDataHandlerStatus(h.config.Name, metrics.CreateMessageSuccess)
+
+	removed := make(map[K]struct{}, len(unsubscribe))
+	for _, id := range unsubscribe {
+		removed[id] = struct{}{}
+	}
+
+	ids := make([]K, 0, len(h.ids)+len(subscribe))
+	for _, id := range h.ids {
+		if _, ok := removed[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	h.ids = append(ids, subscribe...)
+
+	return messages, nil
+}
+
+// setConnected sets whether the connection is established.
+func (h *WebSocketQueryHandlerImpl[K, V]) setConnected(connected bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.connected = connected
+}
+
 // heartBeat is used to send heartbeats to the data provider. This will
 // send a heartbeat message to the data provider every ping interval.
 func (h *WebSocketQueryHandlerImpl[K, V]) heartBeat(ctx context.Context) {
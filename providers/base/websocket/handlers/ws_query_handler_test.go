@@ -843,3 +843,115 @@ func TestWebSocketQueryHandler(t *testing.T) {
 		})
 	}
 }
+
+// subscriptionDataHandler is a data handler that supports updating the subscriptions of a live connection.
+type subscriptionDataHandler struct {
+	*handlermocks.WebSocketDataHandler[slinkytypes.CurrencyPair, *big.Int]
+}
+
+func (h subscriptionDataHandler) CreateUnsubscribeMessages(ids []slinkytypes.CurrencyPair) ([]handlers.WebsocketEncodedMessage, error) {
+	msgs := make([]handlers.WebsocketEncodedMessage, len(ids))
+	for i, id := range ids {
+		msgs[i] = []byte("unsubscribe " + id.String())
+	}
+
+	return msgs, nil
+}
+
+func TestWebSocketQueryHandlerUpdateSubscriptions(t *testing.T) {
+	newMetrics := func() metrics.WebSocketMetrics {
+		m := mockmetrics.NewWebSocketMetrics(t)
+
+		m.On("AddWebSocketConnectionStatus", name, mock.Anything).Return().Maybe()
+		m.On("AddWebSocketDataHandlerStatus", name, mock.Anything).Return().Maybe()
+		m.On("ObserveWebSocketLatency", name, mock.Anything).Return().Maybe()
+
+		return m
+	}
+
+	t.Run("data handler does not support updating subscriptions", func(t *testing.T) {
+		handler, err := handlers.NewWebSocketQueryHandler[slinkytypes.CurrencyPair, *big.Int](
+			logger,
+			cfg,
+			handlermocks.NewWebSocketDataHandler[slinkytypes.CurrencyPair, *big.Int](t),
+			handlermocks.NewWebSocketConnHandler(t),
+			newMetrics(),
+		)
+		require.NoError(t, err)
+
+		updater, ok := handler.(handlers.WebSocketSubscriptionUpdater[slinkytypes.CurrencyPair])
+		require.True(t, ok)
+		require.Error(t, updater.UpdateSubscriptions([]slinkytypes.CurrencyPair{ethusd}, nil))
+	})
+
+	t.Run("connection is not established", func(t *testing.T) {
+		handler, err := handlers.NewWebSocketQueryHandler[slinkytypes.CurrencyPair, *big.Int](
+			logger,
+			cfg,
+			subscriptionDataHandler{handlermocks.NewWebSocketDataHandler[slinkytypes.CurrencyPair, *big.Int](t)},
+			handlermocks.NewWebSocketConnHandler(t),
+			newMetrics(),
+		)
+		require.NoError(t, err)
+
+		updater, ok := handler.(handlers.WebSocketSubscriptionUpdater[slinkytypes.CurrencyPair])
+		require.True(t, ok)
+		require.Error(t, updater.UpdateSubscriptions([]slinkytypes.CurrencyPair{ethusd}, nil))
+	})
+
+	t.Run("updates the subscriptions of a live connection", func(t *testing.T) {
+		connHandler := handlermocks.NewWebSocketConnHandler(t)
+		connHandler.On("Dial").Return(nil).Once()
+		connHandler.On("Write", mock.Anything).Return(nil)
+		connHandler.On("Read").Return(testMessage, nil).Run(func(_ mock.Arguments) {
+			time.Sleep(10 * time.Millisecond)
+		})
+		connHandler.On("Close").Return(nil).Maybe()
+
+		dataHandler := handlermocks.NewWebSocketDataHandler[slinkytypes.CurrencyPair, *big.Int](t)
+		dataHandler.On("CreateMessages", []slinkytypes.CurrencyPair{btcusd, ethusd}).Return(
+			[]handlers.WebsocketEncodedMessage{[]byte("subscribe BTC/USD ETH/USD")}, nil,
+		).Once()
+		dataHandler.On("CreateMessages", []slinkytypes.CurrencyPair{atomusd}).Return(
+			[]handlers.WebsocketEncodedMessage{[]byte("subscribe ATOM/USD")}, nil,
+		).Once()
+		dataHandler.On("HandleMessage", testMessage).Return(
+			providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int]{}, nil, nil,
+		)
+
+		handler, err := handlers.NewWebSocketQueryHandler[slinkytypes.CurrencyPair, *big.Int](
+			logger,
+			cfg,
+			subscriptionDataHandler{dataHandler},
+			connHandler,
+			newMetrics(),
+		)
+		require.NoError(t, err)
+
+		updater, ok := handler.(handlers.WebSocketSubscriptionUpdater[slinkytypes.CurrencyPair])
+		require.True(t, ok)
+
+		responseCh := make(chan providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int], 1024)
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			handler.Start(ctx, []slinkytypes.CurrencyPair{btcusd, ethusd}, responseCh)
+		}()
+
+		require.Eventually(t, func() bool {
+			return updater.UpdateSubscriptions(
+				[]slinkytypes.CurrencyPair{atomusd},
+				[]slinkytypes.CurrencyPair{ethusd},
+			) == nil
+		}, 5*time.Second, 50*time.Millisecond)
+
+		connHandler.AssertCalled(t, "Write", []byte("unsubscribe ETH/USD"))
+		connHandler.AssertCalled(t, "Write", []byte("subscribe ATOM/USD"))
+
+		// The subscriptions cannot be updated once the connection is closed.
+		cancel()
+		<-done
+		require.Error(t, updater.UpdateSubscriptions([]slinkytypes.CurrencyPair{ethusd}, nil))
+	})
+}
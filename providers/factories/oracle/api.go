@@ -15,10 +15,12 @@ import (
 	coinbaseapi "github.com/zoguxprotocol/slinky/providers/apis/coinbase"
 	"github.com/zoguxprotocol/slinky/providers/apis/coingecko"
 	"github.com/zoguxprotocol/slinky/providers/apis/coinmarketcap"
+	"github.com/zoguxprotocol/slinky/providers/apis/defi/erc4626"
 	"github.com/zoguxprotocol/slinky/providers/apis/defi/osmosis"
 	"github.com/zoguxprotocol/slinky/providers/apis/defi/raydium"
 	"github.com/zoguxprotocol/slinky/providers/apis/defi/uniswapv3"
 	"github.com/zoguxprotocol/slinky/providers/apis/geckoterminal"
+	"github.com/zoguxprotocol/slinky/providers/apis/generic"
 	"github.com/zoguxprotocol/slinky/providers/apis/kraken"
 	"github.com/zoguxprotocol/slinky/providers/apis/polymarket"
 	apihandlers "github.com/zoguxprotocol/slinky/providers/base/api/handlers"
@@ -35,6 +37,29 @@ func APIQueryHandlerFactory(
 	logger *zap.Logger,
 	cfg config.ProviderConfig,
 	metrics metrics.APIMetrics,
+) (types.PriceAPIQueryHandler, error) {
+	return newAPIQueryHandler(ctx, logger, cfg, metrics, nil)
+}
+
+// NewAPIQueryHandlerFactory returns an API query handler factory that wraps the request handler of each
+// provider with the given middleware.
+func NewAPIQueryHandlerFactory(middleware RequestHandlerMiddleware) types.PriceAPIQueryHandlerFactory {
+	return func(
+		ctx context.Context,
+		logger *zap.Logger,
+		cfg config.ProviderConfig,
+		metrics metrics.APIMetrics,
+	) (types.PriceAPIQueryHandler, error) {
+		return newAPIQueryHandler(ctx, logger, cfg, metrics, middleware)
+	}
+}
+
+func newAPIQueryHandler(
+	ctx context.Context,
+	logger *zap.Logger,
+	cfg config.ProviderConfig,
+	metrics metrics.APIMetrics,
+	middleware RequestHandlerMiddleware,
 ) (types.PriceAPIQueryHandler, error) {
 	// Validate the provider config.
 	err := cfg.ValidateBasic()
@@ -64,7 +89,14 @@ func APIQueryHandlerFactory(
 		headers[cfg.API.Endpoints[0].Authentication.APIKeyHeader] = cfg.API.Endpoints[0].Authentication.APIKey
 	}
 
-	requestHandler, err := apihandlers.NewRequestHandlerImpl(client, apihandlers.WithHTTPHeaders(headers))
+	// If the provider has multiple endpoints, spread requests across all of them, failing over
+	// to the healthiest endpoint when one is rate limited or unavailable.
+	var requestHandler apihandlers.RequestHandler
+	if len(cfg.API.Endpoints) > 1 {
+		requestHandler, err = apihandlers.NewEndpointPool(logger, client, cfg.API)
+	} else {
+		requestHandler, err = apihandlers.NewRequestHandlerImpl(client, apihandlers.WithHTTPHeaders(headers))
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -86,6 +118,8 @@ func APIQueryHandlerFactory(
 		apiDataHandler, err = kraken.NewAPIHandler(cfg.API)
 	case strings.HasPrefix(providerName, uniswapv3.BaseName):
 		apiPriceFetcher, err = uniswapv3.NewPriceFetcher(ctx, logger, metrics, cfg.API)
+	case strings.HasPrefix(providerName, erc4626.BaseName):
+		apiPriceFetcher, err = erc4626.NewPriceFetcher(ctx, logger, metrics, cfg.API)
 	case providerName == static.Name:
 		apiDataHandler = static.NewAPIHandler()
 		requestHandler = static.NewStaticMockClient()
@@ -98,6 +132,8 @@ func APIQueryHandlerFactory(
 		apiPriceFetcher, err = osmosis.NewAPIPriceFetcher(logger, cfg.API, metrics)
 	case providerName == polymarket.Name:
 		apiDataHandler, err = polymarket.NewAPIHandler(cfg.API)
+	case generic.IsGenericProvider(providerName):
+		apiDataHandler, err = generic.NewAPIHandler(cfg.API)
 	default:
 		return nil, fmt.Errorf("unknown provider: %s", cfg.Name)
 	}
@@ -105,6 +141,17 @@ func APIQueryHandlerFactory(
 		return nil, err
 	}
 
+	if middleware != nil {
+		if !SupportsRequestHandlerMiddleware(cfg.Name) {
+			logger.Warn(
+				"provider fetches prices without the request handler; middleware is not applied",
+				zap.String("provider", cfg.Name),
+			)
+		}
+
+		requestHandler = middleware(cfg.Name, requestHandler)
+	}
+
 	// if no apiPriceFetcher has been created yet, create a default REST API price fetcher.
 	if apiPriceFetcher == nil {
 		apiPriceFetcher, err = apihandlers.NewRestAPIFetcher(
@@ -127,3 +174,19 @@ func APIQueryHandlerFactory(
 		metrics,
 	)
 }
+
+// SupportsRequestHandlerMiddleware returns true if the API price provider with the given name fetches its
+// prices with the request handler that is wrapped by the middleware given to NewAPIQueryHandlerFactory.
+// Providers with their own price fetchers query their data sources directly, so the middleware never sees
+// their requests.
+func SupportsRequestHandlerMiddleware(provider string) bool {
+	switch {
+	case strings.HasPrefix(provider, uniswapv3.BaseName),
+		strings.HasPrefix(provider, erc4626.BaseName),
+		provider == raydium.Name,
+		provider == osmosis.Name:
+		return false
+	default:
+		return true
+	}
+}
@@ -30,6 +30,38 @@ func MarketMapProviderFactory(
 		return nil, err
 	}
 
+	marketMapFetcher, ids, err := MarketMapFetcherFactory(logger, apiMetrics, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	queryHandler, err := types.NewMarketMapAPIQueryHandlerWithMarketMapFetcher(
+		logger,
+		cfg.API,
+		marketMapFetcher,
+		apiMetrics,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewMarketMapProvider(
+		base.WithName[types.Chain, *mmtypes.MarketMapResponse](cfg.Name),
+		base.WithLogger[types.Chain, *mmtypes.MarketMapResponse](logger),
+		base.WithAPIQueryHandler(queryHandler),
+		base.WithAPIConfig[types.Chain, *mmtypes.MarketMapResponse](cfg.API),
+		base.WithMetrics[types.Chain, *mmtypes.MarketMapResponse](providerMetrics),
+		base.WithIDs[types.Chain, *mmtypes.MarketMapResponse](ids),
+	)
+}
+
+// MarketMapFetcherFactory returns the fetcher used by the market map provider with the given config, along
+// with the chains it should be queried for.
+func MarketMapFetcherFactory(
+	logger *zap.Logger,
+	apiMetrics apimetrics.APIMetrics,
+	cfg config.ProviderConfig,
+) (types.MarketMapFetcher, []types.Chain, error) {
 	client := &http.Client{
 		Transport: &http.Transport{
 			MaxConnsPerHost: cfg.API.MaxQueries,
@@ -46,7 +78,7 @@ func MarketMapProviderFactory(
 
 	requestHandler, err := apihandlers.NewRequestHandlerImpl(client)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	switch cfg.Name {
@@ -78,7 +110,7 @@ func MarketMapProviderFactory(
 		ids = []types.Chain{{ChainID: "local-node"}}
 	}
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if marketMapFetcher == nil {
@@ -90,26 +122,9 @@ func MarketMapProviderFactory(
 			logger,
 		)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
-	queryHandler, err := types.NewMarketMapAPIQueryHandlerWithMarketMapFetcher(
-		logger,
-		cfg.API,
-		marketMapFetcher,
-		apiMetrics,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	return types.NewMarketMapProvider(
-		base.WithName[types.Chain, *mmtypes.MarketMapResponse](cfg.Name),
-		base.WithLogger[types.Chain, *mmtypes.MarketMapResponse](logger),
-		base.WithAPIQueryHandler(queryHandler),
-		base.WithAPIConfig[types.Chain, *mmtypes.MarketMapResponse](cfg.API),
-		base.WithMetrics[types.Chain, *mmtypes.MarketMapResponse](providerMetrics),
-		base.WithIDs[types.Chain, *mmtypes.MarketMapResponse](ids),
-	)
+	return marketMapFetcher, ids, nil
 }
@@ -0,0 +1,14 @@
+package oracle
+
+import (
+	apihandlers "github.com/zoguxprotocol/slinky/providers/base/api/handlers"
+	wshandlers "github.com/zoguxprotocol/slinky/providers/base/websocket/handlers"
+)
+
+// RequestHandlerMiddleware wraps the request handler used by the API price provider with the given name,
+// e.g. to record or replay its responses.
+type RequestHandlerMiddleware func(provider string, handler apihandlers.RequestHandler) apihandlers.RequestHandler
+
+// WebSocketConnHandlerMiddleware wraps the connection handler used by the websocket price provider with the
+// given name, e.g. to record or replay its messages.
+type WebSocketConnHandlerMiddleware func(provider string, handler wshandlers.WebSocketConnHandler) wshandlers.WebSocketConnHandler
@@ -19,6 +19,7 @@ import (
 	coinbasews "github.com/zoguxprotocol/slinky/providers/websockets/coinbase"
 	"github.com/zoguxprotocol/slinky/providers/websockets/cryptodotcom"
 	"github.com/zoguxprotocol/slinky/providers/websockets/gate"
+	genericws "github.com/zoguxprotocol/slinky/providers/websockets/generic"
 	"github.com/zoguxprotocol/slinky/providers/websockets/huobi"
 	"github.com/zoguxprotocol/slinky/providers/websockets/kraken"
 	"github.com/zoguxprotocol/slinky/providers/websockets/kucoin"
@@ -34,6 +35,28 @@ func WebSocketQueryHandlerFactory(
 	logger *zap.Logger,
 	cfg config.ProviderConfig,
 	wsMetrics wsmetrics.WebSocketMetrics,
+) (types.PriceWebSocketQueryHandler, error) {
+	return newWebSocketQueryHandler(logger, cfg, wsMetrics, nil)
+}
+
+// NewWebSocketQueryHandlerFactory returns a websocket query handler factory that wraps the connection handler
+// of each provider with the given middleware.
+func NewWebSocketQueryHandlerFactory(middleware WebSocketConnHandlerMiddleware) types.PriceWebSocketQueryHandlerFactory {
+	return func(
+		_ context.Context,
+		logger *zap.Logger,
+		cfg config.ProviderConfig,
+		wsMetrics wsmetrics.WebSocketMetrics,
+	) (types.PriceWebSocketQueryHandler, error) {
+		return newWebSocketQueryHandler(logger, cfg, wsMetrics, middleware)
+	}
+}
+
+func newWebSocketQueryHandler(
+	logger *zap.Logger,
+	cfg config.ProviderConfig,
+	wsMetrics wsmetrics.WebSocketMetrics,
+	middleware WebSocketConnHandlerMiddleware,
 ) (types.PriceWebSocketQueryHandler, error) {
 	err := cfg.ValidateBasic()
 	if err != nil {
@@ -56,26 +79,26 @@ func WebSocketQueryHandlerFactory(
 		connHandler    wshandlers.WebSocketConnHandler
 	)
 
-	switch cfg.Name {
-	case binance.Name:
+	switch providerName := cfg.Name; {
+	case providerName == binance.Name:
 		wsDataHandler, err = binance.NewWebSocketDataHandler(logger, cfg.WebSocket)
-	case bitfinex.Name:
+	case providerName == bitfinex.Name:
 		wsDataHandler, err = bitfinex.NewWebSocketDataHandler(logger, cfg.WebSocket)
-	case bitstamp.Name:
+	case providerName == bitstamp.Name:
 		wsDataHandler, err = bitstamp.NewWebSocketDataHandler(logger, cfg.WebSocket)
-	case bybit.Name:
+	case providerName == bybit.Name:
 		wsDataHandler, err = bybit.NewWebSocketDataHandler(logger, cfg.WebSocket)
-	case coinbasews.Name:
+	case providerName == coinbasews.Name:
 		wsDataHandler, err = coinbasews.NewWebSocketDataHandler(logger, cfg.WebSocket)
-	case cryptodotcom.Name:
+	case providerName == cryptodotcom.Name:
 		wsDataHandler, err = cryptodotcom.NewWebSocketDataHandler(logger, cfg.WebSocket)
-	case gate.Name:
+	case providerName == gate.Name:
 		wsDataHandler, err = gate.NewWebSocketDataHandler(logger, cfg.WebSocket)
-	case huobi.Name:
+	case providerName == huobi.Name:
 		wsDataHandler, err = huobi.NewWebSocketDataHandler(logger, cfg.WebSocket)
-	case kraken.Name:
+	case providerName == kraken.Name:
 		wsDataHandler, err = kraken.NewWebSocketDataHandler(logger, cfg.WebSocket)
-	case kucoin.Name:
+	case providerName == kucoin.Name:
 		// Create the KuCoin websocket data handler.
 		wsDataHandler, err = kucoin.NewWebSocketDataHandler(logger, cfg.WebSocket)
 		if err != nil {
@@ -96,10 +119,12 @@ func WebSocketQueryHandlerFactory(
 			cfg.WebSocket,
 			wshandlers.WithPreDialHook(kucoin.PreDialHook(cfg.API, requestHandler)),
 		)
-	case mexc.Name:
+	case providerName == mexc.Name:
 		wsDataHandler, err = mexc.NewWebSocketDataHandler(logger, cfg.WebSocket)
-	case okx.Name:
+	case providerName == okx.Name:
 		wsDataHandler, err = okx.NewWebSocketDataHandler(logger, cfg.WebSocket)
+	case genericws.IsGenericProvider(providerName):
+		wsDataHandler, err = genericws.NewWebSocketDataHandler(logger, cfg.WebSocket)
 	default:
 		return nil, fmt.Errorf("unknown provider: %s", cfg.Name)
 	}
@@ -115,6 +140,10 @@ func WebSocketQueryHandlerFactory(
 		}
 	}
 
+	if middleware != nil {
+		connHandler = middleware(cfg.Name, connHandler)
+	}
+
 	// Create the websocket query handler which encapsulates all fetching and parsing logic.
 	return types.NewPriceWebSocketQueryHandler(
 		logger,
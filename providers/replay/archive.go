@@ -0,0 +1,84 @@
+package replay
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"time"
+)
+
+// RecordType is the type of a recorded provider response.
+type RecordType string
+
+const (
+	// RecordTypeHTTP is an HTTP response returned by a RequestHandler.
+	RecordTypeHTTP RecordType = "http"
+	// RecordTypeWebSocket is an inbound websocket message returned by a WebSocketConnHandler.
+	RecordTypeWebSocket RecordType = "websocket"
+)
+
+// Record is a single provider response in an archive.
+type Record struct {
+	// Provider is the name of the provider that received the response.
+	Provider string `json:"provider"`
+	// Type is the type of the response.
+	Type RecordType `json:"type"`
+	// Timestamp is the time at which the response was received.
+	Timestamp time.Time `json:"timestamp"`
+	// URL is the requested URL of an HTTP response.
+	URL string `json:"url,omitempty"`
+	// StatusCode is the status code of an HTTP response.
+	StatusCode int `json:"status_code,omitempty"`
+	// Header is the header of an HTTP response.
+	Header http.Header `json:"header,omitempty"`
+	// Conn identifies the websocket connection that received a message. A provider creates a connection
+	// per batch of markets, in the same order on every run.
+	Conn int64 `json:"conn,omitempty"`
+	// Body is the body of an HTTP response, or the websocket message.
+	Body []byte `json:"body,omitempty"`
+	// Error is the error returned instead of a response, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// ReadArchive reads all records from the archive at the given path. The archive is a file of JSON encoded
+// records, one per line. The records are returned sorted by timestamp.
+func ReadArchive(path string) ([]Record, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening archive: %w", err)
+	}
+	defer f.Close()
+
+	var records []Record
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxRecordSize)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf("error unmarshalling record on line %d: %w", line, err)
+		}
+
+		records = append(records, record)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading archive: %w", err)
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Timestamp.Before(records[j].Timestamp)
+	})
+
+	return records, nil
+}
+
+// maxRecordSize is the maximum size of a single encoded record.
+const maxRecordSize = 64 * 1024 * 1024
@@ -0,0 +1,100 @@
+package replay_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/zoguxprotocol/slinky/oracle"
+	"github.com/zoguxprotocol/slinky/oracle/config"
+	oraclemetrics "github.com/zoguxprotocol/slinky/oracle/metrics"
+	oracletypes "github.com/zoguxprotocol/slinky/oracle/types"
+	oraclemath "github.com/zoguxprotocol/slinky/pkg/math/oracle"
+	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
+	"github.com/zoguxprotocol/slinky/providers/apis/coinbase"
+	oraclefactory "github.com/zoguxprotocol/slinky/providers/factories/oracle"
+	"github.com/zoguxprotocol/slinky/providers/replay"
+	mmtypes "github.com/zoguxprotocol/slinky/x/marketmap/types"
+)
+
+func TestReplayThroughOracle(t *testing.T) {
+	btcusd := slinkytypes.NewCurrencyPair("BTC", "USD")
+	marketMap := mmtypes.MarketMap{Markets: map[string]mmtypes.Market{
+		btcusd.String(): {
+			Ticker: mmtypes.Ticker{
+				CurrencyPair:     btcusd,
+				Decimals:         8,
+				MinProviderCount: 1,
+				Enabled:          true,
+			},
+			ProviderConfigs: []mmtypes.ProviderConfig{{Name: coinbase.Name, OffChainTicker: "BTC-USD"}},
+		},
+	}}
+
+	cfg := config.OracleConfig{
+		UpdateInterval: 50 * time.Millisecond,
+		MaxPriceAge:    time.Minute,
+		Host:           "localhost",
+		Port:           "8080",
+		Providers: map[string]config.ProviderConfig{
+			coinbase.Name: {
+				Name: coinbase.Name,
+				API:  coinbase.DefaultAPIConfig,
+				Type: oracletypes.ConfigType,
+			},
+		},
+	}
+
+	// an archive with a single recorded coinbase response; the replayed price is parsed by the real
+	// coinbase handler, and aggregated by the real aggregator.
+	records := []replay.Record{
+		{
+			Provider:   coinbase.Name,
+			Type:       replay.RecordTypeHTTP,
+			Timestamp:  time.Now(),
+			URL:        fmt.Sprintf(coinbase.URL, "BTC-USD"),
+			StatusCode: 200,
+			Body:       []byte(`{"data":{"amount":"42000.5","currency":"USD"}}`),
+		},
+	}
+
+	replayer, err := replay.NewReplayer(zap.NewNop(), records, replay.WithSpeed(0))
+	require.NoError(t, err)
+
+	metrics := oraclemetrics.NewNopMetrics()
+	aggregator, err := oraclemath.NewIndexPriceAggregator(zap.NewNop(), marketMap, metrics)
+	require.NoError(t, err)
+
+	orc, err := oracle.New(
+		cfg,
+		aggregator,
+		oracle.WithLogger(zap.NewNop()),
+		oracle.WithMarketMap(marketMap),
+		oracle.WithMetrics(metrics),
+		oracle.WithPriceAPIQueryHandlerFactory(oraclefactory.NewAPIQueryHandlerFactory(replayer.WrapRequestHandler)),
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go orc.Start(ctx)
+	defer orc.Stop()
+
+	// prices are scaled to the decimals of the market.
+	expected := big.NewInt(4200050000000)
+	require.Eventually(t, func() bool {
+		price, ok := orc.GetPrices()[btcusd.String()]
+		if !ok {
+			return false
+		}
+
+		scaled, _ := price.Int(nil)
+		return scaled.Cmp(expected) == 0
+	}, 5*time.Second, 50*time.Millisecond)
+}
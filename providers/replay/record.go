@@ -0,0 +1,168 @@
+package replay
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	apihandlers "github.com/zoguxprotocol/slinky/providers/base/api/handlers"
+	wshandlers "github.com/zoguxprotocol/slinky/providers/base/websocket/handlers"
+)
+
+// Recorder appends every response received by the request and connection handlers it wraps to an archive.
+// WrapRequestHandler and WrapConnHandler can be used as the middleware of the provider factories.
+type Recorder struct {
+	mu sync.Mutex
+
+	logger *zap.Logger
+	file   *os.File
+	enc    *json.Encoder
+}
+
+// NewRecorder returns a recorder that appends to the archive at the given path, creating it if needed.
+func NewRecorder(logger *zap.Logger, path string) (*Recorder, error) {
+	if logger == nil {
+		return nil, fmt.Errorf("logger is required")
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("error opening archive: %w", err)
+	}
+
+	return &Recorder{
+		logger: logger.With(zap.String("archive", path)),
+		file:   f,
+		enc:    json.NewEncoder(f),
+	}, nil
+}
+
+// Record appends the record to the archive.
+func (r *Recorder) Record(record Record) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.enc.Encode(record)
+}
+
+// Close closes the archive.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.file.Close()
+}
+
+// WrapRequestHandler returns a request handler that records every response returned by the given handler.
+func (r *Recorder) WrapRequestHandler(provider string, handler apihandlers.RequestHandler) apihandlers.RequestHandler {
+	return &recordingRequestHandler{
+		RequestHandler: handler,
+		recorder:       r,
+		provider:       provider,
+	}
+}
+
+// WrapConnHandler returns a connection handler that records every message read by the given handler.
+func (r *Recorder) WrapConnHandler(provider string, handler wshandlers.WebSocketConnHandler) wshandlers.WebSocketConnHandler {
+	return &recordingConnHandler{
+		WebSocketConnHandler: handler,
+		recorder:             r,
+		provider:             provider,
+		conns:                &atomic.Int64{},
+	}
+}
+
+// record records the given record, logging any failure. Failing to record a response never fails the request.
+func (r *Recorder) record(record Record) {
+	if err := r.Record(record); err != nil {
+		r.logger.Error("failed to record response", zap.String("provider", record.Provider), zap.Error(err))
+	}
+}
+
+type recordingRequestHandler struct {
+	apihandlers.RequestHandler
+
+	recorder *Recorder
+	provider string
+}
+
+// Do sends the request, and records its response. The response body is read fully, and replaced with a copy.
+func (h *recordingRequestHandler) Do(ctx context.Context, url string) (*http.Response, error) {
+	record := Record{
+		Provider: h.provider,
+		Type:     RecordTypeHTTP,
+		URL:      url,
+	}
+
+	resp, err := h.RequestHandler.Do(ctx, url)
+	record.Timestamp = time.Now().UTC()
+	if err != nil {
+		record.Error = err.Error()
+		h.recorder.record(record)
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	record.StatusCode = resp.StatusCode
+	record.Header = resp.Header
+	record.Body = body
+	if err != nil {
+		record.Error = err.Error()
+	}
+
+	h.recorder.record(record)
+	return resp, err
+}
+
+type recordingConnHandler struct {
+	wshandlers.WebSocketConnHandler
+
+	recorder *Recorder
+	provider string
+
+	// conn identifies this connection, and conns is the number of connections copied from the same handler.
+	conn  int64
+	conns *atomic.Int64
+}
+
+// Read reads a message, and records it.
+func (h *recordingConnHandler) Read() ([]byte, error) {
+	message, err := h.WebSocketConnHandler.Read()
+
+	record := Record{
+		Provider:  h.provider,
+		Type:      RecordTypeWebSocket,
+		Timestamp: time.Now().UTC(),
+		Conn:      h.conn,
+		Body:      message,
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+
+	h.recorder.record(record)
+	return message, err
+}
+
+// Copy returns a recording copy of the underlying connection handler.
+func (h *recordingConnHandler) Copy() wshandlers.WebSocketConnHandler {
+	return &recordingConnHandler{
+		WebSocketConnHandler: h.WebSocketConnHandler.Copy(),
+		recorder:             h.recorder,
+		provider:             h.provider,
+		conn:                 h.conns.Add(1),
+		conns:                h.conns,
+	}
+}
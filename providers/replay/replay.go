@@ -0,0 +1,316 @@
+package replay
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	apihandlers "github.com/zoguxprotocol/slinky/providers/base/api/handlers"
+	wshandlers "github.com/zoguxprotocol/slinky/providers/base/websocket/handlers"
+)
+
+var (
+	// ErrArchiveExhausted is returned once every recorded response for a request or connection has been replayed.
+	ErrArchiveExhausted = errors.New("replay archive exhausted")
+
+	// ErrConnClosed is returned by Read if the replayed connection is closed while waiting for a message.
+	ErrConnClosed = errors.New("replayed connection closed")
+)
+
+const (
+	// DefaultSpeed replays the archive in real time.
+	DefaultSpeed = 1.0
+
+	// DefaultIdleTimeout is the time Read waits before returning ErrArchiveExhausted.
+	DefaultIdleTimeout = time.Second
+)
+
+// ReplayerOption is a function that configures a Replayer.
+type ReplayerOption func(*Replayer)
+
+// WithSpeed sets the speed at which the archive is replayed, relative to the time it was recorded in. A speed
+// of 0 replays every response as soon as it is requested.
+func WithSpeed(speed float64) ReplayerOption {
+	return func(r *Replayer) {
+		r.speed = speed
+	}
+}
+
+// WithIdleTimeout sets the time a replayed connection waits before returning ErrArchiveExhausted.
+func WithIdleTimeout(timeout time.Duration) ReplayerOption {
+	return func(r *Replayer) {
+		r.idleTimeout = timeout
+	}
+}
+
+// Replayer serves the responses in an archive instead of querying the providers. WrapRequestHandler and
+// WrapConnHandler can be used as the middleware of the provider factories. The archive is replayed from the
+// time of its earliest record, starting on the first request.
+type Replayer struct {
+	mu sync.Mutex
+
+	logger      *zap.Logger
+	speed       float64
+	idleTimeout time.Duration
+
+	// start is the timestamp of the earliest record, and began is the time at which the replay began.
+	start time.Time
+	began time.Time
+
+	// requests are the recorded HTTP responses by provider and URL, and messages are the recorded
+	// websocket messages by provider and connection.
+	requests map[requestKey][]Record
+	messages map[connKey][]Record
+}
+
+type requestKey struct {
+	provider string
+	url      string
+}
+
+type connKey struct {
+	provider string
+	conn     int64
+}
+
+// NewReplayer returns a replayer of the given records.
+func NewReplayer(logger *zap.Logger, records []Record, opts ...ReplayerOption) (*Replayer, error) {
+	if logger == nil {
+		return nil, fmt.Errorf("logger is required")
+	}
+
+	r := &Replayer{
+		logger:      logger.With(zap.String("component", "replayer")),
+		speed:       DefaultSpeed,
+		idleTimeout: DefaultIdleTimeout,
+		requests:    make(map[requestKey][]Record),
+		messages:    make(map[connKey][]Record),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if r.speed < 0 {
+		return nil, fmt.Errorf("replay speed cannot be negative: %f", r.speed)
+	}
+
+	for _, record := range records {
+		if r.start.IsZero() || record.Timestamp.Before(r.start) {
+			r.start = record.Timestamp
+		}
+
+		switch record.Type {
+		case RecordTypeHTTP:
+			key := requestKey{provider: record.Provider, url: record.URL}
+			r.requests[key] = append(r.requests[key], record)
+		case RecordTypeWebSocket:
+			key := connKey{provider: record.Provider, conn: record.Conn}
+			r.messages[key] = append(r.messages[key], record)
+		default:
+			return nil, fmt.Errorf("unknown record type %q for provider %s", record.Type, record.Provider)
+		}
+	}
+
+	return r, nil
+}
+
+// WrapRequestHandler returns a request handler that serves the recorded responses of the given provider. The
+// wrapped handler is never called.
+func (r *Replayer) WrapRequestHandler(provider string, handler apihandlers.RequestHandler) apihandlers.RequestHandler {
+	return &replayRequestHandler{
+		replayer: r,
+		provider: provider,
+		typ:      handler.Type(),
+	}
+}
+
+// WrapConnHandler returns a connection handler that serves the recorded messages of the given provider. The
+// wrapped handler is never called.
+func (r *Replayer) WrapConnHandler(provider string, _ wshandlers.WebSocketConnHandler) wshandlers.WebSocketConnHandler {
+	return newReplayConnHandler(r, provider, 0, &atomic.Int64{})
+}
+
+// untilDue returns the time until the given record is due, starting the replay if needed. Must be called
+// with the lock held.
+func (r *Replayer) untilDue(record Record) time.Duration {
+	if r.began.IsZero() {
+		r.began = time.Now()
+	}
+
+	if r.speed == 0 {
+		return 0
+	}
+
+	elapsed := time.Duration(float64(time.Since(r.began)) * r.speed)
+	return time.Duration(float64(record.Timestamp.Sub(r.start)-elapsed) / r.speed)
+}
+
+// nextResponse returns the latest due response for the given request, or the next response if none is due.
+func (r *Replayer) nextResponse(key requestKey) (Record, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	queue, ok := r.requests[key]
+	if !ok {
+		return Record{}, fmt.Errorf("no recorded responses for %s request to %s", key.provider, key.url)
+	}
+	if len(queue) == 0 {
+		return Record{}, ErrArchiveExhausted
+	}
+
+	// without pacing, responses are served in the order they were recorded.
+	i := 0
+	for r.speed > 0 && i+1 < len(queue) && r.untilDue(queue[i+1]) <= 0 {
+		i++
+	}
+
+	r.untilDue(queue[i])
+	r.requests[key] = queue[i+1:]
+	return queue[i], nil
+}
+
+// nextMessage returns the next message for the given connection, and the time until it is due.
+func (r *Replayer) nextMessage(key connKey) (Record, time.Duration, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	queue := r.messages[key]
+	if len(queue) == 0 {
+		return Record{}, 0, false
+	}
+
+	r.messages[key] = queue[1:]
+	return queue[0], r.untilDue(queue[0]), true
+}
+
+type replayRequestHandler struct {
+	replayer *Replayer
+	provider string
+	typ      string
+}
+
+// Do returns the recorded response for the given URL.
+func (h *replayRequestHandler) Do(_ context.Context, url string) (*http.Response, error) {
+	record, err := h.replayer.nextResponse(requestKey{provider: h.provider, url: url})
+	if err != nil {
+		return nil, err
+	}
+
+	if record.Error != "" && record.StatusCode == 0 {
+		return nil, errors.New(record.Error)
+	}
+
+	return &http.Response{
+		StatusCode: record.StatusCode,
+		Status:     http.StatusText(record.StatusCode),
+		Header:     record.Header,
+		Body:       io.NopCloser(bytes.NewReader(record.Body)),
+	}, nil
+}
+
+// Type returns the type of the replaced request handler.
+func (h *replayRequestHandler) Type() string {
+	return h.typ
+}
+
+type replayConnHandler struct {
+	mu sync.Mutex
+
+	replayer *Replayer
+	provider string
+
+	// conn identifies this connection, and conns is the number of connections copied from the same handler.
+	conn  int64
+	conns *atomic.Int64
+
+	// closed is closed when the connection is closed.
+	closed chan struct{}
+}
+
+func newReplayConnHandler(r *Replayer, provider string, conn int64, conns *atomic.Int64) *replayConnHandler {
+	closed := make(chan struct{})
+	close(closed)
+
+	return &replayConnHandler{
+		replayer: r,
+		provider: provider,
+		conn:     conn,
+		conns:    conns,
+		closed:   closed,
+	}
+}
+
+// Read blocks until the next recorded message for this connection is due, and returns it. Once every message
+// has been read, Read waits for the idle timeout and returns ErrArchiveExhausted.
+func (h *replayConnHandler) Read() ([]byte, error) {
+	h.mu.Lock()
+	closed := h.closed
+	h.mu.Unlock()
+
+	record, wait, ok := h.replayer.nextMessage(connKey{provider: h.provider, conn: h.conn})
+	if !ok {
+		wait = h.replayer.idleTimeout
+	}
+
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+
+		select {
+		case <-closed:
+			return nil, ErrConnClosed
+		case <-timer.C:
+		}
+	}
+
+	if !ok {
+		return nil, ErrArchiveExhausted
+	}
+	if record.Error != "" {
+		return record.Body, errors.New(record.Error)
+	}
+
+	return record.Body, nil
+}
+
+// Write discards the message.
+func (h *replayConnHandler) Write([]byte) error {
+	return nil
+}
+
+// Dial opens the connection.
+func (h *replayConnHandler) Dial() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = make(chan struct{})
+	return nil
+}
+
+// Close closes the connection, unblocking any pending Read.
+func (h *replayConnHandler) Close() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	select {
+	case <-h.closed:
+	default:
+		close(h.closed)
+	}
+
+	return nil
+}
+
+// Copy returns a handler that replays the next connection of the same provider.
+func (h *replayConnHandler) Copy() wshandlers.WebSocketConnHandler {
+	return newReplayConnHandler(h.replayer, h.provider, h.conns.Add(1), h.conns)
+}
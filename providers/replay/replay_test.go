@@ -0,0 +1,198 @@
+package replay_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	apihandlers "github.com/zoguxprotocol/slinky/providers/base/api/handlers"
+	apimocks "github.com/zoguxprotocol/slinky/providers/base/api/handlers/mocks"
+	"github.com/zoguxprotocol/slinky/providers/base/websocket/handlers/mocks"
+	"github.com/zoguxprotocol/slinky/providers/replay"
+)
+
+func TestRecordAndReplayRequests(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"price":%d}`, calls)
+	}))
+	defer server.Close()
+
+	handler, err := apihandlers.NewRequestHandlerImpl(server.Client())
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "archive.jsonl")
+	recorder, err := replay.NewRecorder(zap.NewNop(), path)
+	require.NoError(t, err)
+
+	recording := recorder.WrapRequestHandler("test", handler)
+	for i := 1; i <= 3; i++ {
+		resp, err := recording.Do(context.Background(), server.URL)
+		require.NoError(t, err)
+
+		// the caller still receives the full body.
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.Equal(t, fmt.Sprintf(`{"price":%d}`, i), string(body))
+	}
+	require.NoError(t, recorder.Close())
+
+	records, err := replay.ReadArchive(path)
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	require.Equal(t, replay.RecordTypeHTTP, records[0].Type)
+	require.Equal(t, http.StatusOK, records[0].StatusCode)
+	require.Equal(t, "application/json", records[0].Header.Get("Content-Type"))
+
+	replayer, err := replay.NewReplayer(zap.NewNop(), records, replay.WithSpeed(0))
+	require.NoError(t, err)
+
+	replaying := replayer.WrapRequestHandler("test", handler)
+	require.Equal(t, handler.Type(), replaying.Type())
+	for i := 1; i <= 3; i++ {
+		resp, err := replaying.Do(context.Background(), server.URL)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.Equal(t, fmt.Sprintf(`{"price":%d}`, i), string(body))
+	}
+
+	// the server is never queried during the replay.
+	require.Equal(t, 3, calls)
+
+	_, err = replaying.Do(context.Background(), server.URL)
+	require.ErrorIs(t, err, replay.ErrArchiveExhausted)
+
+	_, err = replaying.Do(context.Background(), server.URL+"/unknown")
+	require.Error(t, err)
+}
+
+func TestReplayRequestsPacing(t *testing.T) {
+	start := time.Now()
+	records := []replay.Record{
+		{Provider: "test", Type: replay.RecordTypeHTTP, Timestamp: start, URL: "url", StatusCode: 200, Body: []byte("1")},
+		{Provider: "test", Type: replay.RecordTypeHTTP, Timestamp: start.Add(time.Millisecond), URL: "url", StatusCode: 200, Body: []byte("2")},
+		{Provider: "test", Type: replay.RecordTypeHTTP, Timestamp: start.Add(2 * time.Millisecond), URL: "url", StatusCode: 200, Body: []byte("3")},
+		{Provider: "test", Type: replay.RecordTypeHTTP, Timestamp: start.Add(time.Hour), URL: "url", StatusCode: 200, Body: []byte("4")},
+		{Provider: "test", Type: replay.RecordTypeHTTP, Timestamp: start.Add(time.Hour), URL: "url", Error: "timeout"},
+	}
+
+	replayer, err := replay.NewReplayer(zap.NewNop(), records)
+	require.NoError(t, err)
+
+	requestHandler := apimocks.NewRequestHandler(t)
+	requestHandler.On("Type").Return(http.MethodGet).Once()
+
+	handler := replayer.WrapRequestHandler("test", requestHandler)
+	read := func() string {
+		resp, err := handler.Do(context.Background(), "url")
+		require.NoError(t, err)
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return string(body)
+	}
+
+	// the first request starts the replay.
+	require.Equal(t, "1", read())
+
+	// stale responses are skipped in favour of the latest due response.
+	time.Sleep(10 * time.Millisecond)
+	require.Equal(t, "3", read())
+
+	// if no response is due, the next one is served.
+	require.Equal(t, "4", read())
+
+	_, err = handler.Do(context.Background(), "url")
+	require.EqualError(t, err, "timeout")
+}
+
+func TestRecordAndReplayConnections(t *testing.T) {
+	conn := mocks.NewWebSocketConnHandler(t)
+	copied := mocks.NewWebSocketConnHandler(t)
+	conn.On("Copy").Return(copied).Once()
+	conn.On("Read").Return([]byte("a"), nil).Once()
+	copied.On("Read").Return([]byte("b"), nil).Once()
+	copied.On("Read").Return(nil, fmt.Errorf("closed")).Once()
+
+	path := filepath.Join(t.TempDir(), "archive.jsonl")
+	recorder, err := replay.NewRecorder(zap.NewNop(), path)
+	require.NoError(t, err)
+
+	recording := recorder.WrapConnHandler("test", conn)
+	recordingCopy := recording.Copy()
+
+	message, err := recording.Read()
+	require.NoError(t, err)
+	require.Equal(t, []byte("a"), message)
+
+	message, err = recordingCopy.Read()
+	require.NoError(t, err)
+	require.Equal(t, []byte("b"), message)
+
+	_, err = recordingCopy.Read()
+	require.Error(t, err)
+	require.NoError(t, recorder.Close())
+
+	records, err := replay.ReadArchive(path)
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+
+	replayer, err := replay.NewReplayer(
+		zap.NewNop(),
+		records,
+		replay.WithSpeed(0),
+		replay.WithIdleTimeout(10*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	replaying := replayer.WrapConnHandler("test", nil)
+	replayingCopy := replaying.Copy()
+	require.NoError(t, replaying.Dial())
+	require.NoError(t, replayingCopy.Dial())
+	require.NoError(t, replaying.Write([]byte("subscribe")))
+
+	message, err = replayingCopy.Read()
+	require.NoError(t, err)
+	require.Equal(t, []byte("b"), message)
+
+	_, err = replayingCopy.Read()
+	require.EqualError(t, err, "closed")
+
+	message, err = replaying.Read()
+	require.NoError(t, err)
+	require.Equal(t, []byte("a"), message)
+
+	_, err = replaying.Read()
+	require.ErrorIs(t, err, replay.ErrArchiveExhausted)
+
+	// closing the connection unblocks a pending read.
+	replayer, err = replay.NewReplayer(zap.NewNop(), records, replay.WithIdleTimeout(time.Hour))
+	require.NoError(t, err)
+
+	replaying = replayer.WrapConnHandler("test", nil)
+	require.NoError(t, replaying.Dial())
+
+	_, err = replaying.Read()
+	require.NoError(t, err)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		replaying.Close()
+	}()
+
+	_, err = replaying.Read()
+	require.ErrorIs(t, err, replay.ErrConnClosed)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"math/big"
 	"time"
 )
 
@@ -56,6 +57,9 @@ type ResolvedResult[V ResponseValue] struct {
 	// ResponseCode is an optional code that can be attached to responses to provide
 	// additional context.
 	ResponseCode ResponseCode
+	// Volume is an optional liquidity measure (e.g. the 24h traded base volume) reported
+	// alongside the value. This is nil if the provider does not report volume.
+	Volume *big.Float
 }
 
 // UnresolvedResult is an unresolved (failed) result of a single requested ID.
@@ -122,6 +126,17 @@ func NewResultWithCode[V ResponseValue](value V, timestamp time.Time, code Respo
 	}
 }
 
+// NewResultWithVolume creates a new ResolvedResult with the given volume. The volume may be nil, e.g.
+// if the provider reported a malformed volume: the value is still valid, it is only not weighted by
+// volume when aggregated.
+func NewResultWithVolume[V ResponseValue](value V, timestamp time.Time, volume *big.Float) ResolvedResult[V] {
+	return ResolvedResult[V]{
+		Value:     value,
+		Timestamp: timestamp,
+		Volume:    volume,
+	}
+}
+
 // String returns a string representation of the ResolvedResult. This is mostly used for logging
 // and testing purposes.
 func (r ResolvedResult[V]) String() string {
@@ -2,6 +2,7 @@ package binance
 
 import (
 	"fmt"
+	"math/big"
 	"time"
 
 	providertypes "github.com/zoguxprotocol/slinky/providers/types"
@@ -11,8 +12,9 @@ import (
 )
 
 // parsePriceUpdateMessage parses a price update message from the Binance websocket feed.
-// This is repurposed for ticker and aggregate trade messages.
-func (h *WebSocketHandler) parsePriceUpdateMessage(offChainTicker string, price string) (types.PriceResponse, error) {
+// This is repurposed for ticker and aggregate trade messages. The volume is only reported
+// by ticker messages and is left empty for aggregate trade messages.
+func (h *WebSocketHandler) parsePriceUpdateMessage(offChainTicker, price, volume string) (types.PriceResponse, error) {
 	var (
 		resolved   = make(types.ResolvedPrices)
 		unResolved = make(types.UnResolvedPrices)
@@ -33,6 +35,12 @@ func (h *WebSocketHandler) parsePriceUpdateMessage(offChainTicker string, price
 		return types.NewPriceResponse(resolved, unResolved), err
 	}
 
-	resolved[ticker] = types.NewPriceResult(priceFloat, time.Now().UTC())
+	// Only ticker messages report the 24h volume.
+	var volumeFloat *big.Float
+	if volume != "" {
+		volumeFloat, _ = math.Float64StringToBigFloat(volume)
+	}
+
+	resolved[ticker] = types.NewPriceResultWithVolume(priceFloat, time.Now().UTC(), volumeFloat)
 	return types.NewPriceResponse(resolved, unResolved), nil
 }
@@ -2,6 +2,7 @@ package bybit
 
 import (
 	"fmt"
+	"math/big"
 	"strings"
 	"time"
 
@@ -67,6 +68,12 @@ func (h *WebSocketHandler) parseTickerUpdate(
 		return types.NewPriceResponse(resolved, unresolved), nil
 	}
 
-	resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
+	// Attach the 24h volume of the ticker, if Bybit reported a valid one.
+	var volume *big.Float
+	if data.Volume24h != "" {
+		volume, _ = math.Float64StringToBigFloat(data.Volume24h)
+	}
+
+	resolved[ticker] = types.NewPriceResultWithVolume(price, time.Now().UTC(), volume)
 	return types.NewPriceResponse(resolved, unresolved), nil
 }
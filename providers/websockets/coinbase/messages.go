@@ -236,6 +236,9 @@ type TickerResponseMessage struct {
 	// Price is the price of the ticker.
 	Price string `json:"price"`
 
+	// Volume is the 24h traded base volume of the ticker.
+	Volume string `json:"volume_24h"`
+
 	// TradeID is the trade ID of the ticker.
 	TradeID int64 `json:"trade_id"`
 }
@@ -51,8 +51,14 @@ func (h *WebSocketHandler) parseTickerResponseMessage(
 	// Update the trade ID.
 	h.tradeIDs[ticker] = msg.TradeID
 
+	// Coinbase reports the 24h volume with every ticker message; it is left unset if malformed.
+	var volume *big.Float
+	if msg.Volume != "" {
+		volume, _ = math.Float64StringToBigFloat(msg.Volume)
+	}
+
 	// Convert the time to a time object and resolve the price into the response.
-	resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
+	resolved[ticker] = types.NewPriceResultWithVolume(price, time.Now().UTC(), volume)
 	return types.NewPriceResponse(resolved, unResolved), nil
 }
 
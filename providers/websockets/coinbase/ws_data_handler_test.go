@@ -283,6 +283,33 @@ func TestHandleMessage(t *testing.T) {
 			updateMessage: func() []handlers.WebsocketEncodedMessage { return nil },
 			expErr:        false,
 		},
+		{
+			name: "ticker message with volume",
+			msg: func() []byte {
+				msg := coinbase.TickerResponseMessage{
+					Type:     string(coinbase.TickerMessage),
+					Ticker:   "BTC-USD",
+					Price:    "10000.00",
+					Volume:   "245532.79",
+					Sequence: 10,
+				}
+
+				bz, err := json.Marshal(msg)
+				require.NoError(t, err)
+
+				return bz
+			},
+			resp: types.PriceResponse{
+				Resolved: types.ResolvedPrices{
+					btcusd: {
+						Value:  big.NewFloat(10000.00),
+						Volume: big.NewFloat(245532.79),
+					},
+				},
+			},
+			updateMessage: func() []handlers.WebsocketEncodedMessage { return nil },
+			expErr:        false,
+		},
 	}
 
 	wsHandler, err := coinbase.NewWebSocketDataHandler(logger, coinbase.DefaultWebSocketConfig)
@@ -315,6 +342,9 @@ func TestHandleMessage(t *testing.T) {
 				require.Contains(t, resp.Resolved, cp)
 				require.Equal(t, result.Value.SetPrec(18), resp.Resolved[cp].Value.SetPrec(18))
 				require.Equal(t, result.ResponseCode, resp.Resolved[cp].ResponseCode)
+				if result.Volume != nil {
+					require.Equal(t, result.Volume.SetPrec(18), resp.Resolved[cp].Volume.SetPrec(18))
+				}
 			}
 
 			for cp := range tc.resp.UnResolved {
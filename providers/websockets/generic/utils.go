@@ -0,0 +1,28 @@
+package generic
+
+import (
+	"strings"
+)
+
+// NOTE: The generic websocket provider subscribes to any websocket feed that sends and receives JSON. The
+// messages exchanged with the endpoint are defined by the generic section of the websocket config, and the
+// price of each market is extracted from its updates as described by the metadata JSON of its provider config,
+// exactly like the generic API provider. Multiple generic providers can be configured, e.g. one per exchange,
+// each named `generic_ws-<exchange>`.
+
+const (
+	// BaseName is the prefix of the name of every generic websocket provider.
+	BaseName = "generic_ws"
+
+	// NameSeparator is the separator between the base name and the exchange name of a generic websocket
+	// provider.
+	NameSeparator = "-"
+
+	// PingPlaceholder is the placeholder of the pong message that is replaced with the ping of the server.
+	PingPlaceholder = "{ping}"
+)
+
+// IsGenericProvider returns true if the given provider name is the name of a generic websocket provider.
+func IsGenericProvider(name string) bool {
+	return strings.HasPrefix(name, BaseName+NameSeparator) && len(name) > len(BaseName+NameSeparator)
+}
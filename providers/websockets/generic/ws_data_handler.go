@@ -0,0 +1,214 @@
+package generic
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/zoguxprotocol/slinky/oracle/config"
+	"github.com/zoguxprotocol/slinky/oracle/types"
+	slinkyjson "github.com/zoguxprotocol/slinky/pkg/json"
+	slinkymath "github.com/zoguxprotocol/slinky/pkg/math"
+	apigeneric "github.com/zoguxprotocol/slinky/providers/apis/generic"
+	"github.com/zoguxprotocol/slinky/providers/base/websocket/handlers"
+)
+
+var _ types.PriceWebSocketDataHandler = (*WebSocketHandler)(nil)
+
+// WebSocketHandler implements the WebSocketDataHandler interface for any websocket feed that sends and
+// receives JSON. The subscribe, heartbeat and pong messages, and the mapping of updates to markets, are
+// defined by the generic section of the websocket config (see config.GenericWebSocketConfig).
+type WebSocketHandler struct {
+	logger *zap.Logger
+
+	// ws is the config for the websocket.
+	ws config.WebSocketConfig
+	// channelPath is the compiled path to the channel of an update.
+	channelPath slinkyjson.Path
+	// pingPath is the compiled path to the ping of the server, if any.
+	pingPath *slinkyjson.Path
+	// channels maps the channels of the subscribed markets to their tickers.
+	channels map[string]types.ProviderTicker
+	// metadata is the cache of the compiled metadata of the tickers. It is shared by all copies of the handler.
+	metadata *apigeneric.MetadataCache
+}
+
+// NewWebSocketDataHandler returns a new generic PriceWebSocketDataHandler.
+func NewWebSocketDataHandler(
+	logger *zap.Logger,
+	ws config.WebSocketConfig,
+) (types.PriceWebSocketDataHandler, error) {
+	if !IsGenericProvider(ws.Name) {
+		return nil, fmt.Errorf("expected websocket config name to start with %s%s, got %s", BaseName, NameSeparator, ws.Name)
+	}
+
+	if !ws.Enabled {
+		return nil, fmt.Errorf("websocket config for %s is not enabled", ws.Name)
+	}
+
+	if err := ws.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid websocket config for %s: %w", ws.Name, err)
+	}
+
+	cfg := ws.Generic
+	if !apigeneric.HasPlaceholder(cfg.SubscribeMessage) {
+		return nil, fmt.Errorf("subscribe message of %s must contain a {ticker} or {tickers} placeholder", ws.Name)
+	}
+	if _, err := apigeneric.ExpandTemplate(cfg.SubscribeMessage, []string{"TICKER"}); err != nil {
+		return nil, fmt.Errorf("invalid subscribe message for %s: %w", ws.Name, err)
+	}
+
+	if cfg.Channel != "" {
+		if !apigeneric.HasPlaceholder(cfg.Channel) || apigeneric.IsBatchTemplate(cfg.Channel) {
+			return nil, fmt.Errorf("channel of %s must contain a {ticker} placeholder", ws.Name)
+		}
+		if _, err := apigeneric.ExpandTemplate(cfg.Channel, []string{"TICKER"}); err != nil {
+			return nil, fmt.Errorf("invalid channel for %s: %w", ws.Name, err)
+		}
+	}
+
+	channelPath, err := slinkyjson.CompilePath(cfg.ChannelPath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid channel path for %s: %w", ws.Name, err)
+	}
+
+	var pingPath *slinkyjson.Path
+	if cfg.PingPath != "" {
+		path, err := slinkyjson.CompilePath(cfg.PingPath)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ping path for %s: %w", ws.Name, err)
+		}
+		if cfg.PongMessage == "" {
+			return nil, fmt.Errorf("pong message of %s cannot be empty when a ping path is set", ws.Name)
+		}
+
+		pingPath = &path
+	}
+
+	return &WebSocketHandler{
+		logger:      logger,
+		ws:          ws,
+		channelPath: channelPath,
+		pingPath:    pingPath,
+		channels:    make(map[string]types.ProviderTicker),
+		metadata:    apigeneric.NewMetadataCache(),
+	}, nil
+}
+
+// HandleMessage is used to handle a message received from the data provider. Three types of messages are
+// handled:
+//
+//  1. Ping messages, which are answered with the pong message if a ping path is configured.
+//  2. Price updates, whose channel identifies the market of the update. The price is extracted as
+//     described by the metadata of the market.
+//  3. Any other message without a channel, e.g. subscription acknowledgements, which is ignored.
+func (h *WebSocketHandler) HandleMessage(
+	message []byte,
+) (types.PriceResponse, []handlers.WebsocketEncodedMessage, error) {
+	var resp types.PriceResponse
+
+	doc, err := slinkyjson.Decode(message)
+	if err != nil {
+		return resp, nil, fmt.Errorf("failed to decode message: %w", err)
+	}
+
+	if h.pingPath != nil {
+		if ping, err := h.pingPath.Lookup(doc); err == nil {
+			h.logger.Debug("received ping message")
+
+			bz, err := json.Marshal(ping)
+			if err != nil {
+				return resp, nil, fmt.Errorf("failed to encode ping: %w", err)
+			}
+
+			pong := strings.ReplaceAll(h.ws.Generic.PongMessage, PingPlaceholder, string(bz))
+			return resp, []handlers.WebsocketEncodedMessage{[]byte(pong)}, nil
+		}
+	}
+
+	value, err := h.channelPath.Lookup(doc)
+	if err != nil {
+		h.logger.Debug("ignoring message without channel", zap.Error(err))
+		return resp, nil, nil
+	}
+
+	channel := fmt.Sprint(value)
+	ticker, ok := h.channels[channel]
+	if !ok {
+		return resp, nil, fmt.Errorf("received update for unknown channel %s", channel)
+	}
+
+	return h.metadata.ParsePrices([]types.ProviderTicker{ticker}, doc), nil, nil
+}
+
+// CreateMessages is used to create the subscription messages of the given tickers. If the subscribe message
+// contains {tickers}, the tickers are subscribed to in batches of MaxSubscriptionsPerBatch. Otherwise, one
+// message is created per ticker.
+func (h *WebSocketHandler) CreateMessages(
+	tickers []types.ProviderTicker,
+) ([]handlers.WebsocketEncodedMessage, error) {
+	if len(tickers) == 0 {
+		return nil, fmt.Errorf("tickers cannot be empty")
+	}
+
+	offChainTickers := make([]string, len(tickers))
+	for i, ticker := range tickers {
+		channel, err := h.channel(ticker.GetOffChainTicker())
+		if err != nil {
+			return nil, err
+		}
+
+		h.channels[channel] = ticker
+		offChainTickers[i] = ticker.GetOffChainTicker()
+	}
+
+	batchSize := 1
+	if apigeneric.IsBatchTemplate(h.ws.Generic.SubscribeMessage) {
+		batchSize = h.ws.MaxSubscriptionsPerBatch
+	}
+
+	msgs := make([]handlers.WebsocketEncodedMessage, 0, (len(tickers)+batchSize-1)/batchSize)
+	for start := 0; start < len(offChainTickers); start += batchSize {
+		end := slinkymath.Min(start+batchSize, len(offChainTickers))
+
+		msg, err := apigeneric.ExpandTemplate(h.ws.Generic.SubscribeMessage, offChainTickers[start:end])
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, []byte(msg))
+	}
+
+	return msgs, nil
+}
+
+// HeartBeatMessages returns the heartbeat message of the config, if any.
+func (h *WebSocketHandler) HeartBeatMessages() ([]handlers.WebsocketEncodedMessage, error) {
+	if h.ws.Generic.HeartbeatMessage == "" {
+		return nil, nil
+	}
+
+	return []handlers.WebsocketEncodedMessage{[]byte(h.ws.Generic.HeartbeatMessage)}, nil
+}
+
+// Copy is used to create a copy of the WebSocketHandler.
+func (h *WebSocketHandler) Copy() types.PriceWebSocketDataHandler {
+	return &WebSocketHandler{
+		logger:      h.logger,
+		ws:          h.ws,
+		channelPath: h.channelPath,
+		pingPath:    h.pingPath,
+		channels:    make(map[string]types.ProviderTicker),
+		metadata:    h.metadata,
+	}
+}
+
+// channel returns the channel of the updates of the given off-chain ticker.
+func (h *WebSocketHandler) channel(offChainTicker string) (string, error) {
+	if h.ws.Generic.Channel == "" {
+		return offChainTicker, nil
+	}
+
+	return apigeneric.ExpandTemplate(h.ws.Generic.Channel, []string{offChainTicker})
+}
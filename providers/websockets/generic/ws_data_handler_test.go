@@ -0,0 +1,268 @@
+package generic_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/zoguxprotocol/slinky/oracle/config"
+	"github.com/zoguxprotocol/slinky/oracle/types"
+	"github.com/zoguxprotocol/slinky/providers/base/websocket/handlers"
+	providertypes "github.com/zoguxprotocol/slinky/providers/types"
+	"github.com/zoguxprotocol/slinky/providers/websockets/generic"
+)
+
+var (
+	btcusdt = types.NewProviderTicker(
+		"BTC-USDT",
+		`{"price_path":"$.data[0].last","timestamp_path":"$.data[0].ts"}`,
+	)
+	ethusdt = types.NewProviderTicker(
+		"ETH-USDT",
+		`{"price_path":"$.data[0].last"}`,
+	)
+	mogusdt = types.NewProviderTicker(
+		"MOG-USDT",
+		`{"price_path":"$.data[0].price"}`,
+	)
+	logger = zap.NewExample()
+)
+
+func wsConfig(subscribe string, batchSize int) config.WebSocketConfig {
+	return config.WebSocketConfig{
+		Name:                          generic.BaseName + generic.NameSeparator + "example",
+		Enabled:                       true,
+		MaxBufferSize:                 config.DefaultMaxBufferSize,
+		ReconnectionTimeout:           config.DefaultReconnectionTimeout,
+		PostConnectionTimeout:         config.DefaultPostConnectionTimeout,
+		Endpoints:                     []config.Endpoint{{URL: "wss://ws.example.com/v1"}},
+		ReadBufferSize:                config.DefaultReadBufferSize,
+		WriteBufferSize:               config.DefaultWriteBufferSize,
+		HandshakeTimeout:              config.DefaultHandshakeTimeout,
+		ReadTimeout:                   config.DefaultReadTimeout,
+		WriteTimeout:                  config.DefaultWriteTimeout,
+		PingInterval:                  config.DefaultPingInterval,
+		WriteInterval:                 config.DefaultWriteInterval,
+		MaxReadErrorCount:             config.DefaultMaxReadErrorCount,
+		MaxSubscriptionsPerConnection: config.DefaultMaxSubscriptionsPerConnection,
+		MaxSubscriptionsPerBatch:      batchSize,
+		Generic: config.GenericWebSocketConfig{
+			SubscribeMessage: subscribe,
+			HeartbeatMessage: `{"op":"ping"}`,
+			PingPath:         "$.ping",
+			PongMessage:      `{"pong":{ping}}`,
+			ChannelPath:      "$.arg.channel",
+			Channel:          "tickers.{ticker|lower}",
+		},
+	}
+}
+
+const batchSubscribe = `{"op":"subscribe","args":[{tickers|quoted}]}`
+
+func TestNewWebSocketDataHandler(t *testing.T) {
+	testCases := []struct {
+		name        string
+		cfg         func() config.WebSocketConfig
+		expectedErr bool
+	}{
+		{
+			name: "valid",
+			cfg: func() config.WebSocketConfig {
+				return wsConfig(batchSubscribe, 2)
+			},
+		},
+		{
+			name: "invalid name",
+			cfg: func() config.WebSocketConfig {
+				cfg := wsConfig(batchSubscribe, 2)
+				cfg.Name = "okx_ws"
+				return cfg
+			},
+			expectedErr: true,
+		},
+		{
+			name: "subscribe message without placeholder",
+			cfg: func() config.WebSocketConfig {
+				return wsConfig(`{"op":"subscribe"}`, 2)
+			},
+			expectedErr: true,
+		},
+		{
+			name: "batch channel",
+			cfg: func() config.WebSocketConfig {
+				cfg := wsConfig(batchSubscribe, 2)
+				cfg.Generic.Channel = "tickers.{tickers}"
+				return cfg
+			},
+			expectedErr: true,
+		},
+		{
+			name: "missing channel path",
+			cfg: func() config.WebSocketConfig {
+				cfg := wsConfig(batchSubscribe, 2)
+				cfg.Generic.ChannelPath = ""
+				return cfg
+			},
+			expectedErr: true,
+		},
+		{
+			name: "ping path without pong message",
+			cfg: func() config.WebSocketConfig {
+				cfg := wsConfig(batchSubscribe, 2)
+				cfg.Generic.PongMessage = ""
+				return cfg
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := generic.NewWebSocketDataHandler(logger, tc.cfg())
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCreateMessages(t *testing.T) {
+	testCases := []struct {
+		name      string
+		subscribe string
+		batchSize int
+		tickers   []types.ProviderTicker
+		expected  []string
+		expErr    bool
+	}{
+		{
+			name:      "no tickers",
+			subscribe: batchSubscribe,
+			batchSize: 2,
+			expErr:    true,
+		},
+		{
+			name:      "batched",
+			subscribe: batchSubscribe,
+			batchSize: 2,
+			tickers:   []types.ProviderTicker{btcusdt, ethusdt, mogusdt},
+			expected: []string{
+				`{"op":"subscribe","args":["BTC-USDT","ETH-USDT"]}`,
+				`{"op":"subscribe","args":["MOG-USDT"]}`,
+			},
+		},
+		{
+			name:      "one message per ticker",
+			subscribe: `{"op":"subscribe","args":["tickers.{ticker|lower}"]}`,
+			batchSize: 2,
+			tickers:   []types.ProviderTicker{btcusdt, ethusdt},
+			expected: []string{
+				`{"op":"subscribe","args":["tickers.btc-usdt"]}`,
+				`{"op":"subscribe","args":["tickers.eth-usdt"]}`,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, err := generic.NewWebSocketDataHandler(logger, wsConfig(tc.subscribe, tc.batchSize))
+			require.NoError(t, err)
+
+			msgs, err := h.CreateMessages(tc.tickers)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, msgs, len(tc.expected))
+			for i, msg := range msgs {
+				require.Equal(t, tc.expected[i], string(msg))
+			}
+		})
+	}
+}
+
+func TestHandleMessage(t *testing.T) {
+	h, err := generic.NewWebSocketDataHandler(logger, wsConfig(batchSubscribe, 2))
+	require.NoError(t, err)
+
+	_, err = h.CreateMessages([]types.ProviderTicker{btcusdt, ethusdt})
+	require.NoError(t, err)
+
+	t.Run("price update", func(t *testing.T) {
+		resp, updates, err := h.HandleMessage([]byte(
+			`{"arg":{"channel":"tickers.btc-usdt"},"data":[{"last":"42000.5","ts":"1704067200000"}]}`,
+		))
+		require.NoError(t, err)
+		require.Empty(t, updates)
+		require.Len(t, resp.Resolved, 1)
+		require.Empty(t, resp.UnResolved)
+
+		result := resp.Resolved[btcusdt]
+		price, _ := result.Value.Float64()
+		require.Equal(t, 42000.5, price)
+		require.Equal(t, time.UnixMilli(1704067200000).UTC(), result.Timestamp)
+	})
+
+	t.Run("invalid price", func(t *testing.T) {
+		resp, _, err := h.HandleMessage([]byte(
+			`{"arg":{"channel":"tickers.eth-usdt"},"data":[{"last":"abc"}]}`,
+		))
+		require.NoError(t, err)
+		require.Empty(t, resp.Resolved)
+		require.Equal(t, providertypes.ErrorFailedToParsePrice, resp.UnResolved[ethusdt].Code())
+	})
+
+	t.Run("ping", func(t *testing.T) {
+		resp, updates, err := h.HandleMessage([]byte(`{"ping":1704067200000}`))
+		require.NoError(t, err)
+		require.Empty(t, resp.Resolved)
+		require.Equal(t, []handlers.WebsocketEncodedMessage{[]byte(`{"pong":1704067200000}`)}, updates)
+	})
+
+	t.Run("subscription acknowledgement", func(t *testing.T) {
+		resp, updates, err := h.HandleMessage([]byte(`{"event":"subscribe","code":0}`))
+		require.NoError(t, err)
+		require.Empty(t, updates)
+		require.Empty(t, resp.Resolved)
+		require.Empty(t, resp.UnResolved)
+	})
+
+	t.Run("unknown channel", func(t *testing.T) {
+		_, _, err := h.HandleMessage([]byte(`{"arg":{"channel":"tickers.mog-usdt"},"data":[{"price":"1"}]}`))
+		require.Error(t, err)
+	})
+
+	t.Run("copy does not share subscriptions", func(t *testing.T) {
+		_, _, err := h.Copy().HandleMessage([]byte(`{"arg":{"channel":"tickers.btc-usdt"},"data":[{"last":"1"}]}`))
+		require.Error(t, err)
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		_, _, err := h.HandleMessage([]byte(`{"arg":`))
+		require.Error(t, err)
+	})
+}
+
+func TestHeartBeatMessages(t *testing.T) {
+	cfg := wsConfig(batchSubscribe, 2)
+	h, err := generic.NewWebSocketDataHandler(logger, cfg)
+	require.NoError(t, err)
+
+	msgs, err := h.HeartBeatMessages()
+	require.NoError(t, err)
+	require.Equal(t, []handlers.WebsocketEncodedMessage{[]byte(`{"op":"ping"}`)}, msgs)
+
+	cfg.Generic.HeartbeatMessage = ""
+	h, err = generic.NewWebSocketDataHandler(logger, cfg)
+	require.NoError(t, err)
+
+	msgs, err = h.HeartBeatMessages()
+	require.NoError(t, err)
+	require.Empty(t, msgs)
+}
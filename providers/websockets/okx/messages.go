@@ -26,6 +26,8 @@ type (
 const (
 	// OperationSubscribe is the operation to subscribe to a channel.
 	OperationSubscribe Operation = "subscribe"
+	// OperationUnsubscribe is the operation to unsubscribe from a channel.
+	OperationUnsubscribe Operation = "unsubscribe"
 )
 
 const (
@@ -38,6 +40,8 @@ const (
 const (
 	// EventSubscribe is the event denoting that we have successfully subscribed to a channel.
 	EventSubscribe EventType = "subscribe"
+	// EventUnsubscribe is the event denoting that we have successfully unsubscribed from a channel.
+	EventUnsubscribe EventType = "unsubscribe"
 	// EventTickers is the event for tickers. By default, this field will not be populated
 	// in a properly formatted message. So we set the default value to an empty string.
 	EventTickers EventType = ""
@@ -97,6 +101,25 @@ type SubscriptionTopic struct {
 // to the tickers channel.
 func (h *WebSocketHandler) NewSubscribeToTickersRequestMessage(
 	instruments []SubscriptionTopic,
+) ([]handlers.WebsocketEncodedMessage, error) {
+	return h.newTickersRequestMessages(OperationSubscribe, instruments)
+}
+
+// NewUnsubscribeFromTickersRequestMessage returns a new SubscribeRequestMessage for unsubscribing
+// from the tickers channel. The unsubscribe request has the same format as the subscribe request.
+//
+// For more information, see https://www.okx.com/docs-v5/en/#overview-websocket-unsubscribe
+func (h *WebSocketHandler) NewUnsubscribeFromTickersRequestMessage(
+	instruments []SubscriptionTopic,
+) ([]handlers.WebsocketEncodedMessage, error) {
+	return h.newTickersRequestMessages(OperationUnsubscribe, instruments)
+}
+
+// newTickersRequestMessages returns the request messages for the given operation on the tickers
+// channel, batched by the max number of subscriptions per batch.
+func (h *WebSocketHandler) newTickersRequestMessages(
+	operation Operation,
+	instruments []SubscriptionTopic,
 ) ([]handlers.WebsocketEncodedMessage, error) {
 	numInstruments := len(instruments)
 	if numInstruments == 0 {
@@ -112,7 +135,7 @@ func (h *WebSocketHandler) NewSubscribeToTickersRequestMessage(
 
 		bz, err := json.Marshal(
 			SubscribeRequestMessage{
-				Operation: string(OperationSubscribe),
+				Operation: string(operation),
 				Arguments: instruments[start:end],
 			},
 		)
@@ -11,7 +11,10 @@ import (
 	"github.com/zoguxprotocol/slinky/providers/base/websocket/handlers"
 )
 
-var _ types.PriceWebSocketDataHandler = (*WebSocketHandler)(nil)
+var (
+	_ types.PriceWebSocketDataHandler                             = (*WebSocketHandler)(nil)
+	_ handlers.WebSocketSubscriptionHandler[types.ProviderTicker] = (*WebSocketHandler)(nil)
+)
 
 // WebSocketHandler implements the WebSocketDataHandler interface. This is used to
 // handle messages received from the OKX websocket API.
@@ -49,11 +52,13 @@ func NewWebSocketDataHandler(
 }
 
 // HandleMessage is used to handle a message received from the data provider. The OKX
-// provider sends two types of messages:
+// provider sends three types of messages:
 //
 //  1. Subscribe response message. The subscribe response message is used to determine if
 //     the subscription was successful.
-//  2. Ticker response message. This is sent when a ticker update is received from the
+//  2. Unsubscribe response message. This is sent when the handler has unsubscribed from a
+//     ticker, and requires no further action.
+//  3. Ticker response message. This is sent when a ticker update is received from the
 //     OKX websocket API.
 //
 // Heartbeat messages are NOT sent by the OKX websocket. The connection is only closed
@@ -90,6 +95,9 @@ func (h *WebSocketHandler) HandleMessage(
 		}
 
 		return resp, updateMessage, nil
+	case eventType == EventUnsubscribe:
+		h.logger.Debug("received unsubscribe response message")
+		return resp, nil, nil
 	case eventType == EventTickers:
 		h.logger.Debug("received ticker response message")
 
@@ -127,6 +135,25 @@ func (h *WebSocketHandler) CreateMessages(
 	return h.NewSubscribeToTickersRequestMessage(instruments)
 }
 
+// CreateUnsubscribeMessages is used to create the messages that unsubscribe from the index
+// tickers channel of the given tickers. This allows the subscriptions of a live connection to
+// be updated when the market map changes. The tickers are removed from the cache, so that any
+// update received before the unsubscription takes effect is ignored.
+func (h *WebSocketHandler) CreateUnsubscribeMessages(
+	tickers []types.ProviderTicker,
+) ([]handlers.WebsocketEncodedMessage, error) {
+	instruments := make([]SubscriptionTopic, 0)
+	for _, ticker := range tickers {
+		instruments = append(instruments, SubscriptionTopic{
+			Channel:      string(TickersChannel),
+			InstrumentID: ticker.GetOffChainTicker(),
+		})
+		h.cache.Remove(ticker)
+	}
+
+	return h.NewUnsubscribeFromTickersRequestMessage(instruments)
+}
+
 // HeartBeatMessages is not used for okx.
 func (h *WebSocketHandler) HeartBeatMessages() ([]handlers.WebsocketEncodedMessage, error) {
 	return nil, nil
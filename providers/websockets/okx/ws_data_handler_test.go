@@ -61,6 +61,27 @@ func TestHandleMessage(t *testing.T) {
 			updateMessage: func() []handlers.WebsocketEncodedMessage { return nil },
 			expErr:        true,
 		},
+		{
+			name: "successful unsubscribe",
+			msg: func() []byte {
+				msg := okx.SubscribeResponseMessage{
+					Arguments: okx.SubscriptionTopic{
+						Channel:      string(okx.TickersChannel),
+						InstrumentID: "BTC-USDT",
+					},
+					Event:        string(okx.EventUnsubscribe),
+					ConnectionID: "123",
+				}
+
+				bz, err := json.Marshal(msg)
+				require.NoError(t, err)
+
+				return bz
+			},
+			resp:          types.NewPriceResponse(nil, nil),
+			updateMessage: func() []handlers.WebsocketEncodedMessage { return nil },
+			expErr:        false,
+		},
 		{
 			name: "instrument price update",
 			msg: func() []byte {
@@ -492,3 +513,104 @@ func TestCreateMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateUnsubscribeMessages(t *testing.T) {
+	batchCfg := okx.DefaultWebSocketConfig
+	batchCfg.MaxSubscriptionsPerBatch = 2
+
+	testCases := []struct {
+		name        string
+		cps         []types.ProviderTicker
+		expected    func() []handlers.WebsocketEncodedMessage
+		expectedErr bool
+	}{
+		{
+			name: "no currency pairs",
+			cps:  []types.ProviderTicker{},
+			expected: func() []handlers.WebsocketEncodedMessage {
+				return nil
+			},
+			expectedErr: true,
+		},
+		{
+			name: "three currency pairs with batch and remainder",
+			cps: []types.ProviderTicker{
+				btcusdt,
+				ethusdt,
+				mogusdt,
+			},
+			expected: func() []handlers.WebsocketEncodedMessage {
+				msgs := make([]handlers.WebsocketEncodedMessage, 0, 2)
+				for _, tickers := range [][]string{{"BTC-USDT", "ETH-USDT"}, {"MOG-USDT"}} {
+					msg := okx.SubscribeRequestMessage{
+						Operation: string(okx.OperationUnsubscribe),
+					}
+					for _, ticker := range tickers {
+						msg.Arguments = append(msg.Arguments, okx.SubscriptionTopic{
+							Channel:      string(okx.TickersChannel),
+							InstrumentID: ticker,
+						})
+					}
+
+					bz, err := json.Marshal(msg)
+					require.NoError(t, err)
+					msgs = append(msgs, bz)
+				}
+
+				return msgs
+			},
+			expectedErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			wsHandler, err := okx.NewWebSocketDataHandler(logger, batchCfg)
+			require.NoError(t, err)
+
+			subscriptionHandler, ok := wsHandler.(handlers.WebSocketSubscriptionHandler[types.ProviderTicker])
+			require.True(t, ok)
+
+			msgs, err := subscriptionHandler.CreateUnsubscribeMessages(tc.cps)
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected(), msgs)
+		})
+	}
+}
+
+func TestCreateUnsubscribeMessagesRemovesTickers(t *testing.T) {
+	wsHandler, err := okx.NewWebSocketDataHandler(logger, okx.DefaultWebSocketConfig)
+	require.NoError(t, err)
+
+	subscriptionHandler, ok := wsHandler.(handlers.WebSocketSubscriptionHandler[types.ProviderTicker])
+	require.True(t, ok)
+
+	_, err = wsHandler.CreateMessages([]types.ProviderTicker{btcusdt, ethusdt})
+	require.NoError(t, err)
+	_, err = subscriptionHandler.CreateUnsubscribeMessages([]types.ProviderTicker{btcusdt})
+	require.NoError(t, err)
+
+	// updates of the unsubscribed tickers are ignored.
+	bz, err := json.Marshal(okx.TickersResponseMessage{
+		Arguments: okx.SubscriptionTopic{
+			Channel:      string(okx.TickersChannel),
+			InstrumentID: "BTC-USDT",
+		},
+		Data: []okx.IndexTicker{
+			{ID: "BTC-USDT", LastPrice: "1"},
+			{ID: "ETH-USDT", LastPrice: "2"},
+		},
+	})
+	require.NoError(t, err)
+
+	resp, _, err := wsHandler.HandleMessage(bz)
+	require.NoError(t, err)
+	require.Len(t, resp.Resolved, 1)
+	require.Contains(t, resp.Resolved, types.ProviderTicker(ethusdt))
+	require.Empty(t, resp.UnResolved)
+}
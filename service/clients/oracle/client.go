@@ -220,6 +220,51 @@ func (c *GRPCClient) Prices(
 	return c.client.Prices(ctx, req, grpc.WaitForReady(true))
 }
 
+// StreamPrices opens a stream of the latest prices from the remote oracle service. Unlike the unary methods, the
+// client's timeout is not applied, as the stream is expected to remain open until ctx is cancelled.
+func (c *GRPCClient) StreamPrices(
+	ctx context.Context,
+	req *types.QueryStreamPricesRequest,
+	_ ...grpc.CallOption,
+) (types.Oracle_StreamPricesClient, error) {
+	c.mutex.Lock()
+	client := c.client
+	c.mutex.Unlock()
+
+	if client == nil {
+		return nil, fmt.Errorf("oracle client not started")
+	}
+
+	return client.StreamPrices(ctx, req, grpc.WaitForReady(true))
+}
+
+// ProviderPrices returns the breakdown of the prices reported by each provider from the remote oracle service.
+func (c *GRPCClient) ProviderPrices(
+	ctx context.Context,
+	req *types.QueryProviderPricesRequest,
+	_ ...grpc.CallOption,
+) (res *types.QueryProviderPricesResponse, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	start := time.Now()
+	defer func() {
+		// Observe the duration of the call as well as the error.
+		c.metrics.ObserveOracleResponseLatency(time.Since(start))
+		c.metrics.AddOracleResponse(metrics.StatusFromError(err))
+	}()
+
+	// set deadline on the context
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	if c.client == nil {
+		return nil, fmt.Errorf("oracle client not started")
+	}
+
+	return c.client.ProviderPrices(ctx, req, grpc.WaitForReady(true))
+}
+
 func (c *GRPCClient) MarketMap(ctx context.Context, req *types.QueryMarketMapRequest, _ ...grpc.CallOption) (res *types.QueryMarketMapResponse, err error) {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
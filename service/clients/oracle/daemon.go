@@ -67,10 +67,14 @@ func (d *PriceDaemon) Start(ctx context.Context) error {
 	ticker := time.NewTicker(d.config.Interval)
 	defer ticker.Stop()
 
-	d.logger.Info("starting price daemon")
+	d.logger.Info("starting price daemon", "stream_prices", d.config.StreamPrices)
 	d.isRunning.Store(true)
 	defer d.isRunning.Store(false)
 
+	if d.config.StreamPrices {
+		return d.runPriceStream(ctx, ticker)
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -85,6 +89,78 @@ func (d *PriceDaemon) Start(ctx context.Context) error {
 	}
 }
 
+// runPriceStream subscribes to the oracle's price stream, and updates the latest response on every message.
+// Whenever the stream fails, prices are polled every interval until the stream is re-established. This method
+// blocks until the daemon is stopped.
+func (d *PriceDaemon) runPriceStream(ctx context.Context, ticker *time.Ticker) error {
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// streamPrices blocks on the stream, so the stream must be cancelled for the daemon to stop.
+	stopped := make(chan struct{})
+	go func() {
+		select {
+		case <-d.doneCh:
+			close(stopped)
+			cancel()
+		case <-streamCtx.Done():
+		}
+	}()
+
+	for {
+		err := d.streamPrices(streamCtx)
+
+		// the stream is cancelled once the daemon is stopped, in which case there is no need to poll.
+		select {
+		case <-stopped:
+			d.logger.Info("price daemon stopped")
+			return nil
+		default:
+		}
+		if ctx.Err() != nil {
+			d.logger.Info("stopping price daemon from context")
+			return ctx.Err()
+		}
+
+		d.logger.Error(
+			"price stream from sidecar failed; polling until re-established",
+			"err", err,
+			"address", d.config.OracleAddress,
+		)
+
+		select {
+		case <-stopped:
+			d.logger.Info("price daemon stopped")
+			return nil
+		case <-ctx.Done():
+			d.logger.Info("stopping price daemon from context")
+			return ctx.Err()
+		case <-ticker.C:
+			d.fetchPrices(ctx)
+		}
+	}
+}
+
+// streamPrices opens a price stream with the oracle client, and updates the latest response on every
+// message until the stream fails.
+func (d *PriceDaemon) streamPrices(ctx context.Context) error {
+	stream, err := d.OracleClient.StreamPrices(ctx, &types.QueryStreamPricesRequest{})
+	if err != nil {
+		return err
+	}
+
+	d.logger.Debug("opened price stream")
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		d.logger.Debug("received prices from stream", "timestamp", resp.Timestamp, "prices", resp.Prices)
+		d.resp.Update(resp)
+	}
+}
+
 // fetchPrices fetches the latest prices from the oracle client.
 func (d *PriceDaemon) fetchPrices(ctx context.Context) {
 	defer func() {
@@ -9,6 +9,7 @@ import (
 	"cosmossdk.io/log"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 
 	"github.com/zoguxprotocol/slinky/oracle/config"
 	"github.com/zoguxprotocol/slinky/service/clients/oracle"
@@ -238,3 +239,89 @@ func TestPriceDaemon_Start(t *testing.T) {
 		require.Nil(t, resp)
 	})
 }
+
+// priceStream is a fake price stream that returns the responses sent on its channel, until its
+// context is cancelled.
+type priceStream struct {
+	grpc.ClientStream
+
+	ctx   context.Context
+	resps chan *types.QueryPricesResponse
+}
+
+func (s *priceStream) Recv() (*types.QueryPricesResponse, error) {
+	select {
+	case resp := <-s.resps:
+		return resp, nil
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	}
+}
+
+func TestPriceDaemon_StreamPrices(t *testing.T) {
+	logger := log.NewTestLogger(t)
+	cfg := config.AppConfig{
+		Enabled:       true,
+		OracleAddress: "localhost:8080",
+		ClientTimeout: time.Second,
+		Interval:      time.Millisecond * 100,
+		PriceTTL:      time.Second,
+		StreamPrices:  true,
+	}
+	prices := map[string]string{
+		"btc/usd": "10000",
+	}
+
+	t.Run("stores the latest streamed prices", func(t *testing.T) {
+		resps := make(chan *types.QueryPricesResponse, 1)
+		resps <- &types.QueryPricesResponse{Prices: prices}
+
+		client := mocks.NewOracleClient(t)
+		client.On("Start", mock.Anything).Return(nil).Once()
+		client.On("StreamPrices", mock.Anything, mock.Anything).Return(
+			func(ctx context.Context, _ *types.QueryStreamPricesRequest, _ ...grpc.CallOption) (types.Oracle_StreamPricesClient, error) {
+				return &priceStream{ctx: ctx, resps: resps}, nil
+			},
+		).Once()
+		client.On("Stop").Return(nil).Once()
+
+		d, err := oracle.NewPriceDaemon(logger, cfg, client)
+		require.NoError(t, err)
+
+		go func() {
+			time.Sleep(time.Millisecond * 300)
+			d.Stop()
+		}()
+
+		err = d.Start(context.Background())
+		require.NoError(t, err)
+
+		resp, err := d.Prices(context.Background(), &types.QueryPricesRequest{})
+		require.NoError(t, err)
+		require.Equal(t, prices, resp.Prices)
+	})
+
+	t.Run("polls prices while the stream fails", func(t *testing.T) {
+		client := mocks.NewOracleClient(t)
+		client.On("Start", mock.Anything).Return(nil).Once()
+		client.On("StreamPrices", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("unimplemented"))
+		client.On("Prices", mock.Anything, mock.Anything).Return(&types.QueryPricesResponse{Prices: prices}, nil)
+		client.On("Stop").Return(nil).Once()
+
+		d, err := oracle.NewPriceDaemon(logger, cfg, client)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			time.Sleep(time.Millisecond * 300)
+			cancel()
+		}()
+
+		err = d.Start(ctx)
+		require.Equal(t, err, context.Canceled)
+
+		resp, err := d.Prices(context.Background(), &types.QueryPricesRequest{})
+		require.NoError(t, err)
+		require.Equal(t, prices, resp.Prices)
+	})
+}
@@ -2,6 +2,7 @@ package oracle
 
 import (
 	"context"
+	"fmt"
 
 	"google.golang.org/grpc"
 
@@ -47,6 +48,24 @@ func (NoOpClient) Prices(
 	return nil, nil
 }
 
+// StreamPrices returns an error, as there are no prices to stream.
+func (NoOpClient) StreamPrices(
+	_ context.Context,
+	_ *types.QueryStreamPricesRequest,
+	_ ...grpc.CallOption,
+) (types.Oracle_StreamPricesClient, error) {
+	return nil, fmt.Errorf("oracle is disabled")
+}
+
+// ProviderPrices is a no-op.
+func (NoOpClient) ProviderPrices(
+	_ context.Context,
+	_ *types.QueryProviderPricesRequest,
+	_ ...grpc.CallOption,
+) (*types.QueryProviderPricesResponse, error) {
+	return nil, nil
+}
+
 func (c NoOpClient) MarketMap(
 	_ context.Context,
 	_ *types.QueryMarketMapRequest,
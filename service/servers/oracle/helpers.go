@@ -1,7 +1,11 @@
 package oracle
 
 import (
+	"math/big"
+	"sort"
+
 	"github.com/zoguxprotocol/slinky/oracle/types"
+	servertypes "github.com/zoguxprotocol/slinky/service/servers/oracle/types"
 )
 
 func ToReqPrices(prices types.Prices) map[string]string {
@@ -14,3 +18,55 @@ func ToReqPrices(prices types.Prices) map[string]string {
 
 	return reqPrices
 }
+
+// ToProviderPrices converts the price breakdowns of the oracle to the provider prices of each ticker, sorted by
+// ticker. The aggregated price of each ticker is taken from the given (scaled) prices. If tickers is non-empty,
+// only the breakdowns of those tickers are returned.
+func ToProviderPrices(
+	breakdowns types.PriceBreakdowns,
+	prices types.Prices,
+	tickers map[string]struct{},
+) []servertypes.TickerProviderPrices {
+	aggregatedPrices := ToReqPrices(prices)
+
+	providerPrices := make([]servertypes.TickerProviderPrices, 0, len(breakdowns))
+	for ticker, breakdown := range breakdowns {
+		if _, ok := tickers[ticker]; len(tickers) > 0 && !ok {
+			continue
+		}
+
+		providers := make([]servertypes.ProviderPrice, len(breakdown))
+		for i, b := range breakdown {
+			providers[i] = servertypes.ProviderPrice{
+				Provider:       b.Provider,
+				OffChainTicker: b.OffChainTicker,
+				RawPrice:       floatToString(b.RawPrice),
+				Timestamp:      b.Timestamp,
+				ConvertedPrice: floatToString(b.ConvertedPrice),
+				Used:           b.Used,
+				Reason:         b.Reason,
+			}
+		}
+
+		providerPrices = append(providerPrices, servertypes.TickerProviderPrices{
+			Ticker:          ticker,
+			AggregatedPrice: aggregatedPrices[ticker],
+			Providers:       providers,
+		})
+	}
+
+	sort.Slice(providerPrices, func(i, j int) bool {
+		return providerPrices[i].Ticker < providerPrices[j].Ticker
+	})
+
+	return providerPrices
+}
+
+// floatToString returns the decimal representation of the given price, or an empty string if it is nil.
+func floatToString(price *big.Float) string {
+	if price == nil {
+		return ""
+	}
+
+	return price.Text('f', -1)
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	gateway "github.com/cosmos/gogogateway"
+	"github.com/gorilla/websocket"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"go.uber.org/zap"
 	"golang.org/x/net/http2"
@@ -38,6 +39,12 @@ type OracleServer struct { //nolint
 	// grpc-gateway mux -- serves all http grpc proxy requests
 	gatewayMux *runtime.ServeMux
 
+	// marshaler used to encode the responses of the grpc-gateway and the http price streams
+	marshaler *gateway.JSONPb
+
+	// upgrader used to upgrade http requests to websocket price streams
+	upgrader websocket.Upgrader
+
 	// underlying http server
 	httpSrv *http.Server
 
@@ -55,6 +62,11 @@ func NewOracleServer(o oracle.Oracle, logger *zap.Logger) *OracleServer {
 	os := &OracleServer{
 		o:      o,
 		logger: logger,
+		marshaler: &gateway.JSONPb{
+			EmitDefaults: true,
+			Indent:       "",
+			OrigName:     true,
+		},
 	}
 	os.Closer = sync.NewCloser().WithCallback(func() {
 		// if the server has been started, close it
@@ -97,11 +109,7 @@ func (os *OracleServer) StartServer(ctx context.Context, host, port string) erro
 	// register the grpc-gateway
 	// it handles the http request and dials the server endpoint with the grpc request
 	os.gatewayMux = runtime.NewServeMux(
-		runtime.WithMarshalerOption(runtime.MIMEWildcard, &gateway.JSONPb{
-			EmitDefaults: true,
-			Indent:       "",
-			OrigName:     true,
-		}),
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, os.marshaler),
 	)
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithNoProxy()}
 	err := types.RegisterOracleHandlerFromEndpoint(ctx, os.gatewayMux, serverEndpoint, opts)
@@ -111,6 +119,8 @@ func (os *OracleServer) StartServer(ctx context.Context, host, port string) erro
 
 	router := http.NewServeMux()
 	router.HandleFunc("/", os.routeRequest)
+	router.HandleFunc(SSEPricesPath, os.handleSSE)
+	router.HandleFunc(WebSocketPricesPath, os.handleWebSocket)
 	os.httpSrv.Handler = h2c.NewHandler(router, &http2.Server{})
 
 	eg, ctx := errgroup.WithContext(ctx)
@@ -189,6 +199,39 @@ func (os *OracleServer) Prices(ctx context.Context, req *types.QueryPricesReques
 	}
 }
 
+// ProviderPrices returns the breakdown of the prices reported by each provider as of the oracle's latest
+// aggregation, i.e. the raw and converted price of each provider and whether it was used to calculate the
+// aggregated price of each ticker. If tickers are specified in the request, only the breakdowns of those
+// tickers are returned.
+func (os *OracleServer) ProviderPrices(
+	_ context.Context,
+	req *types.QueryProviderPricesRequest,
+) (*types.QueryProviderPricesResponse, error) {
+	// check that the request is non-nil
+	if req == nil {
+		return nil, ErrNilRequest
+	}
+
+	os.logger.Debug("received request for provider prices", zap.Strings("tickers", req.Tickers))
+
+	// check that oracle is running
+	if !os.o.IsRunning() {
+		os.logger.Error("oracle not running")
+		return nil, ErrOracleNotRunning
+	}
+
+	filter := make(map[string]struct{}, len(req.Tickers))
+	for _, ticker := range req.Tickers {
+		filter[strings.ToUpper(ticker)] = struct{}{}
+	}
+
+	return &types.QueryProviderPricesResponse{
+		Tickers:   ToProviderPrices(os.o.GetPriceBreakdowns(), os.o.GetPrices(), filter),
+		Timestamp: os.o.GetLastSyncTime(),
+		Version:   build.Build,
+	}, nil
+}
+
 // MarketMap returns the current market map from the Oracle.
 func (os *OracleServer) MarketMap(_ context.Context, _ *types.QueryMarketMapRequest) (*types.QueryMarketMapResponse, error) {
 	mm := os.o.GetMarketMap()
@@ -1,6 +1,7 @@
 package oracle_test
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 	"io"
@@ -10,6 +11,7 @@ import (
 	"time"
 
 	"cosmossdk.io/log"
+	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/zap"
@@ -199,6 +201,90 @@ func (s *ServerTestSuite) TestOracleMarketMap() {
 	s.Require().Equal(*res.GetMarketMap(), dummyMarketMap)
 }
 
+func (s *ServerTestSuite) TestOracleServerProviderPrices() {
+	s.mockOracle.On("IsRunning").Return(true)
+	ts := time.Now()
+	s.mockOracle.On("GetLastSyncTime").Return(ts)
+	s.mockOracle.On("GetPrices").Return(types.Prices{
+		"BTC/USD": big.NewFloat(100),
+	})
+	s.mockOracle.On("GetPriceBreakdowns").Return(types.PriceBreakdowns{
+		"BTC/USD": {
+			{
+				Provider:       "coinbase_api",
+				OffChainTicker: "BTC-USD",
+				RawPrice:       big.NewFloat(1.5),
+				Timestamp:      ts,
+				ConvertedPrice: big.NewFloat(1.5),
+				Used:           true,
+			},
+			{
+				Provider:       "okx_ws",
+				OffChainTicker: "BTC-USDT",
+				RawPrice:       big.NewFloat(2),
+				Timestamp:      ts,
+				Reason:         "missing index price",
+			},
+		},
+		"ETH/USD": {
+			{
+				Provider:       "coinbase_api",
+				OffChainTicker: "ETH-USD",
+				Reason:         types.ExclusionReasonInsufficientProviders,
+			},
+		},
+	})
+
+	// call from grpc client
+	resp, err := s.client.ProviderPrices(context.Background(), &stypes.QueryProviderPricesRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(ts.UTC(), resp.Timestamp)
+	s.Require().Len(resp.Tickers, 2)
+
+	btc := resp.Tickers[0]
+	s.Require().Equal("BTC/USD", btc.Ticker)
+	s.Require().Equal("100", btc.AggregatedPrice)
+	s.Require().Equal([]stypes.ProviderPrice{
+		{
+			Provider:       "coinbase_api",
+			OffChainTicker: "BTC-USD",
+			RawPrice:       "1.5",
+			Timestamp:      ts.UTC(),
+			ConvertedPrice: "1.5",
+			Used:           true,
+		},
+		{
+			Provider:       "okx_ws",
+			OffChainTicker: "BTC-USDT",
+			RawPrice:       "2",
+			Timestamp:      ts.UTC(),
+			Reason:         "missing index price",
+		},
+	}, btc.Providers)
+
+	eth := resp.Tickers[1]
+	s.Require().Equal("ETH/USD", eth.Ticker)
+	s.Require().Empty(eth.AggregatedPrice)
+
+	// filter the tickers from the grpc client
+	resp, err = s.client.ProviderPrices(context.Background(), &stypes.QueryProviderPricesRequest{Tickers: []string{"eth/usd"}})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Tickers, 1)
+	s.Require().Equal("ETH/USD", resp.Tickers[0].Ticker)
+
+	// call from http client
+	httpResp, err := s.httpClient.Get(fmt.Sprintf("http://%s:%s/slinky/oracle/v1/provider_prices?tickers=BTC/USD", localhost, port))
+	s.Require().NoError(err)
+	defer httpResp.Body.Close()
+
+	s.Require().Equal(http.StatusOK, httpResp.StatusCode)
+	respBz, err := io.ReadAll(httpResp.Body)
+	s.Require().NoError(err)
+	s.Require().Contains(string(respBz), `"ticker":"BTC/USD","aggregated_price":"100"`)
+	s.Require().Contains(string(respBz), `"reason":"missing index price"`)
+	s.Require().NotContains(string(respBz), "ETH/USD")
+}
+
 // test that the oracle server closes when expected.
 func (s *ServerTestSuite) TestOracleServerClose() {
 	// close the server, and check that no requests are received
@@ -217,3 +303,75 @@ func (s *ServerTestSuite) TestOracleServerClose() {
 	// expect request to have failed (connection is closed)
 	s.Require().NotNil(err)
 }
+
+func (s *ServerTestSuite) TestOracleServerStreamPrices() {
+	btc := slinkytypes.NewCurrencyPair("BTC", "USD")
+	eth := slinkytypes.NewCurrencyPair("ETH", "USD")
+
+	updates := make(chan struct{}, 1)
+	s.mockOracle.On("IsRunning").Return(true)
+	s.mockOracle.On("SubscribePrices").Return((<-chan struct{})(updates), func() {})
+	s.mockOracle.On("GetPrices").Return(types.Prices{
+		btc.String(): big.NewFloat(100.1),
+		eth.String(): big.NewFloat(200.1),
+	})
+	ts := time.Now()
+	s.mockOracle.On("GetLastSyncTime").Return(ts)
+
+	s.Run("stream prices over grpc", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		stream, err := s.client.StreamPrices(ctx, &stypes.QueryStreamPricesRequest{Tickers: []string{"btc/usd"}})
+		s.Require().NoError(err)
+
+		// the latest prices are sent immediately
+		resp, err := stream.Recv()
+		s.Require().NoError(err)
+		s.Require().Equal(map[string]string{btc.String(): "100"}, resp.Prices)
+		s.Require().Equal(ts.UTC(), resp.Timestamp)
+
+		// and again on every update
+		updates <- struct{}{}
+		resp, err = stream.Recv()
+		s.Require().NoError(err)
+		s.Require().Equal(map[string]string{btc.String(): "100"}, resp.Prices)
+	})
+
+	s.Run("stream prices over sse", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		req, err := http.NewRequestWithContext(
+			ctx,
+			http.MethodGet,
+			fmt.Sprintf("http://%s:%s%s?tickers=%s", localhost, port, server.SSEPricesPath, eth.String()),
+			nil,
+		)
+		s.Require().NoError(err)
+
+		httpResp, err := s.httpClient.Do(req)
+		s.Require().NoError(err)
+		defer httpResp.Body.Close()
+
+		s.Require().Equal(http.StatusOK, httpResp.StatusCode)
+		s.Require().Equal("text/event-stream", httpResp.Header.Get("Content-Type"))
+
+		line, err := bufio.NewReader(httpResp.Body).ReadString('\n')
+		s.Require().NoError(err)
+		s.Require().Contains(line, fmt.Sprintf(`data: {"prices":{"%s":"200"},"timestamp":`, eth.String()))
+	})
+
+	s.Run("stream prices over websocket", func() {
+		conn, _, err := websocket.DefaultDialer.Dial(
+			fmt.Sprintf("ws://%s:%s%s", localhost, port, server.WebSocketPricesPath),
+			nil,
+		)
+		s.Require().NoError(err)
+		defer conn.Close()
+
+		_, msg, err := conn.ReadMessage()
+		s.Require().NoError(err)
+		s.Require().Contains(string(msg), fmt.Sprintf(`{"prices":{"%s":"100","%s":"200"},"timestamp":`, btc.String(), eth.String()))
+	})
+}
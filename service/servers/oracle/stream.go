@@ -0,0 +1,214 @@
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+
+	"github.com/zoguxprotocol/slinky/cmd/build"
+	"github.com/zoguxprotocol/slinky/service/servers/oracle/types"
+)
+
+const (
+	// SSEPricesPath is the HTTP path that streams the latest prices as server-sent events.
+	SSEPricesPath = "/slinky/oracle/v1/prices/sse"
+	// WebSocketPricesPath is the HTTP path that streams the latest prices over a websocket.
+	WebSocketPricesPath = "/slinky/oracle/v1/prices/ws"
+
+	// tickersQueryParam is the query parameter used to filter the streamed tickers over HTTP.
+	tickersQueryParam = "tickers"
+	// wsWriteTimeout is the maximum time to wait for a websocket message to be written.
+	wsWriteTimeout = 5 * time.Second
+)
+
+// StreamPrices streams the latest prices from the underlying oracle. The latest prices are sent as soon as
+// the stream is opened (if the oracle has prices), and then every time the oracle updates its prices. If
+// tickers are specified in the request, only the prices of those tickers are sent. The stream is closed
+// when the client disconnects, or when the server is closed.
+func (os *OracleServer) StreamPrices(req *types.QueryStreamPricesRequest, stream types.Oracle_StreamPricesServer) error {
+	// check that the request is non-nil
+	if req == nil {
+		return ErrNilRequest
+	}
+
+	os.logger.Debug("received request to stream prices", zap.Strings("tickers", req.Tickers))
+
+	return os.streamPrices(stream.Context(), req.Tickers, stream.Send)
+}
+
+// streamPrices calls send with the latest prices every time the oracle updates its prices, until ctx is
+// cancelled, the server is closed, or send fails.
+func (os *OracleServer) streamPrices(
+	ctx context.Context,
+	tickers []string,
+	send func(*types.QueryPricesResponse) error,
+) error {
+	// check that oracle is running
+	if !os.o.IsRunning() {
+		os.logger.Error("oracle not running")
+		return ErrOracleNotRunning
+	}
+
+	updates, unsubscribe := os.o.SubscribePrices()
+	defer unsubscribe()
+
+	filter := make(map[string]struct{}, len(tickers))
+	for _, ticker := range tickers {
+		filter[strings.ToUpper(ticker)] = struct{}{}
+	}
+
+	// send the latest prices immediately, so that subscribers do not have to wait for the next update
+	if !os.o.GetLastSyncTime().IsZero() {
+		if err := send(os.latestPrices(filter)); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			os.logger.Debug("price stream closed by client")
+			return nil
+		case <-os.Done():
+			os.logger.Debug("price stream closed by server")
+			return nil
+		case _, ok := <-updates:
+			if !ok {
+				return nil
+			}
+
+			if err := send(os.latestPrices(filter)); err != nil {
+				os.logger.Debug("failed to send prices to stream", zap.Error(err))
+				return err
+			}
+		}
+	}
+}
+
+// latestPrices returns the latest prices of the oracle, filtered to the given set of tickers
+// (if non-empty).
+func (os *OracleServer) latestPrices(filter map[string]struct{}) *types.QueryPricesResponse {
+	prices := ToReqPrices(os.o.GetPrices())
+	if len(filter) > 0 {
+		for ticker := range prices {
+			if _, ok := filter[ticker]; !ok {
+				delete(prices, ticker)
+			}
+		}
+	}
+
+	return &types.QueryPricesResponse{
+		Prices:    prices,
+		Timestamp: os.o.GetLastSyncTime(),
+		Version:   build.Build,
+	}
+}
+
+// handleSSE streams the latest prices to the client as server-sent events. Each event's data is the
+// JSON encoded QueryPricesResponse, i.e. the same encoding as the /slinky/oracle/v1/prices endpoint.
+func (os *OracleServer) handleSSE(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	if !os.o.IsRunning() {
+		http.Error(w, ErrOracleNotRunning.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	err := os.streamPrices(r.Context(), tickersFromQuery(r), func(resp *types.QueryPricesResponse) error {
+		bz, err := os.marshaler.Marshal(resp)
+		if err != nil {
+			return err
+		}
+
+		if _, err := fmt.Fprintf(w, "data: %s\n\n", bz); err != nil {
+			return err
+		}
+
+		flusher.Flush()
+		return nil
+	})
+	if err != nil {
+		os.logger.Debug("sse price stream closed", zap.Error(err))
+	}
+}
+
+// handleWebSocket streams the latest prices to the client over a websocket. Each message is the JSON
+// encoded QueryPricesResponse, i.e. the same encoding as the /slinky/oracle/v1/prices endpoint.
+func (os *OracleServer) handleWebSocket(w http.ResponseWriter, r *http.Request) {
+	if !os.o.IsRunning() {
+		http.Error(w, ErrOracleNotRunning.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	conn, err := os.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		os.logger.Debug("failed to upgrade websocket connection", zap.Error(err))
+		return
+	}
+	defer conn.Close()
+
+	// the client is not expected to send any messages, however the connection must be read from to
+	// process control messages and to detect when the client disconnects.
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	err = os.streamPrices(ctx, tickersFromQuery(r), func(resp *types.QueryPricesResponse) error {
+		bz, err := os.marshaler.Marshal(resp)
+		if err != nil {
+			return err
+		}
+
+		if err := conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout)); err != nil {
+			return err
+		}
+
+		return conn.WriteMessage(websocket.TextMessage, bz)
+	})
+	if err != nil {
+		os.logger.Debug("websocket price stream closed", zap.Error(err))
+	}
+
+	_ = conn.WriteControl(
+		websocket.CloseMessage,
+		websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+		time.Now().Add(wsWriteTimeout),
+	)
+}
+
+// tickersFromQuery returns the tickers specified in the request's query, either as repeated
+// parameters or as a comma-separated list.
+func tickersFromQuery(r *http.Request) []string {
+	var tickers []string
+	for _, value := range r.URL.Query()[tickersQueryParam] {
+		for _, ticker := range strings.Split(value, ",") {
+			if ticker = strings.TrimSpace(ticker); len(ticker) > 0 {
+				tickers = append(tickers, ticker)
+			}
+		}
+	}
+
+	return tickers
+}
@@ -136,6 +136,338 @@ func (m *QueryPricesResponse) GetVersion() string {
 	return ""
 }
 
+// QueryStreamPricesRequest defines the request type for the StreamPrices
+// method.
+type QueryStreamPricesRequest struct {
+	// Tickers defines the tickers to stream prices for, e.g. "BTC/USD". If
+	// empty, the prices of all tickers are streamed.
+	Tickers []string `protobuf:"bytes,1,rep,name=tickers,proto3" json:"tickers,omitempty"`
+}
+
+func (m *QueryStreamPricesRequest) Reset()         { *m = QueryStreamPricesRequest{} }
+func (m *QueryStreamPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamPricesRequest) ProtoMessage()    {}
+func (*QueryStreamPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{2}
+}
+func (m *QueryStreamPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStreamPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamPricesRequest.Merge(m, src)
+}
+func (m *QueryStreamPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStreamPricesRequest proto.InternalMessageInfo
+
+func (m *QueryStreamPricesRequest) GetTickers() []string {
+	if m != nil {
+		return m.Tickers
+	}
+	return nil
+}
+
+// QueryProviderPricesRequest defines the request type for the ProviderPrices
+// method.
+type QueryProviderPricesRequest struct {
+	// Tickers defines the tickers to return the provider prices of, e.g.
+	// "BTC/USD". If empty, the provider prices of all tickers are returned.
+	Tickers []string `protobuf:"bytes,1,rep,name=tickers,proto3" json:"tickers,omitempty"`
+}
+
+func (m *QueryProviderPricesRequest) Reset()         { *m = QueryProviderPricesRequest{} }
+func (m *QueryProviderPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderPricesRequest) ProtoMessage()    {}
+func (*QueryProviderPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{3}
+}
+func (m *QueryProviderPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProviderPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderPricesRequest.Merge(m, src)
+}
+func (m *QueryProviderPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProviderPricesRequest proto.InternalMessageInfo
+
+func (m *QueryProviderPricesRequest) GetTickers() []string {
+	if m != nil {
+		return m.Tickers
+	}
+	return nil
+}
+
+// QueryProviderPricesResponse defines the response type for the
+// ProviderPrices method.
+type QueryProviderPricesResponse struct {
+	// Tickers defines the breakdown of the provider prices of each ticker.
+	Tickers []TickerProviderPrices `protobuf:"bytes,1,rep,name=tickers,proto3" json:"tickers"`
+	// Timestamp defines the timestamp of the prices.
+	Timestamp time.Time `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	// Version defines the version of the oracle service that provided the prices.
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *QueryProviderPricesResponse) Reset()         { *m = QueryProviderPricesResponse{} }
+func (m *QueryProviderPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderPricesResponse) ProtoMessage()    {}
+func (*QueryProviderPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{4}
+}
+func (m *QueryProviderPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProviderPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderPricesResponse.Merge(m, src)
+}
+func (m *QueryProviderPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProviderPricesResponse proto.InternalMessageInfo
+
+func (m *QueryProviderPricesResponse) GetTickers() []TickerProviderPrices {
+	if m != nil {
+		return m.Tickers
+	}
+	return nil
+}
+
+func (m *QueryProviderPricesResponse) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func (m *QueryProviderPricesResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+// TickerProviderPrices defines the breakdown of the provider prices of a
+// single ticker.
+type TickerProviderPrices struct {
+	// Ticker defines the ticker, e.g. "BTC/USD".
+	Ticker string `protobuf:"bytes,1,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	// AggregatedPrice defines the aggregated price of the ticker, scaled by the
+	// ticker's decimals as returned by the Prices method. This is empty if the
+	// oracle could not calculate a price for the ticker.
+	AggregatedPrice string `protobuf:"bytes,2,opt,name=aggregated_price,json=aggregatedPrice,proto3" json:"aggregated_price,omitempty"`
+	// Providers defines the price reported by each provider of the ticker.
+	Providers []ProviderPrice `protobuf:"bytes,3,rep,name=providers,proto3" json:"providers"`
+}
+
+func (m *TickerProviderPrices) Reset()         { *m = TickerProviderPrices{} }
+func (m *TickerProviderPrices) String() string { return proto.CompactTextString(m) }
+func (*TickerProviderPrices) ProtoMessage()    {}
+func (*TickerProviderPrices) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{5}
+}
+func (m *TickerProviderPrices) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TickerProviderPrices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TickerProviderPrices.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TickerProviderPrices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TickerProviderPrices.Merge(m, src)
+}
+func (m *TickerProviderPrices) XXX_Size() int {
+	return m.Size()
+}
+func (m *TickerProviderPrices) XXX_DiscardUnknown() {
+	xxx_messageInfo_TickerProviderPrices.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TickerProviderPrices proto.InternalMessageInfo
+
+func (m *TickerProviderPrices) GetTicker() string {
+	if m != nil {
+		return m.Ticker
+	}
+	return ""
+}
+
+func (m *TickerProviderPrices) GetAggregatedPrice() string {
+	if m != nil {
+		return m.AggregatedPrice
+	}
+	return ""
+}
+
+func (m *TickerProviderPrices) GetProviders() []ProviderPrice {
+	if m != nil {
+		return m.Providers
+	}
+	return nil
+}
+
+// ProviderPrice defines the price reported by a single provider for a ticker.
+type ProviderPrice struct {
+	// Provider defines the name of the provider.
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// OffChainTicker defines the provider's ticker for the market.
+	OffChainTicker string `protobuf:"bytes,2,opt,name=off_chain_ticker,json=offChainTicker,proto3" json:"off_chain_ticker,omitempty"`
+	// RawPrice defines the price reported by the provider. This is empty if the
+	// provider has not reported a price.
+	RawPrice string `protobuf:"bytes,3,opt,name=raw_price,json=rawPrice,proto3" json:"raw_price,omitempty"`
+	// Timestamp defines the time at which the provider reported the price.
+	Timestamp time.Time `protobuf:"bytes,4,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	// ConvertedPrice defines the price after it was inverted and / or
+	// normalized by another market. This is empty if the price could not be
+	// converted.
+	ConvertedPrice string `protobuf:"bytes,5,opt,name=converted_price,json=convertedPrice,proto3" json:"converted_price,omitempty"`
+	// Used defines whether the converted price was used to calculate the
+	// aggregated price.
+	Used bool `protobuf:"varint,6,opt,name=used,proto3" json:"used,omitempty"`
+	// Reason defines why the price was not used, if it was not.
+	Reason string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *ProviderPrice) Reset()         { *m = ProviderPrice{} }
+func (m *ProviderPrice) String() string { return proto.CompactTextString(m) }
+func (*ProviderPrice) ProtoMessage()    {}
+func (*ProviderPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{6}
+}
+func (m *ProviderPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderPrice.Merge(m, src)
+}
+func (m *ProviderPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderPrice proto.InternalMessageInfo
+
+func (m *ProviderPrice) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *ProviderPrice) GetOffChainTicker() string {
+	if m != nil {
+		return m.OffChainTicker
+	}
+	return ""
+}
+
+func (m *ProviderPrice) GetRawPrice() string {
+	if m != nil {
+		return m.RawPrice
+	}
+	return ""
+}
+
+func (m *ProviderPrice) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func (m *ProviderPrice) GetConvertedPrice() string {
+	if m != nil {
+		return m.ConvertedPrice
+	}
+	return ""
+}
+
+func (m *ProviderPrice) GetUsed() bool {
+	if m != nil {
+		return m.Used
+	}
+	return false
+}
+
+func (m *ProviderPrice) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 // QueryMarketMapRequest defines the request type for the MarketMap method.
 type QueryMarketMapRequest struct {
 }
@@ -144,7 +476,7 @@ func (m *QueryMarketMapRequest) Reset()         { *m = QueryMarketMapRequest{} }
 func (m *QueryMarketMapRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryMarketMapRequest) ProtoMessage()    {}
 func (*QueryMarketMapRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e88883d464f0f25b, []int{2}
+	return fileDescriptor_e88883d464f0f25b, []int{7}
 }
 func (m *QueryMarketMapRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -183,7 +515,7 @@ func (m *QueryMarketMapResponse) Reset()         { *m = QueryMarketMapResponse{}
 func (m *QueryMarketMapResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMarketMapResponse) ProtoMessage()    {}
 func (*QueryMarketMapResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e88883d464f0f25b, []int{3}
+	return fileDescriptor_e88883d464f0f25b, []int{8}
 }
 func (m *QueryMarketMapResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -227,7 +559,7 @@ func (m *QueryVersionRequest) Reset()         { *m = QueryVersionRequest{} }
 func (m *QueryVersionRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVersionRequest) ProtoMessage()    {}
 func (*QueryVersionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e88883d464f0f25b, []int{4}
+	return fileDescriptor_e88883d464f0f25b, []int{9}
 }
 func (m *QueryVersionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -266,7 +598,7 @@ func (m *QueryVersionResponse) Reset()         { *m = QueryVersionResponse{} }
 func (m *QueryVersionResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVersionResponse) ProtoMessage()    {}
 func (*QueryVersionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e88883d464f0f25b, []int{5}
+	return fileDescriptor_e88883d464f0f25b, []int{10}
 }
 func (m *QueryVersionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -306,6 +638,11 @@ func init() {
 	proto.RegisterType((*QueryPricesRequest)(nil), "slinky.service.v1.QueryPricesRequest")
 	proto.RegisterType((*QueryPricesResponse)(nil), "slinky.service.v1.QueryPricesResponse")
 	proto.RegisterMapType((map[string]string)(nil), "slinky.service.v1.QueryPricesResponse.PricesEntry")
+	proto.RegisterType((*QueryStreamPricesRequest)(nil), "slinky.service.v1.QueryStreamPricesRequest")
+	proto.RegisterType((*QueryProviderPricesRequest)(nil), "slinky.service.v1.QueryProviderPricesRequest")
+	proto.RegisterType((*QueryProviderPricesResponse)(nil), "slinky.service.v1.QueryProviderPricesResponse")
+	proto.RegisterType((*TickerProviderPrices)(nil), "slinky.service.v1.TickerProviderPrices")
+	proto.RegisterType((*ProviderPrice)(nil), "slinky.service.v1.ProviderPrice")
 	proto.RegisterType((*QueryMarketMapRequest)(nil), "slinky.service.v1.QueryMarketMapRequest")
 	proto.RegisterType((*QueryMarketMapResponse)(nil), "slinky.service.v1.QueryMarketMapResponse")
 	proto.RegisterType((*QueryVersionRequest)(nil), "slinky.service.v1.QueryVersionRequest")
@@ -315,42 +652,60 @@ func init() {
 func init() { proto.RegisterFile("slinky/service/v1/oracle.proto", fileDescriptor_e88883d464f0f25b) }
 
 var fileDescriptor_e88883d464f0f25b = []byte{
-	// 549 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xae, 0x5b, 0xe8, 0xa8, 0x7b, 0x01, 0xd3, 0x41, 0x96, 0x41, 0x56, 0x8a, 0x80, 0x72, 0xb1,
-	0x59, 0xb8, 0x00, 0x82, 0x4b, 0x25, 0x8e, 0x13, 0x23, 0x42, 0x43, 0xe2, 0x32, 0xb9, 0x91, 0x09,
-	0x51, 0x93, 0x38, 0xc4, 0x4e, 0x44, 0x90, 0x90, 0x10, 0x12, 0x17, 0x4e, 0x93, 0xf8, 0x53, 0x3b,
-	0x4e, 0xe2, 0xc2, 0x09, 0x50, 0xcb, 0x0f, 0x41, 0xb1, 0x9d, 0x6e, 0x6d, 0x99, 0xb6, 0x53, 0xfc,
-	0xfc, 0x3e, 0xbf, 0xf7, 0x7d, 0xdf, 0x7b, 0x0a, 0x74, 0x44, 0x14, 0x26, 0x93, 0x92, 0x08, 0x96,
-	0x15, 0xa1, 0xcf, 0x48, 0xb1, 0x4d, 0x78, 0x46, 0xfd, 0x88, 0xe1, 0x34, 0xe3, 0x92, 0xa3, 0x2b,
-	0x3a, 0x8f, 0x4d, 0x1e, 0x17, 0xdb, 0x76, 0x2f, 0xe0, 0x01, 0x57, 0x59, 0x52, 0x9d, 0x34, 0xd0,
-	0xbe, 0x11, 0x70, 0x1e, 0x44, 0x8c, 0xd0, 0x34, 0x24, 0x34, 0x49, 0xb8, 0xa4, 0x32, 0xe4, 0x89,
-	0x30, 0xd9, 0x2d, 0x93, 0x55, 0xd1, 0x38, 0x7f, 0x4b, 0x64, 0x18, 0x33, 0x21, 0x69, 0x9c, 0x1a,
-	0xc0, 0x86, 0xcf, 0x45, 0xcc, 0xc5, 0xbe, 0xae, 0xab, 0x03, 0x93, 0xea, 0x1b, 0x8a, 0x31, 0xcd,
-	0x26, 0x4c, 0xc6, 0x34, 0xad, 0x48, 0xea, 0x40, 0x23, 0x06, 0x3d, 0x88, 0x5e, 0xe6, 0x2c, 0x2b,
-	0x77, 0xb3, 0xd0, 0x67, 0xc2, 0x63, 0xef, 0x73, 0x26, 0xe4, 0xe0, 0x73, 0x13, 0x5e, 0x5d, 0xb8,
-	0x16, 0x29, 0x4f, 0x04, 0x43, 0xbb, 0xb0, 0x9d, 0xaa, 0x1b, 0x0b, 0xf4, 0x5b, 0xc3, 0xae, 0xeb,
-	0xe2, 0x15, 0x8d, 0xf8, 0x3f, 0xef, 0xb0, 0x0e, 0x9f, 0x27, 0x32, 0x2b, 0x47, 0x17, 0x0e, 0x7f,
-	0x6d, 0x35, 0x3c, 0x53, 0x07, 0x8d, 0x60, 0x67, 0xae, 0xc7, 0x6a, 0xf6, 0xc1, 0xb0, 0xeb, 0xda,
-	0x58, 0x2b, 0xc6, 0xb5, 0x62, 0xfc, 0xaa, 0x46, 0x8c, 0x2e, 0x55, 0x8f, 0x0f, 0x7e, 0x6f, 0x01,
-	0xef, 0xf8, 0x19, 0xb2, 0xe0, 0x5a, 0xc1, 0x32, 0x11, 0xf2, 0xc4, 0x6a, 0xf5, 0xc1, 0xb0, 0xe3,
-	0xd5, 0xa1, 0xfd, 0x18, 0x76, 0x4f, 0xb4, 0x46, 0x97, 0x61, 0x6b, 0xc2, 0x4a, 0x0b, 0x28, 0x50,
-	0x75, 0x44, 0x3d, 0x78, 0xb1, 0xa0, 0x51, 0xce, 0x54, 0xeb, 0x8e, 0xa7, 0x83, 0x27, 0xcd, 0x47,
-	0x60, 0x70, 0x1d, 0xae, 0x2b, 0x25, 0x3b, 0xca, 0xad, 0x1d, 0x9a, 0xd6, 0xde, 0xbc, 0x86, 0xd7,
-	0x96, 0x13, 0xc6, 0x9d, 0x67, 0x10, 0x6a, 0x6f, 0xf7, 0x63, 0x9a, 0xaa, 0x2e, 0x5d, 0xd7, 0xa9,
-	0x1d, 0x9a, 0x8f, 0xa0, 0xf2, 0xe8, 0xf8, 0x6d, 0x27, 0xae, 0x8f, 0x83, 0x75, 0xe3, 0xf9, 0x9e,
-	0x26, 0x5f, 0xf7, 0x7b, 0x00, 0x7b, 0x8b, 0xd7, 0xa6, 0xdb, 0x09, 0xd5, 0x60, 0x41, 0xb5, 0xfb,
-	0xad, 0x05, 0xdb, 0x2f, 0xd4, 0x26, 0xa2, 0x12, 0xb6, 0xb5, 0x01, 0xe8, 0xce, 0x59, 0xa3, 0x52,
-	0xdd, 0xec, 0xbb, 0xe7, 0x9b, 0xe8, 0xa0, 0xff, 0xe5, 0xc7, 0xdf, 0xef, 0x4d, 0x1b, 0x59, 0xc4,
-	0xac, 0x98, 0x5e, 0xfd, 0x6a, 0xbf, 0xcc, 0x64, 0xbf, 0x02, 0xd8, 0x99, 0xeb, 0x44, 0xc3, 0xd3,
-	0xea, 0x2e, 0xfb, 0x6b, 0xdf, 0x3f, 0x07, 0xd2, 0x90, 0xb8, 0xad, 0x48, 0xdc, 0x44, 0x9b, 0xab,
-	0x24, 0xe6, 0x76, 0xa3, 0x4f, 0x70, 0xcd, 0x58, 0x87, 0x4e, 0x15, 0xb7, 0x68, 0xb9, 0x7d, 0xef,
-	0x4c, 0x9c, 0x21, 0x70, 0x4b, 0x11, 0xd8, 0x44, 0x1b, 0xab, 0x04, 0xcc, 0x30, 0x46, 0x7b, 0x87,
-	0x53, 0x07, 0x1c, 0x4d, 0x1d, 0xf0, 0x67, 0xea, 0x80, 0x83, 0x99, 0xd3, 0x38, 0x9a, 0x39, 0x8d,
-	0x9f, 0x33, 0xa7, 0xf1, 0xe6, 0x69, 0x10, 0xca, 0x77, 0xf9, 0x18, 0xfb, 0x3c, 0x26, 0x1f, 0x79,
-	0x90, 0x7f, 0x50, 0xfb, 0xee, 0xf3, 0x88, 0x2c, 0xfd, 0x58, 0xaa, 0x2f, 0xcb, 0x44, 0x5d, 0x5c,
-	0x96, 0x29, 0x13, 0xe3, 0xb6, 0x42, 0x3f, 0xfc, 0x17, 0x00, 0x00, 0xff, 0xff, 0x29, 0xdf, 0xf4,
-	0xe5, 0x86, 0x04, 0x00, 0x00,
+	// 843 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x8f, 0xdb, 0x44,
+	0x14, 0xcf, 0x24, 0xbb, 0xd9, 0xf8, 0x05, 0xda, 0x65, 0x48, 0x8b, 0xeb, 0x80, 0x93, 0x75, 0x05,
+	0xc9, 0x0a, 0x61, 0xb7, 0x01, 0x21, 0x40, 0x70, 0x09, 0x20, 0x4e, 0x15, 0x25, 0x54, 0x45, 0xe2,
+	0x12, 0xcd, 0x7a, 0x27, 0xae, 0x95, 0xd8, 0x63, 0x66, 0x9c, 0x94, 0x20, 0x21, 0x21, 0x24, 0x4e,
+	0x48, 0xa8, 0x12, 0x37, 0x2e, 0x7c, 0x0d, 0x3e, 0x00, 0x87, 0x1e, 0x2b, 0x71, 0xe1, 0x04, 0x68,
+	0x97, 0x0f, 0x82, 0x3c, 0x33, 0x4e, 0xd6, 0x59, 0x47, 0x8d, 0x90, 0x7a, 0xca, 0xbc, 0x79, 0xff,
+	0x7e, 0xef, 0x37, 0x2f, 0x3f, 0x19, 0x6c, 0x31, 0x0b, 0xe3, 0xe9, 0xd2, 0x13, 0x94, 0x2f, 0x42,
+	0x9f, 0x7a, 0x8b, 0xdb, 0x1e, 0xe3, 0xc4, 0x9f, 0x51, 0x37, 0xe1, 0x2c, 0x65, 0xf8, 0x05, 0xe5,
+	0x77, 0xb5, 0xdf, 0x5d, 0xdc, 0xb6, 0x5a, 0x01, 0x0b, 0x98, 0xf4, 0x7a, 0xd9, 0x49, 0x05, 0x5a,
+	0x2f, 0x07, 0x8c, 0x05, 0x33, 0xea, 0x91, 0x24, 0xf4, 0x48, 0x1c, 0xb3, 0x94, 0xa4, 0x21, 0x8b,
+	0x85, 0xf6, 0x76, 0xb4, 0x57, 0x5a, 0x27, 0xf3, 0x89, 0x97, 0x86, 0x11, 0x15, 0x29, 0x89, 0x12,
+	0x1d, 0x70, 0xc3, 0x67, 0x22, 0x62, 0x62, 0xac, 0xea, 0x2a, 0x43, 0xbb, 0xba, 0x1a, 0x62, 0x44,
+	0xf8, 0x94, 0xa6, 0x11, 0x49, 0x32, 0x90, 0xca, 0x50, 0x11, 0x4e, 0x0b, 0xf0, 0x67, 0x73, 0xca,
+	0x97, 0x77, 0x79, 0xe8, 0x53, 0x31, 0xa2, 0x5f, 0xcd, 0xa9, 0x48, 0x9d, 0xef, 0xaa, 0xf0, 0x62,
+	0xe1, 0x5a, 0x24, 0x2c, 0x16, 0x14, 0xdf, 0x85, 0x7a, 0x22, 0x6f, 0x4c, 0xd4, 0xad, 0xf5, 0x9b,
+	0x83, 0x81, 0x7b, 0x69, 0x46, 0xb7, 0x24, 0xcf, 0x55, 0xe6, 0xc7, 0x71, 0xca, 0x97, 0xc3, 0xbd,
+	0xc7, 0x7f, 0x75, 0x2a, 0x23, 0x5d, 0x07, 0x0f, 0xc1, 0x58, 0xcd, 0x63, 0x56, 0xbb, 0xa8, 0xdf,
+	0x1c, 0x58, 0xae, 0x9a, 0xd8, 0xcd, 0x27, 0x76, 0xef, 0xe5, 0x11, 0xc3, 0x46, 0x96, 0xfc, 0xe8,
+	0xef, 0x0e, 0x1a, 0xad, 0xd3, 0xb0, 0x09, 0x07, 0x0b, 0xca, 0x45, 0xc8, 0x62, 0xb3, 0xd6, 0x45,
+	0x7d, 0x63, 0x94, 0x9b, 0xd6, 0xbb, 0xd0, 0xbc, 0xd0, 0x1a, 0x1f, 0x42, 0x6d, 0x4a, 0x97, 0x26,
+	0x92, 0x41, 0xd9, 0x11, 0xb7, 0x60, 0x7f, 0x41, 0x66, 0x73, 0x2a, 0x5b, 0x1b, 0x23, 0x65, 0xbc,
+	0x57, 0x7d, 0x07, 0x39, 0x6f, 0x81, 0x29, 0x27, 0xf9, 0x3c, 0xe5, 0x94, 0x44, 0x05, 0x7a, 0xb2,
+	0x86, 0x69, 0xe8, 0x4f, 0x29, 0x57, 0x3c, 0x18, 0xa3, 0xdc, 0x74, 0xde, 0x06, 0x4b, 0xcf, 0xcf,
+	0x16, 0xe1, 0x29, 0xe5, 0xbb, 0xe6, 0xfd, 0x8e, 0xa0, 0x5d, 0x9a, 0xa8, 0x89, 0xff, 0xa4, 0x98,
+	0xd9, 0x1c, 0xf4, 0x4a, 0x98, 0xbf, 0x27, 0x23, 0x8a, 0x15, 0x34, 0xdd, 0x79, 0xf6, 0xb3, 0xe5,
+	0xdb, 0xf9, 0x15, 0x41, 0xab, 0x0c, 0x05, 0xbe, 0x0e, 0x75, 0x85, 0x40, 0x93, 0xaf, 0x2d, 0x7c,
+	0x0c, 0x87, 0x24, 0x08, 0x38, 0x0d, 0x48, 0x4a, 0x4f, 0xc7, 0x72, 0x27, 0xf4, 0x53, 0x5c, 0x5d,
+	0xdf, 0xcb, 0x1a, 0xf8, 0x23, 0x30, 0x12, 0x5d, 0x54, 0x98, 0x35, 0x49, 0x42, 0xb7, 0x84, 0x84,
+	0x42, 0x63, 0x3d, 0xfd, 0x3a, 0xd1, 0xf9, 0xb1, 0x0a, 0xcf, 0x17, 0x42, 0xb0, 0x05, 0x8d, 0xdc,
+	0xad, 0xc1, 0xad, 0x6c, 0xdc, 0x87, 0x43, 0x36, 0x99, 0x8c, 0xfd, 0x07, 0x24, 0x8c, 0xc7, 0x7a,
+	0x00, 0x05, 0xef, 0x0a, 0x9b, 0x4c, 0x3e, 0xcc, 0xae, 0xd5, 0xb8, 0xb8, 0x0d, 0x06, 0x27, 0x0f,
+	0xf5, 0x04, 0x8a, 0x95, 0x06, 0x27, 0x0f, 0x55, 0x8b, 0x02, 0xe9, 0x7b, 0xff, 0x8f, 0xf4, 0x1e,
+	0x5c, 0xf5, 0x59, 0xbc, 0xa0, 0x7c, 0x4d, 0xd4, 0xbe, 0x42, 0xb2, 0xba, 0x56, 0xcd, 0x30, 0xec,
+	0xcd, 0x05, 0x3d, 0x35, 0xeb, 0x5d, 0xd4, 0x6f, 0x8c, 0xe4, 0x39, 0xa3, 0x9f, 0x53, 0x22, 0x58,
+	0x6c, 0x1e, 0x28, 0xfa, 0x95, 0xe5, 0xbc, 0x04, 0xd7, 0xe4, 0xd6, 0xdd, 0x91, 0x92, 0x70, 0x87,
+	0x24, 0xb9, 0x00, 0x7c, 0x01, 0xd7, 0x37, 0x1d, 0x7a, 0x13, 0x3f, 0x00, 0x50, 0x02, 0x32, 0x8e,
+	0x48, 0x22, 0x09, 0x6b, 0x0e, 0xec, 0xfc, 0x1d, 0x56, 0x3a, 0x93, 0xbd, 0xc4, 0x3a, 0xd7, 0x88,
+	0xf2, 0xa3, 0x73, 0x4d, 0x0b, 0xcb, 0x7d, 0xb5, 0x31, 0x79, 0xbf, 0x5b, 0xd0, 0x2a, 0x5e, 0xeb,
+	0x6e, 0x17, 0x56, 0x0d, 0x15, 0x56, 0x6d, 0xf0, 0xdb, 0x3e, 0xd4, 0x3f, 0x95, 0x72, 0x8b, 0x97,
+	0x50, 0xd7, 0x6b, 0xf6, 0xea, 0xd3, 0xf4, 0x48, 0x76, 0xb3, 0x5e, 0xdb, 0x4d, 0xb6, 0x9c, 0xee,
+	0xf7, 0x7f, 0xfc, 0xfb, 0x73, 0xd5, 0xc2, 0xa6, 0xa7, 0x75, 0x54, 0xe9, 0x7b, 0x26, 0xa2, 0x5a,
+	0xbe, 0x7e, 0x42, 0xf0, 0xdc, 0x45, 0x85, 0xc0, 0xaf, 0x6f, 0x2b, 0x5d, 0xa2, 0x23, 0x3b, 0xe3,
+	0xe8, 0x49, 0x1c, 0x47, 0xb8, 0xb3, 0x0d, 0x87, 0x27, 0x64, 0xf5, 0x5b, 0x08, 0xff, 0x82, 0xe0,
+	0xca, 0xc6, 0x7f, 0xef, 0x8d, 0xed, 0x5d, 0x4a, 0x44, 0xca, 0x72, 0x77, 0x0d, 0xd7, 0xe0, 0x8e,
+	0x25, 0xb8, 0x9b, 0xf8, 0xa8, 0x0c, 0x9c, 0xca, 0x18, 0x6b, 0xb6, 0x7e, 0x40, 0x60, 0xac, 0xb6,
+	0x02, 0xf7, 0xb7, 0x35, 0xda, 0xdc, 0x46, 0xeb, 0x78, 0x87, 0x48, 0x8d, 0xe6, 0xa6, 0x44, 0xf3,
+	0x0a, 0x6e, 0x5f, 0x46, 0xb3, 0x5a, 0x4e, 0xfc, 0x2d, 0x1c, 0xe8, 0x45, 0xc3, 0x5b, 0x9f, 0xa0,
+	0xb8, 0xa0, 0x56, 0xef, 0xa9, 0x71, 0x1a, 0xc0, 0x91, 0x04, 0xd0, 0xc6, 0x37, 0x2e, 0x03, 0xd0,
+	0xab, 0x3b, 0xbc, 0xff, 0xf8, 0xcc, 0x46, 0x4f, 0xce, 0x6c, 0xf4, 0xcf, 0x99, 0x8d, 0x1e, 0x9d,
+	0xdb, 0x95, 0x27, 0xe7, 0x76, 0xe5, 0xcf, 0x73, 0xbb, 0xf2, 0xe5, 0xfb, 0x41, 0x98, 0x3e, 0x98,
+	0x9f, 0xb8, 0x3e, 0x8b, 0xbc, 0x6f, 0x58, 0x30, 0xff, 0x5a, 0xaa, 0x83, 0xcf, 0x66, 0xde, 0xc6,
+	0xb7, 0x46, 0xf6, 0x4b, 0xb9, 0xc8, 0x8b, 0xa7, 0xcb, 0x84, 0x8a, 0x93, 0xba, 0x8c, 0x7e, 0xf3,
+	0xbf, 0x01, 0x00, 0xc4, 0x5d, 0xd3, 0x4b, 0x99, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -367,6 +722,13 @@ const _ = grpc.SupportPackageIsVersion4
 type OracleClient interface {
 	// Prices defines a method for fetching the latest prices.
 	Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error)
+	// StreamPrices defines a method for subscribing to the latest prices. A
+	// message is sent every time the oracle updates its prices.
+	StreamPrices(ctx context.Context, in *QueryStreamPricesRequest, opts ...grpc.CallOption) (Oracle_StreamPricesClient, error)
+	// ProviderPrices defines a method for fetching the breakdown of the prices
+	// reported by each provider, i.e. the raw and converted price of each
+	// provider and whether it was used to calculate the aggregated price.
+	ProviderPrices(ctx context.Context, in *QueryProviderPricesRequest, opts ...grpc.CallOption) (*QueryProviderPricesResponse, error)
 	// MarketMap defines a method for fetching the latest market map
 	// configuration.
 	MarketMap(ctx context.Context, in *QueryMarketMapRequest, opts ...grpc.CallOption) (*QueryMarketMapResponse, error)
@@ -392,6 +754,47 @@ func (c *oracleClient) Prices(ctx context.Context, in *QueryPricesRequest, opts
 	return out, nil
 }
 
+func (c *oracleClient) StreamPrices(ctx context.Context, in *QueryStreamPricesRequest, opts ...grpc.CallOption) (Oracle_StreamPricesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Oracle_serviceDesc.Streams[0], "/slinky.service.v1.Oracle/StreamPrices", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &oracleStreamPricesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Oracle_StreamPricesClient interface {
+	Recv() (*QueryPricesResponse, error)
+	grpc.ClientStream
+}
+
+type oracleStreamPricesClient struct {
+	grpc.ClientStream
+}
+
+func (x *oracleStreamPricesClient) Recv() (*QueryPricesResponse, error) {
+	m := new(QueryPricesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *oracleClient) ProviderPrices(ctx context.Context, in *QueryProviderPricesRequest, opts ...grpc.CallOption) (*QueryProviderPricesResponse, error) {
+	out := new(QueryProviderPricesResponse)
+	err := c.cc.Invoke(ctx, "/slinky.service.v1.Oracle/ProviderPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *oracleClient) MarketMap(ctx context.Context, in *QueryMarketMapRequest, opts ...grpc.CallOption) (*QueryMarketMapResponse, error) {
 	out := new(QueryMarketMapResponse)
 	err := c.cc.Invoke(ctx, "/slinky.service.v1.Oracle/MarketMap", in, out, opts...)
@@ -414,6 +817,13 @@ func (c *oracleClient) Version(ctx context.Context, in *QueryVersionRequest, opt
 type OracleServer interface {
 	// Prices defines a method for fetching the latest prices.
 	Prices(context.Context, *QueryPricesRequest) (*QueryPricesResponse, error)
+	// StreamPrices defines a method for subscribing to the latest prices. A
+	// message is sent every time the oracle updates its prices.
+	StreamPrices(*QueryStreamPricesRequest, Oracle_StreamPricesServer) error
+	// ProviderPrices defines a method for fetching the breakdown of the prices
+	// reported by each provider, i.e. the raw and converted price of each
+	// provider and whether it was used to calculate the aggregated price.
+	ProviderPrices(context.Context, *QueryProviderPricesRequest) (*QueryProviderPricesResponse, error)
 	// MarketMap defines a method for fetching the latest market map
 	// configuration.
 	MarketMap(context.Context, *QueryMarketMapRequest) (*QueryMarketMapResponse, error)
@@ -429,6 +839,12 @@ type UnimplementedOracleServer struct {
 func (*UnimplementedOracleServer) Prices(ctx context.Context, req *QueryPricesRequest) (*QueryPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Prices not implemented")
 }
+func (*UnimplementedOracleServer) StreamPrices(req *QueryStreamPricesRequest, srv Oracle_StreamPricesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPrices not implemented")
+}
+func (*UnimplementedOracleServer) ProviderPrices(ctx context.Context, req *QueryProviderPricesRequest) (*QueryProviderPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderPrices not implemented")
+}
 func (*UnimplementedOracleServer) MarketMap(ctx context.Context, req *QueryMarketMapRequest) (*QueryMarketMapResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MarketMap not implemented")
 }
@@ -458,6 +874,45 @@ func _Oracle_Prices_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Oracle_StreamPrices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryStreamPricesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OracleServer).StreamPrices(m, &oracleStreamPricesServer{stream})
+}
+
+type Oracle_StreamPricesServer interface {
+	Send(*QueryPricesResponse) error
+	grpc.ServerStream
+}
+
+type oracleStreamPricesServer struct {
+	grpc.ServerStream
+}
+
+func (x *oracleStreamPricesServer) Send(m *QueryPricesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Oracle_ProviderPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServer).ProviderPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.service.v1.Oracle/ProviderPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServer).ProviderPrices(ctx, req.(*QueryProviderPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Oracle_MarketMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryMarketMapRequest)
 	if err := dec(in); err != nil {
@@ -503,6 +958,10 @@ var _Oracle_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Prices",
 			Handler:    _Oracle_Prices_Handler,
 		},
+		{
+			MethodName: "ProviderPrices",
+			Handler:    _Oracle_ProviderPrices_Handler,
+		},
 		{
 			MethodName: "MarketMap",
 			Handler:    _Oracle_MarketMap_Handler,
@@ -512,7 +971,13 @@ var _Oracle_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Oracle_Version_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamPrices",
+			Handler:       _Oracle_StreamPrices_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "slinky/service/v1/oracle.proto",
 }
 
@@ -596,7 +1061,7 @@ func (m *QueryPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryMarketMapRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryStreamPricesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -606,20 +1071,29 @@ func (m *QueryMarketMapRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryMarketMapRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryStreamPricesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryMarketMapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryStreamPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Tickers) > 0 {
+		for iNdEx := len(m.Tickers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tickers[iNdEx])
+			copy(dAtA[i:], m.Tickers[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Tickers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryMarketMapResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryProviderPricesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -629,21 +1103,255 @@ func (m *QueryMarketMapResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryMarketMapResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryProviderPricesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryMarketMapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryProviderPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MarketMap != nil {
-		{
-			size, err := m.MarketMap.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Tickers) > 0 {
+		for iNdEx := len(m.Tickers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tickers[iNdEx])
+			copy(dAtA[i:], m.Tickers[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Tickers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintOracle(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Tickers) > 0 {
+		for iNdEx := len(m.Tickers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tickers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TickerProviderPrices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TickerProviderPrices) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TickerProviderPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Providers) > 0 {
+		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Providers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AggregatedPrice) > 0 {
+		i -= len(m.AggregatedPrice)
+		copy(dAtA[i:], m.AggregatedPrice)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.AggregatedPrice)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Ticker) > 0 {
+		i -= len(m.Ticker)
+		copy(dAtA[i:], m.Ticker)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Ticker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Used {
+		i--
+		if m.Used {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ConvertedPrice) > 0 {
+		i -= len(m.ConvertedPrice)
+		copy(dAtA[i:], m.ConvertedPrice)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ConvertedPrice)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintOracle(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if len(m.RawPrice) > 0 {
+		i -= len(m.RawPrice)
+		copy(dAtA[i:], m.RawPrice)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.RawPrice)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OffChainTicker) > 0 {
+		i -= len(m.OffChainTicker)
+		copy(dAtA[i:], m.OffChainTicker)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.OffChainTicker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketMapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketMapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketMapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketMapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketMapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketMapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarketMap != nil {
+		{
+			size, err := m.MarketMap.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
 			i -= size
 			i = encodeVarintOracle(dAtA, i, uint64(size))
@@ -750,6 +1458,114 @@ func (m *QueryPricesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryStreamPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tickers) > 0 {
+		for _, s := range m.Tickers {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProviderPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tickers) > 0 {
+		for _, s := range m.Tickers {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProviderPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tickers) > 0 {
+		for _, e := range m.Tickers {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovOracle(uint64(l))
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *TickerProviderPrices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ticker)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.AggregatedPrice)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.Providers) > 0 {
+		for _, e := range m.Providers {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProviderPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.OffChainTicker)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.RawPrice)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovOracle(uint64(l))
+	l = len(m.ConvertedPrice)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Used {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryMarketMapRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1092,6 +1908,730 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryStreamPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStreamPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStreamPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tickers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tickers = append(m.Tickers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tickers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tickers = append(m.Tickers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tickers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tickers = append(m.Tickers, TickerProviderPrices{})
+			if err := m.Tickers[len(m.Tickers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TickerProviderPrices) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TickerProviderPrices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TickerProviderPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregatedPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Providers = append(m.Providers, ProviderPrice{})
+			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffChainTicker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OffChainTicker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvertedPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConvertedPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Used = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryMarketMapRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -331,10 +331,11 @@ func NewSimApp(
 
 	// Create the aggregation function that will be used to aggregate oracle data
 	// from each validator.
-	aggregatorFn := voteweighted.MedianFromContext(
+	aggregatorFn := voteweighted.MedianFromContextWithQuorums(
 		app.Logger(),
 		app.StakingKeeper,
 		voteweighted.DefaultPowerThreshold,
+		app.OracleKeeper,
 	)
 
 	// Create the pre-finalize block hook that will be used to apply oracle data
@@ -0,0 +1,266 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/zoguxprotocol/slinky/pkg/proposal"
+	"github.com/zoguxprotocol/slinky/x/marketmap/types"
+)
+
+// GetTxCmd returns the parent command for all x/marketmap cli tx commands. Each command either signs and
+// broadcasts its message, or, with --proposal, prints an unsigned governance proposal wrapping the message.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Transaction commands for the marketmap module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdCreateMarkets(),
+		CmdUpdateMarkets(),
+		CmdUpsertMarkets(),
+		CmdRemoveMarkets(),
+		CmdParams(),
+		CmdRemoveMarketAuthorities(),
+	)
+
+	return cmd
+}
+
+// CmdCreateMarkets returns the command for building a MsgCreateMarkets from a market map file.
+func CmdCreateMarkets() *cobra.Command {
+	return marketsTxCmd(
+		"create-markets [markets-file]",
+		"Create the markets defined in a market map JSON file",
+		func(authority string, markets []types.Market) sdk.Msg {
+			return &types.MsgCreateMarkets{Authority: authority, CreateMarkets: markets}
+		},
+	)
+}
+
+// CmdUpdateMarkets returns the command for building a MsgUpdateMarkets from a market map file.
+func CmdUpdateMarkets() *cobra.Command {
+	return marketsTxCmd(
+		"update-markets [markets-file]",
+		"Update the markets defined in a market map JSON file",
+		func(authority string, markets []types.Market) sdk.Msg {
+			return &types.MsgUpdateMarkets{Authority: authority, UpdateMarkets: markets}
+		},
+	)
+}
+
+// CmdUpsertMarkets returns the command for building a MsgUpsertMarkets from a market map file.
+func CmdUpsertMarkets() *cobra.Command {
+	return marketsTxCmd(
+		"upsert-markets [markets-file]",
+		"Create or update the markets defined in a market map JSON file",
+		func(authority string, markets []types.Market) sdk.Msg {
+			return &types.MsgUpsertMarkets{Authority: authority, Markets: markets}
+		},
+	)
+}
+
+// marketsTxCmd returns a tx command that reads the markets in the given file, and builds a message from them
+// using newMsg.
+func marketsTxCmd(use, short string, newMsg func(authority string, markets []types.Market) sdk.Msg) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Long: short + `. The file uses the same format as the market map genesis / oracle configuration, e.g.
+
+{
+  "markets": {
+    "BTC/USD": {
+      "ticker": {...},
+      "provider_configs": [...]
+    }
+  }
+}
+
+Each market is validated locally before the message is built.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			markets, err := ReadMarketsFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			authority, err := proposal.GetAuthority(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			return proposal.GenerateOrBroadcastMsgs(cmd, clientCtx, newMsg(authority, markets))
+		},
+	}
+
+	proposal.AddProposalFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdRemoveMarkets returns the command for building a MsgRemoveMarkets.
+func CmdRemoveMarkets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-markets [ticker]...",
+		Short:   "Remove the given markets from the market map",
+		Example: "remove-markets BTC/USD ETH/USD --proposal --title 'Remove markets' --summary 'Delist BTC/USD and ETH/USD'",
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := proposal.GetAuthority(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRemoveMarkets{
+				Authority: authority,
+				Markets:   args,
+			}
+
+			return proposal.GenerateOrBroadcastMsgs(cmd, clientCtx, msg)
+		},
+	}
+
+	proposal.AddProposalFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdParams returns the command for building a MsgParams from a params JSON file.
+func CmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params [params-file]",
+		Short: "Update the marketmap module parameters to the ones defined in a JSON file",
+		Long: `Update the marketmap module parameters to the ones defined in a JSON file, e.g.
+
+{
+  "market_authorities": ["cosmos1..."],
+  "admin": "cosmos1..."
+}`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("error reading params file: %w", err)
+			}
+
+			var params types.Params
+			if err := json.Unmarshal(bz, &params); err != nil {
+				return fmt.Errorf("error unmarshalling params JSON: %w", err)
+			}
+
+			authority, err := proposal.GetAuthority(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgParams{
+				Authority: authority,
+				Params:    params,
+			}
+
+			return proposal.GenerateOrBroadcastMsgs(cmd, clientCtx, msg)
+		},
+	}
+
+	proposal.AddProposalFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdRemoveMarketAuthorities returns the command for building a MsgRemoveMarketAuthorities. The message must be
+// signed by the module's admin, which is resolved the same way as the authority of the other commands.
+func CmdRemoveMarketAuthorities() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-market-authorities [address]...",
+		Short: "Remove the given addresses from the market authorities (must be signed by the module admin)",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			admin, err := proposal.GetAuthority(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRemoveMarketAuthorities{
+				RemoveAddresses: args,
+				Admin:           admin,
+			}
+
+			return proposal.GenerateOrBroadcastMsgs(cmd, clientCtx, msg)
+		},
+	}
+
+	proposal.AddProposalFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// ReadMarketsFromFile reads the markets of a market map JSON file (the format read by types.ReadMarketMapFromFile)
+// and validates each of them with Market.ValidateBasic. Unlike ReadMarketMapFromFile, the file is not required to be
+// a complete market map, so markets may be normalized by markets that already exist on chain. The markets are
+// returned sorted by ticker.
+func ReadMarketsFromFile(path string) ([]types.Market, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading markets file: %w", err)
+	}
+
+	var mm types.MarketMap
+	if err := json.Unmarshal(bz, &mm); err != nil {
+		return nil, fmt.Errorf("error unmarshalling markets JSON: %w", err)
+	}
+
+	if len(mm.Markets) == 0 {
+		return nil, fmt.Errorf("no markets found in %s", path)
+	}
+
+	markets := make([]types.Market, 0, len(mm.Markets))
+	for ticker, market := range mm.Markets {
+		if ticker != market.Ticker.String() {
+			return nil, fmt.Errorf("ticker %s does not match market.Ticker.String() %s", ticker, market.Ticker.String())
+		}
+
+		if err := market.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("invalid market %s: %w", ticker, err)
+		}
+
+		markets = append(markets, market)
+	}
+
+	sort.Slice(markets, func(i, j int) bool {
+		return markets[i].Ticker.String() < markets[j].Ticker.String()
+	})
+
+	return markets, nil
+}
@@ -0,0 +1,278 @@
+package cli_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/skip-mev/chaintestutil/sample"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zoguxprotocol/slinky/pkg/proposal"
+	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
+	"github.com/zoguxprotocol/slinky/x/marketmap"
+	"github.com/zoguxprotocol/slinky/x/marketmap/client/cli"
+	"github.com/zoguxprotocol/slinky/x/marketmap/types"
+)
+
+var (
+	usdtusd = types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair:     slinkytypes.NewCurrencyPair("USDT", "USD"),
+			Decimals:         8,
+			MinProviderCount: 1,
+			Enabled:          true,
+		},
+		ProviderConfigs: []types.ProviderConfig{
+			{
+				Name:           "kucoin",
+				OffChainTicker: "usdt-usd",
+			},
+		},
+	}
+
+	// btcusd is normalized by a market that is not in the file, which is allowed as it may already exist on chain.
+	btcusd = types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair:     slinkytypes.NewCurrencyPair("BTC", "USD"),
+			Decimals:         8,
+			MinProviderCount: 1,
+			Enabled:          true,
+		},
+		ProviderConfigs: []types.ProviderConfig{
+			{
+				Name:            "kucoin",
+				OffChainTicker:  "btc-usdc",
+				NormalizeByPair: &slinkytypes.CurrencyPair{Base: "USDC", Quote: "USD"},
+			},
+		},
+	}
+
+	invalid = types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair:     slinkytypes.NewCurrencyPair("ETH", "USD"),
+			Decimals:         8,
+			MinProviderCount: 2,
+			Enabled:          true,
+		},
+		ProviderConfigs: []types.ProviderConfig{
+			{
+				Name:           "kucoin",
+				OffChainTicker: "eth-usd",
+			},
+		},
+	}
+)
+
+func writeMarketsFile(t *testing.T, markets map[string]types.Market) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "markets.json")
+	require.NoError(t, types.WriteMarketMapToFile(types.MarketMap{Markets: markets}, path))
+	return path
+}
+
+func TestReadMarketsFromFile(t *testing.T) {
+	testCases := []struct {
+		name     string
+		markets  map[string]types.Market
+		expected []types.Market
+		err      bool
+	}{
+		{
+			name: "valid markets are returned sorted by ticker",
+			markets: map[string]types.Market{
+				usdtusd.Ticker.String(): usdtusd,
+				btcusd.Ticker.String():  btcusd,
+			},
+			expected: []types.Market{btcusd, usdtusd},
+		},
+		{
+			name:    "no markets",
+			markets: map[string]types.Market{},
+			err:     true,
+		},
+		{
+			name: "ticker does not match the market",
+			markets: map[string]types.Market{
+				"ETH/USD": btcusd,
+			},
+			err: true,
+		},
+		{
+			name: "invalid market",
+			markets: map[string]types.Market{
+				invalid.Ticker.String(): invalid,
+			},
+			err: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			markets, err := cli.ReadMarketsFromFile(writeMarketsFile(t, tc.markets))
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, markets)
+		})
+	}
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := cli.ReadMarketsFromFile(filepath.Join(t.TempDir(), "missing.json"))
+		require.Error(t, err)
+	})
+}
+
+func TestTxCmdProposal(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(marketmap.AppModuleBasic{})
+	clientCtx := client.Context{}.
+		WithCodec(encCfg.Codec).
+		WithInterfaceRegistry(encCfg.InterfaceRegistry).
+		WithTxConfig(encCfg.TxConfig)
+
+	govAddress := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	marketsFile := writeMarketsFile(t, map[string]types.Market{
+		usdtusd.Ticker.String(): usdtusd,
+		btcusd.Ticker.String():  btcusd,
+	})
+
+	paramsFile := filepath.Join(t.TempDir(), "params.json")
+	params := types.Params{MarketAuthorities: []string{govAddress}, Admin: govAddress}
+	bz, err := json.Marshal(params)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(paramsFile, bz, 0o600))
+
+	authority := sample.Address(sample.Rand())
+
+	testCases := []struct {
+		name     string
+		args     []string
+		deposit  string
+		expected sdk.Msg
+		err      bool
+	}{
+		{
+			name:     "create markets",
+			args:     []string{"create-markets", marketsFile},
+			expected: &types.MsgCreateMarkets{Authority: govAddress, CreateMarkets: []types.Market{btcusd, usdtusd}},
+		},
+		{
+			name:     "update markets",
+			args:     []string{"update-markets", marketsFile},
+			expected: &types.MsgUpdateMarkets{Authority: govAddress, UpdateMarkets: []types.Market{btcusd, usdtusd}},
+		},
+		{
+			name:     "upsert markets with an authority",
+			args:     []string{"upsert-markets", marketsFile, "--authority", authority},
+			expected: &types.MsgUpsertMarkets{Authority: authority, Markets: []types.Market{btcusd, usdtusd}},
+		},
+		{
+			name:     "remove markets",
+			args:     []string{"remove-markets", "BTC/USD", "USDT/USD"},
+			expected: &types.MsgRemoveMarkets{Authority: govAddress, Markets: []string{"BTC/USD", "USDT/USD"}},
+		},
+		{
+			name:     "params",
+			args:     []string{"params", paramsFile},
+			expected: &types.MsgParams{Authority: govAddress, Params: params},
+		},
+		{
+			name:     "remove market authorities",
+			args:     []string{"remove-market-authorities", authority},
+			expected: &types.MsgRemoveMarketAuthorities{Admin: govAddress, RemoveAddresses: []string{authority}},
+		},
+		{
+			name: "invalid authority",
+			args: []string{"create-markets", marketsFile, "--authority", "invalid"},
+			err:  true,
+		},
+		{
+			name: "duplicate market authorities",
+			args: []string{"remove-market-authorities", authority, authority},
+			err:  true,
+		},
+		{
+			name:    "invalid deposit",
+			args:    []string{"remove-markets", "BTC/USD"},
+			deposit: "invalid",
+			err:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			deposit := tc.deposit
+			if deposit == "" {
+				deposit = "100stake"
+			}
+
+			args := append(tc.args,
+				"--proposal",
+				"--title", "title",
+				"--summary", "summary",
+				"--deposit", deposit,
+			)
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetTxCmd(), args)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			var p proposal.Proposal
+			require.NoError(t, json.Unmarshal(out.Bytes(), &p))
+			require.Equal(t, "title", p.Title)
+			require.Equal(t, "summary", p.Summary)
+			require.Equal(t, "100stake", p.Deposit)
+			require.Len(t, p.Messages, 1)
+
+			var msg sdk.Msg
+			require.NoError(t, encCfg.Codec.UnmarshalInterfaceJSON(p.Messages[0], &msg))
+			require.Equal(t, tc.expected, msg)
+		})
+	}
+}
+
+func TestTxCmdGenerateOnly(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(marketmap.AppModuleBasic{})
+	clientCtx := client.Context{}.
+		WithCodec(encCfg.Codec).
+		WithInterfaceRegistry(encCfg.InterfaceRegistry).
+		WithTxConfig(encCfg.TxConfig)
+
+	from := sample.Address(sample.Rand())
+
+	t.Run("authority defaults to the from address", func(t *testing.T) {
+		out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetTxCmd(), []string{
+			"remove-markets", "BTC/USD",
+			"--generate-only",
+			"--from", from,
+			"--chain-id", "test",
+		})
+		require.NoError(t, err)
+
+		tx, err := encCfg.TxConfig.TxJSONDecoder()(out.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, []sdk.Msg{&types.MsgRemoveMarkets{Authority: from, Markets: []string{"BTC/USD"}}}, tx.GetMsgs())
+	})
+
+	t.Run("authority is required", func(t *testing.T) {
+		_, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetTxCmd(), []string{
+			"remove-markets", "BTC/USD",
+			"--generate-only",
+		})
+		require.Error(t, err)
+	})
+}
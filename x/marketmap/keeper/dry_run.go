@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zoguxprotocol/slinky/x/marketmap/types"
+)
+
+// DryRunMarketMapUpdate simulates moving the market map from current to proposed on chain. It seeds an
+// in-memory keeper with the current markets, and then executes a MsgUpsertMarkets for every added or
+// changed market and a MsgRemoveMarkets for every removed market through the msg server. This runs the
+// same stateful checks as the chain, i.e. ValidateState (NormalizeByPair dependencies) and the delete market
+// validation hooks, which can be overridden with WithDeleteValidationHooks. The upsert is executed before
+// the removal against the same cached state, as the messages of a proposal are, and the error of the first
+// failing message is returned.
+func DryRunMarketMapUpdate(current, proposed types.MarketMap, opts ...Option) error {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, db)
+	if err := cms.LoadLatestVersion(); err != nil {
+		return fmt.Errorf("failed to load dry run store: %w", err)
+	}
+	ctx := sdk.NewContext(cms, cmtproto.Header{}, false, log.NewNopLogger())
+
+	authority := sdk.AccAddress(types.ModuleName)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := NewKeeper(runtime.NewKVStoreService(key), cdc, authority, opts...)
+
+	if err := k.SetParams(ctx, types.Params{
+		MarketAuthorities: []string{authority.String()},
+		Admin:             authority.String(),
+	}); err != nil {
+		return err
+	}
+
+	for _, market := range current.Markets {
+		if err := k.CreateMarket(ctx, market); err != nil {
+			return fmt.Errorf("failed to seed market %s: %w", market.Ticker.String(), err)
+		}
+	}
+
+	diff := types.DiffMarketMaps(current, proposed)
+	ms := NewMsgServer(k)
+
+	// the messages are executed in order against the same cached state, as they would be in a proposal
+	cacheCtx, write := ctx.CacheContext()
+	if upserts := diff.Upserts(proposed); len(upserts) > 0 {
+		msg := &types.MsgUpsertMarkets{Authority: authority.String(), Markets: upserts}
+		if err := dryRunMsg(cacheCtx, msg, func(ctx sdk.Context) error {
+			_, err := ms.UpsertMarkets(ctx, msg)
+			return err
+		}); err != nil {
+			return err
+		}
+	}
+
+	if len(diff.Removed) > 0 {
+		tickers := make([]string, len(diff.Removed))
+		for i, market := range diff.Removed {
+			tickers[i] = market.Ticker.String()
+		}
+
+		msg := &types.MsgRemoveMarkets{Authority: authority.String(), Markets: tickers}
+		if err := dryRunMsg(cacheCtx, msg, func(ctx sdk.Context) error {
+			_, err := ms.RemoveMarkets(ctx, msg)
+			return err
+		}); err != nil {
+			return err
+		}
+	}
+
+	write()
+	return nil
+}
+
+// dryRunMsg validates the message and executes it against the given context.
+func dryRunMsg(ctx sdk.Context, msg sdk.HasValidateBasic, exec func(sdk.Context) error) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return fmt.Errorf("%T: %w", msg, err)
+	}
+
+	if err := exec(ctx); err != nil {
+		return fmt.Errorf("%T: %w", msg, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
+	"github.com/zoguxprotocol/slinky/x/marketmap/keeper"
+	"github.com/zoguxprotocol/slinky/x/marketmap/types"
+)
+
+func TestDryRunMarketMapUpdate(t *testing.T) {
+	usdtusd := types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair:     slinkytypes.NewCurrencyPair("USDT", "USD"),
+			Decimals:         8,
+			MinProviderCount: 1,
+			Enabled:          true,
+		},
+		ProviderConfigs: []types.ProviderConfig{{Name: "kucoin", OffChainTicker: "usdt-usd"}},
+	}
+
+	disabledUsdtusd := usdtusd
+	disabledUsdtusd.Ticker.Enabled = false
+
+	btcusd := types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair:     slinkytypes.NewCurrencyPair("BTC", "USD"),
+			Decimals:         8,
+			MinProviderCount: 1,
+			Enabled:          true,
+		},
+		ProviderConfigs: []types.ProviderConfig{
+			{Name: "kucoin", OffChainTicker: "btc-usdt", NormalizeByPair: &usdtusd.Ticker.CurrencyPair},
+		},
+	}
+
+	disabledBtcusd := btcusd
+	disabledBtcusd.Ticker.Enabled = false
+
+	disabledBtcusdDirect := disabledBtcusd
+	disabledBtcusdDirect.ProviderConfigs = []types.ProviderConfig{{Name: "kucoin", OffChainTicker: "btc-usd"}}
+
+	marketMap := func(markets ...types.Market) types.MarketMap {
+		mm := types.MarketMap{Markets: make(map[string]types.Market)}
+		for _, market := range markets {
+			mm.Markets[market.Ticker.String()] = market
+		}
+		return mm
+	}
+
+	testCases := []struct {
+		name     string
+		current  types.MarketMap
+		proposed types.MarketMap
+		opts     []keeper.Option
+		err      bool
+	}{
+		{
+			name:     "no changes",
+			current:  marketMap(usdtusd),
+			proposed: marketMap(usdtusd),
+		},
+		{
+			name:     "adding a market normalized by an existing market",
+			current:  marketMap(usdtusd),
+			proposed: marketMap(usdtusd, btcusd),
+		},
+		{
+			name:     "adding a market with its normalization market",
+			current:  marketMap(),
+			proposed: marketMap(usdtusd, btcusd),
+		},
+		{
+			name:     "adding a market normalized by a missing market",
+			current:  marketMap(),
+			proposed: marketMap(btcusd),
+			err:      true,
+		},
+		{
+			// ValidateState only checks the updated markets, so this is accepted on chain (and caught by
+			// MarketMap.ValidateBasic instead).
+			name:     "disabling the normalization market of an enabled market",
+			current:  marketMap(usdtusd, btcusd),
+			proposed: marketMap(disabledUsdtusd, btcusd),
+		},
+		{
+			name:     "removing an enabled market",
+			current:  marketMap(usdtusd),
+			proposed: marketMap(),
+			err:      true,
+		},
+		{
+			name:     "removing a disabled market",
+			current:  marketMap(disabledUsdtusd),
+			proposed: marketMap(),
+		},
+		{
+			name:     "removing the normalization market of another market",
+			current:  marketMap(disabledUsdtusd, disabledBtcusd),
+			proposed: marketMap(disabledBtcusd),
+			err:      true,
+		},
+		{
+			name:     "removing the normalization market of a market that no longer uses it",
+			current:  marketMap(disabledUsdtusd, disabledBtcusd),
+			proposed: marketMap(disabledBtcusdDirect),
+		},
+		{
+			name:     "adding a market normalized by a removed market",
+			current:  marketMap(disabledUsdtusd),
+			proposed: marketMap(disabledBtcusd),
+			err:      true,
+		},
+		{
+			name:     "custom delete validation hooks",
+			current:  marketMap(disabledUsdtusd),
+			proposed: marketMap(),
+			opts: []keeper.Option{keeper.WithDeleteValidationHooks([]types.MarketValidationHook{
+				func(context.Context, types.Market) error { return fmt.Errorf("cannot delete") },
+			})},
+			err: true,
+		},
+		{
+			name:     "invalid market",
+			current:  marketMap(),
+			proposed: marketMap(types.Market{Ticker: usdtusd.Ticker}),
+			err:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := keeper.DryRunMarketMapUpdate(tc.current, tc.proposed, tc.opts...)
+			if tc.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
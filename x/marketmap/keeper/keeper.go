@@ -226,17 +226,35 @@ func (k *Keeper) GetParams(ctx sdk.Context) (types.Params, error) {
 // ValidateState is called after keeper modifications have been made to the market map to verify that
 // the aggregate of all updates has led to a valid state.
 func (k *Keeper) ValidateState(ctx sdk.Context, updates []types.Market) error {
+	hasDependencies := false
 	for _, market := range updates {
 		if err := k.IsMarketValid(ctx, market); err != nil {
 			return err
 		}
+
+		hasDependencies = hasDependencies || market.IsSynthetic()
+		for _, providerConfig := range market.ProviderConfigs {
+			hasDependencies = hasDependencies || len(providerConfig.ConversionPairs()) > 0
+		}
 	}
 
-	return nil
+	// normalization pairs, conversion paths and synthetic components can only form a cycle through the
+	// updated markets
+	if !hasDependencies {
+		return nil
+	}
+
+	markets, err := k.GetAllMarkets(ctx)
+	if err != nil {
+		return err
+	}
+
+	mm := types.MarketMap{Markets: markets}
+	return mm.ValidateDependencyCycles()
 }
 
 // IsMarketValid checks if a market is valid by statefully checking if each of the currency pairs
-// specified by its provider configs are valid and in state.
+// specified by its provider configs and synthetic components are valid and in state.
 func (k *Keeper) IsMarketValid(ctx sdk.Context, market types.Market) error {
 	// check that all markets already exist in the keeper store:
 	for _, providerConfig := range market.ProviderConfigs {
@@ -253,6 +271,34 @@ func (k *Keeper) IsMarketValid(ctx sdk.Context, market types.Market) error {
 					providerConfig.NormalizeByPair.String(), market.Ticker.String())
 			}
 		}
+
+		for _, pair := range providerConfig.ConversionPath {
+			conversion, err := k.markets.Get(ctx, types.TickerString(pair.String()))
+			if err != nil {
+				return fmt.Errorf("unable to get conversion market %s for market %s: %w",
+					pair.String(), market.Ticker.String(), err)
+			}
+
+			// if the new market is enabled, its conversion markets must also be enabled
+			if market.Ticker.Enabled && !conversion.Ticker.Enabled {
+				return fmt.Errorf("needed conversion market %s for market %s is not enabled",
+					pair.String(), market.Ticker.String())
+			}
+		}
+	}
+
+	for _, cp := range market.SyntheticComponents() {
+		component, err := k.markets.Get(ctx, types.TickerString(cp))
+		if err != nil {
+			return fmt.Errorf("unable to get synthetic component market %s for market %s: %w",
+				cp, market.Ticker.String(), err)
+		}
+
+		// if the new market is enabled, its synthetic component markets must also be enabled
+		if market.Ticker.Enabled && !component.Ticker.Enabled {
+			return fmt.Errorf("needed synthetic component market %s for market %s is not enabled",
+				cp, market.Ticker.String())
+		}
 	}
 
 	return nil
@@ -346,6 +346,145 @@ func (s *KeeperTestSuite) TestInvalidCreateDisabledNormalizeBy() {
 	s.Require().Error(s.keeper.ValidateState(s.ctx, []types.Market{invalidMarket}))
 }
 
+func (s *KeeperTestSuite) TestConversionPath() {
+	marketUSDTUSD := usdtusd
+	marketETHUSDT := ethusdt
+	marketUSDTUSD.Ticker.Enabled = true
+	marketETHUSDT.Ticker.Enabled = true
+
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, marketUSDTUSD))
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, marketETHUSDT))
+
+	// PEPE/ETHEREUM * ETHEREUM/USDT * USDT/USD = PEPE/USD
+	marketPEPEUSD := types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair:     slinkytypes.NewCurrencyPair("PEPE", "USD"),
+			Decimals:         18,
+			MinProviderCount: 1,
+			Enabled:          true,
+		},
+		ProviderConfigs: []types.ProviderConfig{
+			{
+				Name:           "uniswap",
+				OffChainTicker: "pepe-eth",
+				ConversionPath: []slinkytypes.CurrencyPair{
+					marketETHUSDT.Ticker.CurrencyPair,
+					marketUSDTUSD.Ticker.CurrencyPair,
+				},
+			},
+		},
+	}
+
+	s.Run("valid conversion path", func() {
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, marketPEPEUSD))
+		s.Require().NoError(s.keeper.ValidateState(s.ctx, []types.Market{marketPEPEUSD}))
+	})
+
+	s.Run("conversion market is disabled", func() {
+		disabled := marketETHUSDT
+		disabled.Ticker.Enabled = false
+		s.Require().NoError(s.keeper.UpdateMarket(s.ctx, disabled))
+		s.Require().Error(s.keeper.ValidateState(s.ctx, []types.Market{marketPEPEUSD}))
+		s.Require().NoError(s.keeper.UpdateMarket(s.ctx, marketETHUSDT))
+	})
+
+	s.Run("conversion market is missing", func() {
+		invalidMarket := marketPEPEUSD
+		invalidMarket.ProviderConfigs = []types.ProviderConfig{
+			{
+				Name:           "uniswap",
+				OffChainTicker: "pepe-sol",
+				ConversionPath: []slinkytypes.CurrencyPair{slinkytypes.NewCurrencyPair("SOL", "USD")},
+			},
+		}
+
+		s.Require().NoError(s.keeper.UpdateMarket(s.ctx, invalidMarket))
+		s.Require().Error(s.keeper.ValidateState(s.ctx, []types.Market{invalidMarket}))
+		s.Require().NoError(s.keeper.UpdateMarket(s.ctx, marketPEPEUSD))
+	})
+
+	s.Run("conversion paths form a cycle", func() {
+		// USDT/USD is converted by PEPE/USD, which is converted by USDT/USD.
+		invalidMarket := marketUSDTUSD
+		invalidMarket.ProviderConfigs = append(invalidMarket.ProviderConfigs, types.ProviderConfig{
+			Name:           "uniswap",
+			OffChainTicker: "usdt-pepe",
+			ConversionPath: []slinkytypes.CurrencyPair{marketPEPEUSD.Ticker.CurrencyPair},
+		})
+
+		s.Require().NoError(s.keeper.UpdateMarket(s.ctx, invalidMarket))
+		s.Require().Error(s.keeper.ValidateState(s.ctx, []types.Market{invalidMarket}))
+	})
+
+	s.Run("normalization pair and conversion path form a cycle", func() {
+		// USDT/USD is normalized by PEPE/USD, which is converted by USDT/USD.
+		invalidMarket := marketUSDTUSD
+		invalidMarket.ProviderConfigs = append(invalidMarket.ProviderConfigs, types.ProviderConfig{
+			Name:            "uniswap",
+			OffChainTicker:  "usdt-pepe",
+			NormalizeByPair: &marketPEPEUSD.Ticker.CurrencyPair,
+		})
+
+		s.Require().NoError(s.keeper.UpdateMarket(s.ctx, invalidMarket))
+		s.Require().Error(s.keeper.ValidateState(s.ctx, []types.Market{invalidMarket}))
+	})
+}
+
+func (s *KeeperTestSuite) TestSyntheticMarket() {
+	marketUSDTUSD := usdtusd
+	marketETHUSDT := ethusdt
+	marketUSDTUSD.Ticker.Enabled = true
+	marketETHUSDT.Ticker.Enabled = true
+
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, marketUSDTUSD))
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, marketETHUSDT))
+
+	// ETHEREUM/USD = ETHEREUM/USDT * USDT/USD
+	marketETHUSD := types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair:     slinkytypes.NewCurrencyPair("ETHEREUM", "USD"),
+			Decimals:         8,
+			MinProviderCount: 1,
+			Enabled:          true,
+			Metadata_JSON:    `{"synthetic_type":"cross","synthetic_components":[{"currency_pair":"ETHEREUM/USDT"},{"currency_pair":"USDT/USD"}]}`,
+		},
+	}
+	s.Require().NoError(marketETHUSD.ValidateBasic())
+
+	s.Run("valid synthetic market", func() {
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, marketETHUSD))
+		s.Require().NoError(s.keeper.ValidateState(s.ctx, []types.Market{marketETHUSD}))
+	})
+
+	s.Run("synthetic component is disabled", func() {
+		disabled := marketETHUSDT
+		disabled.Ticker.Enabled = false
+		s.Require().NoError(s.keeper.UpdateMarket(s.ctx, disabled))
+		s.Require().Error(s.keeper.ValidateState(s.ctx, []types.Market{marketETHUSD}))
+		s.Require().NoError(s.keeper.UpdateMarket(s.ctx, marketETHUSDT))
+	})
+
+	s.Run("synthetic component is missing", func() {
+		invalidMarket := marketETHUSD
+		invalidMarket.Ticker.Metadata_JSON = `{"synthetic_type":"cross","synthetic_components":[{"currency_pair":"ETHEREUM/USDC"},{"currency_pair":"USDC/USD"}]}`
+
+		s.Require().NoError(s.keeper.UpdateMarket(s.ctx, invalidMarket))
+		s.Require().Error(s.keeper.ValidateState(s.ctx, []types.Market{invalidMarket}))
+		s.Require().NoError(s.keeper.UpdateMarket(s.ctx, marketETHUSD))
+	})
+
+	s.Run("synthetic components form a cycle", func() {
+		// USDT/USD = ETHEREUM/USD * (ETHEREUM/USDT)^-1, while ETHEREUM/USD is derived from USDT/USD.
+		invalidMarket := marketUSDTUSD
+		invalidMarket.ProviderConfigs = nil
+		invalidMarket.Ticker.Metadata_JSON = `{"synthetic_type":"cross","synthetic_components":[{"currency_pair":"ETHEREUM/USD"},{"currency_pair":"ETHEREUM/USDT","invert":true}]}`
+		s.Require().NoError(invalidMarket.ValidateBasic())
+
+		s.Require().NoError(s.keeper.UpdateMarket(s.ctx, invalidMarket))
+		s.Require().Error(s.keeper.ValidateState(s.ctx, []types.Market{invalidMarket}))
+	})
+}
+
 func (s *KeeperTestSuite) TestDeleteMarket() {
 	// create a valid markets
 	btcCopy := btcusdt
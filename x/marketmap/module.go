@@ -51,9 +51,9 @@ func (amb AppModuleBasic) Name() string {
 	return types.ModuleName
 }
 
-// GetTxCmd is a no-op, as no txs are registered for submission (apart from messages that can only be executed by governance).
+// GetTxCmd returns the x/marketmap module base tx cli-command.
 func (amb AppModuleBasic) GetTxCmd() *cobra.Command {
-	return nil
+	return cli.GetTxCmd()
 }
 
 // GetQueryCmd returns the x/marketmap module base query cli-command.
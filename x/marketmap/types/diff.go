@@ -0,0 +1,143 @@
+package types
+
+import (
+	"sort"
+)
+
+// MarketMapDiff is the difference between two market maps. All markets are sorted by ticker.
+type MarketMapDiff struct {
+	// Added are the markets that only exist in the new market map.
+	Added []Market `json:"added,omitempty"`
+	// Removed are the markets that only exist in the old market map.
+	Removed []Market `json:"removed,omitempty"`
+	// Changed are the markets that exist in both market maps, but are not equal.
+	Changed []MarketDiff `json:"changed,omitempty"`
+}
+
+// MarketDiff is the difference between two versions of the same market.
+type MarketDiff struct {
+	// Ticker is the ticker string of the market.
+	Ticker string `json:"ticker"`
+	// OldTicker and NewTicker are set if the ticker (decimals, min provider count, enabled, metadata) changed.
+	OldTicker *Ticker `json:"old_ticker,omitempty"`
+	NewTicker *Ticker `json:"new_ticker,omitempty"`
+	// AddedProviders are the provider configs that only exist in the new market.
+	AddedProviders []ProviderConfig `json:"added_providers,omitempty"`
+	// RemovedProviders are the provider configs that only exist in the old market.
+	RemovedProviders []ProviderConfig `json:"removed_providers,omitempty"`
+	// ChangedProviders are the provider configs, keyed by provider and off-chain ticker, that exist in both
+	// markets but are not equal.
+	ChangedProviders []ProviderConfigDiff `json:"changed_providers,omitempty"`
+}
+
+// ProviderConfigDiff is the difference between two versions of the same provider config.
+type ProviderConfigDiff struct {
+	Old ProviderConfig `json:"old"`
+	New ProviderConfig `json:"new"`
+}
+
+// Empty returns true if there are no differences between the market maps.
+func (d MarketMapDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// Upserts returns the markets that must be upserted to go from the old market map to the new one.
+func (d MarketMapDiff) Upserts(newMM MarketMap) []Market {
+	upserts := make([]Market, 0, len(d.Added)+len(d.Changed))
+	upserts = append(upserts, d.Added...)
+	for _, changed := range d.Changed {
+		upserts = append(upserts, newMM.Markets[changed.Ticker])
+	}
+
+	sort.Slice(upserts, func(i, j int) bool {
+		return upserts[i].Ticker.String() < upserts[j].Ticker.String()
+	})
+
+	return upserts
+}
+
+// DiffMarketMaps returns the difference between the old and new market maps.
+func DiffMarketMaps(oldMM, newMM MarketMap) MarketMapDiff {
+	var diff MarketMapDiff
+
+	for _, ticker := range sortedTickers(newMM) {
+		newMarket := newMM.Markets[ticker]
+
+		oldMarket, found := oldMM.Markets[ticker]
+		if !found {
+			diff.Added = append(diff.Added, newMarket)
+			continue
+		}
+
+		if marketDiff, changed := diffMarkets(ticker, oldMarket, newMarket); changed {
+			diff.Changed = append(diff.Changed, marketDiff)
+		}
+	}
+
+	for _, ticker := range sortedTickers(oldMM) {
+		if _, found := newMM.Markets[ticker]; !found {
+			diff.Removed = append(diff.Removed, oldMM.Markets[ticker])
+		}
+	}
+
+	return diff
+}
+
+// diffMarkets returns the difference between two versions of a market, and whether they differ. Provider
+// configs are matched by provider name and off-chain ticker, so re-ordering them is not a change.
+func diffMarkets(ticker string, oldMarket, newMarket Market) (MarketDiff, bool) {
+	diff := MarketDiff{Ticker: ticker}
+	changed := false
+
+	if !oldMarket.Ticker.Equal(newMarket.Ticker) {
+		diff.OldTicker = &oldMarket.Ticker
+		diff.NewTicker = &newMarket.Ticker
+		changed = true
+	}
+
+	oldProviders := make(map[string]ProviderConfig, len(oldMarket.ProviderConfigs))
+	for _, providerConfig := range oldMarket.ProviderConfigs {
+		oldProviders[providerConfigKey(providerConfig)] = providerConfig
+	}
+
+	seen := make(map[string]struct{}, len(newMarket.ProviderConfigs))
+	for _, providerConfig := range newMarket.ProviderConfigs {
+		key := providerConfigKey(providerConfig)
+		seen[key] = struct{}{}
+
+		oldProviderConfig, found := oldProviders[key]
+		switch {
+		case !found:
+			diff.AddedProviders = append(diff.AddedProviders, providerConfig)
+			changed = true
+		case !oldProviderConfig.Equal(providerConfig):
+			diff.ChangedProviders = append(diff.ChangedProviders, ProviderConfigDiff{Old: oldProviderConfig, New: providerConfig})
+			changed = true
+		}
+	}
+
+	for _, providerConfig := range oldMarket.ProviderConfigs {
+		if _, found := seen[providerConfigKey(providerConfig)]; !found {
+			diff.RemovedProviders = append(diff.RemovedProviders, providerConfig)
+			changed = true
+		}
+	}
+
+	return diff, changed
+}
+
+// providerConfigKey returns the key that uniquely identifies a provider config within a market.
+func providerConfigKey(providerConfig ProviderConfig) string {
+	return providerConfig.Name + "/" + providerConfig.OffChainTicker
+}
+
+// sortedTickers returns the tickers of the given market map in sorted order.
+func sortedTickers(mm MarketMap) []string {
+	tickers := make([]string, 0, len(mm.Markets))
+	for ticker := range mm.Markets {
+		tickers = append(tickers, ticker)
+	}
+
+	sort.Strings(tickers)
+	return tickers
+}
@@ -0,0 +1,116 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zoguxprotocol/slinky/x/marketmap/types"
+)
+
+func TestDiffMarketMaps(t *testing.T) {
+	disabledUsdtusd := usdtusd
+	disabledUsdtusd.Ticker.Enabled = false
+
+	okx := types.ProviderConfig{Name: "okx", OffChainTicker: "USDT-USD"}
+	usdtusdWithOKX := usdtusd
+	usdtusdWithOKX.ProviderConfigs = append([]types.ProviderConfig{okx}, usdtusd.ProviderConfigs...)
+
+	invertedUsdtusd := usdtusd
+	invertedUsdtusd.ProviderConfigs = []types.ProviderConfig{usdtusd.ProviderConfigs[0]}
+	invertedUsdtusd.ProviderConfigs[0].Invert = true
+
+	testCases := []struct {
+		name     string
+		oldMM    types.MarketMap
+		newMM    types.MarketMap
+		expected types.MarketMapDiff
+	}{
+		{
+			name:     "empty market maps",
+			oldMM:    emptyMM,
+			newMM:    emptyMM,
+			expected: types.MarketMapDiff{},
+		},
+		{
+			name:  "added and removed markets",
+			oldMM: types.MarketMap{Markets: map[string]types.Market{btcusdt.Ticker.String(): btcusdt}},
+			newMM: types.MarketMap{Markets: map[string]types.Market{
+				usdtusd.Ticker.String(): usdtusd,
+				btcusd.Ticker.String():  btcusd,
+			}},
+			expected: types.MarketMapDiff{
+				Added:   []types.Market{btcusd, usdtusd},
+				Removed: []types.Market{btcusdt},
+			},
+		},
+		{
+			name:     "equal markets are not changed",
+			oldMM:    types.MarketMap{Markets: map[string]types.Market{usdtusd.Ticker.String(): usdtusd}},
+			newMM:    types.MarketMap{Markets: map[string]types.Market{usdtusd.Ticker.String(): usdtusd}},
+			expected: types.MarketMapDiff{},
+		},
+		{
+			name:  "changed ticker",
+			oldMM: types.MarketMap{Markets: map[string]types.Market{usdtusd.Ticker.String(): usdtusd}},
+			newMM: types.MarketMap{Markets: map[string]types.Market{usdtusd.Ticker.String(): disabledUsdtusd}},
+			expected: types.MarketMapDiff{
+				Changed: []types.MarketDiff{
+					{
+						Ticker:    usdtusd.Ticker.String(),
+						OldTicker: &usdtusd.Ticker,
+						NewTicker: &disabledUsdtusd.Ticker,
+					},
+				},
+			},
+		},
+		{
+			name:  "added and removed provider configs",
+			oldMM: types.MarketMap{Markets: map[string]types.Market{usdtusd.Ticker.String(): usdtusdWithOKX}},
+			newMM: types.MarketMap{Markets: map[string]types.Market{usdtusd.Ticker.String(): invertedUsdtusd}},
+			expected: types.MarketMapDiff{
+				Changed: []types.MarketDiff{
+					{
+						Ticker:           usdtusd.Ticker.String(),
+						RemovedProviders: []types.ProviderConfig{okx},
+						ChangedProviders: []types.ProviderConfigDiff{
+							{
+								Old: usdtusd.ProviderConfigs[0],
+								New: invertedUsdtusd.ProviderConfigs[0],
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			diff := types.DiffMarketMaps(tc.oldMM, tc.newMM)
+			require.Equal(t, tc.expected, diff)
+			require.Equal(t, len(tc.expected.Added)+len(tc.expected.Removed)+len(tc.expected.Changed) == 0, diff.Empty())
+		})
+	}
+
+	t.Run("upserts include added and changed markets", func(t *testing.T) {
+		newMM := types.MarketMap{Markets: map[string]types.Market{
+			usdtusd.Ticker.String(): disabledUsdtusd,
+			btcusd.Ticker.String():  btcusd,
+		}}
+
+		diff := types.DiffMarketMaps(types.MarketMap{Markets: map[string]types.Market{usdtusd.Ticker.String(): usdtusd}}, newMM)
+		require.Equal(t, []types.Market{btcusd, disabledUsdtusd}, diff.Upserts(newMM))
+	})
+
+	t.Run("re-ordered provider configs are not a change", func(t *testing.T) {
+		reordered := usdtusdWithOKX
+		reordered.ProviderConfigs = []types.ProviderConfig{usdtusdWithOKX.ProviderConfigs[1], usdtusdWithOKX.ProviderConfigs[0]}
+
+		diff := types.DiffMarketMaps(
+			types.MarketMap{Markets: map[string]types.Market{usdtusd.Ticker.String(): usdtusdWithOKX}},
+			types.MarketMap{Markets: map[string]types.Market{usdtusd.Ticker.String(): reordered}},
+		)
+		require.True(t, diff.Empty())
+	})
+}
@@ -2,6 +2,9 @@ package types
 
 import (
 	"fmt"
+	"sort"
+
+	"github.com/zoguxprotocol/slinky/x/marketmap/types/tickermetadata"
 )
 
 // ValidateBasic validates the market map configuration and its expected configuration.
@@ -12,6 +15,9 @@ import (
 //		   markets are supported by the market map.
 //		2. Ensure that each provider config has a valid corresponding ticker.
 //	 	3. Ensure that all normalization markets are enabled.
+//	 	4. Ensure that all conversion path markets exist and are enabled.
+//	 	5. Ensure that all synthetic component markets exist and are enabled, and that the conversion
+//	 	   paths and synthetic components (along with the normalization pairs) do not form a cycle.
 func (mm *MarketMap) ValidateBasic() error {
 	for ticker, market := range mm.Markets {
 		if err := market.ValidateBasic(); err != nil {
@@ -34,12 +40,160 @@ func (mm *MarketMap) ValidateBasic() error {
 					return fmt.Errorf("enabled market %s cannot have use a normalization market %s that is disabled", market.Ticker.String(), normalizeMarket.Ticker.String())
 				}
 			}
+
+			for _, pair := range providerConfig.ConversionPath {
+				conversionMarket, found := mm.Markets[pair.String()]
+				if !found {
+					return fmt.Errorf("provider's (%s) pair for conversion (%s) was not found in the marketmap", providerConfig.Name, pair.String())
+				}
+
+				if !conversionMarket.Ticker.Enabled && market.Ticker.Enabled {
+					return fmt.Errorf("enabled market %s cannot use a conversion market %s that is disabled", market.Ticker.String(), conversionMarket.Ticker.String())
+				}
+			}
 		}
+
+		for _, cp := range market.SyntheticComponents() {
+			componentMarket, found := mm.Markets[cp]
+			if !found {
+				return fmt.Errorf("synthetic component (%s) of market %s was not found in the marketmap", cp, market.Ticker.String())
+			}
+
+			if !componentMarket.Ticker.Enabled && market.Ticker.Enabled {
+				return fmt.Errorf("enabled market %s cannot use a synthetic component %s that is disabled", market.Ticker.String(), componentMarket.Ticker.String())
+			}
+		}
+	}
+
+	return mm.ValidateDependencyCycles()
+}
+
+// ValidateDependencyCycles returns an error if the conversion paths or synthetic components of the markets,
+// along with their normalization pairs, form a cycle, i.e. if the price of a market is derived, directly or
+// through other markets, from its own index price. Cycles of only normalization pairs are allowed, as markets
+// normalized by each other (e.g. BTC/USD by USDT/USD, and USDT/USD by BTC/USD) are common.
+func (mm *MarketMap) ValidateDependencyCycles() error {
+	if ticker, found := mm.dependencyCycle(); found {
+		return fmt.Errorf("normalization pairs, conversion paths or synthetic components of market %s form a cycle", ticker)
 	}
 
 	return nil
 }
 
+// dependency is a dependency of a market on the index price of another market.
+type dependency struct {
+	// ticker is the market that is depended on.
+	ticker string
+	// normalization is true if the dependency is a normalization pair.
+	normalization bool
+}
+
+// dependencies returns the dependencies of the market, i.e. the normalization pairs and conversion paths of
+// its provider configs, and its synthetic components.
+func (m *Market) dependencies() []dependency {
+	var deps []dependency
+	for _, providerConfig := range m.ProviderConfigs {
+		for _, pair := range providerConfig.ConversionPairs() {
+			deps = append(deps, dependency{
+				ticker:        pair.String(),
+				normalization: providerConfig.NormalizeByPair != nil,
+			})
+		}
+	}
+	for _, cp := range m.SyntheticComponents() {
+		deps = append(deps, dependency{ticker: cp})
+	}
+
+	return deps
+}
+
+// dependencyCycle returns a market with a conversion path or synthetic component dependency on a cycle of
+// the dependencies between the markets, if any. The markets are visited in order so that the returned market
+// is deterministic.
+func (mm *MarketMap) dependencyCycle() (string, bool) {
+	tickers := make([]string, 0, len(mm.Markets))
+	for ticker := range mm.Markets {
+		tickers = append(tickers, ticker)
+	}
+	sort.Strings(tickers)
+
+	// a dependency is on a cycle iff both markets are in the same strongly connected component
+	components := mm.dependencyComponents(tickers)
+	for _, ticker := range tickers {
+		market := mm.Markets[ticker]
+		for _, dep := range market.dependencies() {
+			if dep.normalization {
+				continue
+			}
+
+			if component, found := components[dep.ticker]; found && component == components[ticker] {
+				return ticker, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// dependencyComponents returns the strongly connected component of each market of the dependency graph between
+// the markets, using Tarjan's algorithm. Dependencies on markets that are not in the market map are ignored.
+func (mm *MarketMap) dependencyComponents(tickers []string) map[string]int {
+	var (
+		index      = make(map[string]int, len(tickers))
+		lowLink    = make(map[string]int, len(tickers))
+		onStack    = make(map[string]bool, len(tickers))
+		stack      = make([]string, 0, len(tickers))
+		components = make(map[string]int, len(tickers))
+		component  = 0
+	)
+
+	var visit func(ticker string)
+	visit = func(ticker string) {
+		index[ticker] = len(index)
+		lowLink[ticker] = index[ticker]
+		stack = append(stack, ticker)
+		onStack[ticker] = true
+
+		market := mm.Markets[ticker]
+		for _, dep := range market.dependencies() {
+			if _, found := mm.Markets[dep.ticker]; !found {
+				continue
+			}
+
+			if _, visited := index[dep.ticker]; !visited {
+				visit(dep.ticker)
+				lowLink[ticker] = min(lowLink[ticker], lowLink[dep.ticker])
+			} else if onStack[dep.ticker] {
+				lowLink[ticker] = min(lowLink[ticker], index[dep.ticker])
+			}
+		}
+
+		if lowLink[ticker] != index[ticker] {
+			return
+		}
+
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+			components[top] = component
+
+			if top == ticker {
+				break
+			}
+		}
+		component++
+	}
+
+	for _, ticker := range tickers {
+		if _, visited := index[ticker]; !visited {
+			visit(ticker)
+		}
+	}
+
+	return components
+}
+
 // GetValidSubset outputs a MarketMap which contains the maximal valid subset of this MarketMap.
 //
 //	In particular, this will eliminate anything which would otherwise cause a failure in ValidateBasic.
@@ -47,43 +201,129 @@ func (mm *MarketMap) ValidateBasic() error {
 func (mm *MarketMap) GetValidSubset() (MarketMap, error) {
 	validSubset := MarketMap{Markets: make(map[string]Market)}
 
-	// Operates in 2 passes:
-	// 1. Remove invalid ProviderConfigs
 	for ticker, market := range mm.Markets {
-		var validProviderConfigs []ProviderConfig
-		for _, providerConfig := range market.ProviderConfigs {
-			if providerConfig.NormalizeByPair != nil {
-				normalizeMarket, found := mm.Markets[providerConfig.NormalizeByPair.String()]
-				if !found {
-					continue
+		validSubset.Markets[ticker] = market
+	}
+
+	// Operates in 2 passes, which are repeated until no market is removed, as removing a market can
+	// invalidate the markets depending on it:
+	for removed := true; removed; {
+		// 1. Remove invalid ProviderConfigs and synthetic markets with missing components, and break
+		// the dependency cycles.
+		validSubset.removeInvalidDependencies()
+		validSubset.removeDependencyCycles()
+
+		// 2. Remove ValidateBasic failures on all included markets
+		removed = false
+		for ticker, market := range validSubset.Markets {
+			if err := market.ValidateBasic(); err != nil {
+				delete(validSubset.Markets, ticker)
+				removed = true
+				continue
+			}
+			// expect that the ticker (index) is equal to the market.Ticker.String()
+			if ticker != market.Ticker.String() {
+				delete(validSubset.Markets, ticker)
+				removed = true
+				continue
+			}
+		}
+	}
+	if valErr := validSubset.ValidateBasic(); valErr != nil {
+		return validSubset, valErr
+	}
+
+	return validSubset, nil
+}
+
+// removeInvalidDependencies removes the provider configs whose normalization or conversion markets are
+// missing (or disabled while the market is enabled), and the synthetic markets whose components are.
+func (mm *MarketMap) removeInvalidDependencies() {
+	for changed := true; changed; {
+		changed = false
+		for ticker, market := range mm.Markets {
+			if market.IsSynthetic() {
+				for _, cp := range market.SyntheticComponents() {
+					componentMarket, found := mm.Markets[cp]
+					if !found || (!componentMarket.Ticker.Enabled && market.Ticker.Enabled) {
+						delete(mm.Markets, ticker)
+						changed = true
+						break
+					}
 				}
+				continue
+			}
 
-				if !normalizeMarket.Ticker.Enabled && market.Ticker.Enabled {
+			var validProviderConfigs []ProviderConfig
+			for _, providerConfig := range market.ProviderConfigs {
+				if providerConfig.NormalizeByPair != nil {
+					normalizeMarket, found := mm.Markets[providerConfig.NormalizeByPair.String()]
+					if !found {
+						continue
+					}
+
+					if !normalizeMarket.Ticker.Enabled && market.Ticker.Enabled {
+						continue
+					}
+				}
+
+				if !mm.hasConversionMarkets(market, providerConfig) {
 					continue
 				}
+				validProviderConfigs = append(validProviderConfigs, providerConfig)
+			}
+			if len(validProviderConfigs) != len(market.ProviderConfigs) {
+				market.ProviderConfigs = validProviderConfigs
+				mm.Markets[ticker] = market
 			}
-			validProviderConfigs = append(validProviderConfigs, providerConfig)
 		}
-		market.ProviderConfigs = validProviderConfigs
-		validSubset.Markets[ticker] = market
 	}
-	// 2. Remove ValidateBasic failures on all included markets
-	for ticker, market := range validSubset.Markets {
-		if err := market.ValidateBasic(); err != nil {
-			delete(validSubset.Markets, ticker)
-			continue
+}
+
+// removeDependencyCycles breaks the dependency cycles between the markets. A synthetic market on a cycle
+// is removed, otherwise the conversion path provider configs of the market on the cycle are. Removing a
+// synthetic market can invalidate the markets depending on it, so the invalid dependencies are removed
+// again after each cycle is broken.
+func (mm *MarketMap) removeDependencyCycles() {
+	for {
+		ticker, found := mm.dependencyCycle()
+		if !found {
+			return
 		}
-		// expect that the ticker (index) is equal to the market.Ticker.String()
-		if ticker != market.Ticker.String() {
-			delete(validSubset.Markets, ticker)
+
+		market := mm.Markets[ticker]
+		if market.IsSynthetic() {
+			delete(mm.Markets, ticker)
+			mm.removeInvalidDependencies()
 			continue
 		}
+
+		var validProviderConfigs []ProviderConfig
+		for _, providerConfig := range market.ProviderConfigs {
+			if len(providerConfig.ConversionPath) == 0 {
+				validProviderConfigs = append(validProviderConfigs, providerConfig)
+			}
+		}
+		market.ProviderConfigs = validProviderConfigs
+		mm.Markets[ticker] = market
 	}
-	if valErr := validSubset.ValidateBasic(); valErr != nil {
-		return validSubset, valErr
+}
+
+// hasConversionMarkets returns true iff all the markets of the conversion path of the provider config
+// exist, and are enabled if the market is enabled.
+func (mm *MarketMap) hasConversionMarkets(market Market, providerConfig ProviderConfig) bool {
+	for _, pair := range providerConfig.ConversionPath {
+		conversionMarket, found := mm.Markets[pair.String()]
+		if !found {
+			return false
+		}
+
+		if !conversionMarket.Ticker.Enabled && market.Ticker.Enabled {
+			return false
+		}
 	}
 
-	return validSubset, nil
+	return true
 }
 
 // String returns the string representation of the market map.
@@ -100,6 +340,29 @@ func (m *Market) ValidateBasic() error {
 		return err
 	}
 
+	if synthetic := m.GetSynthetic(); synthetic.IsSynthetic() {
+		if len(m.ProviderConfigs) > 0 {
+			return fmt.Errorf("synthetic market %q cannot have provider configs", m.Ticker.String())
+		}
+
+		if err := synthetic.ValidateBasic(m.Ticker.CurrencyPair); err != nil {
+			return fmt.Errorf("invalid synthetic market %q: %w", m.Ticker.String(), err)
+		}
+
+		if synthetic.Type == tickermetadata.SyntheticTypeIndex {
+			index, err := tickermetadata.IndexFromJSONString(m.Ticker.Metadata_JSON)
+			if err != nil {
+				return fmt.Errorf("invalid index market %q: %w", m.Ticker.String(), err)
+			}
+
+			if err := index.ValidateBasic(synthetic); err != nil {
+				return fmt.Errorf("invalid index market %q: %w", m.Ticker.String(), err)
+			}
+		}
+
+		return nil
+	}
+
 	if uint64(len(m.ProviderConfigs)) < m.Ticker.MinProviderCount {
 		return fmt.Errorf(
 			"ticker %q must have at least %d providers; got %d",
@@ -122,11 +385,53 @@ func (m *Market) ValidateBasic() error {
 		}
 		seenProviders[key] = struct{}{}
 
+		// the conversion path must convert to the quote of the ticker
+		if n := len(providerConfig.ConversionPath); n > 0 && providerConfig.ConversionPath[n-1].Quote != m.Ticker.CurrencyPair.Quote {
+			return fmt.Errorf(
+				"conversion path of provider %s for ticker %q must end in quote %s",
+				providerConfig.Name,
+				m.Ticker.String(),
+				m.Ticker.CurrencyPair.Quote,
+			)
+		}
 	}
 
 	return nil
 }
 
+// GetSynthetic returns the synthetic configuration of the market, read from its ticker metadata. Ticker
+// metadata that cannot be decoded is treated as a non-synthetic configuration.
+func (m *Market) GetSynthetic() tickermetadata.Synthetic {
+	synthetic, err := tickermetadata.SyntheticFromJSONString(m.Ticker.Metadata_JSON)
+	if err != nil {
+		return tickermetadata.Synthetic{}
+	}
+
+	return synthetic
+}
+
+// IsSynthetic returns true iff the market is a synthetic market, i.e. its price is derived from the
+// index prices of other markets instead of being fetched by providers.
+func (m *Market) IsSynthetic() bool {
+	return m.GetSynthetic().IsSynthetic()
+}
+
+// SyntheticComponents returns the tickers of the component markets of a synthetic market, or nil if
+// the market is not synthetic.
+func (m *Market) SyntheticComponents() []string {
+	synthetic := m.GetSynthetic()
+	if !synthetic.IsSynthetic() {
+		return nil
+	}
+
+	tickers := make([]string, len(synthetic.Components))
+	for i, component := range synthetic.Components {
+		tickers[i] = component.CurrencyPair
+	}
+
+	return tickers
+}
+
 // String returns the string representation of the market.
 func (m *Market) String() string {
 	return fmt.Sprintf(
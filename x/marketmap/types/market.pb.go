@@ -183,6 +183,19 @@ type ProviderConfig struct {
 	// Invert is a boolean indicating if the BASE and QUOTE of the market should
 	// be inverted. i.e. BASE -> QUOTE, QUOTE -> BASE
 	Invert bool `protobuf:"varint,4,opt,name=invert,proto3" json:"invert,omitempty"`
+	// ConversionPath is the ordered list of currency pairs for this ticker to be
+	// converted through. The price of the OffChainTicker is multiplied by the
+	// index price of each pair in order. For example, if the desired Ticker is
+	// FOO/USD, this market could be reached using: OffChainTicker = FOO/ETH
+	// ConversionPath = [ETH/BTC, BTC/USD]. This field is optional, and cannot be
+	// set together with NormalizeByPair.
+	ConversionPath []types.CurrencyPair `protobuf:"bytes,5,rep,name=conversion_path,json=conversionPath,proto3" json:"conversion_path"`
+	// RedemptionRate is the (optional) redemption rate feed of a liquid staking
+	// token. If set, the price of the OffChainTicker, which is the price of the
+	// underlying asset, is multiplied by the redemption rate i.e. the price of
+	// stETH/USD is reached using: OffChainTicker = ETH/USD RedemptionRate =
+	// stETH -> ETH exchange rate.
+	RedemptionRate *RedemptionRate `protobuf:"bytes,6,opt,name=redemption_rate,json=redemptionRate,proto3" json:"redemption_rate,omitempty"`
 	// MetadataJSON is a string of JSON that encodes any extra configuration
 	// for the given provider config.
 	Metadata_JSON string `protobuf:"bytes,15,opt,name=metadata_JSON,json=metadataJSON,proto3" json:"metadata_JSON,omitempty"`
@@ -249,6 +262,20 @@ func (m *ProviderConfig) GetInvert() bool {
 	return false
 }
 
+func (m *ProviderConfig) GetConversionPath() []types.CurrencyPair {
+	if m != nil {
+		return m.ConversionPath
+	}
+	return nil
+}
+
+func (m *ProviderConfig) GetRedemptionRate() *RedemptionRate {
+	if m != nil {
+		return m.RedemptionRate
+	}
+	return nil
+}
+
 func (m *ProviderConfig) GetMetadata_JSON() string {
 	if m != nil {
 		return m.Metadata_JSON
@@ -256,6 +283,77 @@ func (m *ProviderConfig) GetMetadata_JSON() string {
 	return ""
 }
 
+// RedemptionRate is the redemption rate feed of a liquid staking token, i.e.
+// the amount of the underlying asset that one unit of the token can be redeemed
+// for.
+type RedemptionRate struct {
+	// Rate is the provider config of the on-chain feed that reports the
+	// redemption rate i.e. an ERC-4626 vault or a Cosmos LST host zone.
+	Rate ProviderConfig `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate"`
+	// MarketRate is the (optional) provider config of the feed that reports the
+	// market price of the token in units of the underlying asset i.e. a DEX pool.
+	// If set, it is used as a sanity check of the redemption rate.
+	MarketRate *ProviderConfig `protobuf:"bytes,2,opt,name=market_rate,json=marketRate,proto3" json:"market_rate,omitempty"`
+	// MaxDeviation is the maximum relative deviation, as a decimal string i.e.
+	// "0.05", of the market rate from the redemption rate. It must be set iff
+	// MarketRate is set.
+	MaxDeviation string `protobuf:"bytes,3,opt,name=max_deviation,json=maxDeviation,proto3" json:"max_deviation,omitempty"`
+}
+
+func (m *RedemptionRate) Reset()         { *m = RedemptionRate{} }
+func (m *RedemptionRate) String() string { return proto.CompactTextString(m) }
+func (*RedemptionRate) ProtoMessage()    {}
+func (*RedemptionRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fefe265720fc8a78, []int{3}
+}
+func (m *RedemptionRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedemptionRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedemptionRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedemptionRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedemptionRate.Merge(m, src)
+}
+func (m *RedemptionRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedemptionRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedemptionRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedemptionRate proto.InternalMessageInfo
+
+func (m *RedemptionRate) GetRate() ProviderConfig {
+	if m != nil {
+		return m.Rate
+	}
+	return ProviderConfig{}
+}
+
+func (m *RedemptionRate) GetMarketRate() *ProviderConfig {
+	if m != nil {
+		return m.MarketRate
+	}
+	return nil
+}
+
+func (m *RedemptionRate) GetMaxDeviation() string {
+	if m != nil {
+		return m.MaxDeviation
+	}
+	return ""
+}
+
 // MarketMap maps ticker strings to their Markets.
 type MarketMap struct {
 	// Markets is the full list of tickers and their associated configurations
@@ -266,7 +364,7 @@ type MarketMap struct {
 func (m *MarketMap) Reset()      { *m = MarketMap{} }
 func (*MarketMap) ProtoMessage() {}
 func (*MarketMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fefe265720fc8a78, []int{3}
+	return fileDescriptor_fefe265720fc8a78, []int{4}
 }
 func (m *MarketMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -306,6 +404,7 @@ func init() {
 	proto.RegisterType((*Market)(nil), "slinky.marketmap.v1.Market")
 	proto.RegisterType((*Ticker)(nil), "slinky.marketmap.v1.Ticker")
 	proto.RegisterType((*ProviderConfig)(nil), "slinky.marketmap.v1.ProviderConfig")
+	proto.RegisterType((*RedemptionRate)(nil), "slinky.marketmap.v1.RedemptionRate")
 	proto.RegisterType((*MarketMap)(nil), "slinky.marketmap.v1.MarketMap")
 	proto.RegisterMapType((map[string]Market)(nil), "slinky.marketmap.v1.MarketMap.MarketsEntry")
 }
@@ -313,42 +412,48 @@ func init() {
 func init() { proto.RegisterFile("slinky/marketmap/v1/market.proto", fileDescriptor_fefe265720fc8a78) }
 
 var fileDescriptor_fefe265720fc8a78 = []byte{
-	// 551 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x41, 0x6b, 0x13, 0x4f,
-	0x14, 0xdf, 0x49, 0xf2, 0x4f, 0x93, 0x69, 0x9a, 0xe4, 0x3f, 0x8a, 0x2c, 0x11, 0xb7, 0x4b, 0x72,
-	0x59, 0x50, 0x36, 0xa4, 0x5e, 0xb4, 0xc7, 0x04, 0x41, 0x2d, 0xd5, 0xb2, 0x16, 0x04, 0x2f, 0xcb,
-	0x64, 0x33, 0x49, 0x87, 0xec, 0xce, 0x2c, 0xb3, 0xb3, 0xa1, 0xe9, 0xc9, 0x8f, 0xe0, 0xd1, 0xa3,
-	0xe0, 0xc7, 0xf0, 0x0b, 0xf4, 0xd8, 0x93, 0x78, 0x10, 0x91, 0x04, 0xbf, 0x87, 0xec, 0xec, 0x24,
-	0x4d, 0x20, 0x42, 0x6f, 0xef, 0xbd, 0xf9, 0xcd, 0xef, 0xcd, 0xef, 0x37, 0xef, 0x41, 0x3b, 0x09,
-	0x29, 0x9b, 0xce, 0xbb, 0x11, 0x16, 0x53, 0x22, 0x23, 0x1c, 0x77, 0x67, 0x3d, 0x9d, 0xb8, 0xb1,
-	0xe0, 0x92, 0xa3, 0x7b, 0x39, 0xc2, 0x5d, 0x23, 0xdc, 0x59, 0xaf, 0x75, 0x7f, 0xc2, 0x27, 0x5c,
-	0x9d, 0x77, 0xb3, 0x28, 0x87, 0xb6, 0x3a, 0x9a, 0x4c, 0xce, 0x63, 0x92, 0x64, 0x44, 0x41, 0x2a,
-	0x04, 0x61, 0xc1, 0xdc, 0x8f, 0x31, 0x15, 0x39, 0xa8, 0xfd, 0x15, 0xc0, 0xf2, 0xa9, 0xe2, 0x42,
-	0xcf, 0x61, 0x59, 0xd2, 0x60, 0x4a, 0x84, 0x09, 0x6c, 0xe0, 0xec, 0x1f, 0x3d, 0x74, 0x77, 0xf4,
-	0x72, 0xcf, 0x15, 0xa4, 0x5f, 0xba, 0xfe, 0x75, 0x68, 0x78, 0xfa, 0x02, 0x3a, 0x87, 0xcd, 0x58,
-	0xf0, 0x19, 0x1d, 0x11, 0xe1, 0x07, 0x9c, 0x8d, 0xe9, 0x24, 0x31, 0x0b, 0x76, 0xd1, 0xd9, 0x3f,
-	0xea, 0xec, 0x24, 0x39, 0xd3, 0xe0, 0x81, 0xc2, 0x6a, 0xb2, 0x46, 0xbc, 0x55, 0x4d, 0x8e, 0x2b,
-	0x9f, 0xbf, 0x1c, 0x1a, 0x1f, 0x7f, 0xda, 0x46, 0xfb, 0x0f, 0x80, 0xe5, 0xbc, 0x31, 0x7a, 0x09,
-	0x0f, 0xb6, 0x74, 0xe8, 0xc7, 0x3e, 0x5a, 0xf5, 0x51, 0x6a, 0xb3, 0x1e, 0x03, 0x8d, 0x3a, 0xc3,
-	0x74, 0xf5, 0xdc, 0x5a, 0xb0, 0x51, 0x43, 0x2d, 0x58, 0x19, 0x91, 0x80, 0x46, 0x38, 0xcc, 0x1e,
-	0x0b, 0x9c, 0x92, 0xb7, 0xce, 0xd1, 0x13, 0x88, 0x22, 0xca, 0xfc, 0x0d, 0x51, 0x29, 0x93, 0x66,
-	0x51, 0xa1, 0x9a, 0x11, 0x65, 0xb7, 0x02, 0x52, 0x26, 0x91, 0x09, 0xf7, 0x08, 0xc3, 0xc3, 0x90,
-	0x8c, 0xcc, 0xba, 0x0d, 0x9c, 0x8a, 0xb7, 0x4a, 0x51, 0x07, 0x1e, 0x44, 0x44, 0xe2, 0x11, 0x96,
-	0xd8, 0x7f, 0xfd, 0xee, 0xed, 0x1b, 0xb3, 0x61, 0x03, 0xa7, 0xea, 0xd5, 0x56, 0xc5, 0xac, 0xb6,
-	0xa1, 0xf3, 0x3b, 0x80, 0xf5, 0x6d, 0x6f, 0x10, 0x82, 0x25, 0x86, 0x23, 0xa2, 0x64, 0x56, 0x3d,
-	0x15, 0x23, 0x07, 0x36, 0xf9, 0x78, 0xec, 0x07, 0x17, 0x98, 0x32, 0x5f, 0xff, 0x59, 0x41, 0x9d,
-	0xd7, 0xf9, 0x78, 0x3c, 0xc8, 0xca, 0xda, 0xad, 0x57, 0xf0, 0x7f, 0xc6, 0x45, 0x84, 0x43, 0x7a,
-	0x45, 0xfc, 0xa1, 0x76, 0xac, 0x78, 0x07, 0xc7, 0xbc, 0xc6, 0xfa, 0x5e, 0x3f, 0xb7, 0xeb, 0x01,
-	0x2c, 0x53, 0x36, 0x23, 0x42, 0x9a, 0x25, 0xa5, 0x51, 0x67, 0x77, 0x92, 0xd8, 0xfe, 0x06, 0x60,
-	0x35, 0x1f, 0xb3, 0x53, 0x1c, 0xa3, 0x13, 0xb8, 0x97, 0x8f, 0x43, 0x62, 0x02, 0x35, 0x25, 0x8f,
-	0x77, 0x4e, 0xc9, 0xfa, 0x82, 0x8e, 0x92, 0x17, 0x4c, 0x8a, 0xb9, 0xfe, 0xcb, 0x15, 0x43, 0xeb,
-	0x3d, 0xac, 0x6d, 0x1e, 0xa3, 0x26, 0x2c, 0x4e, 0xc9, 0x5c, 0xfb, 0x95, 0x85, 0xa8, 0x07, 0xff,
-	0x9b, 0xe1, 0x30, 0x25, 0xca, 0xa3, 0x7f, 0xcd, 0x75, 0xce, 0xe1, 0xe5, 0xc8, 0xe3, 0xc2, 0x33,
-	0x70, 0xfb, 0x2d, 0xfd, 0x93, 0xeb, 0x85, 0x05, 0x6e, 0x16, 0x16, 0xf8, 0xbd, 0xb0, 0xc0, 0xa7,
-	0xa5, 0x65, 0xdc, 0x2c, 0x2d, 0xe3, 0xc7, 0xd2, 0x32, 0x3e, 0xf4, 0x26, 0x54, 0x5e, 0xa4, 0x43,
-	0x37, 0xe0, 0x51, 0xf7, 0x8a, 0x4f, 0xd2, 0x4b, 0xb5, 0x55, 0x01, 0x0f, 0xbb, 0x7a, 0xf9, 0x2e,
-	0x37, 0x76, 0x59, 0x19, 0x3d, 0x2c, 0x2b, 0xc8, 0xd3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x87,
-	0x15, 0xa3, 0x7f, 0xec, 0x03, 0x00, 0x00,
+	// 652 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0xce, 0x26, 0x69, 0xda, 0x4e, 0xdb, 0x24, 0xff, 0xfe, 0x08, 0x59, 0xa9, 0x48, 0xad, 0xf4,
+	0x12, 0x09, 0x94, 0x28, 0xe5, 0x02, 0x95, 0xb8, 0xb4, 0x45, 0x02, 0x4a, 0xa1, 0x32, 0x95, 0x90,
+	0xb8, 0x58, 0x1b, 0x67, 0x93, 0xac, 0x12, 0xef, 0x5a, 0xeb, 0x4d, 0x94, 0xf4, 0xc4, 0x23, 0x70,
+	0xe4, 0x88, 0xc4, 0x5b, 0xc0, 0x0b, 0xf4, 0xd8, 0x23, 0x07, 0x84, 0x50, 0x2b, 0x5e, 0x80, 0x27,
+	0x40, 0x5e, 0xaf, 0xdd, 0x44, 0x0a, 0x25, 0xb7, 0x9d, 0xd9, 0x6f, 0xbe, 0x6f, 0xbe, 0xf1, 0x78,
+	0xc1, 0x0e, 0x87, 0x8c, 0x0f, 0xa6, 0x4d, 0x9f, 0xc8, 0x01, 0x55, 0x3e, 0x09, 0x9a, 0xe3, 0x96,
+	0x09, 0x1a, 0x81, 0x14, 0x4a, 0xe0, 0xff, 0x63, 0x44, 0x23, 0x45, 0x34, 0xc6, 0xad, 0xca, 0x9d,
+	0x9e, 0xe8, 0x09, 0x7d, 0xdf, 0x8c, 0x4e, 0x31, 0xb4, 0xb2, 0x6b, 0xc8, 0xd4, 0x34, 0xa0, 0x61,
+	0x44, 0xe4, 0x8d, 0xa4, 0xa4, 0xdc, 0x9b, 0xba, 0x01, 0x61, 0x32, 0x06, 0xd5, 0x3e, 0x23, 0x28,
+	0x9c, 0x68, 0x2e, 0xfc, 0x18, 0x0a, 0x8a, 0x79, 0x03, 0x2a, 0x2d, 0x64, 0xa3, 0xfa, 0xc6, 0xde,
+	0x76, 0x63, 0x81, 0x56, 0xe3, 0x4c, 0x43, 0x0e, 0xf2, 0x17, 0x3f, 0x76, 0x32, 0x8e, 0x29, 0xc0,
+	0x67, 0x50, 0x0e, 0xa4, 0x18, 0xb3, 0x0e, 0x95, 0xae, 0x27, 0x78, 0x97, 0xf5, 0x42, 0x2b, 0x6b,
+	0xe7, 0xea, 0x1b, 0x7b, 0xbb, 0x0b, 0x49, 0x4e, 0x0d, 0xf8, 0x50, 0x63, 0x0d, 0x59, 0x29, 0x98,
+	0xcb, 0x86, 0xfb, 0x6b, 0x1f, 0x3f, 0xed, 0x64, 0xde, 0x7f, 0xb7, 0x33, 0xb5, 0x5f, 0x08, 0x0a,
+	0xb1, 0x30, 0x7e, 0x06, 0x5b, 0x73, 0x3e, 0x4c, 0xb3, 0xf7, 0x12, 0x1d, 0xed, 0x36, 0xd2, 0x38,
+	0x34, 0xa8, 0x53, 0xc2, 0x92, 0x76, 0x37, 0xbd, 0x99, 0x1c, 0xae, 0xc0, 0x5a, 0x87, 0x7a, 0xcc,
+	0x27, 0xc3, 0xa8, 0x59, 0x54, 0xcf, 0x3b, 0x69, 0x8c, 0x1f, 0x00, 0xf6, 0x19, 0x77, 0x67, 0x4c,
+	0x8d, 0xb8, 0xb2, 0x72, 0x1a, 0x55, 0xf6, 0x19, 0xbf, 0x31, 0x30, 0xe2, 0x0a, 0x5b, 0xb0, 0x4a,
+	0x39, 0x69, 0x0f, 0x69, 0xc7, 0x2a, 0xda, 0xa8, 0xbe, 0xe6, 0x24, 0x21, 0xde, 0x85, 0x2d, 0x9f,
+	0x2a, 0xd2, 0x21, 0x8a, 0xb8, 0x2f, 0xde, 0xbc, 0x7e, 0x65, 0x95, 0x6c, 0x54, 0x5f, 0x77, 0x36,
+	0x93, 0x64, 0x94, 0x9b, 0xf1, 0xf9, 0x3b, 0x0b, 0xc5, 0xf9, 0xd9, 0x60, 0x0c, 0x79, 0x4e, 0x7c,
+	0xaa, 0x6d, 0xae, 0x3b, 0xfa, 0x8c, 0xeb, 0x50, 0x16, 0xdd, 0xae, 0xeb, 0xf5, 0x09, 0xe3, 0xae,
+	0xf9, 0x66, 0x59, 0x7d, 0x5f, 0x14, 0xdd, 0xee, 0x61, 0x94, 0x36, 0xd3, 0x7a, 0x0e, 0xff, 0x71,
+	0x21, 0x7d, 0x32, 0x64, 0xe7, 0xd4, 0x6d, 0x9b, 0x89, 0xe5, 0x96, 0x98, 0x98, 0x53, 0x4a, 0xeb,
+	0x0e, 0xe2, 0x71, 0xdd, 0x85, 0x02, 0xe3, 0x63, 0x2a, 0x95, 0x95, 0xd7, 0x1e, 0x4d, 0x84, 0x5f,
+	0x42, 0xc9, 0x13, 0xd1, 0x31, 0x64, 0x82, 0xbb, 0x01, 0x51, 0x7d, 0x6b, 0xc5, 0xce, 0xfd, 0x53,
+	0xc0, 0x7c, 0x92, 0xe2, 0x4d, 0xed, 0x29, 0x51, 0xfd, 0x88, 0x4d, 0xd2, 0x0e, 0xf5, 0x03, 0x15,
+	0xb1, 0x49, 0xa2, 0xa8, 0x55, 0xb0, 0xd1, 0x5f, 0x17, 0xc9, 0x49, 0xb1, 0x0e, 0x51, 0xd4, 0x29,
+	0xca, 0xb9, 0x78, 0xa9, 0xf1, 0xd7, 0xbe, 0x20, 0x28, 0xce, 0xf3, 0xe0, 0x27, 0x90, 0xd7, 0xd2,
+	0xe8, 0x16, 0xe9, 0x85, 0x3b, 0xac, 0xcb, 0xf0, 0x11, 0x6c, 0xc4, 0xd0, 0xd8, 0x40, 0x76, 0x69,
+	0x16, 0x07, 0xe2, 0xcb, 0xb4, 0x79, 0x32, 0x71, 0x3b, 0x74, 0xcc, 0x48, 0xd4, 0x99, 0x95, 0x33,
+	0xcd, 0x93, 0xc9, 0x51, 0x92, 0xab, 0x7d, 0x45, 0xb0, 0x1e, 0xff, 0xbf, 0x27, 0x24, 0xc0, 0xc7,
+	0xb0, 0x1a, 0x13, 0x84, 0x16, 0xd2, 0xdf, 0xe0, 0xfe, 0x42, 0xd1, 0xb4, 0xc0, 0x9c, 0xc2, 0xa7,
+	0x5c, 0xc9, 0xa9, 0xb1, 0x90, 0x30, 0x54, 0xde, 0xc2, 0xe6, 0xec, 0x35, 0x2e, 0x43, 0x6e, 0x40,
+	0xa7, 0x66, 0x11, 0xa3, 0x23, 0x6e, 0xc1, 0xca, 0x98, 0x0c, 0x47, 0x89, 0xc3, 0xed, 0x5b, 0xc4,
+	0x9c, 0x18, 0xb9, 0x9f, 0x7d, 0x84, 0x6e, 0xf6, 0xfd, 0xe0, 0xf8, 0xe2, 0xaa, 0x8a, 0x2e, 0xaf,
+	0xaa, 0xe8, 0xe7, 0x55, 0x15, 0x7d, 0xb8, 0xae, 0x66, 0x2e, 0xaf, 0xab, 0x99, 0x6f, 0xd7, 0xd5,
+	0xcc, 0xbb, 0x56, 0x8f, 0xa9, 0xfe, 0xa8, 0xdd, 0xf0, 0x84, 0xdf, 0x3c, 0x17, 0xbd, 0xd1, 0x44,
+	0x3f, 0x57, 0x9e, 0x18, 0x36, 0xcd, 0xab, 0x36, 0x99, 0x79, 0x24, 0xf5, 0x82, 0xb5, 0x0b, 0x1a,
+	0xf2, 0xf0, 0xcf, 0x00, 0xfa, 0x8a, 0x6f, 0xe0, 0x45, 0x05, 0x00, 0x00,
 }
 
 func (m *Market) Marshal() (dAtA []byte, err error) {
@@ -485,6 +590,32 @@ func (m *ProviderConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x7a
 	}
+	if m.RedemptionRate != nil {
+		{
+			size, err := m.RedemptionRate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMarket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ConversionPath) > 0 {
+		for iNdEx := len(m.ConversionPath) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConversionPath[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.Invert {
 		i--
 		if m.Invert {
@@ -524,6 +655,58 @@ func (m *ProviderConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RedemptionRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedemptionRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedemptionRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxDeviation) > 0 {
+		i -= len(m.MaxDeviation)
+		copy(dAtA[i:], m.MaxDeviation)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.MaxDeviation)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MarketRate != nil {
+		{
+			size, err := m.MarketRate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMarket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Rate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MarketMap) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -644,6 +827,16 @@ func (m *ProviderConfig) Size() (n int) {
 	if m.Invert {
 		n += 2
 	}
+	if len(m.ConversionPath) > 0 {
+		for _, e := range m.ConversionPath {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	if m.RedemptionRate != nil {
+		l = m.RedemptionRate.Size()
+		n += 1 + l + sovMarket(uint64(l))
+	}
 	l = len(m.Metadata_JSON)
 	if l > 0 {
 		n += 1 + l + sovMarket(uint64(l))
@@ -651,6 +844,25 @@ func (m *ProviderConfig) Size() (n int) {
 	return n
 }
 
+func (m *RedemptionRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rate.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	if m.MarketRate != nil {
+		l = m.MarketRate.Size()
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.MaxDeviation)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	return n
+}
+
 func (m *MarketMap) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1114,6 +1326,76 @@ func (m *ProviderConfig) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Invert = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionPath", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConversionPath = append(m.ConversionPath, types.CurrencyPair{})
+			if err := m.ConversionPath[len(m.ConversionPath)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RedemptionRate == nil {
+				m.RedemptionRate = &RedemptionRate{}
+			}
+			if err := m.RedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 15:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata_JSON", wireType)
@@ -1167,6 +1449,157 @@ func (m *ProviderConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RedemptionRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedemptionRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedemptionRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MarketRate == nil {
+				m.MarketRate = &ProviderConfig{}
+			}
+			if err := m.MarketRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxDeviation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MarketMap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -268,6 +268,221 @@ var (
 		usdtusd.Ticker.String():         usdtusd,
 		usdcusdDisabled.Ticker.String(): usdcusdDisabled,
 	}
+
+	ethbtcCP = slinkytypes.NewCurrencyPair("ETHEREUM", "BTC")
+
+	ethbtc = types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair:     ethbtcCP,
+			Decimals:         8,
+			MinProviderCount: 1,
+			Enabled:          true,
+		},
+		ProviderConfigs: []types.ProviderConfig{
+			{
+				Name:           "kucoin",
+				OffChainTicker: "eth-btc",
+			},
+		},
+	}
+
+	ethbtcDisabled = types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair:     ethbtcCP,
+			Decimals:         8,
+			MinProviderCount: 1,
+			Enabled:          false,
+		},
+		ProviderConfigs: []types.ProviderConfig{
+			{
+				Name:           "kucoin",
+				OffChainTicker: "eth-btc",
+			},
+		},
+	}
+
+	// PEPE/ETHEREUM * ETHEREUM/BTC * BTC/USD = PEPE/USD
+	pepeusdViaETH = types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair:     slinkytypes.NewCurrencyPair("PEPE", "USD"),
+			Decimals:         18,
+			MinProviderCount: 1,
+			Enabled:          true,
+		},
+		ProviderConfigs: []types.ProviderConfig{
+			{
+				Name:           "uniswap",
+				OffChainTicker: "pepe-eth",
+				ConversionPath: []slinkytypes.CurrencyPair{ethbtcCP, btcusd.Ticker.CurrencyPair},
+			},
+		},
+	}
+
+	conversionPathMarkets = map[string]types.Market{
+		btcusd.Ticker.String():        btcusd,
+		usdtusd.Ticker.String():       usdtusd,
+		ethbtc.Ticker.String():        ethbtc,
+		pepeusdViaETH.Ticker.String(): pepeusdViaETH,
+	}
+
+	// BTC/USD and ETHEREUM/USD are converted by each other's index prices.
+	btcusdDirect = types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair:     slinkytypes.NewCurrencyPair("BTC", "USD"),
+			Decimals:         8,
+			MinProviderCount: 1,
+			Enabled:          true,
+		},
+		ProviderConfigs: []types.ProviderConfig{
+			{
+				Name:           coinbase.Name,
+				OffChainTicker: "BTC-USD",
+			},
+		},
+	}
+
+	btcusdViaETH = types.Market{
+		Ticker: btcusdDirect.Ticker,
+		ProviderConfigs: []types.ProviderConfig{
+			btcusdDirect.ProviderConfigs[0],
+			{
+				Name:           "kucoin",
+				OffChainTicker: "btc-eth",
+				ConversionPath: []slinkytypes.CurrencyPair{slinkytypes.NewCurrencyPair("ETHEREUM", "USD")},
+			},
+		},
+	}
+
+	ethusdViaBTC = types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair:     slinkytypes.NewCurrencyPair("ETHEREUM", "USD"),
+			Decimals:         8,
+			MinProviderCount: 1,
+			Enabled:          true,
+		},
+		ProviderConfigs: []types.ProviderConfig{
+			{
+				Name:           "kucoin",
+				OffChainTicker: "eth-btc",
+				ConversionPath: []slinkytypes.CurrencyPair{btcusdDirect.Ticker.CurrencyPair},
+			},
+		},
+	}
+
+	btcusdNormalizedByETH = types.Market{
+		Ticker: btcusdDirect.Ticker,
+		ProviderConfigs: []types.ProviderConfig{
+			btcusdDirect.ProviderConfigs[0],
+			{
+				Name:            "kucoin",
+				OffChainTicker:  "btc-eth",
+				NormalizeByPair: &ethusdViaBTC.Ticker.CurrencyPair,
+			},
+		},
+	}
+
+	// BTC/USD is normalized by ETHEREUM/USD, whose conversion path goes through BTC/USD.
+	mixedCyclicMarkets = map[string]types.Market{
+		btcusdNormalizedByETH.Ticker.String(): btcusdNormalizedByETH,
+		ethusdViaBTC.Ticker.String():          ethusdViaBTC,
+	}
+
+	ethusdNormalizedByBTC = types.Market{
+		Ticker: ethusdViaBTC.Ticker,
+		ProviderConfigs: []types.ProviderConfig{
+			{
+				Name:            "kucoin",
+				OffChainTicker:  "eth-btc",
+				NormalizeByPair: &btcusdDirect.Ticker.CurrencyPair,
+			},
+		},
+	}
+
+	// BTC/USD and ETHEREUM/USD are normalized by each other.
+	normalizedCyclicMarkets = map[string]types.Market{
+		btcusdNormalizedByETH.Ticker.String(): btcusdNormalizedByETH,
+		ethusdNormalizedByBTC.Ticker.String(): ethusdNormalizedByBTC,
+	}
+
+	cyclicMarkets = map[string]types.Market{
+		btcusdViaETH.Ticker.String(): btcusdViaETH,
+		ethusdViaBTC.Ticker.String(): ethusdViaBTC,
+	}
+
+	// The conversion path provider configs of a market on the cycle are removed.
+	cyclicValidSubset = map[string]types.Market{
+		btcusdDirect.Ticker.String(): btcusdDirect,
+		ethusdViaBTC.Ticker.String(): ethusdViaBTC,
+	}
+
+	// ETHEREUM/BTC = ETHEREUM/USD * (BTC/USD)^-1
+	ethbtcSynthetic = types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair:     ethbtcCP,
+			Decimals:         8,
+			MinProviderCount: 1,
+			Enabled:          true,
+			Metadata_JSON:    `{"synthetic_type":"cross","synthetic_components":[{"currency_pair":"ETHEREUM/USD"},{"currency_pair":"BTC/USD","invert":true}]}`,
+		},
+	}
+
+	// 1 ETHEREUM + 0.05 BTC in USD
+	basketSynthetic = types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair:     slinkytypes.NewCurrencyPair("BASKET", "USD"),
+			Decimals:         8,
+			MinProviderCount: 1,
+			Enabled:          true,
+			Metadata_JSON:    `{"synthetic_type":"basket","synthetic_components":[{"currency_pair":"ETHEREUM/USD","quantity":"1"},{"currency_pair":"BTC/USD","quantity":"0.05"}]}`,
+		},
+	}
+
+	// (2 ETHEREUM + 0.1 BTC in USD) / 10, rebalanced to (0.2 BTC in USD) / 5
+	indexSynthetic = types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair:     slinkytypes.NewCurrencyPair("INDEX", "USD"),
+			Decimals:         8,
+			MinProviderCount: 1,
+			Enabled:          true,
+			Metadata_JSON: `{"synthetic_type":"index","synthetic_components":[{"currency_pair":"ETHEREUM/USD"},{"currency_pair":"BTC/USD"}],` +
+				`"index_rebalances":[{"timestamp":100,"weights":[{"currency_pair":"ETHEREUM/USD","weight":"2"},{"currency_pair":"BTC/USD","weight":"0.1"}],"divisor":"10"},` +
+				`{"timestamp":200,"weights":[{"currency_pair":"BTC/USD","weight":"0.2"}],"divisor":"5"}]}`,
+		},
+	}
+
+	syntheticMarkets = map[string]types.Market{
+		btcusd.Ticker.String():          btcusd,
+		usdtusd.Ticker.String():         usdtusd,
+		ethusd.Ticker.String():          ethusd,
+		ethbtcSynthetic.Ticker.String(): ethbtcSynthetic,
+		basketSynthetic.Ticker.String(): basketSynthetic,
+		indexSynthetic.Ticker.String():  indexSynthetic,
+	}
+
+	// BTC/USD is converted by the index price of ETHEREUM/BTC, which is derived from BTC/USD.
+	btcusdViaSynthetic = types.Market{
+		Ticker: btcusdDirect.Ticker,
+		ProviderConfigs: []types.ProviderConfig{
+			btcusdDirect.ProviderConfigs[0],
+			{
+				Name:           "kucoin",
+				OffChainTicker: "btc-eth",
+				ConversionPath: []slinkytypes.CurrencyPair{
+					slinkytypes.NewCurrencyPair("BTC", "ETHEREUM"),
+				},
+			},
+		},
+	}
+
+	btcethSynthetic = types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair:     slinkytypes.NewCurrencyPair("BTC", "ETHEREUM"),
+			Decimals:         8,
+			MinProviderCount: 1,
+			Enabled:          true,
+			Metadata_JSON:    `{"synthetic_type":"cross","synthetic_components":[{"currency_pair":"ETHEREUM/BTC","invert":true}]}`,
+		},
+	}
 )
 
 func TestMarketMapGetValidSubset(t *testing.T) {
@@ -455,6 +670,73 @@ func TestMarketMapGetValidSubset(t *testing.T) {
 			marketMap:   types.MarketMap{Markets: partiallyValidMarkets2},
 			validSubset: types.MarketMap{Markets: validSubset2},
 		},
+		{
+			name:        "valid conversion path",
+			marketMap:   types.MarketMap{Markets: conversionPathMarkets},
+			validSubset: types.MarketMap{Markets: conversionPathMarkets},
+		},
+		{
+			name: "missing conversion market, remove entire market",
+			marketMap: types.MarketMap{Markets: map[string]types.Market{
+				btcusd.Ticker.String():        btcusd,
+				usdtusd.Ticker.String():       usdtusd,
+				pepeusdViaETH.Ticker.String(): pepeusdViaETH,
+			}},
+			validSubset: types.MarketMap{Markets: map[string]types.Market{
+				btcusd.Ticker.String():  btcusd,
+				usdtusd.Ticker.String(): usdtusd,
+			}},
+		},
+		{
+			name:        "cyclic conversion paths, only remove provider config",
+			marketMap:   types.MarketMap{Markets: cyclicMarkets},
+			validSubset: types.MarketMap{Markets: cyclicValidSubset},
+		},
+		{
+			// The conversion path provider config of ETHEREUM/USD is removed, which leaves it without providers.
+			name:      "normalization pair and conversion path on a cycle, remove conversion market",
+			marketMap: types.MarketMap{Markets: mixedCyclicMarkets},
+			validSubset: types.MarketMap{Markets: map[string]types.Market{
+				btcusdDirect.Ticker.String(): btcusdDirect,
+			}},
+		},
+		{
+			name:        "normalization pairs on a cycle",
+			marketMap:   types.MarketMap{Markets: normalizedCyclicMarkets},
+			validSubset: types.MarketMap{Markets: normalizedCyclicMarkets},
+		},
+		{
+			name:        "valid synthetic markets",
+			marketMap:   types.MarketMap{Markets: syntheticMarkets},
+			validSubset: types.MarketMap{Markets: syntheticMarkets},
+		},
+		{
+			name: "missing synthetic component, remove synthetic markets depending on it",
+			marketMap: types.MarketMap{Markets: map[string]types.Market{
+				btcusdDirect.Ticker.String():    btcusdDirect,
+				ethbtcSynthetic.Ticker.String(): ethbtcSynthetic,
+				btcethSynthetic.Ticker.String(): btcethSynthetic,
+			}},
+			validSubset: types.MarketMap{Markets: map[string]types.Market{
+				btcusdDirect.Ticker.String(): btcusdDirect,
+			}},
+		},
+		{
+			name: "synthetic market on a cycle, remove synthetic market",
+			marketMap: types.MarketMap{Markets: map[string]types.Market{
+				btcusdViaSynthetic.Ticker.String(): btcusdViaSynthetic,
+				usdtusd.Ticker.String():            usdtusd,
+				ethusd.Ticker.String():             ethusd,
+				ethbtcSynthetic.Ticker.String():    ethbtcSynthetic,
+				btcethSynthetic.Ticker.String():    btcethSynthetic,
+			}},
+			validSubset: types.MarketMap{Markets: map[string]types.Market{
+				btcusdDirect.Ticker.String():    btcusdDirect,
+				usdtusd.Ticker.String():         usdtusd,
+				ethusd.Ticker.String():          ethusd,
+				ethbtcSynthetic.Ticker.String(): ethbtcSynthetic,
+			}},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -491,6 +773,202 @@ func TestMarketMapValidateBasic(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "valid conversion path",
+			marketMap: types.MarketMap{
+				Markets: conversionPathMarkets,
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid missing conversion market",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					btcusd.Ticker.String():        btcusd,
+					usdtusd.Ticker.String():       usdtusd,
+					pepeusdViaETH.Ticker.String(): pepeusdViaETH,
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid disabled conversion market",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					btcusd.Ticker.String():         btcusd,
+					usdtusd.Ticker.String():        usdtusd,
+					ethbtcDisabled.Ticker.String(): ethbtcDisabled,
+					pepeusdViaETH.Ticker.String():  pepeusdViaETH,
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid cyclic conversion paths",
+			marketMap: types.MarketMap{
+				Markets: cyclicMarkets,
+			},
+			expectErr: true,
+		},
+		{
+			name: "valid synthetic markets",
+			marketMap: types.MarketMap{
+				Markets: syntheticMarkets,
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid missing synthetic component",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					btcusd.Ticker.String():          btcusd,
+					ethbtcSynthetic.Ticker.String(): ethbtcSynthetic,
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid disabled synthetic component",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					btcusd.Ticker.String():  btcusd,
+					usdtusd.Ticker.String(): usdtusd,
+					ethusd.Ticker.String(): {
+						Ticker: types.Ticker{
+							CurrencyPair:     ethusd.Ticker.CurrencyPair,
+							Decimals:         ethusd.Ticker.Decimals,
+							MinProviderCount: ethusd.Ticker.MinProviderCount,
+							Enabled:          false,
+						},
+						ProviderConfigs: ethusd.ProviderConfigs,
+					},
+					ethbtcSynthetic.Ticker.String(): ethbtcSynthetic,
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "valid normalization pairs on a cycle",
+			marketMap: types.MarketMap{
+				Markets: normalizedCyclicMarkets,
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid normalization pair and conversion path on a cycle",
+			marketMap: types.MarketMap{
+				Markets: mixedCyclicMarkets,
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid synthetic market on a cycle",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					btcusdViaSynthetic.Ticker.String(): btcusdViaSynthetic,
+					usdtusd.Ticker.String():            usdtusd,
+					ethusd.Ticker.String():             ethusd,
+					ethbtcSynthetic.Ticker.String():    ethbtcSynthetic,
+					btcethSynthetic.Ticker.String():    btcethSynthetic,
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid synthetic market with provider configs",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					btcusd.Ticker.String():  btcusd,
+					usdtusd.Ticker.String(): usdtusd,
+					ethusd.Ticker.String():  ethusd,
+					ethbtcSynthetic.Ticker.String(): {
+						Ticker:          ethbtcSynthetic.Ticker,
+						ProviderConfigs: ethbtc.ProviderConfigs,
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid index market weights",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					btcusd.Ticker.String():  btcusd,
+					usdtusd.Ticker.String(): usdtusd,
+					ethusd.Ticker.String():  ethusd,
+					indexSynthetic.Ticker.String(): {
+						Ticker: types.Ticker{
+							CurrencyPair:     indexSynthetic.Ticker.CurrencyPair,
+							Decimals:         8,
+							MinProviderCount: 1,
+							Enabled:          true,
+							Metadata_JSON: `{"synthetic_type":"index","synthetic_components":[{"currency_pair":"ETHEREUM/USD"},{"currency_pair":"BTC/USD"}],` +
+								`"index_rebalances":[{"timestamp":100,"weights":[{"currency_pair":"USDT/USD","weight":"2"}],"divisor":"10"}]}`,
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid index market without rebalances",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					btcusd.Ticker.String():  btcusd,
+					usdtusd.Ticker.String(): usdtusd,
+					ethusd.Ticker.String():  ethusd,
+					indexSynthetic.Ticker.String(): {
+						Ticker: types.Ticker{
+							CurrencyPair:     indexSynthetic.Ticker.CurrencyPair,
+							Decimals:         8,
+							MinProviderCount: 1,
+							Enabled:          true,
+							Metadata_JSON:    `{"synthetic_type":"index","synthetic_components":[{"currency_pair":"ETHEREUM/USD"},{"currency_pair":"BTC/USD"}]}`,
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid synthetic market that does not resolve to the ticker",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					btcusd.Ticker.String():  btcusd,
+					usdtusd.Ticker.String(): usdtusd,
+					ethusd.Ticker.String():  ethusd,
+					ethbtcSynthetic.Ticker.String(): {
+						Ticker: types.Ticker{
+							CurrencyPair:     ethbtcCP,
+							Decimals:         8,
+							MinProviderCount: 1,
+							Enabled:          true,
+							Metadata_JSON:    `{"synthetic_type":"cross","synthetic_components":[{"currency_pair":"ETHEREUM/USD"},{"currency_pair":"BTC/USD"}]}`,
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid conversion path that does not end in the quote of the ticker",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					ethbtc.Ticker.String(): ethbtc,
+					pepeusdViaETH.Ticker.String(): {
+						Ticker: pepeusdViaETH.Ticker,
+						ProviderConfigs: []types.ProviderConfig{
+							{
+								Name:           "uniswap",
+								OffChainTicker: "pepe-eth",
+								ConversionPath: []slinkytypes.CurrencyPair{ethbtcCP},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
 		{
 			name: "market with no ticker",
 			marketMap: types.MarketMap{
@@ -2,10 +2,15 @@ package types
 
 import (
 	"fmt"
+	"math/big"
 
 	"github.com/zoguxprotocol/slinky/pkg/json"
+	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
 )
 
+// MaxConversionPathLength is the maximum number of pairs in the conversion path of a provider config.
+const MaxConversionPathLength = 4
+
 // ValidateBasic performs basic validation on a ProviderConfig.
 func (pc *ProviderConfig) ValidateBasic() error {
 	if len(pc.Name) == 0 {
@@ -23,6 +28,16 @@ func (pc *ProviderConfig) ValidateBasic() error {
 		}
 	}
 
+	if err := pc.validateConversionPath(); err != nil {
+		return err
+	}
+
+	if pc.RedemptionRate != nil {
+		if err := pc.RedemptionRate.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid redemption rate: %w", err)
+		}
+	}
+
 	if len(pc.Metadata_JSON) > MaxMetadataJSONFieldLength {
 		return fmt.Errorf("metadata json field is longer than maximum length of %d", MaxMetadataJSONFieldLength)
 	}
@@ -62,5 +77,182 @@ func (pc *ProviderConfig) Equal(other ProviderConfig) bool {
 		}
 	}
 
+	if len(pc.ConversionPath) != len(other.ConversionPath) {
+		return false
+	}
+
+	for i, pair := range pc.ConversionPath {
+		if !pair.Equal(other.ConversionPath[i]) {
+			return false
+		}
+	}
+
+	if pc.RedemptionRate == nil {
+		if other.RedemptionRate != nil {
+			return false
+		}
+	} else {
+		if other.RedemptionRate == nil {
+			return false
+		}
+
+		if !pc.RedemptionRate.Equal(*other.RedemptionRate) {
+			return false
+		}
+	}
+
 	return pc.Metadata_JSON == other.Metadata_JSON
 }
+
+// ConversionPairs returns the pairs whose index prices the price of the provider config is
+// converted by, i.e. either the NormalizeByPair or the pairs of the ConversionPath.
+func (pc *ProviderConfig) ConversionPairs() []slinkytypes.CurrencyPair {
+	if pc.NormalizeByPair != nil {
+		return []slinkytypes.CurrencyPair{*pc.NormalizeByPair}
+	}
+
+	return pc.ConversionPath
+}
+
+// Feeds returns the provider config along with the rate feeds of its redemption rate (if any), i.e.
+// every provider config whose provider price is used to calculate the price of the provider config.
+func (pc *ProviderConfig) Feeds() []ProviderConfig {
+	feeds := []ProviderConfig{*pc}
+	if pc.RedemptionRate == nil {
+		return feeds
+	}
+
+	feeds = append(feeds, pc.RedemptionRate.Rate)
+	if pc.RedemptionRate.MarketRate != nil {
+		feeds = append(feeds, *pc.RedemptionRate.MarketRate)
+	}
+
+	return feeds
+}
+
+// validateConversionPath performs basic validation on the ConversionPath of a ProviderConfig. The
+// path is allowed to be empty. Otherwise, it must not be set together with NormalizeByPair, each
+// pair must be valid, consecutive pairs must be chained (the quote of a pair is the base of the
+// next one), and the path must not revisit an asset.
+func (pc *ProviderConfig) validateConversionPath() error {
+	if len(pc.ConversionPath) == 0 {
+		return nil
+	}
+
+	if pc.NormalizeByPair != nil {
+		return fmt.Errorf("provider config cannot have both a normalize by pair and a conversion path")
+	}
+
+	if len(pc.ConversionPath) > MaxConversionPathLength {
+		return fmt.Errorf(
+			"conversion path has %d pairs; the maximum is %d",
+			len(pc.ConversionPath),
+			MaxConversionPathLength,
+		)
+	}
+
+	seen := make(map[string]struct{}, len(pc.ConversionPath)+1)
+	for i, pair := range pc.ConversionPath {
+		if err := pair.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if i > 0 && pc.ConversionPath[i-1].Quote != pair.Base {
+			return fmt.Errorf(
+				"conversion path is not chained: %s is followed by %s",
+				pc.ConversionPath[i-1].String(),
+				pair.String(),
+			)
+		}
+
+		if _, ok := seen[pair.Base]; ok {
+			return fmt.Errorf("conversion path revisits asset %s", pair.Base)
+		}
+		seen[pair.Base] = struct{}{}
+	}
+
+	last := pc.ConversionPath[len(pc.ConversionPath)-1]
+	if _, ok := seen[last.Quote]; ok {
+		return fmt.Errorf("conversion path revisits asset %s", last.Quote)
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation on a RedemptionRate. In particular, the rate and market
+// rate (if any) must be valid provider configs that are not themselves converted, and the max
+// deviation must be a positive decimal iff the market rate is set.
+func (rr *RedemptionRate) ValidateBasic() error {
+	if err := validateRateFeed(rr.Rate); err != nil {
+		return fmt.Errorf("invalid rate feed: %w", err)
+	}
+
+	if rr.MarketRate == nil {
+		if len(rr.MaxDeviation) != 0 {
+			return fmt.Errorf("max deviation cannot be set without a market rate")
+		}
+		return nil
+	}
+
+	if err := validateRateFeed(*rr.MarketRate); err != nil {
+		return fmt.Errorf("invalid market rate feed: %w", err)
+	}
+
+	maxDeviation, err := rr.ParseMaxDeviation()
+	if err != nil {
+		return err
+	}
+	if maxDeviation.Sign() <= 0 {
+		return fmt.Errorf("max deviation must be positive; got %s", rr.MaxDeviation)
+	}
+
+	return nil
+}
+
+// validateRateFeed performs basic validation on the provider config of a rate feed. The rate is
+// reported directly by the provider, so the config cannot be normalized, converted or have a
+// redemption rate of its own.
+func validateRateFeed(pc ProviderConfig) error {
+	if err := pc.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if pc.NormalizeByPair != nil || len(pc.ConversionPath) > 0 || pc.RedemptionRate != nil {
+		return fmt.Errorf("rate feed of provider %s cannot be normalized, converted or have a redemption rate", pc.Name)
+	}
+
+	return nil
+}
+
+// ParseMaxDeviation parses the max deviation of the market rate from the redemption rate.
+func (rr *RedemptionRate) ParseMaxDeviation() (*big.Float, error) {
+	maxDeviation, ok := new(big.Float).SetString(rr.MaxDeviation)
+	if !ok || maxDeviation.IsInf() {
+		return nil, fmt.Errorf("invalid max deviation %q", rr.MaxDeviation)
+	}
+
+	return maxDeviation, nil
+}
+
+// Equal returns true iff the RedemptionRate is equal to the given RedemptionRate.
+func (rr *RedemptionRate) Equal(other RedemptionRate) bool {
+	if !rr.Rate.Equal(other.Rate) {
+		return false
+	}
+
+	if rr.MarketRate == nil {
+		if other.MarketRate != nil {
+			return false
+		}
+	} else {
+		if other.MarketRate == nil {
+			return false
+		}
+
+		if !rr.MarketRate.Equal(*other.MarketRate) {
+			return false
+		}
+	}
+
+	return rr.MaxDeviation == other.MaxDeviation
+}
@@ -52,6 +52,172 @@ func TestProviderConfigValidateBasic(t *testing.T) {
 		}
 		require.Error(t, pc.ValidateBasic())
 	})
+	t.Run("valid config with conversion path - pass", func(t *testing.T) {
+		pc := types.ProviderConfig{
+			Name:           "mexc",
+			OffChainTicker: "ticker",
+			ConversionPath: []slinkytypes.CurrencyPair{
+				{Base: "ETH", Quote: "BTC"},
+				{Base: "BTC", Quote: "USD"},
+			},
+		}
+		require.NoError(t, pc.ValidateBasic())
+		require.Equal(t, pc.ConversionPath, pc.ConversionPairs())
+	})
+	t.Run("invalid config with normalize by and conversion path - fail", func(t *testing.T) {
+		pc := types.ProviderConfig{
+			Name:           "mexc",
+			OffChainTicker: "ticker",
+			NormalizeByPair: &slinkytypes.CurrencyPair{
+				Base:  "BASE",
+				Quote: "QUOTE",
+			},
+			ConversionPath: []slinkytypes.CurrencyPair{
+				{Base: "QUOTE", Quote: "USD"},
+			},
+		}
+		require.Error(t, pc.ValidateBasic())
+	})
+	t.Run("invalid config with invalid conversion path pair - fail", func(t *testing.T) {
+		pc := types.ProviderConfig{
+			Name:           "mexc",
+			OffChainTicker: "ticker",
+			ConversionPath: []slinkytypes.CurrencyPair{
+				{Base: "ETH", Quote: ""},
+			},
+		}
+		require.Error(t, pc.ValidateBasic())
+	})
+	t.Run("invalid config with unchained conversion path - fail", func(t *testing.T) {
+		pc := types.ProviderConfig{
+			Name:           "mexc",
+			OffChainTicker: "ticker",
+			ConversionPath: []slinkytypes.CurrencyPair{
+				{Base: "ETH", Quote: "BTC"},
+				{Base: "SOL", Quote: "USD"},
+			},
+		}
+		require.Error(t, pc.ValidateBasic())
+	})
+	t.Run("invalid config with conversion path revisiting an asset - fail", func(t *testing.T) {
+		pc := types.ProviderConfig{
+			Name:           "mexc",
+			OffChainTicker: "ticker",
+			ConversionPath: []slinkytypes.CurrencyPair{
+				{Base: "ETH", Quote: "BTC"},
+				{Base: "BTC", Quote: "ETH"},
+				{Base: "ETH", Quote: "USD"},
+			},
+		}
+		require.Error(t, pc.ValidateBasic())
+	})
+	t.Run("invalid config with too long conversion path - fail", func(t *testing.T) {
+		assets := []string{"A", "B", "C", "D", "E", "F", "G"}
+		pc := types.ProviderConfig{
+			Name:           "mexc",
+			OffChainTicker: "ticker",
+		}
+		for i := 0; i <= types.MaxConversionPathLength; i++ {
+			pc.ConversionPath = append(pc.ConversionPath, slinkytypes.CurrencyPair{Base: assets[i], Quote: assets[i+1]})
+		}
+		require.Error(t, pc.ValidateBasic())
+	})
+	t.Run("valid config with redemption rate - pass", func(t *testing.T) {
+		pc := types.ProviderConfig{
+			Name:           "mexc",
+			OffChainTicker: "ticker",
+			RedemptionRate: &types.RedemptionRate{
+				Rate: types.ProviderConfig{
+					Name:           "erc4626_api-ethereum",
+					OffChainTicker: "STETH_RATE",
+				},
+				MarketRate: &types.ProviderConfig{
+					Name:           "uniswapv3_api-ethereum",
+					OffChainTicker: "STETH/ETH",
+				},
+				MaxDeviation: "0.05",
+			},
+		}
+		require.NoError(t, pc.ValidateBasic())
+		require.Len(t, pc.Feeds(), 3)
+	})
+	t.Run("invalid config with invalid redemption rate feed - fail", func(t *testing.T) {
+		pc := types.ProviderConfig{
+			Name:           "mexc",
+			OffChainTicker: "ticker",
+			RedemptionRate: &types.RedemptionRate{
+				Rate: types.ProviderConfig{
+					Name: "erc4626_api-ethereum",
+				},
+			},
+		}
+		require.Error(t, pc.ValidateBasic())
+	})
+	t.Run("invalid config with converted redemption rate feed - fail", func(t *testing.T) {
+		pc := types.ProviderConfig{
+			Name:           "mexc",
+			OffChainTicker: "ticker",
+			RedemptionRate: &types.RedemptionRate{
+				Rate: types.ProviderConfig{
+					Name:           "erc4626_api-ethereum",
+					OffChainTicker: "STETH_RATE",
+					ConversionPath: []slinkytypes.CurrencyPair{
+						{Base: "ETH", Quote: "USD"},
+					},
+				},
+			},
+		}
+		require.Error(t, pc.ValidateBasic())
+	})
+	t.Run("invalid config with max deviation but no market rate - fail", func(t *testing.T) {
+		pc := types.ProviderConfig{
+			Name:           "mexc",
+			OffChainTicker: "ticker",
+			RedemptionRate: &types.RedemptionRate{
+				Rate: types.ProviderConfig{
+					Name:           "erc4626_api-ethereum",
+					OffChainTicker: "STETH_RATE",
+				},
+				MaxDeviation: "0.05",
+			},
+		}
+		require.Error(t, pc.ValidateBasic())
+	})
+	t.Run("invalid config with market rate but no max deviation - fail", func(t *testing.T) {
+		pc := types.ProviderConfig{
+			Name:           "mexc",
+			OffChainTicker: "ticker",
+			RedemptionRate: &types.RedemptionRate{
+				Rate: types.ProviderConfig{
+					Name:           "erc4626_api-ethereum",
+					OffChainTicker: "STETH_RATE",
+				},
+				MarketRate: &types.ProviderConfig{
+					Name:           "uniswapv3_api-ethereum",
+					OffChainTicker: "STETH/ETH",
+				},
+			},
+		}
+		require.Error(t, pc.ValidateBasic())
+	})
+	t.Run("invalid config with non-positive max deviation - fail", func(t *testing.T) {
+		pc := types.ProviderConfig{
+			Name:           "mexc",
+			OffChainTicker: "ticker",
+			RedemptionRate: &types.RedemptionRate{
+				Rate: types.ProviderConfig{
+					Name:           "erc4626_api-ethereum",
+					OffChainTicker: "STETH_RATE",
+				},
+				MarketRate: &types.ProviderConfig{
+					Name:           "uniswapv3_api-ethereum",
+					OffChainTicker: "STETH/ETH",
+				},
+				MaxDeviation: "-0.05",
+			},
+		}
+		require.Error(t, pc.ValidateBasic())
+	})
 	t.Run("invalid name - fail", func(t *testing.T) {
 		pc := types.ProviderConfig{
 			Name:           "",
@@ -185,6 +351,72 @@ func TestProviderConfigEqual(t *testing.T) {
 			},
 			exp: false,
 		},
+		{
+			name: "different conversion path",
+			pc: types.ProviderConfig{
+				Name:           "mexc",
+				OffChainTicker: "ticker",
+				ConversionPath: []slinkytypes.CurrencyPair{
+					{Base: "ETH", Quote: "BTC"},
+					{Base: "BTC", Quote: "USD"},
+				},
+			},
+			other: types.ProviderConfig{
+				Name:           "mexc",
+				OffChainTicker: "ticker",
+				ConversionPath: []slinkytypes.CurrencyPair{
+					{Base: "ETH", Quote: "USD"},
+				},
+			},
+			exp: false,
+		},
+		{
+			name: "different redemption rate",
+			pc: types.ProviderConfig{
+				Name:           "mexc",
+				OffChainTicker: "ticker",
+				RedemptionRate: &types.RedemptionRate{
+					Rate: types.ProviderConfig{
+						Name:           "erc4626_api-ethereum",
+						OffChainTicker: "STETH_RATE",
+					},
+				},
+			},
+			other: types.ProviderConfig{
+				Name:           "mexc",
+				OffChainTicker: "ticker",
+				RedemptionRate: &types.RedemptionRate{
+					Rate: types.ProviderConfig{
+						Name:           "erc4626_api-ethereum",
+						OffChainTicker: "STETH_RATE",
+					},
+					MarketRate: &types.ProviderConfig{
+						Name:           "uniswapv3_api-ethereum",
+						OffChainTicker: "STETH/ETH",
+					},
+					MaxDeviation: "0.05",
+				},
+			},
+			exp: false,
+		},
+		{
+			name: "missing redemption rate",
+			pc: types.ProviderConfig{
+				Name:           "mexc",
+				OffChainTicker: "ticker",
+			},
+			other: types.ProviderConfig{
+				Name:           "mexc",
+				OffChainTicker: "ticker",
+				RedemptionRate: &types.RedemptionRate{
+					Rate: types.ProviderConfig{
+						Name:           "erc4626_api-ethereum",
+						OffChainTicker: "STETH_RATE",
+					},
+				},
+			},
+			exp: false,
+		},
 		{
 			name: "different normalize by",
 			pc: types.ProviderConfig{
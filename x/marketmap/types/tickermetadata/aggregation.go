@@ -0,0 +1,125 @@
+package tickermetadata
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// AggregationStrategy is the strategy the sidecar uses to aggregate the converted
+// provider prices of a market into a single index price.
+type AggregationStrategy string
+
+const (
+	// AggregationStrategyMedian takes the median of the converted provider prices. This is
+	// the default strategy.
+	AggregationStrategyMedian AggregationStrategy = "median"
+	// AggregationStrategyVolumeWeightedMedian takes the median of the converted provider prices
+	// weighted by the volume reported by each provider. The median is used if fewer providers than
+	// the market's MinProviderCount report a volume.
+	AggregationStrategyVolumeWeightedMedian AggregationStrategy = "volume_weighted_median"
+	// AggregationStrategyTrimmedMean takes the mean of the converted provider prices after
+	// removing the highest and lowest TrimPercent of prices.
+	AggregationStrategyTrimmedMean AggregationStrategy = "trimmed_mean"
+	// AggregationStrategyTWAP takes the time-weighted average of the median prices computed
+	// over the last TWAPWindowSeconds.
+	AggregationStrategyTWAP AggregationStrategy = "twap"
+)
+
+const (
+	// DefaultTrimPercent is the percent of prices trimmed from each side when using the
+	// trimmed mean strategy and no TrimPercent is set.
+	DefaultTrimPercent = 10
+	// MaxTrimPercent is the maximum percent of prices that can be trimmed from each side.
+	MaxTrimPercent = 49
+	// DefaultTWAPWindowSeconds is the TWAP window used when no TWAPWindowSeconds is configured.
+	DefaultTWAPWindowSeconds = 60
+)
+
+// Aggregation is the (optional) aggregation configuration of a market. It is read from
+// the Ticker.Metadata_JSON, or from the Metadata_JSON of the first ProviderConfig that selects
+// a strategy if the ticker does not, and can be combined with any other metadata as long as
+// the field names do not collide.
+type Aggregation struct {
+	// Strategy is the aggregation strategy used for the market. If empty, the median is used.
+	Strategy AggregationStrategy `json:"aggregation_strategy,omitempty"`
+	// TrimPercent is the percent of prices removed from each side of the sorted prices
+	// before averaging. This is only used by the trimmed mean strategy. If unset, the
+	// DefaultTrimPercent is used; an explicit 0 trims nothing, i.e. takes the plain mean.
+	TrimPercent *uint64 `json:"trim_percent,omitempty"`
+	// TWAPWindowSeconds is the window over which median prices are time-weighted. This is
+	// only used by the TWAP strategy.
+	TWAPWindowSeconds uint64 `json:"twap_window_seconds,omitempty"`
+}
+
+// NewAggregation returns a new Aggregation instance.
+func NewAggregation(strategy AggregationStrategy, trimPercent *uint64, twapWindowSeconds uint64) Aggregation {
+	return Aggregation{
+		Strategy:          strategy,
+		TrimPercent:       trimPercent,
+		TWAPWindowSeconds: twapWindowSeconds,
+	}
+}
+
+// ValidateBasic performs basic validation on the Aggregation.
+func (a Aggregation) ValidateBasic() error {
+	switch a.Strategy {
+	case "", AggregationStrategyMedian, AggregationStrategyVolumeWeightedMedian,
+		AggregationStrategyTrimmedMean, AggregationStrategyTWAP:
+	default:
+		return fmt.Errorf("unknown aggregation strategy %q", a.Strategy)
+	}
+
+	if a.TrimPercent != nil && *a.TrimPercent > MaxTrimPercent {
+		return fmt.Errorf("trim percent must be at most %d; got %d", MaxTrimPercent, *a.TrimPercent)
+	}
+
+	return nil
+}
+
+// GetStrategy returns the configured strategy, defaulting to the median.
+func (a Aggregation) GetStrategy() AggregationStrategy {
+	if a.Strategy == "" {
+		return AggregationStrategyMedian
+	}
+	return a.Strategy
+}
+
+// GetTrimPercent returns the configured trim percent, defaulting to DefaultTrimPercent if
+// no trim percent is set. An explicitly configured trim percent of 0 is returned as is.
+func (a Aggregation) GetTrimPercent() uint64 {
+	if a.TrimPercent == nil {
+		return DefaultTrimPercent
+	}
+	return *a.TrimPercent
+}
+
+// GetTWAPWindowSeconds returns the configured TWAP window, defaulting to DefaultTWAPWindowSeconds.
+func (a Aggregation) GetTWAPWindowSeconds() uint64 {
+	if a.TWAPWindowSeconds == 0 {
+		return DefaultTWAPWindowSeconds
+	}
+	return a.TWAPWindowSeconds
+}
+
+// MarshalAggregation returns the JSON byte encoding of the Aggregation.
+func MarshalAggregation(m Aggregation) ([]byte, error) {
+	return json.Marshal(m)
+}
+
+// AggregationFromJSONString returns an Aggregation instance from a JSON string. An empty
+// string is treated as an empty (default) configuration.
+func AggregationFromJSONString(jsonString string) (Aggregation, error) {
+	return AggregationFromJSONBytes([]byte(jsonString))
+}
+
+// AggregationFromJSONBytes returns an Aggregation instance from JSON bytes. Empty bytes
+// are treated as an empty (default) configuration.
+func AggregationFromJSONBytes(jsonBytes []byte) (Aggregation, error) {
+	var elem Aggregation
+	if len(jsonBytes) == 0 {
+		return elem, nil
+	}
+
+	err := json.Unmarshal(jsonBytes, &elem)
+	return elem, err
+}
@@ -0,0 +1,75 @@
+package tickermetadata_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zoguxprotocol/slinky/x/marketmap/types/tickermetadata"
+)
+
+func Test_UnmarshalAggregation(t *testing.T) {
+	t.Run("can marshal and unmarshal the same struct and values", func(t *testing.T) {
+		trimPercent := uint64(20)
+		elem := tickermetadata.NewAggregation(tickermetadata.AggregationStrategyTrimmedMean, &trimPercent, 0)
+
+		bz, err := tickermetadata.MarshalAggregation(elem)
+		require.NoError(t, err)
+
+		elem2, err := tickermetadata.AggregationFromJSONBytes(bz)
+		require.NoError(t, err)
+		require.Equal(t, elem, elem2)
+	})
+
+	t.Run("can unmarshal a JSON string alongside other ticker metadata", func(t *testing.T) {
+		elemJSON := `{"reference_price":1,"liquidity":2,"aggregate_ids":[],"aggregation_strategy":"twap","twap_window_seconds":30}`
+		elem, err := tickermetadata.AggregationFromJSONString(elemJSON)
+		require.NoError(t, err)
+
+		require.Equal(t, tickermetadata.NewAggregation(tickermetadata.AggregationStrategyTWAP, nil, 30), elem)
+	})
+
+	t.Run("an explicit trim percent of 0 is not replaced by the default", func(t *testing.T) {
+		elem, err := tickermetadata.AggregationFromJSONString(`{"aggregation_strategy":"trimmed_mean","trim_percent":0}`)
+		require.NoError(t, err)
+		require.NoError(t, elem.ValidateBasic())
+
+		require.Equal(t, uint64(0), elem.GetTrimPercent())
+	})
+
+	t.Run("empty metadata defaults to the median", func(t *testing.T) {
+		elem, err := tickermetadata.AggregationFromJSONString("")
+		require.NoError(t, err)
+		require.NoError(t, elem.ValidateBasic())
+
+		require.Equal(t, tickermetadata.AggregationStrategyMedian, elem.GetStrategy())
+		require.Equal(t, uint64(tickermetadata.DefaultTrimPercent), elem.GetTrimPercent())
+		require.Equal(t, uint64(tickermetadata.DefaultTWAPWindowSeconds), elem.GetTWAPWindowSeconds())
+	})
+}
+
+func TestAggregation_ValidateBasic(t *testing.T) {
+	t.Run("valid strategies", func(t *testing.T) {
+		for _, strategy := range []tickermetadata.AggregationStrategy{
+			tickermetadata.AggregationStrategyMedian,
+			tickermetadata.AggregationStrategyVolumeWeightedMedian,
+			tickermetadata.AggregationStrategyTrimmedMean,
+			tickermetadata.AggregationStrategyTWAP,
+		} {
+			require.NoError(t, tickermetadata.NewAggregation(strategy, nil, 0).ValidateBasic())
+		}
+	})
+
+	t.Run("unknown strategy", func(t *testing.T) {
+		require.Error(t, tickermetadata.NewAggregation("mode", nil, 0).ValidateBasic())
+	})
+
+	t.Run("trim percent too large", func(t *testing.T) {
+		trimPercent := uint64(tickermetadata.MaxTrimPercent + 1)
+		require.Error(t, tickermetadata.NewAggregation(
+			tickermetadata.AggregationStrategyTrimmedMean,
+			&trimPercent,
+			0,
+		).ValidateBasic())
+	})
+}
@@ -0,0 +1,171 @@
+package tickermetadata
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// MaxIndexRebalances is the maximum number of rebalances of an index market.
+const MaxIndexRebalances = 16
+
+// IndexWeight is the weight of a component market in an index market.
+type IndexWeight struct {
+	// CurrencyPair is the ticker of the component market i.e. ETH/USD.
+	CurrencyPair string `json:"currency_pair"`
+	// Weight is the decimal amount of the base asset of the component market held by the index.
+	Weight string `json:"weight"`
+}
+
+// IndexRebalance is the weighting of an index market from a point in time until the next rebalance.
+type IndexRebalance struct {
+	// Timestamp is the unix time, in seconds, from which the rebalance applies.
+	Timestamp uint64 `json:"timestamp"`
+	// Weights are the weights of the component markets.
+	Weights []IndexWeight `json:"weights"`
+	// Divisor is the decimal number the weighted sum of the component prices is divided by. It is
+	// typically set at each rebalance so that the price of the index is continuous across the rebalance.
+	Divisor string `json:"divisor"`
+}
+
+// Index is the weighting of a synthetic index market (SyntheticTypeIndex). The price of the index is the
+// sum of the index prices of its components, each multiplied by its weight, divided by the divisor of the
+// latest rebalance that applies. It is read from the Ticker.Metadata_JSON and can be combined with any other
+// ticker metadata as long as the field names do not collide.
+type Index struct {
+	// Rebalances are the rebalances of the index, ordered by timestamp.
+	Rebalances []IndexRebalance `json:"index_rebalances,omitempty"`
+}
+
+// NewIndexWeight returns a new IndexWeight instance.
+func NewIndexWeight(currencyPair, weight string) IndexWeight {
+	return IndexWeight{
+		CurrencyPair: currencyPair,
+		Weight:       weight,
+	}
+}
+
+// NewIndexRebalance returns a new IndexRebalance instance.
+func NewIndexRebalance(timestamp uint64, divisor string, weights ...IndexWeight) IndexRebalance {
+	return IndexRebalance{
+		Timestamp: timestamp,
+		Weights:   weights,
+		Divisor:   divisor,
+	}
+}
+
+// NewIndex returns a new Index instance.
+func NewIndex(rebalances ...IndexRebalance) Index {
+	return Index{
+		Rebalances: rebalances,
+	}
+}
+
+// GetWeight returns the weight of the component.
+func (w IndexWeight) GetWeight() (*big.Float, error) {
+	return parseDecimal(w.Weight)
+}
+
+// GetDivisor returns the divisor of the rebalance.
+func (r IndexRebalance) GetDivisor() (*big.Float, error) {
+	return parseDecimal(r.Divisor)
+}
+
+// GetRebalance returns the latest rebalance that applies at the given time, or false if no rebalance
+// applies yet.
+func (i Index) GetRebalance(now time.Time) (IndexRebalance, bool) {
+	if now.Unix() < 0 {
+		return IndexRebalance{}, false
+	}
+
+	timestamp := uint64(now.Unix()) //nolint:gosec
+	for j := len(i.Rebalances) - 1; j >= 0; j-- {
+		if timestamp >= i.Rebalances[j].Timestamp {
+			return i.Rebalances[j], true
+		}
+	}
+
+	return IndexRebalance{}, false
+}
+
+// ValidateBasic performs basic validation on the Index, given the synthetic configuration of the index
+// market. In particular, this ensures that the rebalances are ordered by timestamp, that each divisor and
+// weight is positive, and that each weight is for a component of the synthetic market.
+func (i Index) ValidateBasic(synthetic Synthetic) error {
+	if len(i.Rebalances) == 0 || len(i.Rebalances) > MaxIndexRebalances {
+		return fmt.Errorf(
+			"index market must have between 1 and %d rebalances; got %d",
+			MaxIndexRebalances,
+			len(i.Rebalances),
+		)
+	}
+
+	components := make(map[string]struct{}, len(synthetic.Components))
+	for _, component := range synthetic.Components {
+		components[component.CurrencyPair] = struct{}{}
+	}
+
+	for j, rebalance := range i.Rebalances {
+		if j > 0 && rebalance.Timestamp <= i.Rebalances[j-1].Timestamp {
+			return fmt.Errorf("index rebalances must be ordered by increasing timestamp; got %d after %d", rebalance.Timestamp, i.Rebalances[j-1].Timestamp)
+		}
+
+		divisor, err := rebalance.GetDivisor()
+		if err != nil {
+			return fmt.Errorf("invalid divisor of index rebalance at %d: %w", rebalance.Timestamp, err)
+		}
+		if divisor.Sign() <= 0 {
+			return fmt.Errorf("divisor of index rebalance at %d must be positive; got %s", rebalance.Timestamp, rebalance.Divisor)
+		}
+
+		if len(rebalance.Weights) == 0 {
+			return fmt.Errorf("index rebalance at %d must have at least one weight", rebalance.Timestamp)
+		}
+
+		seen := make(map[string]struct{}, len(rebalance.Weights))
+		for _, weight := range rebalance.Weights {
+			if _, ok := components[weight.CurrencyPair]; !ok {
+				return fmt.Errorf("weight of index rebalance at %d is for %q, which is not a synthetic component", rebalance.Timestamp, weight.CurrencyPair)
+			}
+
+			if _, ok := seen[weight.CurrencyPair]; ok {
+				return fmt.Errorf("duplicate weight for %s in index rebalance at %d", weight.CurrencyPair, rebalance.Timestamp)
+			}
+			seen[weight.CurrencyPair] = struct{}{}
+
+			value, err := weight.GetWeight()
+			if err != nil {
+				return fmt.Errorf("invalid weight for %s in index rebalance at %d: %w", weight.CurrencyPair, rebalance.Timestamp, err)
+			}
+			if value.Sign() <= 0 {
+				return fmt.Errorf("weight for %s in index rebalance at %d must be positive; got %s", weight.CurrencyPair, rebalance.Timestamp, weight.Weight)
+			}
+		}
+	}
+
+	return nil
+}
+
+// MarshalIndex returns the JSON byte encoding of the Index.
+func MarshalIndex(m Index) ([]byte, error) {
+	return json.Marshal(m)
+}
+
+// IndexFromJSONString returns an Index instance from a JSON string. An empty string is treated as an
+// empty configuration.
+func IndexFromJSONString(jsonString string) (Index, error) {
+	return IndexFromJSONBytes([]byte(jsonString))
+}
+
+// IndexFromJSONBytes returns an Index instance from JSON bytes. Empty bytes are treated as an empty
+// configuration.
+func IndexFromJSONBytes(jsonBytes []byte) (Index, error) {
+	var elem Index
+	if len(jsonBytes) == 0 {
+		return elem, nil
+	}
+
+	err := json.Unmarshal(jsonBytes, &elem)
+	return elem, err
+}
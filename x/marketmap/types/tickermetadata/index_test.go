@@ -0,0 +1,167 @@
+package tickermetadata_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zoguxprotocol/slinky/x/marketmap/types/tickermetadata"
+)
+
+var indexSynthetic = tickermetadata.NewSynthetic(
+	tickermetadata.SyntheticTypeIndex,
+	tickermetadata.NewSyntheticComponent(ethusd, false, ""),
+	tickermetadata.NewSyntheticComponent(btcusd, false, ""),
+)
+
+func Test_UnmarshalIndex(t *testing.T) {
+	t.Run("can marshal and unmarshal the same struct and values", func(t *testing.T) {
+		elem := tickermetadata.NewIndex(
+			tickermetadata.NewIndexRebalance(
+				100,
+				"10",
+				tickermetadata.NewIndexWeight(ethusd.String(), "2"),
+				tickermetadata.NewIndexWeight(btcusd.String(), "0.1"),
+			),
+		)
+
+		bz, err := tickermetadata.MarshalIndex(elem)
+		require.NoError(t, err)
+
+		elem2, err := tickermetadata.IndexFromJSONBytes(bz)
+		require.NoError(t, err)
+		require.Equal(t, elem, elem2)
+	})
+
+	t.Run("can unmarshal a JSON string alongside the synthetic configuration", func(t *testing.T) {
+		elemJSON := `{"synthetic_type":"index","synthetic_components":[{"currency_pair":"ETH/USD"}],"index_rebalances":[{"timestamp":100,"weights":[{"currency_pair":"ETH/USD","weight":"2"}],"divisor":"10"}]}`
+		elem, err := tickermetadata.IndexFromJSONString(elemJSON)
+		require.NoError(t, err)
+
+		require.Equal(t, tickermetadata.NewIndex(
+			tickermetadata.NewIndexRebalance(100, "10", tickermetadata.NewIndexWeight(ethusd.String(), "2")),
+		), elem)
+
+		synthetic, err := tickermetadata.SyntheticFromJSONString(elemJSON)
+		require.NoError(t, err)
+		require.Equal(t, tickermetadata.SyntheticTypeIndex, synthetic.Type)
+	})
+}
+
+func TestIndex_GetRebalance(t *testing.T) {
+	index := tickermetadata.NewIndex(
+		tickermetadata.NewIndexRebalance(100, "10", tickermetadata.NewIndexWeight(ethusd.String(), "2")),
+		tickermetadata.NewIndexRebalance(200, "20", tickermetadata.NewIndexWeight(btcusd.String(), "1")),
+	)
+
+	t.Run("no rebalance applies before the first timestamp", func(t *testing.T) {
+		_, ok := index.GetRebalance(time.Unix(99, 0))
+		require.False(t, ok)
+	})
+
+	t.Run("first rebalance applies until the second timestamp", func(t *testing.T) {
+		rebalance, ok := index.GetRebalance(time.Unix(199, 0))
+		require.True(t, ok)
+		require.Equal(t, index.Rebalances[0], rebalance)
+	})
+
+	t.Run("latest rebalance applies from its timestamp", func(t *testing.T) {
+		rebalance, ok := index.GetRebalance(time.Unix(200, 0))
+		require.True(t, ok)
+		require.Equal(t, index.Rebalances[1], rebalance)
+	})
+}
+
+func TestIndex_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		index     tickermetadata.Index
+		expectErr bool
+	}{
+		{
+			name: "valid",
+			index: tickermetadata.NewIndex(
+				tickermetadata.NewIndexRebalance(
+					100,
+					"10",
+					tickermetadata.NewIndexWeight(ethusd.String(), "2"),
+					tickermetadata.NewIndexWeight(btcusd.String(), "0.1"),
+				),
+				tickermetadata.NewIndexRebalance(200, "5", tickermetadata.NewIndexWeight(btcusd.String(), "0.2")),
+			),
+			expectErr: false,
+		},
+		{
+			name:      "no rebalances",
+			index:     tickermetadata.NewIndex(),
+			expectErr: true,
+		},
+		{
+			name: "rebalances out of order",
+			index: tickermetadata.NewIndex(
+				tickermetadata.NewIndexRebalance(200, "10", tickermetadata.NewIndexWeight(ethusd.String(), "2")),
+				tickermetadata.NewIndexRebalance(100, "10", tickermetadata.NewIndexWeight(ethusd.String(), "2")),
+			),
+			expectErr: true,
+		},
+		{
+			name: "non-positive divisor",
+			index: tickermetadata.NewIndex(
+				tickermetadata.NewIndexRebalance(100, "0", tickermetadata.NewIndexWeight(ethusd.String(), "2")),
+			),
+			expectErr: true,
+		},
+		{
+			name: "invalid divisor",
+			index: tickermetadata.NewIndex(
+				tickermetadata.NewIndexRebalance(100, "ten", tickermetadata.NewIndexWeight(ethusd.String(), "2")),
+			),
+			expectErr: true,
+		},
+		{
+			name: "no weights",
+			index: tickermetadata.NewIndex(
+				tickermetadata.NewIndexRebalance(100, "10"),
+			),
+			expectErr: true,
+		},
+		{
+			name: "weight for a market that is not a component",
+			index: tickermetadata.NewIndex(
+				tickermetadata.NewIndexRebalance(100, "10", tickermetadata.NewIndexWeight(ethbtc.String(), "2")),
+			),
+			expectErr: true,
+		},
+		{
+			name: "duplicate weights",
+			index: tickermetadata.NewIndex(
+				tickermetadata.NewIndexRebalance(
+					100,
+					"10",
+					tickermetadata.NewIndexWeight(ethusd.String(), "2"),
+					tickermetadata.NewIndexWeight(ethusd.String(), "3"),
+				),
+			),
+			expectErr: true,
+		},
+		{
+			name: "non-positive weight",
+			index: tickermetadata.NewIndex(
+				tickermetadata.NewIndexRebalance(100, "10", tickermetadata.NewIndexWeight(ethusd.String(), "-2")),
+			),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.index.ValidateBasic(indexSynthetic)
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
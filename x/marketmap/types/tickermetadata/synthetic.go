@@ -0,0 +1,234 @@
+package tickermetadata
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
+)
+
+// SyntheticType is the type of a synthetic market i.e. how its price is derived from the index prices
+// of its components.
+type SyntheticType string
+
+const (
+	// SyntheticTypeCross derives the price as the product of the index prices of the components, each of
+	// which can be inverted. e.g. ETH/BTC = ETH/USD * (BTC/USD)^-1.
+	SyntheticTypeCross SyntheticType = "cross"
+	// SyntheticTypeBasket derives the price as the sum of the index prices of the components, each
+	// multiplied by a fixed quantity. e.g. 1 ETH + 0.05 BTC in USD = 1 * ETH/USD + 0.05 * BTC/USD.
+	SyntheticTypeBasket SyntheticType = "basket"
+	// SyntheticTypeIndex derives the price as the sum of the index prices of the components, each multiplied
+	// by its weight, divided by a divisor. The weights and divisor are set by the rebalances of the Index.
+	SyntheticTypeIndex SyntheticType = "index"
+)
+
+// MaxSyntheticComponents is the maximum number of components of a synthetic market.
+const MaxSyntheticComponents = 16
+
+// SyntheticComponent is a market whose index price is used to derive the price of a synthetic market.
+type SyntheticComponent struct {
+	// CurrencyPair is the ticker of the market i.e. ETH/USD.
+	CurrencyPair string `json:"currency_pair"`
+	// Invert indicates that the index price of the market is inverted. This is only used by cross
+	// markets.
+	Invert bool `json:"invert,omitempty"`
+	// Quantity is the decimal amount of the base asset of the market held by the basket. This is only
+	// used by basket markets.
+	Quantity string `json:"quantity,omitempty"`
+}
+
+// Synthetic is the (optional) synthetic configuration of a market. A synthetic market has no provider
+// configs of its own; its price is derived by the sidecar from the index prices of its components. It
+// is read from the Ticker.Metadata_JSON and can be combined with any other ticker metadata as long as
+// the field names do not collide.
+type Synthetic struct {
+	// Type is the type of the synthetic market. If empty, the market is not synthetic.
+	Type SyntheticType `json:"synthetic_type,omitempty"`
+	// Components are the markets the price of the synthetic market is derived from.
+	Components []SyntheticComponent `json:"synthetic_components,omitempty"`
+}
+
+// NewSyntheticComponent returns a new SyntheticComponent instance.
+func NewSyntheticComponent(cp slinkytypes.CurrencyPair, invert bool, quantity string) SyntheticComponent {
+	return SyntheticComponent{
+		CurrencyPair: cp.String(),
+		Invert:       invert,
+		Quantity:     quantity,
+	}
+}
+
+// NewSynthetic returns a new Synthetic instance.
+func NewSynthetic(syntheticType SyntheticType, components ...SyntheticComponent) Synthetic {
+	return Synthetic{
+		Type:       syntheticType,
+		Components: components,
+	}
+}
+
+// IsSynthetic returns true iff the market is synthetic.
+func (s Synthetic) IsSynthetic() bool {
+	return s.Type != ""
+}
+
+// GetCurrencyPair returns the currency pair of the component.
+func (c SyntheticComponent) GetCurrencyPair() (slinkytypes.CurrencyPair, error) {
+	cp, err := slinkytypes.CurrencyPairFromString(c.CurrencyPair)
+	if err != nil {
+		return slinkytypes.CurrencyPair{}, err
+	}
+
+	return cp, cp.ValidateBasic()
+}
+
+// GetQuantity returns the quantity of the component.
+func (c SyntheticComponent) GetQuantity() (*big.Float, error) {
+	return parseDecimal(c.Quantity)
+}
+
+// parseDecimal parses a finite decimal number.
+func parseDecimal(s string) (*big.Float, error) {
+	f, ok := new(big.Float).SetString(s)
+	if !ok || f.IsInf() {
+		return nil, fmt.Errorf("invalid decimal %q", s)
+	}
+
+	return f, nil
+}
+
+// GetCurrencyPairs returns the currency pairs of the components.
+func (s Synthetic) GetCurrencyPairs() ([]slinkytypes.CurrencyPair, error) {
+	cps := make([]slinkytypes.CurrencyPair, len(s.Components))
+	for i, component := range s.Components {
+		cp, err := component.GetCurrencyPair()
+		if err != nil {
+			return nil, err
+		}
+		cps[i] = cp
+	}
+
+	return cps, nil
+}
+
+// ValidateBasic performs basic validation on the Synthetic, given the currency pair of the market it
+// configures. In particular, this ensures that the components resolve to the currency pair i.e. the
+// assets of a cross market cancel out to the base and quote of the currency pair, and each component of
+// a basket or index market is quoted in the quote of the currency pair. The weights of an index market
+// are validated separately, see Index.ValidateBasic.
+func (s Synthetic) ValidateBasic(target slinkytypes.CurrencyPair) error {
+	if !s.IsSynthetic() {
+		if len(s.Components) > 0 {
+			return fmt.Errorf("synthetic components are set without a synthetic type")
+		}
+		return nil
+	}
+
+	if len(s.Components) == 0 || len(s.Components) > MaxSyntheticComponents {
+		return fmt.Errorf(
+			"synthetic market must have between 1 and %d components; got %d",
+			MaxSyntheticComponents,
+			len(s.Components),
+		)
+	}
+
+	cps, err := s.GetCurrencyPairs()
+	if err != nil {
+		return fmt.Errorf("invalid synthetic component: %w", err)
+	}
+
+	seen := make(map[string]struct{}, len(cps))
+	for i, cp := range cps {
+		if s.Components[i].CurrencyPair != cp.String() {
+			return fmt.Errorf("synthetic component %q must be formatted as %s", s.Components[i].CurrencyPair, cp.String())
+		}
+
+		if _, ok := seen[cp.String()]; ok {
+			return fmt.Errorf("duplicate synthetic component %s", cp.String())
+		}
+		seen[cp.String()] = struct{}{}
+	}
+
+	switch s.Type {
+	case SyntheticTypeCross:
+		// Each component contributes its base asset to the numerator and its quote asset to the
+		// denominator (or vice versa if inverted). All assets must cancel out except for the base
+		// and quote of the target.
+		exponents := make(map[string]int)
+		for i, component := range s.Components {
+			if component.Quantity != "" {
+				return fmt.Errorf("quantity of component %s cannot be set on a cross market", cps[i].String())
+			}
+
+			sign := 1
+			if component.Invert {
+				sign = -1
+			}
+			exponents[cps[i].Base] += sign
+			exponents[cps[i].Quote] -= sign
+		}
+
+		exponents[target.Base]--
+		exponents[target.Quote]++
+		for asset, exponent := range exponents {
+			if exponent != 0 {
+				return fmt.Errorf("cross market components do not resolve to %s; %s does not cancel out", target.String(), asset)
+			}
+		}
+	case SyntheticTypeBasket:
+		for i, component := range s.Components {
+			if component.Invert {
+				return fmt.Errorf("component %s cannot be inverted on a basket market", cps[i].String())
+			}
+
+			quantity, err := component.GetQuantity()
+			if err != nil {
+				return fmt.Errorf("invalid quantity of component %s: %w", cps[i].String(), err)
+			}
+			if quantity.Sign() <= 0 {
+				return fmt.Errorf("quantity of component %s must be positive; got %s", cps[i].String(), component.Quantity)
+			}
+
+			if cps[i].Quote != target.Quote {
+				return fmt.Errorf("component %s of basket market must be quoted in %s", cps[i].String(), target.Quote)
+			}
+		}
+	case SyntheticTypeIndex:
+		for i, component := range s.Components {
+			if component.Invert || component.Quantity != "" {
+				return fmt.Errorf("component %s of index market cannot be inverted or have a quantity", cps[i].String())
+			}
+
+			if cps[i].Quote != target.Quote {
+				return fmt.Errorf("component %s of index market must be quoted in %s", cps[i].String(), target.Quote)
+			}
+		}
+	default:
+		return fmt.Errorf("unknown synthetic type %q", s.Type)
+	}
+
+	return nil
+}
+
+// MarshalSynthetic returns the JSON byte encoding of the Synthetic.
+func MarshalSynthetic(m Synthetic) ([]byte, error) {
+	return json.Marshal(m)
+}
+
+// SyntheticFromJSONString returns a Synthetic instance from a JSON string. An empty string is treated
+// as an empty (non-synthetic) configuration.
+func SyntheticFromJSONString(jsonString string) (Synthetic, error) {
+	return SyntheticFromJSONBytes([]byte(jsonString))
+}
+
+// SyntheticFromJSONBytes returns a Synthetic instance from JSON bytes. Empty bytes are treated as an
+// empty (non-synthetic) configuration.
+func SyntheticFromJSONBytes(jsonBytes []byte) (Synthetic, error) {
+	var elem Synthetic
+	if len(jsonBytes) == 0 {
+		return elem, nil
+	}
+
+	err := json.Unmarshal(jsonBytes, &elem)
+	return elem, err
+}
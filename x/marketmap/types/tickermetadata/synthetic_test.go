@@ -0,0 +1,227 @@
+package tickermetadata_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
+	"github.com/zoguxprotocol/slinky/x/marketmap/types/tickermetadata"
+)
+
+var (
+	ethusd = slinkytypes.NewCurrencyPair("ETH", "USD")
+	btcusd = slinkytypes.NewCurrencyPair("BTC", "USD")
+	ethbtc = slinkytypes.NewCurrencyPair("ETH", "BTC")
+)
+
+func Test_UnmarshalSynthetic(t *testing.T) {
+	t.Run("can marshal and unmarshal the same struct and values", func(t *testing.T) {
+		elem := tickermetadata.NewSynthetic(
+			tickermetadata.SyntheticTypeCross,
+			tickermetadata.NewSyntheticComponent(ethusd, false, ""),
+			tickermetadata.NewSyntheticComponent(btcusd, true, ""),
+		)
+
+		bz, err := tickermetadata.MarshalSynthetic(elem)
+		require.NoError(t, err)
+
+		elem2, err := tickermetadata.SyntheticFromJSONBytes(bz)
+		require.NoError(t, err)
+		require.Equal(t, elem, elem2)
+	})
+
+	t.Run("can unmarshal a JSON string alongside other ticker metadata", func(t *testing.T) {
+		elemJSON := `{"reference_price":1,"liquidity":2,"aggregate_ids":[],"synthetic_type":"basket","synthetic_components":[{"currency_pair":"ETH/USD","quantity":"1.5"}]}`
+		elem, err := tickermetadata.SyntheticFromJSONString(elemJSON)
+		require.NoError(t, err)
+
+		require.Equal(t, tickermetadata.NewSynthetic(
+			tickermetadata.SyntheticTypeBasket,
+			tickermetadata.NewSyntheticComponent(ethusd, false, "1.5"),
+		), elem)
+		require.True(t, elem.IsSynthetic())
+	})
+
+	t.Run("empty metadata is not synthetic", func(t *testing.T) {
+		elem, err := tickermetadata.SyntheticFromJSONString("")
+		require.NoError(t, err)
+		require.False(t, elem.IsSynthetic())
+		require.NoError(t, elem.ValidateBasic(ethbtc))
+	})
+}
+
+func TestSynthetic_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		synthetic tickermetadata.Synthetic
+		target    slinkytypes.CurrencyPair
+		expectErr bool
+	}{
+		{
+			name: "valid cross",
+			synthetic: tickermetadata.NewSynthetic(
+				tickermetadata.SyntheticTypeCross,
+				tickermetadata.NewSyntheticComponent(ethusd, false, ""),
+				tickermetadata.NewSyntheticComponent(btcusd, true, ""),
+			),
+			target:    ethbtc,
+			expectErr: false,
+		},
+		{
+			name: "valid inverted cross",
+			synthetic: tickermetadata.NewSynthetic(
+				tickermetadata.SyntheticTypeCross,
+				tickermetadata.NewSyntheticComponent(ethbtc, true, ""),
+			),
+			target:    slinkytypes.NewCurrencyPair("BTC", "ETH"),
+			expectErr: false,
+		},
+		{
+			name: "cross that does not resolve to the target",
+			synthetic: tickermetadata.NewSynthetic(
+				tickermetadata.SyntheticTypeCross,
+				tickermetadata.NewSyntheticComponent(ethusd, false, ""),
+				tickermetadata.NewSyntheticComponent(btcusd, false, ""),
+			),
+			target:    ethbtc,
+			expectErr: true,
+		},
+		{
+			name: "cross with a quantity",
+			synthetic: tickermetadata.NewSynthetic(
+				tickermetadata.SyntheticTypeCross,
+				tickermetadata.NewSyntheticComponent(ethusd, false, "1"),
+				tickermetadata.NewSyntheticComponent(btcusd, true, ""),
+			),
+			target:    ethbtc,
+			expectErr: true,
+		},
+		{
+			name: "valid basket",
+			synthetic: tickermetadata.NewSynthetic(
+				tickermetadata.SyntheticTypeBasket,
+				tickermetadata.NewSyntheticComponent(ethusd, false, "1"),
+				tickermetadata.NewSyntheticComponent(btcusd, false, "0.05"),
+			),
+			target:    slinkytypes.NewCurrencyPair("BASKET", "USD"),
+			expectErr: false,
+		},
+		{
+			name: "basket with a component in a different quote",
+			synthetic: tickermetadata.NewSynthetic(
+				tickermetadata.SyntheticTypeBasket,
+				tickermetadata.NewSyntheticComponent(ethusd, false, "1"),
+				tickermetadata.NewSyntheticComponent(ethbtc, false, "1"),
+			),
+			target:    slinkytypes.NewCurrencyPair("BASKET", "USD"),
+			expectErr: true,
+		},
+		{
+			name: "basket with a non-positive quantity",
+			synthetic: tickermetadata.NewSynthetic(
+				tickermetadata.SyntheticTypeBasket,
+				tickermetadata.NewSyntheticComponent(ethusd, false, "0"),
+			),
+			target:    slinkytypes.NewCurrencyPair("BASKET", "USD"),
+			expectErr: true,
+		},
+		{
+			name: "basket with an invalid quantity",
+			synthetic: tickermetadata.NewSynthetic(
+				tickermetadata.SyntheticTypeBasket,
+				tickermetadata.NewSyntheticComponent(ethusd, false, "one"),
+			),
+			target:    slinkytypes.NewCurrencyPair("BASKET", "USD"),
+			expectErr: true,
+		},
+		{
+			name: "basket with an inverted component",
+			synthetic: tickermetadata.NewSynthetic(
+				tickermetadata.SyntheticTypeBasket,
+				tickermetadata.NewSyntheticComponent(ethusd, true, "1"),
+			),
+			target:    slinkytypes.NewCurrencyPair("BASKET", "USD"),
+			expectErr: true,
+		},
+		{
+			name:      "valid index",
+			synthetic: indexSynthetic,
+			target:    slinkytypes.NewCurrencyPair("INDEX", "USD"),
+			expectErr: false,
+		},
+		{
+			name: "index with a component in a different quote",
+			synthetic: tickermetadata.NewSynthetic(
+				tickermetadata.SyntheticTypeIndex,
+				tickermetadata.NewSyntheticComponent(ethusd, false, ""),
+				tickermetadata.NewSyntheticComponent(ethbtc, false, ""),
+			),
+			target:    slinkytypes.NewCurrencyPair("INDEX", "USD"),
+			expectErr: true,
+		},
+		{
+			name: "index with a quantity",
+			synthetic: tickermetadata.NewSynthetic(
+				tickermetadata.SyntheticTypeIndex,
+				tickermetadata.NewSyntheticComponent(ethusd, false, "1"),
+			),
+			target:    slinkytypes.NewCurrencyPair("INDEX", "USD"),
+			expectErr: true,
+		},
+		{
+			name:      "no components",
+			synthetic: tickermetadata.NewSynthetic(tickermetadata.SyntheticTypeCross),
+			target:    ethbtc,
+			expectErr: true,
+		},
+		{
+			name: "duplicate components",
+			synthetic: tickermetadata.NewSynthetic(
+				tickermetadata.SyntheticTypeBasket,
+				tickermetadata.NewSyntheticComponent(ethusd, false, "1"),
+				tickermetadata.NewSyntheticComponent(ethusd, false, "2"),
+			),
+			target:    slinkytypes.NewCurrencyPair("BASKET", "USD"),
+			expectErr: true,
+		},
+		{
+			name: "component that is not formatted as a ticker",
+			synthetic: tickermetadata.Synthetic{
+				Type:       tickermetadata.SyntheticTypeCross,
+				Components: []tickermetadata.SyntheticComponent{{CurrencyPair: "eth/btc"}},
+			},
+			target:    ethbtc,
+			expectErr: true,
+		},
+		{
+			name: "unknown type",
+			synthetic: tickermetadata.NewSynthetic(
+				"product",
+				tickermetadata.NewSyntheticComponent(ethbtc, false, ""),
+			),
+			target:    ethbtc,
+			expectErr: true,
+		},
+		{
+			name: "components without a type",
+			synthetic: tickermetadata.NewSynthetic(
+				"",
+				tickermetadata.NewSyntheticComponent(ethbtc, false, ""),
+			),
+			target:    ethbtc,
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.synthetic.ValidateBasic(tc.target)
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
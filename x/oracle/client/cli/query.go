@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -26,6 +27,14 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetPriceCmd(),
 		GetAllCurrencyPairsCmd(),
+		GetPriceHistoryCmd(),
+		GetTWAPCmd(),
+		GetMedianOverWindowCmd(),
+		GetPriceStatusCmd(),
+		GetValidatorPerformanceCmd(),
+		GetValidatorPerformancesCmd(),
+		GetOracleKeyCmd(),
+		GetParamsCmd(),
 	)
 
 	return cmd
@@ -95,3 +104,308 @@ func GetAllCurrencyPairsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetPriceHistoryCmd returns the cli-command that queries the stored price history for a given CurrencyPair. An optional
+// limit restricts the response to the most recent prices.
+func GetPriceHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-history [base] [quote] [limit]",
+		Short: "Query for the stored price history of a specified currency-pair",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// get context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// retrieve CurrencyPair + limit from arguments
+			cp := slinkytypes.NewCurrencyPair(args[0], args[1])
+
+			var limit uint64
+			if len(args) == 3 {
+				limit, err = strconv.ParseUint(args[2], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid limit: %w", err)
+				}
+			}
+
+			// create client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the price history
+			res, err := qc.GetPriceHistory(cmd.Context(), &types.GetPriceHistoryRequest{
+				CurrencyPair: cp,
+				Limit:        limit,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetTWAPCmd returns the cli-command that queries the time-weighted average price of a given CurrencyPair over a
+// window of seconds ending at the latest block.
+func GetTWAPCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [base] [quote] [window-seconds]",
+		Short: "Query for the time-weighted average price of a specified currency-pair",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// get context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// retrieve CurrencyPair + window from arguments
+			cp := slinkytypes.NewCurrencyPair(args[0], args[1])
+			window, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid window: %w", err)
+			}
+
+			// create client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the twap
+			res, err := qc.GetTWAP(cmd.Context(), &types.GetTWAPRequest{
+				CurrencyPair:  cp,
+				WindowSeconds: window,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetMedianOverWindowCmd returns the cli-command that queries the median price of a given CurrencyPair over a window
+// of seconds ending at the latest block.
+func GetMedianOverWindowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "median [base] [quote] [window-seconds]",
+		Short: "Query for the median price of a specified currency-pair over a window",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// get context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// retrieve CurrencyPair + window from arguments
+			cp := slinkytypes.NewCurrencyPair(args[0], args[1])
+			window, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid window: %w", err)
+			}
+
+			// create client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the median
+			res, err := qc.GetMedianOverWindow(cmd.Context(), &types.GetMedianOverWindowRequest{
+				CurrencyPair:  cp,
+				WindowSeconds: window,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetPriceStatusCmd returns the cli-command that queries the price guardrail of a given CurrencyPair, and whether its
+// price is stale or halted.
+func GetPriceStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-status [base] [quote]",
+		Short: "Query for the price guardrail and stale / halted status of a specified currency-pair",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// get context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// create client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the price status
+			res, err := qc.GetPriceStatus(cmd.Context(), &types.GetPriceStatusRequest{
+				CurrencyPair: slinkytypes.NewCurrencyPair(args[0], args[1]),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetValidatorPerformanceCmd returns the cli-command that queries the oracle performance of a given validator
+// over a performance window, or the current window if no window is given.
+func GetValidatorPerformanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-performance [validator-cons-address] [window]",
+		Short: "Query for the oracle performance of a validator over a performance window",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// get context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			window, err := parseWindowArg(args[1:])
+			if err != nil {
+				return err
+			}
+
+			// create client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the validator performance
+			res, err := qc.GetValidatorPerformance(cmd.Context(), &types.GetValidatorPerformanceRequest{
+				Validator: args[0],
+				Window:    window,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetValidatorPerformancesCmd returns the cli-command that queries the oracle performances of all validators over
+// a performance window, or the current window if no window is given.
+func GetValidatorPerformancesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-performances [window]",
+		Short: "Query for the oracle performances of all validators over a performance window",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// get context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			window, err := parseWindowArg(args)
+			if err != nil {
+				return err
+			}
+
+			// create client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the validator performances
+			res, err := qc.GetValidatorPerformances(cmd.Context(), &types.GetValidatorPerformancesRequest{
+				Window: window,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetOracleKeyCmd returns the cli-command that queries the oracle key registered by a validator.
+func GetOracleKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "oracle-key [validator-cons-address]",
+		Short: "Query for the oracle key registered by a validator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// get context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// create client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the oracle key
+			res, err := qc.GetOracleKey(cmd.Context(), &types.GetOracleKeyRequest{
+				Validator: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetParamsCmd returns the cli-command that queries the x/oracle module parameters.
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query for the parameters of the module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			// get the context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// create a new query client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the params
+			res, err := qc.Params(cmd.Context(), &types.ParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseWindowArg parses the optional performance window argument, defaulting to the current window (0).
+func parseWindowArg(args []string) (uint64, error) {
+	if len(args) == 0 {
+		return 0, nil
+	}
+
+	window, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid window: %w", err)
+	}
+
+	return window, nil
+}
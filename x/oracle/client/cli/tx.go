@@ -0,0 +1,154 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/zoguxprotocol/slinky/pkg/proposal"
+	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
+	"github.com/zoguxprotocol/slinky/x/oracle/types"
+)
+
+// GetTxCmd returns the parent command for all x/oracle cli tx commands. Each command either signs and broadcasts
+// its message, or, with --proposal, prints an unsigned governance proposal wrapping the message.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Transaction commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		AddCurrencyPairsCmd(),
+		RemoveCurrencyPairsCmd(),
+		SetOracleKeyCmd(),
+	)
+
+	return cmd
+}
+
+// AddCurrencyPairsCmd returns the cli-command that builds a MsgAddCurrencyPairs for the given currency-pairs.
+func AddCurrencyPairsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "add-currency-pairs [currency-pair]...",
+		Short:   "Add the given currency-pairs (formatted as BASE/QUOTE) to the x/oracle module",
+		Example: "add-currency-pairs BTC/USD ETH/USD --proposal --title 'Add currency pairs' --summary 'Add BTC/USD and ETH/USD'",
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cps, err := currencyPairsFromArgs(args)
+			if err != nil {
+				return err
+			}
+
+			authority, err := proposal.GetAuthority(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddCurrencyPairs(authority, cps)
+			return proposal.GenerateOrBroadcastMsgs(cmd, clientCtx, &msg)
+		},
+	}
+
+	proposal.AddProposalFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// RemoveCurrencyPairsCmd returns the cli-command that builds a MsgRemoveCurrencyPairs for the given currency-pairs.
+func RemoveCurrencyPairsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-currency-pairs [currency-pair]...",
+		Short:   "Remove the given currency-pairs (formatted as BASE/QUOTE) from the x/oracle module",
+		Example: "remove-currency-pairs BTC/USD --proposal --title 'Remove currency pairs' --summary 'Remove BTC/USD'",
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cps, err := currencyPairsFromArgs(args)
+			if err != nil {
+				return err
+			}
+
+			ids := make([]string, len(cps))
+			for i, cp := range cps {
+				ids[i] = cp.String()
+			}
+
+			authority, err := proposal.GetAuthority(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveCurrencyPairs(authority, ids)
+			return proposal.GenerateOrBroadcastMsgs(cmd, clientCtx, &msg)
+		},
+	}
+
+	proposal.AddProposalFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// SetOracleKeyCmd returns the cli-command that registers the oracle key of the validator operated by the --from
+// account. The key is given in the JSON format of the public keys, e.g. as printed by `keys show --output json`.
+func SetOracleKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-oracle-key [pubkey]",
+		Short:   "Register the key the validator signs the prices of its vote extensions with",
+		Example: `set-oracle-key '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"..."}' --from validator`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var pubKey cryptotypes.PubKey
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[0]), &pubKey); err != nil {
+				return fmt.Errorf("invalid oracle key: %w", err)
+			}
+
+			msg, err := types.NewMsgSetOracleKey(sdk.ValAddress(clientCtx.GetFromAddress()), pubKey)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// currencyPairsFromArgs parses and validates currency-pairs formatted as BASE/QUOTE.
+func currencyPairsFromArgs(args []string) ([]slinkytypes.CurrencyPair, error) {
+	cps := make([]slinkytypes.CurrencyPair, len(args))
+	for i, arg := range args {
+		cp, err := slinkytypes.CurrencyPairFromString(arg)
+		if err != nil {
+			return nil, err
+		}
+
+		cps[i] = cp
+	}
+
+	return cps, nil
+}
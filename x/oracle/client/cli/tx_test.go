@@ -0,0 +1,90 @@
+package cli_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zoguxprotocol/slinky/pkg/proposal"
+	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
+	"github.com/zoguxprotocol/slinky/x/oracle"
+	"github.com/zoguxprotocol/slinky/x/oracle/client/cli"
+	"github.com/zoguxprotocol/slinky/x/oracle/types"
+)
+
+func TestTxCmdProposal(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(oracle.AppModuleBasic{})
+	clientCtx := client.Context{}.
+		WithCodec(encCfg.Codec).
+		WithInterfaceRegistry(encCfg.InterfaceRegistry).
+		WithTxConfig(encCfg.TxConfig)
+
+	govAddress := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name     string
+		args     []string
+		expected sdk.Msg
+		err      bool
+	}{
+		{
+			name: "add currency pairs",
+			args: []string{"add-currency-pairs", "BTC/USD", "eth/usd"},
+			expected: &types.MsgAddCurrencyPairs{
+				Authority: govAddress,
+				CurrencyPairs: []slinkytypes.CurrencyPair{
+					slinkytypes.NewCurrencyPair("BTC", "USD"),
+					slinkytypes.NewCurrencyPair("ETH", "USD"),
+				},
+			},
+		},
+		{
+			name: "remove currency pairs",
+			args: []string{"remove-currency-pairs", "BTC/USD"},
+			expected: &types.MsgRemoveCurrencyPairs{
+				Authority:       govAddress,
+				CurrencyPairIds: []string{"BTC/USD"},
+			},
+		},
+		{
+			name: "invalid currency pair",
+			args: []string{"add-currency-pairs", "BTCUSD"},
+			err:  true,
+		},
+		{
+			name: "invalid authority",
+			args: []string{"remove-currency-pairs", "BTC/USD", "--authority", "invalid"},
+			err:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			args := append(tc.args, "--proposal", "--title", "title", "--summary", "summary")
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetTxCmd(), args)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			var p proposal.Proposal
+			require.NoError(t, json.Unmarshal(out.Bytes(), &p))
+			require.Equal(t, "title", p.Title)
+			require.Equal(t, "summary", p.Summary)
+			require.Len(t, p.Messages, 1)
+
+			var msg sdk.Msg
+			require.NoError(t, encCfg.Codec.UnmarshalInterfaceJSON(p.Messages[0], &msg))
+			require.Equal(t, tc.expected, msg)
+		})
+	}
+}
@@ -7,9 +7,14 @@ import (
 )
 
 // BeginBlocker is called at the beginning of every block.  It resets the count of
-// removed currency pairs.
+// removed currency pairs and, if the price history length was lowered, prunes the
+// price history of every currency pair.
 func (k *Keeper) BeginBlocker(goCtx context.Context) error {
 	// unwrap the context
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	return k.numRemoves.Set(ctx, 0)
+	if err := k.numRemoves.Set(ctx, 0); err != nil {
+		return err
+	}
+
+	return k.PrunePriceHistory(ctx)
 }
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
@@ -17,6 +18,11 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 		panic(err)
 	}
 
+	// set the module params
+	if err := k.SetParams(ctx, gs.Params); err != nil {
+		panic(fmt.Errorf("error in genesis: %w", err))
+	}
+
 	// initialize all CurrencyPairs + genesis prices
 	for _, cpg := range gs.CurrencyPairGenesis {
 		state := types.NewCurrencyPairState(cpg.Id, cpg.Nonce, cpg.CurrencyPairPrice)
@@ -38,10 +44,53 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 	if err := k.numRemoves.Set(ctx, 0); err != nil {
 		panic(fmt.Errorf("error in genesis: %w", err))
 	}
+
+	// set the price guardrails + halted CurrencyPairs
+	for _, g := range gs.PriceGuardrails {
+		if err := k.SetPriceGuardrail(ctx, g.CurrencyPair, g.Guardrail); err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
+	}
+
+	for _, cp := range gs.HaltedCurrencyPairs {
+		if err := k.haltedCPs.Set(ctx, cp.String()); err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
+	}
+
+	// set the validator performance window + performances
+	if gs.PerformanceWindow != nil {
+		if err := k.performanceWindow.Set(ctx, *gs.PerformanceWindow); err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
+	}
+
+	for _, vp := range gs.ValidatorPerformances {
+		validator, err := sdk.ConsAddressFromBech32(vp.Validator)
+		if err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
+
+		if err := k.validatorPerformances.Set(ctx, collections.Join(vp.Window, validator), vp); err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
+	}
+
+	// set the oracle keys of the validators
+	for _, key := range gs.OracleKeys {
+		validator, err := sdk.ConsAddressFromBech32(key.Validator)
+		if err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
+
+		if err := k.oracleKeys.Set(ctx, validator, key); err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
+	}
 }
 
 // ExportGenesis retrieve all CurrencyPairs + QuotePrices set for the module, and return them as a genesis state.
-// This module panics on any errors encountered in execution.
+// The price history is not exported. This module panics on any errors encountered in execution.
 func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	// get the current next ID
 	id, err := k.nextCurrencyPairID.Peek(ctx)
@@ -49,10 +98,50 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(fmt.Errorf("error in genesis: %w", err))
 	}
 
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		panic(fmt.Errorf("error in genesis: %w", err))
+	}
+
+	guardrails, err := k.GetAllPriceGuardrails(ctx)
+	if err != nil {
+		panic(fmt.Errorf("error in genesis: %w", err))
+	}
+
+	halted, err := k.GetAllHaltedCurrencyPairs(ctx)
+	if err != nil {
+		panic(fmt.Errorf("error in genesis: %w", err))
+	}
+
+	window, err := k.GetPerformanceWindow(ctx)
+	if err != nil {
+		panic(fmt.Errorf("error in genesis: %w", err))
+	}
+
+	performances, err := k.GetAllValidatorPerformances(ctx)
+	if err != nil {
+		panic(fmt.Errorf("error in genesis: %w", err))
+	}
+
+	oracleKeys, err := k.GetAllOracleKeys(ctx)
+	if err != nil {
+		panic(fmt.Errorf("error in genesis: %w", err))
+	}
+
 	// instantiate genesis-state w/ empty array
 	gs := &types.GenesisState{
-		CurrencyPairGenesis: make([]types.CurrencyPairGenesis, 0),
-		NextId:              id,
+		CurrencyPairGenesis:   make([]types.CurrencyPairGenesis, 0),
+		NextId:                id,
+		Params:                params,
+		PriceGuardrails:       guardrails,
+		HaltedCurrencyPairs:   halted,
+		ValidatorPerformances: performances,
+		OracleKeys:            oracleKeys,
+	}
+
+	// only export the performance window if the performances have been tracked
+	if window.Window != 0 {
+		gs.PerformanceWindow = &window
 	}
 
 	// next, iterate over NonceKey to retrieve any CurrencyPairs that have not yet been traversed (CurrencyPairs w/ no Price info)
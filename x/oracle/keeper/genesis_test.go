@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
@@ -255,4 +256,36 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 			s.Require().Equal(id, cpg.Id)
 		}
 	})
+	s.Run("ExportGenesis with validator performances", func() {
+		vp := types.NewValidatorPerformance(sdk.ConsAddress("validator"), 2)
+		vp.Blocks = 1
+		gs := types.DefaultGenesisState()
+		gs.PerformanceWindow = &types.PerformanceWindow{Window: 2, StartHeight: 10}
+		gs.ValidatorPerformances = []types.ValidatorPerformance{vp}
+
+		s.oracleKeeper.InitGenesis(s.ctx, *gs)
+
+		egs := s.oracleKeeper.ExportGenesis(s.ctx)
+		s.Require().Equal(gs.PerformanceWindow, egs.PerformanceWindow)
+		s.Require().Equal(gs.ValidatorPerformances, egs.ValidatorPerformances)
+	})
+	s.Run("ExportGenesis with oracle keys", func() {
+		pubKey := ed25519.GenPrivKey().PubKey()
+		key, err := types.NewOracleKey(sdk.ConsAddress("validator"), pubKey, 10)
+		s.Require().NoError(err)
+
+		gs := types.DefaultGenesisState()
+		gs.OracleKeys = []types.OracleKey{key}
+
+		s.oracleKeeper.InitGenesis(s.ctx, *gs)
+
+		egs := s.oracleKeeper.ExportGenesis(s.ctx)
+		s.Require().Len(egs.OracleKeys, 1)
+		s.Require().Equal(key.Validator, egs.OracleKeys[0].Validator)
+		s.Require().Equal(key.Height, egs.OracleKeys[0].Height)
+
+		exported, err := egs.OracleKeys[0].OraclePubKey()
+		s.Require().NoError(err)
+		s.Require().True(pubKey.Equals(exported))
+	})
 }
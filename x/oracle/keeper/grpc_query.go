@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -145,3 +146,276 @@ func (q queryServer) GetCurrencyPairMappingList(ctx context.Context, _ *types.Ge
 
 	return &types.GetCurrencyPairMappingListResponse{Mappings: pairs}, nil
 }
+
+// GetPriceHistory returns the stored price history for a given CurrencyPair, ordered from oldest to newest. If a
+// limit is given, only the most recent limit prices are returned. This method fails if the CurrencyPair is not tracked
+// by the module.
+func (q queryServer) GetPriceHistory(goCtx context.Context, req *types.GetPriceHistoryRequest) (*types.GetPriceHistoryResponse, error) {
+	// fail on nil requests
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	cp := req.CurrencyPair
+	if err := cp.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid currency pair: %w", err)
+	}
+
+	// unwrap ctx
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	id, decimals, err := q.getIDAndDecimals(ctx, cp)
+	if err != nil {
+		return nil, err
+	}
+
+	prices, err := q.k.GetPriceHistory(ctx, cp)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Limit > 0 && uint64(len(prices)) > req.Limit {
+		prices = prices[uint64(len(prices))-req.Limit:]
+	}
+
+	return &types.GetPriceHistoryResponse{
+		Prices:   prices,
+		Decimals: decimals,
+		Id:       id,
+	}, nil
+}
+
+// GetTWAP returns the time-weighted average price for a given CurrencyPair over the requested window. This method
+// fails if the CurrencyPair is not tracked by the module, the window is zero, or no prices are stored for the
+// CurrencyPair.
+func (q queryServer) GetTWAP(goCtx context.Context, req *types.GetTWAPRequest) (*types.GetTWAPResponse, error) {
+	// fail on nil requests
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	cp := req.CurrencyPair
+	if err := cp.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid currency pair: %w", err)
+	}
+
+	// unwrap ctx
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	id, decimals, err := q.getIDAndDecimals(ctx, cp)
+	if err != nil {
+		return nil, err
+	}
+
+	price, err := q.k.GetTWAP(ctx, cp, time.Duration(req.WindowSeconds)*time.Second) //nolint:gosec
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetTWAPResponse{
+		Price:    price,
+		Decimals: decimals,
+		Id:       id,
+	}, nil
+}
+
+// GetMedianOverWindow returns the median price for a given CurrencyPair over the requested window. This method
+// fails if the CurrencyPair is not tracked by the module, the window is zero, or no prices are stored for the
+// CurrencyPair within the window.
+func (q queryServer) GetMedianOverWindow(goCtx context.Context, req *types.GetMedianOverWindowRequest) (*types.GetMedianOverWindowResponse, error) {
+	// fail on nil requests
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	cp := req.CurrencyPair
+	if err := cp.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid currency pair: %w", err)
+	}
+
+	// unwrap ctx
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	id, decimals, err := q.getIDAndDecimals(ctx, cp)
+	if err != nil {
+		return nil, err
+	}
+
+	price, numPrices, err := q.k.GetMedianOverWindow(ctx, cp, time.Duration(req.WindowSeconds)*time.Second) //nolint:gosec
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetMedianOverWindowResponse{
+		Price:     price,
+		Decimals:  decimals,
+		Id:        id,
+		NumPrices: numPrices,
+	}, nil
+}
+
+// GetPriceStatus returns the price guardrail of a given CurrencyPair, and whether its price is stale or halted.
+func (q queryServer) GetPriceStatus(goCtx context.Context, req *types.GetPriceStatusRequest) (*types.GetPriceStatusResponse, error) {
+	// fail on nil requests
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	cp := req.CurrencyPair
+	if err := cp.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid currency pair: %w", err)
+	}
+
+	// unwrap ctx
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	guardrail, err := q.k.GetPriceGuardrail(ctx, cp)
+	if err != nil {
+		return nil, err
+	}
+
+	stale, err := q.k.IsPriceStale(ctx, cp)
+	if err != nil {
+		return nil, err
+	}
+
+	halted, err := q.k.IsPriceHalted(ctx, cp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetPriceStatusResponse{
+		Guardrail: guardrail,
+		Stale:     stale,
+		Halted:    halted,
+	}, nil
+}
+
+// GetValidatorPerformance returns the oracle performance of a given validator over a given performance window,
+// or the current window if the window is 0.
+func (q queryServer) GetValidatorPerformance(goCtx context.Context, req *types.GetValidatorPerformanceRequest) (*types.GetValidatorPerformanceResponse, error) {
+	// fail on nil requests
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	validator, err := sdk.ConsAddressFromBech32(req.Validator)
+	if err != nil {
+		return nil, fmt.Errorf("invalid validator address: %w", err)
+	}
+
+	// unwrap ctx
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	window, err := q.getPerformanceWindow(ctx, req.Window)
+	if err != nil {
+		return nil, err
+	}
+
+	performance, err := q.k.GetValidatorPerformance(ctx, validator, window)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetValidatorPerformanceResponse{
+		Performance:        performance,
+		MedianDeviationBps: performance.MedianDeviationBps(),
+	}, nil
+}
+
+// GetValidatorPerformances returns the oracle performances of all validators over a given performance window, or
+// the current window if the window is 0.
+func (q queryServer) GetValidatorPerformances(goCtx context.Context, req *types.GetValidatorPerformancesRequest) (*types.GetValidatorPerformancesResponse, error) {
+	// fail on nil requests
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	// unwrap ctx
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	window, err := q.getPerformanceWindow(ctx, req.Window)
+	if err != nil {
+		return nil, err
+	}
+
+	performances, err := q.k.GetValidatorPerformances(ctx, window)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetValidatorPerformancesResponse{
+		Window:       window,
+		Performances: performances,
+	}, nil
+}
+
+// GetOracleKey returns the oracle key registered by a given validator, failing if the validator has not registered
+// an oracle key.
+func (q queryServer) GetOracleKey(goCtx context.Context, req *types.GetOracleKeyRequest) (*types.GetOracleKeyResponse, error) {
+	// fail on nil requests
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	validator, err := sdk.ConsAddressFromBech32(req.Validator)
+	if err != nil {
+		return nil, fmt.Errorf("invalid validator address %s: %w", req.Validator, err)
+	}
+
+	key, err := q.k.GetOracleKey(sdk.UnwrapSDKContext(goCtx), validator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetOracleKeyResponse{OracleKey: key}, nil
+}
+
+// Params returns the parameters stored in the x/oracle module.
+func (q queryServer) Params(goCtx context.Context, req *types.ParamsRequest) (*types.ParamsResponse, error) {
+	// fail on nil requests
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	params, err := q.k.GetParams(sdk.UnwrapSDKContext(goCtx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ParamsResponse{Params: params}, nil
+}
+
+// getIDAndDecimals returns the ID and decimals of a given CurrencyPair, failing if the CurrencyPair is not tracked.
+func (q queryServer) getIDAndDecimals(ctx sdk.Context, cp slinkytypes.CurrencyPair) (uint64, uint64, error) {
+	id, ok := q.k.GetIDForCurrencyPair(ctx, cp)
+	if !ok {
+		return 0, 0, fmt.Errorf("no ID found for CurrencyPair: %s", cp.String())
+	}
+
+	decimals, err := q.k.GetDecimalsForCurrencyPair(ctx, cp)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return id, decimals, nil
+}
+
+// getPerformanceWindow returns the given performance window, or the current window if the window is 0, failing
+// if the validator performances have never been tracked.
+func (q queryServer) getPerformanceWindow(ctx sdk.Context, window uint64) (uint64, error) {
+	if window != 0 {
+		return window, nil
+	}
+
+	current, err := q.k.GetPerformanceWindow(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if current.Window == 0 {
+		return 0, fmt.Errorf("validator performances are not tracked")
+	}
+
+	return current.Window, nil
+}
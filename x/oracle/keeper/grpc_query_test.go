@@ -1,7 +1,12 @@
 package keeper_test
 
 import (
+	"math/big"
+	"time"
+
 	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/mock"
 
 	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
@@ -273,3 +278,167 @@ func (s *KeeperTestSuite) TestGetCurrencyPairMappingListGRPC() {
 		}, res.Mappings)
 	})
 }
+
+func (s *KeeperTestSuite) TestPriceHistoryGRPC() {
+	start := time.Unix(1_000, 0).UTC()
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, historyCP))
+	s.setHistoricalPrices(start, 100, 200, 300, 400)
+
+	s.mockMarketMapKeeper.On("GetMarket", mock.Anything, historyCP.String()).Return(marketmaptypes.Market{
+		Ticker: marketmaptypes.Ticker{
+			CurrencyPair: historyCP,
+			Decimals:     8,
+		},
+	}, nil)
+
+	ctx := s.ctx.WithBlockTime(start.Add(4 * time.Second))
+	qs := keeper.NewQueryServer(s.oracleKeeper)
+
+	s.Run("get price history with a limit", func() {
+		res, err := qs.GetPriceHistory(ctx, &types.GetPriceHistoryRequest{CurrencyPair: historyCP, Limit: 2})
+		s.Require().NoError(err)
+		s.Require().Len(res.Prices, 2)
+		s.Require().Equal(sdkmath.NewInt(300), res.Prices[0].Price)
+		s.Require().Equal(sdkmath.NewInt(400), res.Prices[1].Price)
+		s.Require().Equal(uint64(8), res.Decimals)
+	})
+
+	s.Run("get price history for an unknown currency pair", func() {
+		_, err := qs.GetPriceHistory(ctx, &types.GetPriceHistoryRequest{CurrencyPair: slinkytypes.NewCurrencyPair("DD", "EE")})
+		s.Require().Error(err)
+	})
+
+	s.Run("get twap", func() {
+		res, err := qs.GetTWAP(ctx, &types.GetTWAPRequest{CurrencyPair: historyCP, WindowSeconds: 2})
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(350), res.Price)
+	})
+
+	s.Run("get twap with a zero window", func() {
+		_, err := qs.GetTWAP(ctx, &types.GetTWAPRequest{CurrencyPair: historyCP})
+		s.Require().Error(err)
+	})
+
+	s.Run("get median over window", func() {
+		res, err := qs.GetMedianOverWindow(ctx, &types.GetMedianOverWindowRequest{CurrencyPair: historyCP, WindowSeconds: 4})
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(250), res.Price)
+		s.Require().Equal(uint64(4), res.NumPrices)
+	})
+
+	s.Run("get params", func() {
+		res, err := qs.Params(ctx, &types.ParamsRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal(types.DefaultParams(), res.Params)
+	})
+}
+
+func (s *KeeperTestSuite) TestGetPriceStatusGRPC() {
+	cp := slinkytypes.NewCurrencyPair("ETH", "USD")
+	start := time.Unix(1_000, 0).UTC()
+	guardrail := types.NewPriceGuardrail(100_000, time.Minute, false)
+
+	s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, cp, types.QuotePrice{
+		Price:          sdkmath.NewInt(100),
+		BlockTimestamp: start,
+	}))
+	s.Require().NoError(s.oracleKeeper.SetPriceGuardrail(s.ctx, cp, guardrail))
+
+	qs := keeper.NewQueryServer(s.oracleKeeper)
+
+	s.Run("fresh price", func() {
+		res, err := qs.GetPriceStatus(s.ctx.WithBlockTime(start.Add(time.Second)), &types.GetPriceStatusRequest{CurrencyPair: cp})
+		s.Require().NoError(err)
+		s.Require().Equal(guardrail, res.Guardrail)
+		s.Require().False(res.Stale)
+		s.Require().False(res.Halted)
+	})
+
+	s.Run("stale price", func() {
+		res, err := qs.GetPriceStatus(s.ctx.WithBlockTime(start.Add(time.Hour)), &types.GetPriceStatusRequest{CurrencyPair: cp})
+		s.Require().NoError(err)
+		s.Require().True(res.Stale)
+		s.Require().True(res.Halted)
+	})
+
+	s.Run("unknown currency pair", func() {
+		_, err := qs.GetPriceStatus(s.ctx, &types.GetPriceStatusRequest{CurrencyPair: slinkytypes.NewCurrencyPair("DD", "EE")})
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestValidatorPerformanceGRPC() {
+	qs := keeper.NewQueryServer(s.oracleKeeper)
+	validator := sdk.ConsAddress("validator")
+
+	s.Run("performances are not tracked", func() {
+		_, err := qs.GetValidatorPerformances(s.ctx, &types.GetValidatorPerformancesRequest{})
+		s.Require().Error(err)
+	})
+
+	s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.NewParamsWithPerformanceTracking(0, 10, 1)))
+	s.Require().NoError(s.oracleKeeper.TrackValidatorPerformance(
+		s.ctx.WithBlockHeight(1),
+		[]types.ValidatorReport{{
+			Validator: validator,
+			Prices:    map[slinkytypes.CurrencyPair]*big.Int{performanceBTC: big.NewInt(101)},
+		}},
+		nil,
+		map[slinkytypes.CurrencyPair]*big.Int{performanceBTC: big.NewInt(100)},
+	))
+
+	s.Run("get the performance of a validator in the current window", func() {
+		res, err := qs.GetValidatorPerformance(s.ctx, &types.GetValidatorPerformanceRequest{Validator: validator.String()})
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(1), res.Performance.Window)
+		s.Require().Equal(uint64(1), res.Performance.Reports)
+		s.Require().Equal(uint64(100), res.MedianDeviationBps)
+	})
+
+	s.Run("get the performances of all validators in a window", func() {
+		res, err := qs.GetValidatorPerformances(s.ctx, &types.GetValidatorPerformancesRequest{Window: 1})
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(1), res.Window)
+		s.Require().Len(res.Performances, 1)
+		s.Require().Equal(validator.String(), res.Performances[0].Validator)
+	})
+
+	s.Run("invalid validator address", func() {
+		_, err := qs.GetValidatorPerformance(s.ctx, &types.GetValidatorPerformanceRequest{Validator: "invalid"})
+		s.Require().Error(err)
+	})
+
+	s.Run("unknown window", func() {
+		_, err := qs.GetValidatorPerformance(s.ctx, &types.GetValidatorPerformanceRequest{Validator: validator.String(), Window: 2})
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestOracleKeyGRPC() {
+	qs := keeper.NewQueryServer(s.oracleKeeper)
+	validator := sdk.ConsAddress("validator")
+
+	s.Run("no oracle key registered", func() {
+		_, err := qs.GetOracleKey(s.ctx, &types.GetOracleKeyRequest{Validator: validator.String()})
+		s.Require().Error(err)
+	})
+
+	pubKey := ed25519.GenPrivKey().PubKey()
+	s.Require().NoError(s.oracleKeeper.SetOracleKey(s.ctx.WithBlockHeight(10), validator, pubKey))
+
+	s.Run("get the oracle key of a validator", func() {
+		res, err := qs.GetOracleKey(s.ctx, &types.GetOracleKeyRequest{Validator: validator.String()})
+		s.Require().NoError(err)
+		s.Require().Equal(validator.String(), res.OracleKey.Validator)
+		s.Require().Equal(int64(10), res.OracleKey.Height)
+
+		pk, err := res.OracleKey.OraclePubKey()
+		s.Require().NoError(err)
+		s.Require().True(pubKey.Equals(pk))
+	})
+
+	s.Run("invalid validator address", func() {
+		_, err := qs.GetOracleKey(s.ctx, &types.GetOracleKeyRequest{Validator: "invalid"})
+		s.Require().Error(err)
+	})
+}
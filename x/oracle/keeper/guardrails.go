@@ -0,0 +1,203 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
+	"github.com/zoguxprotocol/slinky/x/oracle/types"
+)
+
+// SetPriceGuardrail sets the price guardrail of a given CurrencyPair. Setting an empty guardrail removes the
+// CurrencyPair's guardrail, and un-halts the CurrencyPair. This method fails if the CurrencyPair does not exist.
+func (k *Keeper) SetPriceGuardrail(ctx sdk.Context, cp slinkytypes.CurrencyPair, guardrail types.PriceGuardrail) error {
+	if !k.HasCurrencyPair(ctx, cp) {
+		return types.NewCurrencyPairNotExistError(cp)
+	}
+
+	if guardrail.IsEmpty() {
+		if err := k.priceGuardrails.Remove(ctx, cp.String()); err != nil {
+			return err
+		}
+
+		return k.unhaltCurrencyPair(ctx, cp)
+	}
+
+	return k.priceGuardrails.Set(ctx, cp.String(), guardrail)
+}
+
+// GetPriceGuardrail returns the price guardrail of a given CurrencyPair. If no guardrail is set, an empty
+// guardrail is returned.
+func (k *Keeper) GetPriceGuardrail(ctx sdk.Context, cp slinkytypes.CurrencyPair) (types.PriceGuardrail, error) {
+	guardrail, err := k.priceGuardrails.Get(ctx, cp.String())
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.PriceGuardrail{}, nil
+		}
+
+		return types.PriceGuardrail{}, err
+	}
+
+	return guardrail, nil
+}
+
+// GetPriceQuorum returns the minimum voting power, in parts-per-million of the total bonded stake, and the
+// minimum number of validators that must report a price for a given CurrencyPair for its price to be updated,
+// as set by its price guardrail. Zero values mean that the CurrencyPair has no such requirement.
+func (k *Keeper) GetPriceQuorum(ctx sdk.Context, cp slinkytypes.CurrencyPair) (uint64, uint64, error) {
+	guardrail, err := k.GetPriceGuardrail(ctx, cp)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return guardrail.MinVotingPowerPpm, guardrail.MinValidators, nil
+}
+
+// GetAllPriceGuardrails returns the price guardrails of all CurrencyPairs that have one.
+func (k *Keeper) GetAllPriceGuardrails(ctx sdk.Context) ([]types.CurrencyPairGuardrail, error) {
+	it, err := k.priceGuardrails.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	guardrails := make([]types.CurrencyPairGuardrail, 0)
+	for ; it.Valid(); it.Next() {
+		kv, err := it.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+
+		cp, err := slinkytypes.CurrencyPairFromString(kv.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		guardrails = append(guardrails, types.CurrencyPairGuardrail{
+			CurrencyPair: cp,
+			Guardrail:    kv.Value,
+		})
+	}
+
+	return guardrails, nil
+}
+
+// GetAllHaltedCurrencyPairs returns all CurrencyPairs whose latest price update was rejected by their guardrail.
+func (k *Keeper) GetAllHaltedCurrencyPairs(ctx sdk.Context) ([]slinkytypes.CurrencyPair, error) {
+	it, err := k.haltedCPs.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := it.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	cps := make([]slinkytypes.CurrencyPair, len(keys))
+	for i, key := range keys {
+		cp, err := slinkytypes.CurrencyPairFromString(key)
+		if err != nil {
+			return nil, err
+		}
+
+		cps[i] = cp
+	}
+
+	return cps, nil
+}
+
+// ApplyPriceGuardrail checks the given QuotePrice for a CurrencyPair against the CurrencyPair's price guardrail,
+// before it is written to state. It returns the QuotePrice to write, and whether the QuotePrice should be written
+// at all. If the price moved further from the latest price than the guardrail allows, a breach event is emitted
+// and the price is either clamped to the maximum allowed move, or rejected. A rejected price halts the
+// CurrencyPair until a price is accepted again.
+func (k *Keeper) ApplyPriceGuardrail(ctx sdk.Context, cp slinkytypes.CurrencyPair, qp types.QuotePrice) (types.QuotePrice, bool, error) {
+	guardrail, err := k.GetPriceGuardrail(ctx, cp)
+	if err != nil {
+		return types.QuotePrice{}, false, err
+	}
+
+	cps, err := k.currencyPairs.Get(ctx, cp.String())
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.QuotePrice{}, false, err
+	}
+
+	// the first price of a CurrencyPair is always accepted
+	if cps.Price == nil {
+		return qp, true, nil
+	}
+
+	price, breached := guardrail.CheckPriceChange(*cps.Price, qp)
+	if !breached {
+		return qp, true, k.unhaltCurrencyPair(ctx, cp)
+	}
+
+	action := types.AttributeValueActionRejected
+	if guardrail.Clamp {
+		action = types.AttributeValueActionClamped
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePriceGuardrailBreach,
+			sdk.NewAttribute(types.AttributeKeyCurrencyPair, cp.String()),
+			sdk.NewAttribute(types.AttributeKeyPreviousPrice, cps.Price.Price.String()),
+			sdk.NewAttribute(types.AttributeKeyReportedPrice, qp.Price.String()),
+			sdk.NewAttribute(types.AttributeKeyAppliedPrice, price.String()),
+			sdk.NewAttribute(types.AttributeKeyAction, action),
+		),
+	)
+
+	if !guardrail.Clamp {
+		return types.QuotePrice{}, false, k.haltedCPs.Set(ctx, cp.String())
+	}
+
+	qp.Price = price
+	return qp, true, k.unhaltCurrencyPair(ctx, cp)
+}
+
+// unhaltCurrencyPair un-halts a given CurrencyPair. The halted state is checked first so that the common case,
+// a CurrencyPair that is not halted, does not write to the store.
+func (k *Keeper) unhaltCurrencyPair(ctx sdk.Context, cp slinkytypes.CurrencyPair) error {
+	halted, err := k.haltedCPs.Has(ctx, cp.String())
+	if err != nil || !halted {
+		return err
+	}
+
+	return k.haltedCPs.Remove(ctx, cp.String())
+}
+
+// IsPriceStale returns true if the latest price of a given CurrencyPair is older than the max price age of
+// the CurrencyPair's guardrail at the current block time, or if the CurrencyPair has no price. CurrencyPairs
+// without a max price age are never stale.
+func (k *Keeper) IsPriceStale(ctx sdk.Context, cp slinkytypes.CurrencyPair) (bool, error) {
+	guardrail, err := k.GetPriceGuardrail(ctx, cp)
+	if err != nil {
+		return false, err
+	}
+
+	cps, err := k.currencyPairs.Get(ctx, cp.String())
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return false, types.NewCurrencyPairNotExistError(cp)
+		}
+
+		return false, err
+	}
+
+	return guardrail.IsStale(cps.Price, ctx.BlockTime()), nil
+}
+
+// IsPriceHalted returns true if the price of a given CurrencyPair should not be used by consumers, i.e. if
+// the price is stale, or if the latest price update was rejected by the CurrencyPair's guardrail.
+func (k *Keeper) IsPriceHalted(ctx sdk.Context, cp slinkytypes.CurrencyPair) (bool, error) {
+	stale, err := k.IsPriceStale(ctx, cp)
+	if err != nil || stale {
+		return stale, err
+	}
+
+	return k.haltedCPs.Has(ctx, cp.String())
+}
@@ -0,0 +1,164 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
+	"github.com/zoguxprotocol/slinky/x/oracle/types"
+)
+
+var guardrailCP = slinkytypes.NewCurrencyPair("ETH", "USD")
+
+func (s *KeeperTestSuite) TestSetPriceGuardrail() {
+	guardrail := types.NewPriceGuardrail(100_000, time.Minute, false)
+
+	s.Run("setting a guardrail for an unknown currency pair fails", func() {
+		s.Require().Error(s.oracleKeeper.SetPriceGuardrail(s.ctx, guardrailCP, guardrail))
+	})
+
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, guardrailCP))
+
+	s.Run("set and get a guardrail", func() {
+		s.Require().NoError(s.oracleKeeper.SetPriceGuardrail(s.ctx, guardrailCP, guardrail))
+
+		got, err := s.oracleKeeper.GetPriceGuardrail(s.ctx, guardrailCP)
+		s.Require().NoError(err)
+		s.Require().Equal(guardrail, got)
+
+		all, err := s.oracleKeeper.GetAllPriceGuardrails(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal([]types.CurrencyPairGuardrail{{CurrencyPair: guardrailCP, Guardrail: guardrail}}, all)
+	})
+
+	s.Run("setting an empty guardrail removes it", func() {
+		s.Require().NoError(s.oracleKeeper.SetPriceGuardrail(s.ctx, guardrailCP, types.PriceGuardrail{}))
+
+		got, err := s.oracleKeeper.GetPriceGuardrail(s.ctx, guardrailCP)
+		s.Require().NoError(err)
+		s.Require().True(got.IsEmpty())
+	})
+
+	s.Run("removing a currency pair removes its guardrail", func() {
+		s.Require().NoError(s.oracleKeeper.SetPriceGuardrail(s.ctx, guardrailCP, guardrail))
+		s.Require().NoError(s.oracleKeeper.RemoveCurrencyPair(s.ctx, guardrailCP))
+
+		all, err := s.oracleKeeper.GetAllPriceGuardrails(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(all)
+	})
+}
+
+func (s *KeeperTestSuite) TestGetPriceQuorum() {
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, guardrailCP))
+
+	s.Run("currency pair without guardrail has no quorum", func() {
+		minVotingPowerPpm, minValidators, err := s.oracleKeeper.GetPriceQuorum(s.ctx, guardrailCP)
+		s.Require().NoError(err)
+		s.Require().Zero(minVotingPowerPpm)
+		s.Require().Zero(minValidators)
+	})
+
+	s.Run("quorum is set by the guardrail", func() {
+		guardrail := types.NewPriceGuardrail(0, 0, false).WithQuorum(800_000, 5)
+		s.Require().NoError(s.oracleKeeper.SetPriceGuardrail(s.ctx, guardrailCP, guardrail))
+
+		minVotingPowerPpm, minValidators, err := s.oracleKeeper.GetPriceQuorum(s.ctx, guardrailCP)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(800_000), minVotingPowerPpm)
+		s.Require().Equal(uint64(5), minValidators)
+	})
+
+	s.Run("quorum-only guardrails do not restrict price changes", func() {
+		qp := types.QuotePrice{Price: sdkmath.NewInt(100), BlockHeight: 1}
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, guardrailCP, qp))
+
+		next := types.QuotePrice{Price: sdkmath.NewInt(10_000), BlockHeight: 2}
+		applied, accepted, err := s.oracleKeeper.ApplyPriceGuardrail(s.ctx, guardrailCP, next)
+		s.Require().NoError(err)
+		s.Require().True(accepted)
+		s.Require().Equal(next, applied)
+	})
+}
+
+func (s *KeeperTestSuite) TestApplyPriceGuardrail() {
+	start := time.Unix(1_000, 0).UTC()
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, guardrailCP))
+
+	quotePrice := func(price int64, height uint64) types.QuotePrice {
+		return types.QuotePrice{
+			Price:          sdkmath.NewInt(price),
+			BlockTimestamp: start.Add(time.Duration(height) * time.Second), //nolint:gosec
+			BlockHeight:    height,
+		}
+	}
+
+	s.Run("prices are accepted without a guardrail", func() {
+		qp, accepted, err := s.oracleKeeper.ApplyPriceGuardrail(s.ctx, guardrailCP, quotePrice(1_000, 1))
+		s.Require().NoError(err)
+		s.Require().True(accepted)
+		s.Require().Equal(quotePrice(1_000, 1), qp)
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, guardrailCP, qp))
+	})
+
+	s.Require().NoError(s.oracleKeeper.SetPriceGuardrail(s.ctx, guardrailCP, types.NewPriceGuardrail(100_000, 5*time.Second, false)))
+
+	s.Run("a price beyond the max price change is rejected and halts the currency pair", func() {
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		_, accepted, err := s.oracleKeeper.ApplyPriceGuardrail(ctx, guardrailCP, quotePrice(2_000, 2))
+		s.Require().NoError(err)
+		s.Require().False(accepted)
+
+		halted, err := s.oracleKeeper.IsPriceHalted(ctx, guardrailCP)
+		s.Require().NoError(err)
+		s.Require().True(halted)
+
+		events := ctx.EventManager().Events()
+		s.Require().Len(events, 1)
+		s.Require().Equal(types.EventTypePriceGuardrailBreach, events[0].Type)
+		action, ok := events[0].GetAttribute(types.AttributeKeyAction)
+		s.Require().True(ok)
+		s.Require().Equal(types.AttributeValueActionRejected, action.Value)
+	})
+
+	s.Run("an accepted price un-halts the currency pair", func() {
+		qp, accepted, err := s.oracleKeeper.ApplyPriceGuardrail(s.ctx, guardrailCP, quotePrice(1_050, 2))
+		s.Require().NoError(err)
+		s.Require().True(accepted)
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, guardrailCP, qp))
+
+		halted, err := s.oracleKeeper.IsPriceHalted(s.ctx.WithBlockTime(start.Add(3*time.Second)), guardrailCP)
+		s.Require().NoError(err)
+		s.Require().False(halted)
+	})
+
+	s.Run("a price beyond the max price change is clamped", func() {
+		s.Require().NoError(s.oracleKeeper.SetPriceGuardrail(s.ctx, guardrailCP, types.NewPriceGuardrail(100_000, 5*time.Second, true)))
+
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		qp, accepted, err := s.oracleKeeper.ApplyPriceGuardrail(ctx, guardrailCP, quotePrice(2_000, 3))
+		s.Require().NoError(err)
+		s.Require().True(accepted)
+		s.Require().Equal(sdkmath.NewInt(1_155), qp.Price)
+
+		events := ctx.EventManager().Events()
+		s.Require().Len(events, 1)
+		action, ok := events[0].GetAttribute(types.AttributeKeyAction)
+		s.Require().True(ok)
+		s.Require().Equal(types.AttributeValueActionClamped, action.Value)
+	})
+
+	s.Run("a price older than the max price age is stale and halted", func() {
+		ctx := s.ctx.WithBlockTime(start.Add(time.Minute))
+
+		stale, err := s.oracleKeeper.IsPriceStale(ctx, guardrailCP)
+		s.Require().NoError(err)
+		s.Require().True(stale)
+
+		halted, err := s.oracleKeeper.IsPriceHalted(ctx, guardrailCP)
+		s.Require().NoError(err)
+		s.Require().True(halted)
+	})
+}
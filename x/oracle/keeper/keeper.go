@@ -51,7 +51,8 @@ type Keeper struct {
 	cdc          codec.BinaryCodec
 
 	// expected keepers
-	mmKeeper types.MarketMapKeeper
+	mmKeeper      types.MarketMapKeeper
+	stakingKeeper types.StakingKeeper
 
 	// schema
 	nextCurrencyPairID collections.Sequence
@@ -67,6 +68,34 @@ type Keeper struct {
 	// numCPs is the number of CPs.
 	numCPs collections.Item[uint64]
 
+	// params are the module parameters.
+	params collections.Item[types.Params]
+
+	// priceHistory is the bounded history of prices for each CP, i.e. (id, nonce) -> QuotePrice.
+	priceHistory collections.Map[collections.Pair[uint64, uint64], types.QuotePrice]
+
+	// prunedPriceHistoryLength is the price history length that the history of every CP was last pruned to.
+	prunedPriceHistoryLength collections.Item[uint64]
+
+	// priceGuardrails are the price guardrails of each CP, i.e. CurrencyPair.String() -> PriceGuardrail.
+	priceGuardrails collections.Map[string, types.PriceGuardrail]
+
+	// haltedCPs are the CPs whose latest price update was rejected by their guardrail.
+	haltedCPs collections.KeySet[string]
+
+	// performanceWindow is the current validator performance window.
+	performanceWindow collections.Item[types.PerformanceWindow]
+
+	// validatorPerformances are the validator performances of the current and retained windows, i.e.
+	// (window, validator) -> ValidatorPerformance.
+	validatorPerformances collections.Map[collections.Pair[uint64, sdk.ConsAddress], types.ValidatorPerformance]
+
+	// performanceHooks are called after each completed performance window.
+	performanceHooks types.ValidatorPerformanceHooks
+
+	// oracleKeys are the oracle keys registered by the validators, i.e. validator -> OracleKey.
+	oracleKeys collections.Map[sdk.ConsAddress, types.OracleKey]
+
 	// module authority
 	authority sdk.AccAddress
 }
@@ -89,15 +118,24 @@ func NewKeeper(
 	}
 
 	k := Keeper{
-		storeService:       ss,
-		cdc:                cdc,
-		authority:          authority,
-		mmKeeper:           mmKeeper,
-		numRemoves:         collections.NewItem[uint64](sb, types.NumRemovesKeyPrefix, "removed_cps", types.CounterCodec),
-		numCPs:             collections.NewItem[uint64](sb, types.NumCPsKeyPrefix, "num_cps", types.CounterCodec),
-		nextCurrencyPairID: collections.NewSequence(sb, types.CurrencyPairIDKeyPrefix, "currency_pair_id"),
-		currencyPairs:      collections.NewIndexedMap(sb, types.CurrencyPairKeyPrefix, "currency_pair", collections.StringKey, codec.CollValue[types.CurrencyPairState](cdc), indices),
-		idIndex:            idMulti,
+		storeService:             ss,
+		cdc:                      cdc,
+		authority:                authority,
+		mmKeeper:                 mmKeeper,
+		numRemoves:               collections.NewItem[uint64](sb, types.NumRemovesKeyPrefix, "removed_cps", types.CounterCodec),
+		numCPs:                   collections.NewItem[uint64](sb, types.NumCPsKeyPrefix, "num_cps", types.CounterCodec),
+		params:                   collections.NewItem[types.Params](sb, types.ParamsKeyPrefix, "params", codec.CollValue[types.Params](cdc)),
+		priceHistory:             collections.NewMap(sb, types.PriceHistoryKeyPrefix, "price_history", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), codec.CollValue[types.QuotePrice](cdc)),
+		prunedPriceHistoryLength: collections.NewItem[uint64](sb, types.PrunedPriceHistoryLengthKeyPrefix, "pruned_price_history_length", types.CounterCodec),
+		priceGuardrails:          collections.NewMap(sb, types.PriceGuardrailKeyPrefix, "price_guardrails", collections.StringKey, codec.CollValue[types.PriceGuardrail](cdc)),
+		haltedCPs:                collections.NewKeySet(sb, types.HaltedCurrencyPairKeyPrefix, "halted_currency_pairs", collections.StringKey),
+		performanceWindow:        collections.NewItem(sb, types.PerformanceWindowKeyPrefix, "performance_window", codec.CollValue[types.PerformanceWindow](cdc)),
+		validatorPerformances:    collections.NewMap(sb, types.ValidatorPerformanceKeyPrefix, "validator_performances", collections.PairKeyCodec(collections.Uint64Key, sdk.ConsAddressKey), codec.CollValue[types.ValidatorPerformance](cdc)),
+		performanceHooks:         &types.NoopValidatorPerformanceHooks{},
+		oracleKeys:               collections.NewMap(sb, types.OracleKeyKeyPrefix, "oracle_keys", sdk.ConsAddressKey, codec.CollValue[types.OracleKey](cdc)),
+		nextCurrencyPairID:       collections.NewSequence(sb, types.CurrencyPairIDKeyPrefix, "currency_pair_id"),
+		currencyPairs:            collections.NewIndexedMap(sb, types.CurrencyPairKeyPrefix, "currency_pair", collections.StringKey, codec.CollValue[types.CurrencyPairState](cdc), indices),
+		idIndex:                  idMulti,
 	}
 
 	// create the schema
@@ -110,16 +148,26 @@ func NewKeeper(
 	return k
 }
 
-// RemoveCurrencyPair removes a given CurrencyPair from state, i.e. removes its nonce + QuotePrice from the module's store.
+// RemoveCurrencyPair removes a given CurrencyPair from state, i.e. removes its nonce + QuotePrice + price history + guardrail from the module's store.
 func (k *Keeper) RemoveCurrencyPair(ctx sdk.Context, cp slinkytypes.CurrencyPair) error {
 	// check if the currency pair exists.
-	if !k.HasCurrencyPair(ctx, cp) {
+	cps, err := k.currencyPairs.Get(ctx, cp.String())
+	if err != nil {
 		return types.NewCurrencyPairNotExistError(cp)
 	}
 
 	if err := k.currencyPairs.Remove(ctx, cp.String()); err != nil {
 		return err
 	}
+	if err := k.prunePriceHistory(ctx, cps.Id, nil); err != nil {
+		return err
+	}
+	if err := k.priceGuardrails.Remove(ctx, cp.String()); err != nil {
+		return err
+	}
+	if err := k.haltedCPs.Remove(ctx, cp.String()); err != nil {
+		return err
+	}
 	if err := k.incrementRemovedCPCounter(ctx); err != nil {
 		return err
 	}
@@ -211,7 +259,12 @@ func (k *Keeper) SetPriceForCurrencyPair(ctx sdk.Context, cp slinkytypes.Currenc
 	}
 
 	// set the updated state
-	return k.currencyPairs.Set(ctx, cp.String(), cps)
+	if err := k.currencyPairs.Set(ctx, cp.String(), cps); err != nil {
+		return err
+	}
+
+	// record the price in the currency-pair's price history
+	return k.appendPriceHistory(ctx, cps.Id, cps.Nonce, qp)
 }
 
 // CreateCurrencyPair creates a CurrencyPair in state, and sets its ID to the next available ID. If the CurrencyPair already exists, return an error.
@@ -406,6 +459,16 @@ func (k *Keeper) decrementCPCounter(ctx sdk.Context) error {
 	return k.numCPs.Set(ctx, val)
 }
 
+// SetParams sets the x/oracle module's parameters.
+func (k *Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	return k.params.Set(ctx, params)
+}
+
+// GetParams returns the x/oracle module's parameters.
+func (k *Keeper) GetParams(ctx sdk.Context) (types.Params, error) {
+	return k.params.Get(ctx)
+}
+
 // GetNumCurrencyPairs returns the number of currency pairs currently in state.
 func (k *Keeper) GetNumCurrencyPairs(ctx sdk.Context) (uint64, error) {
 	return k.numCPs.Get(ctx)
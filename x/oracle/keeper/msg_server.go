@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strconv"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
@@ -92,3 +94,109 @@ func (m *msgServer) RemoveCurrencyPairs(goCtx context.Context, req *types.MsgRem
 
 	return nil, nil
 }
+
+// UpdateParams updates the x/oracle module's Params. This method fails if the message is invalid, or if the signer is
+// not the authority account of the module.
+func (m *msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	// check validity of message
+	if req == nil {
+		return nil, fmt.Errorf("message cannot be empty")
+	}
+
+	// check that the authority of the message is the authority of the module
+	if req.Authority != m.k.authority.String() {
+		return nil, fmt.Errorf("message validation failed: authority %s is not module authority %s", req.Authority, m.k.authority)
+	}
+
+	if err := req.Params.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid params: %w", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// SetPriceGuardrails sets the price guardrails of a set of CurrencyPairs. An empty guardrail removes the guardrail
+// of its CurrencyPair. This method fails if the message is invalid, if the signer is not the authority account of the
+// module, or if any of the CurrencyPairs does not exist.
+func (m *msgServer) SetPriceGuardrails(goCtx context.Context, req *types.MsgSetPriceGuardrails) (*types.MsgSetPriceGuardrailsResponse, error) {
+	// check validity of message
+	if req == nil {
+		return nil, fmt.Errorf("message cannot be empty")
+	}
+
+	// check that the authority of the message is the authority of the module
+	if req.Authority != m.k.authority.String() {
+		return nil, fmt.Errorf("message validation failed: authority %s is not module authority %s", req.Authority, m.k.authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, g := range req.Guardrails {
+		if err := g.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("invalid guardrail for %s: %w", g.CurrencyPair.String(), err)
+		}
+
+		if err := m.k.SetPriceGuardrail(ctx, g.CurrencyPair, g.Guardrail); err != nil {
+			return nil, fmt.Errorf("error setting guardrail for %s: %w", g.CurrencyPair.String(), err)
+		}
+	}
+
+	return &types.MsgSetPriceGuardrailsResponse{}, nil
+}
+
+// SetOracleKey registers the oracle key of the validator signing the message. The key takes effect for the vote
+// extensions created after the current block. This method fails if the message is invalid, if the staking keeper
+// has not been set, or if the signer is not a validator.
+func (m *msgServer) SetOracleKey(goCtx context.Context, req *types.MsgSetOracleKey) (*types.MsgSetOracleKeyResponse, error) {
+	// check validity of message
+	if req == nil {
+		return nil, fmt.Errorf("message cannot be empty")
+	}
+
+	if m.k.stakingKeeper == nil {
+		return nil, fmt.Errorf("x/oracle oracle keys are disabled without a staking keeper")
+	}
+
+	if err := req.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("message validation failed: %w", err)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	validator, err := m.k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return nil, fmt.Errorf("error getting validator %s: %w", req.ValidatorAddress, err)
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, fmt.Errorf("error getting consensus address of validator %s: %w", req.ValidatorAddress, err)
+	}
+
+	pubKey, ok := req.PubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, fmt.Errorf("invalid oracle key of validator %s", req.ValidatorAddress)
+	}
+
+	if err := m.k.SetOracleKey(ctx, consAddr, pubKey); err != nil {
+		return nil, fmt.Errorf("error setting oracle key of validator %s: %w", req.ValidatorAddress, err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetOracleKey,
+			sdk.NewAttribute(types.AttributeKeyValidator, sdk.ConsAddress(consAddr).String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+	)
+
+	return &types.MsgSetOracleKeyResponse{}, nil
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -254,3 +255,128 @@ func (s *KeeperTestSuite) TestMsgRemoveCurrencyPairs() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMsgUpdateParams() {
+	tcs := []struct {
+		name       string
+		req        *types.MsgUpdateParams
+		expectPass bool
+	}{
+		{
+			"if the request is empty - fail",
+			nil,
+			false,
+		},
+		{
+			"if the authority is not the authority of the module - fail",
+			&types.MsgUpdateParams{
+				Authority: sdk.AccAddress("not-authority").String(),
+				Params:    types.NewParams(10),
+			},
+			false,
+		},
+		{
+			"if the params are invalid - fail",
+			&types.MsgUpdateParams{
+				Authority: sdk.AccAddress(moduleAuth).String(),
+				Params:    types.NewParams(types.MaxPriceHistoryLength + 1),
+			},
+			false,
+		},
+		{
+			"if the authority is correct and the params are valid - pass",
+			&types.MsgUpdateParams{
+				Authority: sdk.AccAddress(moduleAuth).String(),
+				Params:    types.NewParams(10),
+			},
+			true,
+		},
+	}
+
+	ms := keeper.NewMsgServer(s.oracleKeeper)
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			_, err := ms.UpdateParams(s.ctx, tc.req)
+			if !tc.expectPass {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			params, err := s.oracleKeeper.GetParams(s.ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.req.Params, params)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgSetPriceGuardrails() {
+	cp := slinkytypes.NewCurrencyPair("ETH", "USD")
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+
+	guardrail := types.NewPriceGuardrail(100_000, time.Minute, true)
+
+	tcs := []struct {
+		name       string
+		req        *types.MsgSetPriceGuardrails
+		expectPass bool
+	}{
+		{
+			"if the request is empty - fail",
+			nil,
+			false,
+		},
+		{
+			"if the authority is not the authority of the module - fail",
+			&types.MsgSetPriceGuardrails{
+				Authority:  sdk.AccAddress("not-authority").String(),
+				Guardrails: []types.CurrencyPairGuardrail{{CurrencyPair: cp, Guardrail: guardrail}},
+			},
+			false,
+		},
+		{
+			"if a guardrail is invalid - fail",
+			&types.MsgSetPriceGuardrails{
+				Authority:  sdk.AccAddress(moduleAuth).String(),
+				Guardrails: []types.CurrencyPairGuardrail{{CurrencyPair: cp, Guardrail: types.NewPriceGuardrail(types.PPMPrecision+1, 0, false)}},
+			},
+			false,
+		},
+		{
+			"if a currency pair does not exist - fail",
+			&types.MsgSetPriceGuardrails{
+				Authority:  sdk.AccAddress(moduleAuth).String(),
+				Guardrails: []types.CurrencyPairGuardrail{{CurrencyPair: slinkytypes.NewCurrencyPair("DD", "EE"), Guardrail: guardrail}},
+			},
+			false,
+		},
+		{
+			"if the authority is correct and the guardrails are valid - pass",
+			&types.MsgSetPriceGuardrails{
+				Authority:  sdk.AccAddress(moduleAuth).String(),
+				Guardrails: []types.CurrencyPairGuardrail{{CurrencyPair: cp, Guardrail: guardrail}},
+			},
+			true,
+		},
+	}
+
+	ms := keeper.NewMsgServer(s.oracleKeeper)
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			_, err := ms.SetPriceGuardrails(s.ctx, tc.req)
+			if !tc.expectPass {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			for _, g := range tc.req.Guardrails {
+				got, err := s.oracleKeeper.GetPriceGuardrail(s.ctx, g.CurrencyPair)
+				s.Require().NoError(err)
+				s.Require().Equal(g.Guardrail, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zoguxprotocol/slinky/x/oracle/types"
+)
+
+// SetStakingKeeper sets the staking keeper used to resolve the consensus address of the validators
+// registering an oracle key. The Msg/SetOracleKey message is disabled if it is not set.
+func (k *Keeper) SetStakingKeeper(sk types.StakingKeeper) {
+	k.stakingKeeper = sk
+}
+
+// SetOracleKey registers the oracle key of a validator at the current height. The key the validator
+// had registered before (if any) is kept as its previous key, so that the vote extensions created up
+// to the current height can still be verified. If the validator registers several keys in the same
+// block, the previous key is the key registered before the block.
+func (k *Keeper) SetOracleKey(ctx sdk.Context, validator sdk.ConsAddress, pubKey cryptotypes.PubKey) error {
+	key, err := types.NewOracleKey(validator, pubKey, ctx.BlockHeight())
+	if err != nil {
+		return err
+	}
+
+	current, err := k.oracleKeys.Get(ctx, validator)
+	switch {
+	case err == nil:
+		key.PreviousPubKey = current.PubKey
+		if current.Height == ctx.BlockHeight() {
+			key.PreviousPubKey = current.PreviousPubKey
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	return k.oracleKeys.Set(ctx, validator, key)
+}
+
+// GetOracleKey returns the oracle key registered by a validator. This method fails if the validator has
+// not registered an oracle key.
+func (k *Keeper) GetOracleKey(ctx sdk.Context, validator sdk.ConsAddress) (types.OracleKey, error) {
+	key, err := k.oracleKeys.Get(ctx, validator)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.OracleKey{}, fmt.Errorf("no oracle key found for validator %s", validator.String())
+		}
+
+		return types.OracleKey{}, err
+	}
+
+	return key, nil
+}
+
+// GetOracleKeyForHeight returns the public oracle key a validator signs the vote extensions created at the
+// given height with. If the validator had no oracle key registered at that height, nil is returned.
+func (k *Keeper) GetOracleKeyForHeight(ctx sdk.Context, validator sdk.ConsAddress, height int64) (cryptotypes.PubKey, error) {
+	key, err := k.oracleKeys.Get(ctx, validator)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return key.PubKeyForHeight(height)
+}
+
+// GetAllOracleKeys returns the oracle keys registered by all validators, ordered by consensus address.
+func (k *Keeper) GetAllOracleKeys(ctx sdk.Context) ([]types.OracleKey, error) {
+	it, err := k.oracleKeys.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return it.Values()
+}
@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/zoguxprotocol/slinky/x/oracle/keeper"
+	"github.com/zoguxprotocol/slinky/x/oracle/types"
+	"github.com/zoguxprotocol/slinky/x/oracle/types/mocks"
+)
+
+func (s *KeeperTestSuite) TestSetOracleKey() {
+	validator := sdk.ConsAddress("validator")
+	first := ed25519.GenPrivKey().PubKey()
+	second := ed25519.GenPrivKey().PubKey()
+	third := ed25519.GenPrivKey().PubKey()
+
+	s.Run("no oracle key is registered by default", func() {
+		_, err := s.oracleKeeper.GetOracleKey(s.ctx, validator)
+		s.Require().Error(err)
+
+		pk, err := s.oracleKeeper.GetOracleKeyForHeight(s.ctx, validator, 100)
+		s.Require().NoError(err)
+		s.Require().Nil(pk)
+	})
+
+	s.Run("the first key takes effect after the block it was registered in", func() {
+		s.Require().NoError(s.oracleKeeper.SetOracleKey(s.ctx.WithBlockHeight(10), validator, first))
+
+		pk, err := s.oracleKeeper.GetOracleKeyForHeight(s.ctx, validator, 10)
+		s.Require().NoError(err)
+		s.Require().Nil(pk)
+
+		pk, err = s.oracleKeeper.GetOracleKeyForHeight(s.ctx, validator, 11)
+		s.Require().NoError(err)
+		s.Require().True(first.Equals(pk))
+	})
+
+	s.Run("rotating the key keeps the previous key for the previous heights", func() {
+		s.Require().NoError(s.oracleKeeper.SetOracleKey(s.ctx.WithBlockHeight(20), validator, second))
+
+		pk, err := s.oracleKeeper.GetOracleKeyForHeight(s.ctx, validator, 20)
+		s.Require().NoError(err)
+		s.Require().True(first.Equals(pk))
+
+		pk, err = s.oracleKeeper.GetOracleKeyForHeight(s.ctx, validator, 21)
+		s.Require().NoError(err)
+		s.Require().True(second.Equals(pk))
+	})
+
+	s.Run("rotating the key twice in a block keeps the key registered before the block", func() {
+		s.Require().NoError(s.oracleKeeper.SetOracleKey(s.ctx.WithBlockHeight(20), validator, third))
+
+		pk, err := s.oracleKeeper.GetOracleKeyForHeight(s.ctx, validator, 20)
+		s.Require().NoError(err)
+		s.Require().True(first.Equals(pk))
+
+		pk, err = s.oracleKeeper.GetOracleKeyForHeight(s.ctx, validator, 21)
+		s.Require().NoError(err)
+		s.Require().True(third.Equals(pk))
+
+		keys, err := s.oracleKeeper.GetAllOracleKeys(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Len(keys, 1)
+		s.Require().Equal(validator.String(), keys[0].Validator)
+	})
+}
+
+func (s *KeeperTestSuite) TestMsgSetOracleKey() {
+	consPubKey := ed25519.GenPrivKey().PubKey()
+	valAddr := sdk.ValAddress("operator")
+	validator, err := stakingtypes.NewValidator(valAddr.String(), consPubKey, stakingtypes.Description{})
+	s.Require().NoError(err)
+
+	oracleKey := ed25519.GenPrivKey().PubKey()
+	msg, err := types.NewMsgSetOracleKey(valAddr, oracleKey)
+	s.Require().NoError(err)
+
+	s.Run("if the staking keeper is not set - fail", func() {
+		_, err := keeper.NewMsgServer(s.oracleKeeper).SetOracleKey(s.ctx, msg)
+		s.Require().Error(err)
+	})
+
+	sk := mocks.NewStakingKeeper(s.T())
+	s.oracleKeeper.SetStakingKeeper(sk)
+	ms := keeper.NewMsgServer(s.oracleKeeper)
+
+	s.Run("if the request is empty - fail", func() {
+		_, err := ms.SetOracleKey(s.ctx, nil)
+		s.Require().Error(err)
+	})
+
+	s.Run("if the signer is not a validator - fail", func() {
+		sk.On("GetValidator", mock.Anything, sdk.ValAddress("unknown")).Return(stakingtypes.Validator{}, fmt.Errorf("not found")).Once()
+
+		req, err := types.NewMsgSetOracleKey(sdk.ValAddress("unknown"), oracleKey)
+		s.Require().NoError(err)
+
+		_, err = ms.SetOracleKey(s.ctx, req)
+		s.Require().Error(err)
+	})
+
+	s.Run("if the signer is a validator - pass", func() {
+		sk.On("GetValidator", mock.Anything, valAddr).Return(validator, nil).Once()
+
+		ctx := s.ctx.WithBlockHeight(10)
+		_, err := ms.SetOracleKey(ctx, msg)
+		s.Require().NoError(err)
+
+		key, err := s.oracleKeeper.GetOracleKey(ctx, sdk.ConsAddress(consPubKey.Address()))
+		s.Require().NoError(err)
+		s.Require().Equal(int64(10), key.Height)
+
+		pk, err := key.OraclePubKey()
+		s.Require().NoError(err)
+		s.Require().True(oracleKey.Equals(pk))
+	})
+}
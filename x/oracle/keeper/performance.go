@@ -0,0 +1,216 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
+	"github.com/zoguxprotocol/slinky/x/oracle/types"
+)
+
+// SetValidatorPerformanceHooks sets the hooks that are called after each completed performance window.
+func (k *Keeper) SetValidatorPerformanceHooks(hooks types.ValidatorPerformanceHooks) {
+	k.performanceHooks = hooks
+}
+
+// IsPerformanceTrackingEnabled returns true if the oracle performance of validators is tracked. If the module
+// Params have not been set (i.e. the chain has not initialized them yet), the tracking is disabled.
+func (k *Keeper) IsPerformanceTrackingEnabled(ctx sdk.Context) bool {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return false
+	}
+
+	return params.PerformanceTrackingEnabled()
+}
+
+// GetPerformanceWindow returns the current performance window. If the performances have never been tracked,
+// an empty window (with index 0) is returned.
+func (k *Keeper) GetPerformanceWindow(ctx sdk.Context) (types.PerformanceWindow, error) {
+	window, err := k.performanceWindow.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.PerformanceWindow{}, nil
+		}
+
+		return types.PerformanceWindow{}, err
+	}
+
+	return window, nil
+}
+
+// GetValidatorPerformance returns the performance of a validator over the given window. This method fails if
+// no performance is stored for the validator and window.
+func (k *Keeper) GetValidatorPerformance(ctx sdk.Context, validator sdk.ConsAddress, window uint64) (types.ValidatorPerformance, error) {
+	vp, err := k.validatorPerformances.Get(ctx, collections.Join(window, validator))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ValidatorPerformance{}, fmt.Errorf("no performance found for validator %s in window %d", validator.String(), window)
+		}
+
+		return types.ValidatorPerformance{}, err
+	}
+
+	return vp, nil
+}
+
+// GetValidatorPerformances returns the performances of all validators over the given window, ordered by
+// consensus address.
+func (k *Keeper) GetValidatorPerformances(ctx sdk.Context, window uint64) ([]types.ValidatorPerformance, error) {
+	it, err := k.validatorPerformances.Iterate(ctx, collections.NewPrefixedPairRange[uint64, sdk.ConsAddress](window))
+	if err != nil {
+		return nil, err
+	}
+
+	return it.Values()
+}
+
+// GetAllValidatorPerformances returns the performances of all validators over the current and retained
+// windows, ordered by window.
+func (k *Keeper) GetAllValidatorPerformances(ctx sdk.Context) ([]types.ValidatorPerformance, error) {
+	it, err := k.validatorPerformances.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return it.Values()
+}
+
+// TrackValidatorPerformance records the prices reported by each validator in the current block against the
+// prices committed by the block. The prices the validators were expected to report are the committed prices.
+// A report is missed if the validator was absent or did not report the price, and stale if the reported price
+// is the previous on-chain price while the committed price moved. The deviation of every reported price from
+// the committed price is recorded in the validator's deviation histogram. If the current performance window
+// is complete, the performance hooks are called with the performances over the window, and the windows that
+// are no longer retained are pruned before a new window is started. This is a no-op if the tracking is
+// disabled.
+func (k *Keeper) TrackValidatorPerformance(
+	ctx sdk.Context,
+	reports []types.ValidatorReport,
+	previous, committed map[slinkytypes.CurrencyPair]*big.Int,
+) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	if !params.PerformanceTrackingEnabled() {
+		return nil
+	}
+
+	window, err := k.advancePerformanceWindow(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	for _, report := range reports {
+		key := collections.Join(window, report.Validator)
+		vp, err := k.validatorPerformances.Get(ctx, key)
+		if err != nil {
+			if !errors.Is(err, collections.ErrNotFound) {
+				return err
+			}
+
+			vp = types.NewValidatorPerformance(report.Validator, window)
+		}
+
+		vp.Blocks++
+		if report.Absent {
+			vp.AbsentVotes++
+			vp.Reports += uint64(len(committed))
+			vp.MissedReports += uint64(len(committed))
+
+			if err := k.validatorPerformances.Set(ctx, key, vp); err != nil {
+				return err
+			}
+			continue
+		}
+
+		for cp, committedPrice := range committed {
+			vp.Reports++
+
+			price, ok := report.Prices[cp]
+			if !ok || price == nil {
+				vp.MissedReports++
+				continue
+			}
+
+			if previousPrice, ok := previous[cp]; ok && previousPrice.Cmp(committedPrice) != 0 && price.Cmp(previousPrice) == 0 {
+				vp.StaleReports++
+			}
+
+			if committedPrice.Sign() > 0 {
+				vp.AddDeviation(types.DeviationBps(price, committedPrice))
+			}
+		}
+
+		if err := k.validatorPerformances.Set(ctx, key, vp); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// advancePerformanceWindow returns the performance window of the current block. If the current window spans
+// PerformanceWindow blocks, it is completed and a new window is started at the current block.
+func (k *Keeper) advancePerformanceWindow(ctx sdk.Context, params types.Params) (uint64, error) {
+	current, err := k.GetPerformanceWindow(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if current.Window == 0 {
+		current = types.PerformanceWindow{Window: 1, StartHeight: ctx.BlockHeight()}
+		return current.Window, k.performanceWindow.Set(ctx, current)
+	}
+
+	if uint64(ctx.BlockHeight()-current.StartHeight) < params.PerformanceWindow {
+		return current.Window, nil
+	}
+
+	if err := k.completePerformanceWindow(ctx, current, params.PerformanceHistory); err != nil {
+		return 0, err
+	}
+
+	next := types.PerformanceWindow{Window: current.Window + 1, StartHeight: ctx.BlockHeight()}
+	return next.Window, k.performanceWindow.Set(ctx, next)
+}
+
+// completePerformanceWindow calls the performance hooks with the performances over the given window, and
+// prunes the windows that are no longer retained.
+func (k *Keeper) completePerformanceWindow(ctx sdk.Context, window types.PerformanceWindow, history uint64) error {
+	performances, err := k.GetValidatorPerformances(ctx, window.Window)
+	if err != nil {
+		return err
+	}
+
+	if err := k.performanceHooks.AfterPerformanceWindowCompleted(ctx, window.Window, performances); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePerformanceWindowComplete,
+			sdk.NewAttribute(types.AttributeKeyWindow, strconv.FormatUint(window.Window, 10)),
+			sdk.NewAttribute(types.AttributeKeyStartHeight, strconv.FormatInt(window.StartHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyValidators, strconv.Itoa(len(performances))),
+		),
+	)
+
+	// keep the performances of the windows in (window - history, window]
+	if window.Window <= history {
+		return nil
+	}
+
+	return k.validatorPerformances.Clear(ctx, collections.NewPrefixUntilPairRange[uint64, sdk.ConsAddress](window.Window-history))
+}
@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
+	"github.com/zoguxprotocol/slinky/x/oracle/types"
+)
+
+var (
+	performanceBTC = slinkytypes.NewCurrencyPair("BTC", "USD")
+	performanceETH = slinkytypes.NewCurrencyPair("ETH", "USD")
+
+	performanceVal1 = sdk.ConsAddress("validator1")
+	performanceVal2 = sdk.ConsAddress("validator2")
+)
+
+// recordingPerformanceHooks records the performances passed to AfterPerformanceWindowCompleted.
+type recordingPerformanceHooks struct {
+	windows      []uint64
+	performances [][]types.ValidatorPerformance
+}
+
+func (h *recordingPerformanceHooks) AfterPerformanceWindowCompleted(_ sdk.Context, window uint64, performances []types.ValidatorPerformance) error {
+	h.windows = append(h.windows, window)
+	h.performances = append(h.performances, performances)
+	return nil
+}
+
+func (s *KeeperTestSuite) TestTrackValidatorPerformance() {
+	committed := map[slinkytypes.CurrencyPair]*big.Int{
+		performanceBTC: big.NewInt(100_000),
+		performanceETH: big.NewInt(2_000),
+	}
+	previous := map[slinkytypes.CurrencyPair]*big.Int{
+		performanceBTC: big.NewInt(99_000),
+		performanceETH: big.NewInt(2_000),
+	}
+	reports := []types.ValidatorReport{
+		{
+			// reports the previous BTC price (stale), and no ETH price
+			Validator: performanceVal1,
+			Prices: map[slinkytypes.CurrencyPair]*big.Int{
+				performanceBTC: big.NewInt(99_000),
+			},
+		},
+		{
+			Validator: performanceVal2,
+			Absent:    true,
+		},
+	}
+
+	s.Run("tracking is disabled by default", func() {
+		s.Require().False(s.oracleKeeper.IsPerformanceTrackingEnabled(s.ctx))
+		s.Require().NoError(s.oracleKeeper.TrackValidatorPerformance(s.ctx, reports, previous, committed))
+
+		window, err := s.oracleKeeper.GetPerformanceWindow(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(0), window.Window)
+	})
+
+	s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.NewParamsWithPerformanceTracking(0, 10, 1)))
+	ctx := s.ctx.WithBlockHeight(5)
+
+	s.Run("reports are recorded in the first window", func() {
+		s.Require().True(s.oracleKeeper.IsPerformanceTrackingEnabled(ctx))
+		s.Require().NoError(s.oracleKeeper.TrackValidatorPerformance(ctx, reports, previous, committed))
+
+		window, err := s.oracleKeeper.GetPerformanceWindow(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(types.PerformanceWindow{Window: 1, StartHeight: 5}, window)
+
+		vp, err := s.oracleKeeper.GetValidatorPerformance(ctx, performanceVal1, 1)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(1), vp.Blocks)
+		s.Require().Equal(uint64(0), vp.AbsentVotes)
+		s.Require().Equal(uint64(2), vp.Reports)
+		s.Require().Equal(uint64(1), vp.MissedReports)
+		s.Require().Equal(uint64(1), vp.StaleReports)
+		s.Require().Equal(uint64(100), vp.MedianDeviationBps())
+
+		vp, err = s.oracleKeeper.GetValidatorPerformance(ctx, performanceVal2, 1)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(1), vp.Blocks)
+		s.Require().Equal(uint64(1), vp.AbsentVotes)
+		s.Require().Equal(uint64(2), vp.Reports)
+		s.Require().Equal(uint64(2), vp.MissedReports)
+		s.Require().Equal(uint64(0), vp.StaleReports)
+	})
+
+	s.Run("reports accumulate within the window", func() {
+		s.Require().NoError(s.oracleKeeper.TrackValidatorPerformance(ctx.WithBlockHeight(14), reports, previous, committed))
+
+		vp, err := s.oracleKeeper.GetValidatorPerformance(ctx, performanceVal1, 1)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(2), vp.Blocks)
+		s.Require().Equal(uint64(4), vp.Reports)
+		s.Require().Equal(uint64(2), vp.MissedReports)
+		s.Require().Equal(uint64(2), vp.StaleReports)
+	})
+
+	hooks := &recordingPerformanceHooks{}
+	s.oracleKeeper.SetValidatorPerformanceHooks(hooks)
+
+	s.Run("completing a window calls the hooks", func() {
+		s.Require().NoError(s.oracleKeeper.TrackValidatorPerformance(ctx.WithBlockHeight(15), reports[:1], previous, committed))
+
+		window, err := s.oracleKeeper.GetPerformanceWindow(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(types.PerformanceWindow{Window: 2, StartHeight: 15}, window)
+
+		s.Require().Equal([]uint64{1}, hooks.windows)
+		s.Require().Len(hooks.performances[0], 2)
+		s.Require().Equal(performanceVal1.String(), hooks.performances[0][0].Validator)
+		s.Require().Equal(uint64(2), hooks.performances[0][0].Blocks)
+
+		performances, err := s.oracleKeeper.GetValidatorPerformances(ctx, 2)
+		s.Require().NoError(err)
+		s.Require().Len(performances, 1)
+		s.Require().Equal(uint64(1), performances[0].Blocks)
+	})
+
+	s.Run("windows beyond the performance history are pruned", func() {
+		s.Require().NoError(s.oracleKeeper.TrackValidatorPerformance(ctx.WithBlockHeight(25), reports, previous, committed))
+		s.Require().Equal([]uint64{1, 2}, hooks.windows)
+
+		performances, err := s.oracleKeeper.GetValidatorPerformances(ctx, 1)
+		s.Require().NoError(err)
+		s.Require().Empty(performances)
+
+		_, err = s.oracleKeeper.GetValidatorPerformance(ctx, performanceVal1, 1)
+		s.Require().Error(err)
+
+		all, err := s.oracleKeeper.GetAllValidatorPerformances(ctx)
+		s.Require().NoError(err)
+		s.Require().Len(all, 3)
+	})
+}
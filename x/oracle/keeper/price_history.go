@@ -0,0 +1,246 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
+	"github.com/zoguxprotocol/slinky/x/oracle/types"
+)
+
+// GetPriceHistoryLength returns the number of most recent prices stored per currency-pair. If the
+// module Params have not been set (i.e. the chain has not initialized them yet), the price history
+// is disabled.
+func (k *Keeper) GetPriceHistoryLength(ctx sdk.Context) (uint64, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	return params.PriceHistoryLength, nil
+}
+
+// GetPriceHistory returns the stored price history for a given CurrencyPair, ordered from oldest to newest.
+func (k *Keeper) GetPriceHistory(ctx sdk.Context, cp slinkytypes.CurrencyPair) ([]types.QuotePrice, error) {
+	id, ok := k.GetIDForCurrencyPair(ctx, cp)
+	if !ok {
+		return nil, types.NewCurrencyPairNotExistError(cp)
+	}
+
+	it, err := k.priceHistory.Iterate(ctx, collections.NewPrefixedPairRange[uint64, uint64](id))
+	if err != nil {
+		return nil, err
+	}
+
+	return it.Values()
+}
+
+// GetPricesOverWindow returns the stored prices for a given CurrencyPair whose block timestamp is within
+// the given window, ending at the current block time. Prices are ordered from oldest to newest.
+func (k *Keeper) GetPricesOverWindow(ctx sdk.Context, cp slinkytypes.CurrencyPair, window time.Duration) ([]types.QuotePrice, error) {
+	if window <= 0 {
+		return nil, fmt.Errorf("window must be greater than 0")
+	}
+
+	history, err := k.GetPriceHistory(ctx, cp)
+	if err != nil {
+		return nil, err
+	}
+
+	start := ctx.BlockTime().Add(-window)
+	prices := make([]types.QuotePrice, 0, len(history))
+	for _, qp := range history {
+		if qp.BlockTimestamp.Before(start) || qp.BlockTimestamp.After(ctx.BlockTime()) {
+			continue
+		}
+
+		prices = append(prices, qp)
+	}
+
+	return prices, nil
+}
+
+// GetTWAP returns the time-weighted average of the stored prices for a given CurrencyPair over the given
+// window, ending at the current block time. Each price is weighted by the time it was the latest price
+// within the window; a price stored before the window began is weighted by the time it remained the
+// latest price after the window began. If the window is only covered by the current block's price, that
+// price is returned.
+func (k *Keeper) GetTWAP(ctx sdk.Context, cp slinkytypes.CurrencyPair, window time.Duration) (math.Int, error) {
+	if window <= 0 {
+		return math.Int{}, fmt.Errorf("window must be greater than 0")
+	}
+
+	history, err := k.GetPriceHistory(ctx, cp)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	now := ctx.BlockTime()
+	start := now.Add(-window)
+
+	var (
+		weightedSum = math.ZeroInt()
+		totalWeight = math.ZeroInt()
+		latest      *types.QuotePrice
+	)
+	for i := range history {
+		qp := history[i]
+		if qp.BlockTimestamp.After(now) {
+			break
+		}
+		latest = &history[i]
+
+		// the price is the latest price from its timestamp until the next price's timestamp (or now).
+		end := now
+		if i+1 < len(history) && !history[i+1].BlockTimestamp.After(now) {
+			end = history[i+1].BlockTimestamp
+		}
+
+		begin := qp.BlockTimestamp
+		if begin.Before(start) {
+			begin = start
+		}
+
+		if !end.After(begin) {
+			continue
+		}
+
+		weight := math.NewInt(end.Sub(begin).Nanoseconds())
+		weightedSum = weightedSum.Add(qp.Price.Mul(weight))
+		totalWeight = totalWeight.Add(weight)
+	}
+
+	if latest == nil {
+		return math.Int{}, types.NewQuotePriceNotExistError(cp)
+	}
+
+	if totalWeight.IsZero() {
+		return latest.Price, nil
+	}
+
+	return weightedSum.Quo(totalWeight), nil
+}
+
+// GetMedianOverWindow returns the median of the stored prices for a given CurrencyPair whose block timestamp
+// is within the given window, ending at the current block time, along with the number of prices in the window.
+// If the window contains an even number of prices, the (truncated) mean of the two middle prices is returned.
+func (k *Keeper) GetMedianOverWindow(ctx sdk.Context, cp slinkytypes.CurrencyPair, window time.Duration) (math.Int, uint64, error) {
+	prices, err := k.GetPricesOverWindow(ctx, cp, window)
+	if err != nil {
+		return math.Int{}, 0, err
+	}
+
+	if len(prices) == 0 {
+		return math.Int{}, 0, types.NewQuotePriceNotExistError(cp)
+	}
+
+	values := make([]math.Int, len(prices))
+	for i, qp := range prices {
+		values[i] = qp.Price
+	}
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].LT(values[j])
+	})
+
+	mid := len(values) / 2
+	if len(values)%2 == 1 {
+		return values[mid], uint64(len(values)), nil
+	}
+
+	return values[mid-1].Add(values[mid]).QuoRaw(2), uint64(len(values)), nil
+}
+
+// PrunePriceHistory removes, for every currency-pair, all stored prices that are older than the most
+// recent PriceHistoryLength prices. Prices are already pruned as new prices are stored, so this only
+// removes prices if the PriceHistoryLength was lowered. The length that the history was last pruned to
+// is stored, so that the currency-pairs are only iterated over when the PriceHistoryLength is lowered.
+func (k *Keeper) PrunePriceHistory(ctx sdk.Context) error {
+	length, err := k.GetPriceHistoryLength(ctx)
+	if err != nil {
+		return err
+	}
+
+	pruned, err := k.prunedPriceHistoryLength.Get(ctx)
+	switch {
+	case err == nil && length == pruned:
+		return nil
+	case err == nil && length > pruned:
+		// the history is already bounded by the lower length it was last pruned to
+		return k.prunedPriceHistoryLength.Set(ctx, length)
+	case err != nil && !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	// collect the states first so that the store is not written to while it is being iterated over
+	states := make([]types.CurrencyPairState, 0)
+	err = k.IterateCurrencyPairs(ctx, func(_ slinkytypes.CurrencyPair, cps types.CurrencyPairState) {
+		states = append(states, cps)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, cps := range states {
+		if err := k.prunePriceHistoryBefore(ctx, cps.Id, cps.Nonce, length); err != nil {
+			return err
+		}
+	}
+
+	return k.prunedPriceHistoryLength.Set(ctx, length)
+}
+
+// appendPriceHistory stores the given price in the price history of the currency-pair with the given ID
+// and removes the oldest price(s) beyond the configured PriceHistoryLength.
+func (k *Keeper) appendPriceHistory(ctx sdk.Context, id, nonce uint64, qp types.QuotePrice) error {
+	length, err := k.GetPriceHistoryLength(ctx)
+	if err != nil {
+		return err
+	}
+
+	if length == 0 {
+		return nil
+	}
+
+	if err := k.priceHistory.Set(ctx, collections.Join(id, nonce), qp); err != nil {
+		return err
+	}
+
+	return k.prunePriceHistoryBefore(ctx, id, nonce, length)
+}
+
+// prunePriceHistoryBefore removes all prices of the currency-pair with the given ID that are not among
+// the most recent length prices, where nonce is the nonce of the currency-pair's latest price.
+func (k *Keeper) prunePriceHistoryBefore(ctx sdk.Context, id, nonce, length uint64) error {
+	if length == 0 {
+		return k.prunePriceHistory(ctx, id, nil)
+	}
+
+	if nonce < length {
+		return nil
+	}
+
+	// keep the prices with nonces in (nonce - length, nonce]
+	end := nonce - length
+	return k.prunePriceHistory(ctx, id, &end)
+}
+
+// prunePriceHistory removes all prices of the currency-pair with the given ID with a nonce less than
+// or equal to end. If end is nil, the entire price history of the currency-pair is removed.
+func (k *Keeper) prunePriceHistory(ctx sdk.Context, id uint64, end *uint64) error {
+	rng := collections.NewPrefixedPairRange[uint64, uint64](id)
+	if end != nil {
+		rng = rng.EndInclusive(*end)
+	}
+
+	return k.priceHistory.Clear(ctx, rng)
+}
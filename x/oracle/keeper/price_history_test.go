@@ -0,0 +1,235 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
+	"github.com/zoguxprotocol/slinky/x/oracle/types"
+)
+
+var historyCP = slinkytypes.CurrencyPair{Base: "BTC", Quote: "USD"}
+
+// setHistoricalPrices sets a price for historyCP every second starting at start.
+func (s *KeeperTestSuite) setHistoricalPrices(start time.Time, prices ...int64) {
+	for i, price := range prices {
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, historyCP, types.QuotePrice{
+			Price:          sdkmath.NewInt(price),
+			BlockTimestamp: start.Add(time.Duration(i) * time.Second),
+			BlockHeight:    uint64(i), //nolint:gosec
+		}))
+	}
+}
+
+func (s *KeeperTestSuite) TestPriceHistory() {
+	start := time.Unix(1_000, 0).UTC()
+
+	s.Run("history is bounded by the price history length", func() {
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.NewParams(3)))
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, historyCP))
+
+		s.setHistoricalPrices(start, 100, 200, 300, 400, 500)
+
+		history, err := s.oracleKeeper.GetPriceHistory(s.ctx, historyCP)
+		s.Require().NoError(err)
+		s.Require().Len(history, 3)
+		for i, price := range []int64{300, 400, 500} {
+			s.Require().Equal(sdkmath.NewInt(price), history[i].Price)
+		}
+	})
+
+	s.Run("lowering the length prunes the history in the begin blocker", func() {
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.NewParams(1)))
+		s.Require().NoError(s.oracleKeeper.BeginBlocker(s.ctx))
+
+		history, err := s.oracleKeeper.GetPriceHistory(s.ctx, historyCP)
+		s.Require().NoError(err)
+		s.Require().Len(history, 1)
+		s.Require().Equal(sdkmath.NewInt(500), history[0].Price)
+	})
+
+	s.Run("raising and then lowering the length prunes the history in the begin blocker", func() {
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.NewParams(3)))
+		s.Require().NoError(s.oracleKeeper.BeginBlocker(s.ctx))
+		s.setHistoricalPrices(start, 600, 700)
+
+		history, err := s.oracleKeeper.GetPriceHistory(s.ctx, historyCP)
+		s.Require().NoError(err)
+		s.Require().Len(history, 3)
+
+		// the length is unchanged, so the history is not pruned
+		s.Require().NoError(s.oracleKeeper.BeginBlocker(s.ctx))
+		history, err = s.oracleKeeper.GetPriceHistory(s.ctx, historyCP)
+		s.Require().NoError(err)
+		s.Require().Len(history, 3)
+
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.NewParams(2)))
+		s.Require().NoError(s.oracleKeeper.BeginBlocker(s.ctx))
+
+		history, err = s.oracleKeeper.GetPriceHistory(s.ctx, historyCP)
+		s.Require().NoError(err)
+		s.Require().Len(history, 2)
+		s.Require().Equal(sdkmath.NewInt(600), history[0].Price)
+		s.Require().Equal(sdkmath.NewInt(700), history[1].Price)
+	})
+
+	s.Run("a length of 0 disables the history", func() {
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.NewParams(0)))
+		s.Require().NoError(s.oracleKeeper.BeginBlocker(s.ctx))
+		s.setHistoricalPrices(start, 100)
+
+		history, err := s.oracleKeeper.GetPriceHistory(s.ctx, historyCP)
+		s.Require().NoError(err)
+		s.Require().Empty(history)
+	})
+
+	s.Run("removing a currency pair removes its history", func() {
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.NewParams(3)))
+		s.setHistoricalPrices(start, 100, 200)
+		id, ok := s.oracleKeeper.GetIDForCurrencyPair(s.ctx, historyCP)
+		s.Require().True(ok)
+
+		s.Require().NoError(s.oracleKeeper.RemoveCurrencyPair(s.ctx, historyCP))
+		_, err := s.oracleKeeper.GetPriceHistory(s.ctx, historyCP)
+		s.Require().Error(err)
+
+		// re-creating the currency pair with the same ID does not resurrect the history
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, historyCP, types.QuotePrice{
+			Price:          sdkmath.NewInt(1),
+			BlockTimestamp: start,
+		}))
+		newID, ok := s.oracleKeeper.GetIDForCurrencyPair(s.ctx, historyCP)
+		s.Require().True(ok)
+		s.Require().NotEqual(id, newID)
+
+		history, err := s.oracleKeeper.GetPriceHistory(s.ctx, historyCP)
+		s.Require().NoError(err)
+		s.Require().Len(history, 1)
+	})
+}
+
+func (s *KeeperTestSuite) TestGetTWAP() {
+	start := time.Unix(1_000, 0).UTC()
+	s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.NewParams(10)))
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, historyCP))
+
+	s.Run("no prices", func() {
+		_, err := s.oracleKeeper.GetTWAP(s.ctx.WithBlockTime(start), historyCP, time.Minute)
+		s.Require().Error(err)
+	})
+
+	// prices at t=0s, 1s, 2s, 3s
+	s.setHistoricalPrices(start, 100, 200, 300, 400)
+
+	tcs := []struct {
+		name     string
+		now      time.Time
+		window   time.Duration
+		expected int64
+		err      bool
+	}{
+		{
+			name:   "zero window",
+			now:    start.Add(3 * time.Second),
+			window: 0,
+			err:    true,
+		},
+		{
+			name:     "window covers all prices",
+			now:      start.Add(4 * time.Second),
+			window:   4 * time.Second,
+			expected: 250,
+		},
+		{
+			name:     "window begins between prices",
+			now:      start.Add(4 * time.Second),
+			window:   1500 * time.Millisecond,
+			expected: (300*500 + 400*1000) / 1500,
+		},
+		{
+			name:     "window is only covered by the latest price",
+			now:      start.Add(10 * time.Second),
+			window:   5 * time.Second,
+			expected: 400,
+		},
+		{
+			name:     "the current block's price has no weight",
+			now:      start.Add(3 * time.Second),
+			window:   time.Second,
+			expected: 300,
+		},
+	}
+
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			twap, err := s.oracleKeeper.GetTWAP(s.ctx.WithBlockTime(tc.now), historyCP, tc.window)
+			if tc.err {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(sdkmath.NewInt(tc.expected), twap)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGetMedianOverWindow() {
+	start := time.Unix(1_000, 0).UTC()
+	s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, types.NewParams(10)))
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, historyCP))
+
+	// prices at t=0s, 1s, 2s, 3s, 4s
+	s.setHistoricalPrices(start, 500, 100, 400, 200, 900)
+
+	tcs := []struct {
+		name      string
+		now       time.Time
+		window    time.Duration
+		expected  int64
+		numPrices uint64
+		err       bool
+	}{
+		{
+			name:   "zero window",
+			now:    start.Add(4 * time.Second),
+			window: 0,
+			err:    true,
+		},
+		{
+			name:      "odd number of prices",
+			now:       start.Add(4 * time.Second),
+			window:    4 * time.Second,
+			expected:  400,
+			numPrices: 5,
+		},
+		{
+			name:      "even number of prices",
+			now:       start.Add(4 * time.Second),
+			window:    3 * time.Second,
+			expected:  300,
+			numPrices: 4,
+		},
+		{
+			name:   "no prices in window",
+			now:    start.Add(10 * time.Second),
+			window: time.Second,
+			err:    true,
+		},
+	}
+
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			median, numPrices, err := s.oracleKeeper.GetMedianOverWindow(s.ctx.WithBlockTime(tc.now), historyCP, tc.window)
+			if tc.err {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(sdkmath.NewInt(tc.expected), median)
+			s.Require().Equal(tc.numPrices, numPrices)
+		})
+	}
+}
@@ -66,9 +66,9 @@ func (amb AppModuleBasic) RegisterGRPCGatewayRoutes(cliCtx client.Context, mux *
 	}
 }
 
-// GetTxCmd is a no-op, as no txs are registered for submission (apart from messages that can only be executed by governance).
+// GetTxCmd returns the x/oracle module base tx cli-command.
 func (amb AppModuleBasic) GetTxCmd() *cobra.Command {
-	return nil
+	return cli.GetTxCmd()
 }
 
 // GetQueryCmd returns the x/oracle module base query cli-command.
@@ -175,6 +175,7 @@ type Inputs struct {
 
 	// keepers
 	types.MarketMapKeeper
+	StakingKeeper types.StakingKeeper `optional:"true"`
 
 	// module-dependencies
 	Config       *oraclemodulev1.Module
@@ -204,6 +205,11 @@ func ProvideModule(in Inputs) Outputs {
 		authority,
 	)
 
+	// the oracle keys can only be registered if the staking keeper is provided
+	if in.StakingKeeper != nil {
+		oracleKeeper.SetStakingKeeper(in.StakingKeeper)
+	}
+
 	m := NewAppModule(in.Cdc, oracleKeeper)
 
 	return Outputs{
@@ -16,6 +16,15 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 
 	// register the MsgRemoveCurrencyPairs for amino serialization
 	legacy.RegisterAminoMsg(cdc, &MsgRemoveCurrencyPairs{}, "slinky/x/oracle/MsgRemoveCurrencyPairs")
+
+	// register the MsgUpdateParams for amino serialization
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "slinky/x/oracle/MsgUpdateParams")
+
+	// register the MsgSetPriceGuardrails for amino serialization
+	legacy.RegisterAminoMsg(cdc, &MsgSetPriceGuardrails{}, "slinky/x/oracle/MsgSetPriceGuardrails")
+
+	// register the MsgSetOracleKey for amino serialization
+	legacy.RegisterAminoMsg(cdc, &MsgSetOracleKey{}, "slinky/x/oracle/MsgSetOracleKey")
 }
 
 // RegisterInterfaces registers the x/oracle messages + message service w/ the InterfaceRegistry (registry).
@@ -24,6 +33,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgAddCurrencyPairs{},
 		&MsgRemoveCurrencyPairs{},
+		&MsgUpdateParams{},
+		&MsgSetPriceGuardrails{},
+		&MsgSetOracleKey{},
 	)
 
 	// register the x/oracle message-service
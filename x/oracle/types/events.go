@@ -0,0 +1,23 @@
+package types
+
+// oracle module event types
+
+const (
+	EventTypePriceGuardrailBreach      = "price_guardrail_breach"
+	EventTypePerformanceWindowComplete = "performance_window_complete"
+	EventTypeSetOracleKey              = "set_oracle_key"
+
+	AttributeKeyCurrencyPair  = "currency_pair"
+	AttributeKeyPreviousPrice = "previous_price"
+	AttributeKeyReportedPrice = "reported_price"
+	AttributeKeyAppliedPrice  = "applied_price"
+	AttributeKeyAction        = "action"
+	AttributeKeyWindow        = "window"
+	AttributeKeyStartHeight   = "start_height"
+	AttributeKeyValidators    = "validators"
+	AttributeKeyValidator     = "validator"
+	AttributeKeyHeight        = "height"
+
+	AttributeValueActionRejected = "rejected"
+	AttributeValueActionClamped  = "clamped"
+)
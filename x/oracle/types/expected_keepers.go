@@ -1,7 +1,10 @@
 package types
 
 import (
+	"context"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/zoguxprotocol/slinky/x/marketmap/types"
 )
@@ -12,3 +15,11 @@ import (
 type MarketMapKeeper interface {
 	GetMarket(ctx sdk.Context, tickerStr string) (types.Market, error)
 }
+
+// StakingKeeper is the expected keeper interface for the staking keeper. It is used to resolve the
+// consensus address of the validators registering an oracle key.
+//
+//go:generate mockery --name StakingKeeper --output ./mocks/ --case underscore
+type StakingKeeper interface {
+	GetValidator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error)
+}
@@ -32,14 +32,30 @@ func NewGenesisState(cpgs []CurrencyPairGenesis, nextID uint64) *GenesisState {
 	}
 }
 
+// NewGenesisStateWithParams returns a new genesis-state from a set of CurrencyPairGeneses and
+// the module Params.
+func NewGenesisStateWithParams(cpgs []CurrencyPairGenesis, nextID uint64, params Params) *GenesisState {
+	gs := NewGenesisState(cpgs, nextID)
+	gs.Params = params
+	return gs
+}
+
 // DefaultGenesisState returns a default genesis state for the oracle module.
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(nil, 0)
+	return NewGenesisStateWithParams(nil, 0, DefaultParams())
 }
 
 // Validate validates the currency-pair geneses that the Genesis-State is composed of
-// valid CurrencyPairGenesis, and that no ID for a currency-pair is repeated.
+// valid CurrencyPairGenesis, that no ID for a currency-pair is repeated, that the
+// Params are valid, that the price guardrails + halted currency-pairs refer to
+// currency-pairs in the genesis, that the validator performances are valid and
+// do not belong to future performance windows, and that the oracle keys are valid
+// and registered at most once per validator.
 func (gs *GenesisState) Validate() error {
+	if err := gs.Params.ValidateBasic(); err != nil {
+		return err
+	}
+
 	ids := make(map[uint64]struct{})
 	cps := make(map[string]struct{})
 	for _, cpg := range gs.CurrencyPairGenesis {
@@ -70,6 +86,71 @@ func (gs *GenesisState) Validate() error {
 		cps[cpg.CurrencyPair.String()] = struct{}{}
 	}
 
+	guardrails := make(map[string]struct{})
+	for _, g := range gs.PriceGuardrails {
+		if err := g.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if _, ok := cps[g.CurrencyPair.String()]; !ok {
+			return fmt.Errorf("price guardrail for unknown currency-pair: %v", g.CurrencyPair.String())
+		}
+
+		if _, ok := guardrails[g.CurrencyPair.String()]; ok {
+			return fmt.Errorf("repeated price guardrail: %v", g.CurrencyPair.String())
+		}
+
+		guardrails[g.CurrencyPair.String()] = struct{}{}
+	}
+
+	halted := make(map[string]struct{})
+	for _, cp := range gs.HaltedCurrencyPairs {
+		if _, ok := cps[cp.String()]; !ok {
+			return fmt.Errorf("unknown halted currency-pair: %v", cp.String())
+		}
+
+		if _, ok := halted[cp.String()]; ok {
+			return fmt.Errorf("repeated halted currency-pair: %v", cp.String())
+		}
+
+		halted[cp.String()] = struct{}{}
+	}
+
+	if gs.PerformanceWindow != nil && gs.PerformanceWindow.Window == 0 {
+		return fmt.Errorf("invalid performance window: windows start at 1")
+	}
+
+	performances := make(map[string]struct{})
+	for _, vp := range gs.ValidatorPerformances {
+		if err := vp.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if gs.PerformanceWindow == nil || vp.Window > gs.PerformanceWindow.Window {
+			return fmt.Errorf("validator performance for future window: %v", vp.Window)
+		}
+
+		key := fmt.Sprintf("%d/%s", vp.Window, vp.Validator)
+		if _, ok := performances[key]; ok {
+			return fmt.Errorf("repeated validator performance: %v", key)
+		}
+
+		performances[key] = struct{}{}
+	}
+
+	oracleKeys := make(map[string]struct{})
+	for _, key := range gs.OracleKeys {
+		if err := key.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if _, ok := oracleKeys[key.Validator]; ok {
+			return fmt.Errorf("repeated oracle key: %v", key.Validator)
+		}
+
+		oracleKeys[key.Validator] = struct{}{}
+	}
+
 	return nil
 }
 
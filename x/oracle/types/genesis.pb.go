@@ -7,10 +7,12 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	types "github.com/zoguxprotocol/slinky/pkg/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -155,6 +157,404 @@ func (m *CurrencyPairState) GetId() uint64 {
 	return 0
 }
 
+// PriceGuardrail is the set of guardrails applied to the price updates of a
+// CurrencyPair before they are written to state.
+type PriceGuardrail struct {
+	// MaxPriceChangePpm is the maximum change of the price per block, in
+	// parts-per-million of the latest price. Updates that move the price further
+	// are rejected (or clamped). A value of 0 disables the check.
+	MaxPriceChangePpm uint64 `protobuf:"varint,1,opt,name=max_price_change_ppm,json=maxPriceChangePpm,proto3" json:"max_price_change_ppm,omitempty"`
+	// MaxPriceAge is the maximum age of the latest price before it is considered
+	// stale. A value of 0 disables the check.
+	MaxPriceAge time.Duration `protobuf:"bytes,2,opt,name=max_price_age,json=maxPriceAge,proto3,stdduration" json:"max_price_age"`
+	// Clamp determines whether updates that exceed MaxPriceChangePpm are clamped
+	// to the maximum allowed change instead of being rejected.
+	Clamp bool `protobuf:"varint,3,opt,name=clamp,proto3" json:"clamp,omitempty"`
+	// MinVotingPowerPpm is the minimum voting power of the validators reporting
+	// a price for the CurrencyPair, in parts-per-million of the total bonded
+	// stake, for the price to be updated. It can only make the power threshold
+	// of the vote weighted median stricter. A value of 0 disables the check.
+	MinVotingPowerPpm uint64 `protobuf:"varint,4,opt,name=min_voting_power_ppm,json=minVotingPowerPpm,proto3" json:"min_voting_power_ppm,omitempty"`
+	// MinValidators is the minimum number of distinct validators reporting a
+	// price for the CurrencyPair for the price to be updated. A value of 0
+	// disables the check.
+	MinValidators uint64 `protobuf:"varint,5,opt,name=min_validators,json=minValidators,proto3" json:"min_validators,omitempty"`
+}
+
+func (m *PriceGuardrail) Reset()         { *m = PriceGuardrail{} }
+func (m *PriceGuardrail) String() string { return proto.CompactTextString(m) }
+func (*PriceGuardrail) ProtoMessage()    {}
+func (*PriceGuardrail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de36a97821ccc13b, []int{2}
+}
+func (m *PriceGuardrail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceGuardrail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceGuardrail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceGuardrail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceGuardrail.Merge(m, src)
+}
+func (m *PriceGuardrail) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceGuardrail) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceGuardrail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceGuardrail proto.InternalMessageInfo
+
+func (m *PriceGuardrail) GetMaxPriceChangePpm() uint64 {
+	if m != nil {
+		return m.MaxPriceChangePpm
+	}
+	return 0
+}
+
+func (m *PriceGuardrail) GetMaxPriceAge() time.Duration {
+	if m != nil {
+		return m.MaxPriceAge
+	}
+	return 0
+}
+
+func (m *PriceGuardrail) GetClamp() bool {
+	if m != nil {
+		return m.Clamp
+	}
+	return false
+}
+
+func (m *PriceGuardrail) GetMinVotingPowerPpm() uint64 {
+	if m != nil {
+		return m.MinVotingPowerPpm
+	}
+	return 0
+}
+
+func (m *PriceGuardrail) GetMinValidators() uint64 {
+	if m != nil {
+		return m.MinValidators
+	}
+	return 0
+}
+
+// CurrencyPairGuardrail associates a PriceGuardrail with a CurrencyPair.
+type CurrencyPairGuardrail struct {
+	// CurrencyPair is the pair the guardrail applies to.
+	CurrencyPair types.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
+	// Guardrail is the guardrail applied to the CurrencyPair's price updates.
+	Guardrail PriceGuardrail `protobuf:"bytes,2,opt,name=guardrail,proto3" json:"guardrail"`
+}
+
+func (m *CurrencyPairGuardrail) Reset()         { *m = CurrencyPairGuardrail{} }
+func (m *CurrencyPairGuardrail) String() string { return proto.CompactTextString(m) }
+func (*CurrencyPairGuardrail) ProtoMessage()    {}
+func (*CurrencyPairGuardrail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de36a97821ccc13b, []int{3}
+}
+func (m *CurrencyPairGuardrail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CurrencyPairGuardrail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CurrencyPairGuardrail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CurrencyPairGuardrail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurrencyPairGuardrail.Merge(m, src)
+}
+func (m *CurrencyPairGuardrail) XXX_Size() int {
+	return m.Size()
+}
+func (m *CurrencyPairGuardrail) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurrencyPairGuardrail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CurrencyPairGuardrail proto.InternalMessageInfo
+
+func (m *CurrencyPairGuardrail) GetCurrencyPair() types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return types.CurrencyPair{}
+}
+
+func (m *CurrencyPairGuardrail) GetGuardrail() PriceGuardrail {
+	if m != nil {
+		return m.Guardrail
+	}
+	return PriceGuardrail{}
+}
+
+// ValidatorPerformance is the oracle performance of a validator over a
+// performance window.
+type ValidatorPerformance struct {
+	// Validator is the consensus address of the validator.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// Window is the index of the performance window.
+	Window uint64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+	// Blocks is the number of blocks of the window whose commit included the
+	// validator.
+	Blocks uint64 `protobuf:"varint,3,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// AbsentVotes is the number of blocks in which the validator did not vote
+	// for the block, and therefore did not report any prices.
+	AbsentVotes uint64 `protobuf:"varint,4,opt,name=absent_votes,json=absentVotes,proto3" json:"absent_votes,omitempty"`
+	// Reports is the number of prices the validator was expected to report, i.e.
+	// the number of prices committed in the blocks of the window.
+	Reports uint64 `protobuf:"varint,5,opt,name=reports,proto3" json:"reports,omitempty"`
+	// MissedReports is the number of expected prices the validator did not
+	// report, including the prices of the blocks it was absent from.
+	MissedReports uint64 `protobuf:"varint,6,opt,name=missed_reports,json=missedReports,proto3" json:"missed_reports,omitempty"`
+	// StaleReports is the number of prices the validator reported that were
+	// equal to the previous on-chain price, while the committed price moved.
+	StaleReports uint64 `protobuf:"varint,7,opt,name=stale_reports,json=staleReports,proto3" json:"stale_reports,omitempty"`
+	// DeviationHistogram is the number of reported prices per bucket of
+	// deviation from the committed price. The buckets are bounded by
+	// DeviationBucketBounds, in basis points, with a last bucket for larger
+	// deviations.
+	DeviationHistogram []uint64 `protobuf:"varint,8,rep,packed,name=deviation_histogram,json=deviationHistogram,proto3" json:"deviation_histogram,omitempty"`
+}
+
+func (m *ValidatorPerformance) Reset()         { *m = ValidatorPerformance{} }
+func (m *ValidatorPerformance) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformance) ProtoMessage()    {}
+func (*ValidatorPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de36a97821ccc13b, []int{4}
+}
+func (m *ValidatorPerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformance.Merge(m, src)
+}
+func (m *ValidatorPerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPerformance proto.InternalMessageInfo
+
+func (m *ValidatorPerformance) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *ValidatorPerformance) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetAbsentVotes() uint64 {
+	if m != nil {
+		return m.AbsentVotes
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetReports() uint64 {
+	if m != nil {
+		return m.Reports
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetMissedReports() uint64 {
+	if m != nil {
+		return m.MissedReports
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetStaleReports() uint64 {
+	if m != nil {
+		return m.StaleReports
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetDeviationHistogram() []uint64 {
+	if m != nil {
+		return m.DeviationHistogram
+	}
+	return nil
+}
+
+// PerformanceWindow is the performance window validator performances are
+// currently tracked in.
+type PerformanceWindow struct {
+	// Window is the index of the performance window, starting at 1.
+	Window uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// StartHeight is the height of the first block of the window.
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+}
+
+func (m *PerformanceWindow) Reset()         { *m = PerformanceWindow{} }
+func (m *PerformanceWindow) String() string { return proto.CompactTextString(m) }
+func (*PerformanceWindow) ProtoMessage()    {}
+func (*PerformanceWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de36a97821ccc13b, []int{5}
+}
+func (m *PerformanceWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PerformanceWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PerformanceWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PerformanceWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PerformanceWindow.Merge(m, src)
+}
+func (m *PerformanceWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *PerformanceWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_PerformanceWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PerformanceWindow proto.InternalMessageInfo
+
+func (m *PerformanceWindow) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *PerformanceWindow) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+// OracleKey is the key a validator signs the prices of its vote extensions
+// with, in addition to the signature of the vote extension by its consensus
+// key.
+type OracleKey struct {
+	// Validator is the consensus address of the validator.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// PubKey is the public oracle key of the validator.
+	PubKey *types1.Any `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// PreviousPubKey is the public oracle key PubKey replaced, if any. Vote
+	// extensions created up to Height are verified against it.
+	PreviousPubKey *types1.Any `protobuf:"bytes,3,opt,name=previous_pub_key,json=previousPubKey,proto3" json:"previous_pub_key,omitempty"`
+	// Height is the height PubKey was registered at. Vote extensions created
+	// after Height are verified against it.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *OracleKey) Reset()         { *m = OracleKey{} }
+func (m *OracleKey) String() string { return proto.CompactTextString(m) }
+func (*OracleKey) ProtoMessage()    {}
+func (*OracleKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de36a97821ccc13b, []int{6}
+}
+func (m *OracleKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleKey.Merge(m, src)
+}
+func (m *OracleKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleKey proto.InternalMessageInfo
+
+func (m *OracleKey) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *OracleKey) GetPubKey() *types1.Any {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *OracleKey) GetPreviousPubKey() *types1.Any {
+	if m != nil {
+		return m.PreviousPubKey
+	}
+	return nil
+}
+
+func (m *OracleKey) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // CurrencyPairGenesis is the information necessary for initialization of a
 // CurrencyPair.
 type CurrencyPairGenesis struct {
@@ -174,7 +574,7 @@ func (m *CurrencyPairGenesis) Reset()         { *m = CurrencyPairGenesis{} }
 func (m *CurrencyPairGenesis) String() string { return proto.CompactTextString(m) }
 func (*CurrencyPairGenesis) ProtoMessage()    {}
 func (*CurrencyPairGenesis) Descriptor() ([]byte, []int) {
-	return fileDescriptor_de36a97821ccc13b, []int{2}
+	return fileDescriptor_de36a97821ccc13b, []int{7}
 }
 func (m *CurrencyPairGenesis) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -240,13 +640,28 @@ type GenesisState struct {
 	CurrencyPairGenesis []CurrencyPairGenesis `protobuf:"bytes,1,rep,name=currency_pair_genesis,json=currencyPairGenesis,proto3" json:"currency_pair_genesis"`
 	// NextID is the next ID to be used for a CurrencyPair
 	NextId uint64 `protobuf:"varint,2,opt,name=next_id,json=nextId,proto3" json:"next_id,omitempty"`
+	// Params are the parameters for the x/oracle module.
+	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// PriceGuardrails are the price guardrails of the CurrencyPairs.
+	PriceGuardrails []CurrencyPairGuardrail `protobuf:"bytes,4,rep,name=price_guardrails,json=priceGuardrails,proto3" json:"price_guardrails"`
+	// HaltedCurrencyPairs are the CurrencyPairs whose latest price update was
+	// rejected by their guardrail.
+	HaltedCurrencyPairs []types.CurrencyPair `protobuf:"bytes,5,rep,name=halted_currency_pairs,json=haltedCurrencyPairs,proto3" json:"halted_currency_pairs"`
+	// PerformanceWindow is the current validator performance window, if the
+	// validator performances are tracked.
+	PerformanceWindow *PerformanceWindow `protobuf:"bytes,6,opt,name=performance_window,json=performanceWindow,proto3" json:"performance_window,omitempty"`
+	// ValidatorPerformances are the validator performances of the current and
+	// retained performance windows.
+	ValidatorPerformances []ValidatorPerformance `protobuf:"bytes,7,rep,name=validator_performances,json=validatorPerformances,proto3" json:"validator_performances"`
+	// OracleKeys are the oracle keys registered by validators.
+	OracleKeys []OracleKey `protobuf:"bytes,8,rep,name=oracle_keys,json=oracleKeys,proto3" json:"oracle_keys"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_de36a97821ccc13b, []int{3}
+	return fileDescriptor_de36a97821ccc13b, []int{8}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -289,9 +704,56 @@ func (m *GenesisState) GetNextId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetPriceGuardrails() []CurrencyPairGuardrail {
+	if m != nil {
+		return m.PriceGuardrails
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHaltedCurrencyPairs() []types.CurrencyPair {
+	if m != nil {
+		return m.HaltedCurrencyPairs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPerformanceWindow() *PerformanceWindow {
+	if m != nil {
+		return m.PerformanceWindow
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorPerformances() []ValidatorPerformance {
+	if m != nil {
+		return m.ValidatorPerformances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOracleKeys() []OracleKey {
+	if m != nil {
+		return m.OracleKeys
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QuotePrice)(nil), "slinky.oracle.v1.QuotePrice")
 	proto.RegisterType((*CurrencyPairState)(nil), "slinky.oracle.v1.CurrencyPairState")
+	proto.RegisterType((*PriceGuardrail)(nil), "slinky.oracle.v1.PriceGuardrail")
+	proto.RegisterType((*CurrencyPairGuardrail)(nil), "slinky.oracle.v1.CurrencyPairGuardrail")
+	proto.RegisterType((*ValidatorPerformance)(nil), "slinky.oracle.v1.ValidatorPerformance")
+	proto.RegisterType((*PerformanceWindow)(nil), "slinky.oracle.v1.PerformanceWindow")
+	proto.RegisterType((*OracleKey)(nil), "slinky.oracle.v1.OracleKey")
 	proto.RegisterType((*CurrencyPairGenesis)(nil), "slinky.oracle.v1.CurrencyPairGenesis")
 	proto.RegisterType((*GenesisState)(nil), "slinky.oracle.v1.GenesisState")
 }
@@ -299,39 +761,74 @@ func init() {
 func init() { proto.RegisterFile("slinky/oracle/v1/genesis.proto", fileDescriptor_de36a97821ccc13b) }
 
 var fileDescriptor_de36a97821ccc13b = []byte{
-	// 505 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x8e, 0xd2, 0x40,
-	0x1c, 0xc7, 0x19, 0x60, 0x57, 0x1d, 0x70, 0x75, 0xcb, 0x6e, 0x44, 0xa2, 0x2d, 0x62, 0x4c, 0x48,
-	0x8c, 0xd3, 0x2c, 0x5e, 0xbc, 0x8a, 0x07, 0xe1, 0x60, 0x82, 0xd5, 0x93, 0x97, 0xa6, 0x4c, 0xc7,
-	0x32, 0xa1, 0xed, 0x34, 0x9d, 0xe9, 0x06, 0x7c, 0x02, 0x8f, 0xfb, 0x30, 0xbe, 0x80, 0x37, 0x8e,
-	0x1b, 0x4f, 0xea, 0x01, 0x0d, 0xbc, 0x88, 0xe9, 0xcc, 0x14, 0x8b, 0xbb, 0x07, 0x6f, 0xfd, 0xcd,
-	0xef, 0xdf, 0xf7, 0xf3, 0x9d, 0x29, 0x34, 0x79, 0x48, 0xe3, 0xf9, 0xd2, 0x66, 0xa9, 0x87, 0x43,
-	0x62, 0x9f, 0x9f, 0xd9, 0x01, 0x89, 0x09, 0xa7, 0x1c, 0x25, 0x29, 0x13, 0xcc, 0xb8, 0xab, 0xf2,
-	0x48, 0xe5, 0xd1, 0xf9, 0x59, 0xe7, 0x24, 0x60, 0x01, 0x93, 0x49, 0x3b, 0xff, 0x52, 0x75, 0x1d,
-	0x2b, 0x60, 0x2c, 0x08, 0x89, 0x2d, 0xa3, 0x69, 0xf6, 0xd1, 0x16, 0x34, 0x22, 0x5c, 0x78, 0x51,
-	0xa2, 0x0b, 0xee, 0x63, 0xc6, 0x23, 0xc6, 0x5d, 0xd5, 0xa9, 0x02, 0x9d, 0x7a, 0xac, 0x35, 0x88,
-	0x65, 0x42, 0x78, 0x2e, 0x01, 0x67, 0x69, 0x4a, 0x62, 0xbc, 0x74, 0x13, 0x8f, 0xa6, 0xaa, 0xa8,
-	0xf7, 0x15, 0x40, 0xf8, 0x36, 0x63, 0x82, 0x4c, 0x52, 0x8a, 0x89, 0xf1, 0x12, 0x1e, 0x24, 0xf9,
-	0x47, 0x1b, 0x74, 0x41, 0xff, 0xd6, 0xf0, 0xe9, 0x6a, 0x6d, 0x55, 0x7e, 0xae, 0xad, 0x53, 0x35,
-	0x98, 0xfb, 0x73, 0x44, 0x99, 0x1d, 0x79, 0x62, 0x86, 0xc6, 0xb1, 0xf8, 0xf6, 0xe5, 0x19, 0xd4,
-	0x1b, 0xc7, 0xb1, 0x70, 0x54, 0xa7, 0xf1, 0x06, 0xde, 0x99, 0x86, 0x0c, 0xcf, 0xdd, 0x9d, 0xd4,
-	0x76, 0xb5, 0x0b, 0xfa, 0x8d, 0x41, 0x07, 0x29, 0x18, 0x54, 0xc0, 0xa0, 0xf7, 0x45, 0xc5, 0xf0,
-	0x66, 0xbe, 0xe8, 0xe2, 0x97, 0x05, 0x9c, 0x23, 0xd9, 0xbc, 0xcb, 0x18, 0x8f, 0x60, 0x53, 0x8d,
-	0x9b, 0x11, 0x1a, 0xcc, 0x44, 0xbb, 0xd6, 0x05, 0xfd, 0xba, 0xd3, 0x90, 0x67, 0x23, 0x79, 0xd4,
-	0xe3, 0xf0, 0xf8, 0x95, 0x46, 0x9b, 0x78, 0x34, 0x7d, 0x27, 0x3c, 0x41, 0x8c, 0x17, 0x65, 0x92,
-	0xc6, 0xe0, 0x01, 0xfa, 0xd7, 0x71, 0xf4, 0x17, 0x7b, 0x58, 0x5f, 0xad, 0x2d, 0x50, 0x00, 0x9c,
-	0xc0, 0x83, 0x98, 0xc5, 0x98, 0x48, 0xd9, 0x75, 0x47, 0x05, 0xc6, 0x11, 0xac, 0x52, 0x5f, 0x6f,
-	0xaf, 0x52, 0xbf, 0xf7, 0x03, 0xc0, 0x56, 0x79, 0xeb, 0x6b, 0x75, 0xbf, 0xc6, 0x08, 0xde, 0xde,
-	0xf3, 0x59, 0xef, 0x7f, 0x58, 0xec, 0x97, 0xb7, 0x91, 0xaf, 0x2f, 0x37, 0x4b, 0x01, 0x15, 0xa7,
-	0x89, 0x4b, 0x67, 0x86, 0x03, 0x5b, 0x7b, 0x93, 0x5c, 0xc5, 0x53, 0xfd, 0x6f, 0x9e, 0xe3, 0xf2,
-	0xb8, 0xc9, 0x3e, 0x5b, 0xed, 0x2a, 0x5b, 0x7d, 0xc7, 0xf6, 0x19, 0xc0, 0xa6, 0xe6, 0x51, 0x66,
-	0xba, 0xf0, 0x74, 0x5f, 0x8a, 0x7e, 0xcd, 0x6d, 0xd0, 0xad, 0xf5, 0x1b, 0x83, 0x27, 0x57, 0xc5,
-	0x5c, 0x63, 0x8d, 0x86, 0x6c, 0xe1, 0x6b, 0x5c, 0xbb, 0x07, 0x6f, 0xc4, 0x64, 0x21, 0x5c, 0xea,
-	0x6b, 0xd7, 0x0f, 0xf3, 0x70, 0xec, 0x0f, 0x47, 0xab, 0x8d, 0x09, 0x2e, 0x37, 0x26, 0xf8, 0xbd,
-	0x31, 0xc1, 0xc5, 0xd6, 0xac, 0x5c, 0x6e, 0xcd, 0xca, 0xf7, 0xad, 0x59, 0xf9, 0x80, 0x02, 0x2a,
-	0x66, 0xd9, 0x14, 0x61, 0x16, 0xd9, 0x9f, 0x58, 0x90, 0x2d, 0xe4, 0xb3, 0xc2, 0x2c, 0xb4, 0xf5,
-	0xbb, 0x5f, 0x14, 0x7f, 0x9f, 0xb4, 0x7c, 0x7a, 0x28, 0xf3, 0xcf, 0xff, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0x50, 0x95, 0x3b, 0x4b, 0x9b, 0x03, 0x00, 0x00,
+	// 1064 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xc6, 0x8e, 0x93, 0x8c, 0x93, 0xb4, 0xd9, 0x38, 0x65, 0x1b, 0x5a, 0xc7, 0x75, 0x54,
+	0x88, 0x84, 0xba, 0xab, 0x06, 0x09, 0x71, 0x8d, 0x5b, 0x29, 0x89, 0x2a, 0xc0, 0x2c, 0xa8, 0x45,
+	0x5c, 0x56, 0xe3, 0xdd, 0xe9, 0x7a, 0x94, 0xdd, 0x9d, 0xd5, 0xcc, 0xac, 0x63, 0xf3, 0x29, 0x7a,
+	0xe4, 0xc4, 0x95, 0x2f, 0xd0, 0x2f, 0xc0, 0x2d, 0xe2, 0x54, 0x71, 0x02, 0x0e, 0x01, 0x25, 0x7c,
+	0x06, 0xc4, 0x11, 0xcd, 0xbf, 0xf5, 0x5f, 0xa9, 0x15, 0xe2, 0x94, 0xbc, 0xf7, 0x7b, 0xf3, 0xe6,
+	0xf7, 0x7e, 0xf3, 0xde, 0x5b, 0x83, 0x26, 0x4b, 0x70, 0x76, 0x3e, 0xf2, 0x08, 0x85, 0x61, 0x82,
+	0xbc, 0xc1, 0x63, 0x2f, 0x46, 0x19, 0x62, 0x98, 0xb9, 0x39, 0x25, 0x9c, 0xd8, 0xb7, 0x15, 0xee,
+	0x2a, 0xdc, 0x1d, 0x3c, 0xde, 0x6b, 0xc4, 0x24, 0x26, 0x12, 0xf4, 0xc4, 0x7f, 0x2a, 0x6e, 0x6f,
+	0x3f, 0x26, 0x24, 0x4e, 0x90, 0x27, 0xad, 0x5e, 0xf1, 0xd2, 0xe3, 0x38, 0x45, 0x8c, 0xc3, 0x34,
+	0xd7, 0x01, 0xcd, 0xd9, 0x80, 0xa8, 0xa0, 0x90, 0x63, 0x92, 0x69, 0xfc, 0xee, 0x2c, 0x0e, 0xb3,
+	0x91, 0x81, 0x42, 0xc2, 0x52, 0xc2, 0x02, 0x75, 0xa9, 0x32, 0x34, 0x74, 0xa0, 0xe9, 0xf3, 0x51,
+	0x8e, 0x98, 0x60, 0x1f, 0x16, 0x94, 0xa2, 0x2c, 0x1c, 0x05, 0x39, 0xc4, 0x54, 0x07, 0xdd, 0x9f,
+	0xab, 0x31, 0x87, 0x14, 0xa6, 0x3a, 0x47, 0xfb, 0x27, 0x0b, 0x80, 0x2f, 0x0b, 0xc2, 0x51, 0x97,
+	0xe2, 0x10, 0xd9, 0xc7, 0x60, 0x25, 0x17, 0xff, 0x38, 0x56, 0xcb, 0x3a, 0x5c, 0xef, 0x7c, 0x74,
+	0x79, 0xb5, 0xbf, 0xf4, 0xfb, 0xd5, 0xfe, 0xae, 0xba, 0x97, 0x45, 0xe7, 0x2e, 0x26, 0x5e, 0x0a,
+	0x79, 0xdf, 0x3d, 0xcb, 0xf8, 0x2f, 0xaf, 0x1f, 0x01, 0x4d, 0xe8, 0x2c, 0xe3, 0xbe, 0x3a, 0x69,
+	0x7f, 0x06, 0x6e, 0xf5, 0x12, 0x12, 0x9e, 0x07, 0xa5, 0x08, 0xce, 0x72, 0xcb, 0x3a, 0xac, 0x1f,
+	0xed, 0xb9, 0xaa, 0x4a, 0xd7, 0x54, 0xe9, 0x7e, 0x6d, 0x22, 0x3a, 0x6b, 0xe2, 0xa2, 0x57, 0x7f,
+	0xec, 0x5b, 0xfe, 0x96, 0x3c, 0x5c, 0x22, 0xf6, 0x03, 0xb0, 0xa1, 0xd2, 0xf5, 0x11, 0x8e, 0xfb,
+	0xdc, 0xa9, 0xb4, 0xac, 0xc3, 0xaa, 0x5f, 0x97, 0xbe, 0x53, 0xe9, 0x6a, 0x33, 0xb0, 0xfd, 0x44,
+	0x57, 0xde, 0x85, 0x98, 0x7e, 0xc5, 0x21, 0x47, 0xf6, 0xa7, 0x93, 0x95, 0xd4, 0x8f, 0xee, 0xb9,
+	0xb3, 0x6f, 0xe9, 0x8e, 0xcb, 0xee, 0x54, 0x2f, 0xaf, 0xf6, 0x2d, 0x53, 0x40, 0x03, 0xac, 0x64,
+	0x24, 0x0b, 0x91, 0xa4, 0x5d, 0xf5, 0x95, 0x61, 0x6f, 0x81, 0x65, 0x1c, 0xe9, 0xdb, 0x97, 0x71,
+	0xd4, 0xfe, 0xc7, 0x02, 0x5b, 0xf2, 0xf0, 0x49, 0x01, 0x69, 0x44, 0x21, 0x4e, 0x6c, 0x0f, 0x34,
+	0x52, 0x38, 0x0c, 0x64, 0x96, 0x20, 0xec, 0xc3, 0x2c, 0x46, 0x41, 0x9e, 0xa7, 0x92, 0x41, 0xd5,
+	0xdf, 0x4e, 0xe1, 0x50, 0x1e, 0x78, 0x22, 0x91, 0x6e, 0x9e, 0xda, 0x27, 0x60, 0x73, 0x7c, 0x00,
+	0xc6, 0x48, 0x0b, 0x75, 0x77, 0x4e, 0xa8, 0xa7, 0xba, 0x5d, 0x94, 0x4e, 0xdf, 0x0b, 0x9d, 0xea,
+	0x26, 0xdd, 0x71, 0x2c, 0x29, 0x87, 0x89, 0x50, 0x5a, 0xf0, 0x5b, 0xf3, 0x95, 0x21, 0xf9, 0xe0,
+	0x2c, 0x18, 0x10, 0x8e, 0xb3, 0x38, 0xc8, 0xc9, 0x05, 0xa2, 0x92, 0x4f, 0x55, 0xf3, 0xc1, 0xd9,
+	0x73, 0x09, 0x75, 0x05, 0x22, 0xf8, 0x3c, 0x04, 0x5b, 0xf2, 0x00, 0x4c, 0x70, 0x04, 0x39, 0xa1,
+	0xcc, 0x59, 0x91, 0xa1, 0x9b, 0x22, 0xb4, 0x74, 0xb6, 0x7f, 0xb4, 0xc0, 0xee, 0xa4, 0xe0, 0x63,
+	0x05, 0x4e, 0xc1, 0xe6, 0x54, 0x0f, 0x6a, 0xf1, 0xef, 0x1b, 0xf1, 0x65, 0xa7, 0x0a, 0xed, 0x27,
+	0x8f, 0x4b, 0xf5, 0x97, 0xfc, 0x8d, 0x70, 0xc2, 0x67, 0x3f, 0x05, 0xeb, 0xb1, 0x49, 0xab, 0x65,
+	0x69, 0xcd, 0x3f, 0xe1, 0xf4, 0x03, 0xe8, 0x44, 0xe3, 0x83, 0xed, 0x1f, 0x96, 0x41, 0xa3, 0x24,
+	0xde, 0x45, 0xf4, 0x25, 0xa1, 0x29, 0x14, 0xaf, 0x79, 0x0f, 0xac, 0x97, 0x55, 0xaa, 0x5e, 0xf7,
+	0xc7, 0x0e, 0xfb, 0x0e, 0xa8, 0x5d, 0xe0, 0x2c, 0x22, 0x17, 0xba, 0x05, 0xb4, 0x25, 0xfc, 0xb2,
+	0xef, 0x98, 0xee, 0x03, 0x6d, 0x89, 0x1e, 0x85, 0x3d, 0x86, 0x32, 0x2e, 0xb4, 0x46, 0x4c, 0x0b,
+	0x5c, 0x57, 0xbe, 0xe7, 0xc2, 0x65, 0x3b, 0x60, 0x95, 0xa2, 0x9c, 0x50, 0x6e, 0x34, 0x35, 0xa6,
+	0x12, 0x9d, 0x31, 0x14, 0x05, 0x26, 0xa0, 0x66, 0x44, 0x17, 0x5e, 0x5f, 0x87, 0x1d, 0x80, 0x4d,
+	0xc6, 0x61, 0x82, 0xca, 0xa8, 0x55, 0x19, 0xb5, 0x21, 0x9d, 0x26, 0xc8, 0x03, 0x3b, 0x11, 0x1a,
+	0x60, 0xd9, 0x2b, 0x41, 0x1f, 0x33, 0x4e, 0x62, 0x0a, 0x53, 0x67, 0xad, 0x55, 0x39, 0xac, 0xfa,
+	0x76, 0x09, 0x9d, 0x1a, 0xa4, 0xfd, 0x39, 0xd8, 0x9e, 0x90, 0xe5, 0x45, 0x59, 0xa6, 0x2e, 0xdf,
+	0x9a, 0x2a, 0xff, 0x01, 0x10, 0xb7, 0x51, 0x6e, 0x46, 0x51, 0x88, 0x53, 0xf1, 0xeb, 0xd2, 0xa7,
+	0x47, 0xf1, 0x2f, 0x0b, 0xac, 0x7f, 0x21, 0x9f, 0xe7, 0x19, 0x1a, 0xbd, 0x45, 0xe5, 0x13, 0xb0,
+	0x9a, 0x17, 0xbd, 0xe0, 0x1c, 0x8d, 0xf4, 0x03, 0x37, 0xe6, 0xfa, 0xfe, 0x38, 0x1b, 0x75, 0x9c,
+	0x9f, 0x5f, 0x3f, 0x6a, 0xe8, 0x35, 0x13, 0xd2, 0x51, 0xce, 0x89, 0xdb, 0x2d, 0x7a, 0xcf, 0xd0,
+	0xc8, 0xaf, 0xe5, 0xf2, 0xaf, 0xfd, 0x0d, 0xb8, 0x9d, 0x53, 0x34, 0xc0, 0xa4, 0x60, 0x81, 0xc9,
+	0x58, 0xf9, 0x4f, 0x19, 0xb7, 0x4c, 0x1e, 0x65, 0x0b, 0x25, 0x74, 0xad, 0x55, 0x59, 0xab, 0xb6,
+	0xda, 0xbf, 0x59, 0x60, 0x67, 0x6a, 0x02, 0xd4, 0x67, 0xe3, 0x7f, 0xec, 0x7f, 0x1f, 0xec, 0x4c,
+	0x65, 0x52, 0x4b, 0xc2, 0x59, 0x7e, 0xe7, 0x65, 0xb6, 0x3d, 0x99, 0xae, 0x3b, 0xbd, 0xd8, 0x2a,
+	0xf3, 0x8b, 0xad, 0x5a, 0x2e, 0xb6, 0xbf, 0xab, 0x60, 0x43, 0xd7, 0xa3, 0x36, 0x69, 0x00, 0x76,
+	0xa7, 0xa9, 0xe8, 0x8f, 0xa4, 0x63, 0xb5, 0x2a, 0x87, 0xf5, 0xa3, 0x87, 0xf3, 0x64, 0x16, 0x48,
+	0xa3, 0x8b, 0xdc, 0x09, 0x17, 0xa8, 0xf6, 0x1e, 0x58, 0xcd, 0xd0, 0x90, 0x07, 0x38, 0x32, 0xf3,
+	0x26, 0xcc, 0xb3, 0xc8, 0xfe, 0x04, 0xd4, 0xd4, 0xc7, 0x4a, 0x3f, 0xa7, 0xb3, 0x60, 0x03, 0x48,
+	0x5c, 0x67, 0xd7, 0xd1, 0xaa, 0x21, 0xc4, 0x4e, 0x2d, 0x37, 0x81, 0x98, 0x49, 0x41, 0xf6, 0xc3,
+	0xb7, 0x90, 0x9d, 0x59, 0x25, 0xb7, 0xf2, 0xa9, 0x05, 0xc3, 0xec, 0x17, 0x60, 0xb7, 0x0f, 0x13,
+	0x8e, 0xa2, 0x60, 0x4a, 0x12, 0x31, 0xd4, 0x95, 0x77, 0x7d, 0xe8, 0x1d, 0x95, 0x61, 0x12, 0x11,
+	0x94, 0xed, 0x7c, 0x3c, 0x88, 0x81, 0x9e, 0xbf, 0x9a, 0x2c, 0xfb, 0x60, 0x41, 0xd9, 0xb3, 0x43,
+	0x6b, 0x5e, 0x3d, 0x9f, 0x9b, 0xe6, 0x10, 0xdc, 0x29, 0x67, 0x2e, 0x98, 0x80, 0xc5, 0x06, 0x11,
+	0x9c, 0x3f, 0x98, 0xcf, 0xbe, 0x68, 0x65, 0x6a, 0xf2, 0xbb, 0x83, 0x05, 0x18, 0xb3, 0x3b, 0xa0,
+	0xae, 0x8e, 0x8b, 0xe1, 0x63, 0x72, 0xe1, 0xd4, 0x8f, 0xde, 0x9f, 0xcf, 0x5c, 0xee, 0x06, 0x9d,
+	0x0e, 0x10, 0xe3, 0x60, 0x9d, 0xd3, 0xcb, 0xeb, 0xa6, 0xf5, 0xe6, 0xba, 0x69, 0xfd, 0x79, 0xdd,
+	0xb4, 0x5e, 0xdd, 0x34, 0x97, 0xde, 0xdc, 0x34, 0x97, 0x7e, 0xbd, 0x69, 0x2e, 0x7d, 0xeb, 0xc6,
+	0x98, 0xf7, 0x8b, 0x9e, 0x1b, 0x92, 0xd4, 0xfb, 0x8e, 0xc4, 0xc5, 0x50, 0x8e, 0x73, 0x48, 0x12,
+	0x4f, 0xff, 0xb8, 0x19, 0x9a, 0x9f, 0x37, 0x52, 0xf7, 0x5e, 0x4d, 0xe2, 0x1f, 0xff, 0x3b, 0x00,
+	0x93, 0x47, 0xcd, 0x61, 0xe0, 0x09, 0x00, 0x00,
 }
 
 func (m *QuotePrice) Marshal() (dAtA []byte, err error) {
@@ -425,7 +922,7 @@ func (m *CurrencyPairState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *CurrencyPairGenesis) Marshal() (dAtA []byte, err error) {
+func (m *PriceGuardrail) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -435,30 +932,299 @@ func (m *CurrencyPairGenesis) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CurrencyPairGenesis) MarshalTo(dAtA []byte) (int, error) {
+func (m *PriceGuardrail) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CurrencyPairGenesis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PriceGuardrail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
+	if m.MinValidators != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MinValidators))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MinVotingPowerPpm != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MinVotingPowerPpm))
 		i--
 		dAtA[i] = 0x20
 	}
-	if m.Nonce != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.Nonce))
+	if m.Clamp {
+		i--
+		if m.Clamp {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.CurrencyPairPrice != nil {
-		{
-			size, err := m.CurrencyPairPrice.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxPriceAge, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxPriceAge):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.MaxPriceChangePpm != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxPriceChangePpm))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CurrencyPairGuardrail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CurrencyPairGuardrail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CurrencyPairGuardrail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Guardrail.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeviationHistogram) > 0 {
+		dAtA7 := make([]byte, len(m.DeviationHistogram)*10)
+		var j6 int
+		for _, num := range m.DeviationHistogram {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintGenesis(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.StaleReports != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StaleReports))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MissedReports != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MissedReports))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Reports != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Reports))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AbsentVotes != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AbsentVotes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Window != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PerformanceWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PerformanceWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PerformanceWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Window != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PreviousPubKey != nil {
+		{
+			size, err := m.PreviousPubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PubKey != nil {
+		{
+			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CurrencyPairGenesis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CurrencyPairGenesis) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CurrencyPairGenesis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CurrencyPairPrice != nil {
+		{
+			size, err := m.CurrencyPairPrice.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
 			i -= size
@@ -500,6 +1266,84 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OracleKeys) > 0 {
+		for iNdEx := len(m.OracleKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ValidatorPerformances) > 0 {
+		for iNdEx := len(m.ValidatorPerformances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorPerformances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.PerformanceWindow != nil {
+		{
+			size, err := m.PerformanceWindow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.HaltedCurrencyPairs) > 0 {
+		for iNdEx := len(m.HaltedCurrencyPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HaltedCurrencyPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PriceGuardrails) > 0 {
+		for iNdEx := len(m.PriceGuardrails) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceGuardrails[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if m.NextId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextId))
 		i--
@@ -568,19 +1412,132 @@ func (m *CurrencyPairState) Size() (n int) {
 	return n
 }
 
-func (m *CurrencyPairGenesis) Size() (n int) {
+func (m *PriceGuardrail) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.CurrencyPair.Size()
+	if m.MaxPriceChangePpm != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxPriceChangePpm))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxPriceAge)
 	n += 1 + l + sovGenesis(uint64(l))
-	if m.CurrencyPairPrice != nil {
-		l = m.CurrencyPairPrice.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.Clamp {
+		n += 2
 	}
-	if m.Nonce != 0 {
+	if m.MinVotingPowerPpm != 0 {
+		n += 1 + sovGenesis(uint64(m.MinVotingPowerPpm))
+	}
+	if m.MinValidators != 0 {
+		n += 1 + sovGenesis(uint64(m.MinValidators))
+	}
+	return n
+}
+
+func (m *CurrencyPairGuardrail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Guardrail.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ValidatorPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Window != 0 {
+		n += 1 + sovGenesis(uint64(m.Window))
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovGenesis(uint64(m.Blocks))
+	}
+	if m.AbsentVotes != 0 {
+		n += 1 + sovGenesis(uint64(m.AbsentVotes))
+	}
+	if m.Reports != 0 {
+		n += 1 + sovGenesis(uint64(m.Reports))
+	}
+	if m.MissedReports != 0 {
+		n += 1 + sovGenesis(uint64(m.MissedReports))
+	}
+	if m.StaleReports != 0 {
+		n += 1 + sovGenesis(uint64(m.StaleReports))
+	}
+	if len(m.DeviationHistogram) > 0 {
+		l = 0
+		for _, e := range m.DeviationHistogram {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *PerformanceWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovGenesis(uint64(m.Window))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.StartHeight))
+	}
+	return n
+}
+
+func (m *OracleKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.PreviousPubKey != nil {
+		l = m.PreviousPubKey.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *CurrencyPairGenesis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.CurrencyPairPrice != nil {
+		l = m.CurrencyPairPrice.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Nonce != 0 {
 		n += 1 + sovGenesis(uint64(m.Nonce))
 	}
 	if m.Id != 0 {
@@ -604,6 +1561,36 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextId != 0 {
 		n += 1 + sovGenesis(uint64(m.NextId))
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PriceGuardrails) > 0 {
+		for _, e := range m.PriceGuardrails {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HaltedCurrencyPairs) > 0 {
+		for _, e := range m.HaltedCurrencyPairs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PerformanceWindow != nil {
+		l = m.PerformanceWindow.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.ValidatorPerformances) > 0 {
+		for _, e := range m.ValidatorPerformances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OracleKeys) > 0 {
+		for _, e := range m.OracleKeys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -708,12 +1695,772 @@ func (m *QuotePrice) Unmarshal(dAtA []byte) error {
 			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTimestamp, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CurrencyPairState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurrencyPairState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurrencyPairState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Price == nil {
+				m.Price = &QuotePrice{}
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceGuardrail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceGuardrail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceGuardrail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceChangePpm", wireType)
+			}
+			m.MaxPriceChangePpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceChangePpm |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxPriceAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clamp", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Clamp = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVotingPowerPpm", wireType)
+			}
+			m.MinVotingPowerPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinVotingPowerPpm |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidators", wireType)
+			}
+			m.MinValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinValidators |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CurrencyPairGuardrail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurrencyPairGuardrail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurrencyPairGuardrail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardrail", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Guardrail.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPerformance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPerformance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbsentVotes", wireType)
+			}
+			m.AbsentVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AbsentVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
+			}
+			m.Reports = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reports |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedReports", wireType)
+			}
+			m.MissedReports = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedReports |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleReports", wireType)
+			}
+			m.StaleReports = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StaleReports |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DeviationHistogram = append(m.DeviationHistogram, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.DeviationHistogram) == 0 {
+					m.DeviationHistogram = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DeviationHistogram = append(m.DeviationHistogram, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationHistogram", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PerformanceWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PerformanceWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PerformanceWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			m.BlockHeight = 0
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -723,7 +2470,7 @@ func (m *QuotePrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				m.StartHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -749,7 +2496,7 @@ func (m *QuotePrice) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CurrencyPairState) Unmarshal(dAtA []byte) error {
+func (m *OracleKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -772,15 +2519,47 @@ func (m *CurrencyPairState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CurrencyPairState: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CurrencyPairState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -807,18 +2586,18 @@ func (m *CurrencyPairState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Price == nil {
-				m.Price = &QuotePrice{}
+			if m.PubKey == nil {
+				m.PubKey = &types1.Any{}
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPubKey", wireType)
 			}
-			m.Nonce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -828,16 +2607,33 @@ func (m *CurrencyPairState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreviousPubKey == nil {
+				m.PreviousPubKey = &types1.Any{}
+			}
+			if err := m.PreviousPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.Id = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -847,7 +2643,7 @@ func (m *CurrencyPairState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1112,6 +2908,211 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceGuardrails", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceGuardrails = append(m.PriceGuardrails, CurrencyPairGuardrail{})
+			if err := m.PriceGuardrails[len(m.PriceGuardrails)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltedCurrencyPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HaltedCurrencyPairs = append(m.HaltedCurrencyPairs, types.CurrencyPair{})
+			if err := m.HaltedCurrencyPairs[len(m.HaltedCurrencyPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PerformanceWindow == nil {
+				m.PerformanceWindow = &PerformanceWindow{}
+			}
+			if err := m.PerformanceWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPerformances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorPerformances = append(m.ValidatorPerformances, ValidatorPerformance{})
+			if err := m.ValidatorPerformances[len(m.ValidatorPerformances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleKeys = append(m.OracleKeys, OracleKey{})
+			if err := m.OracleKeys[len(m.OracleKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
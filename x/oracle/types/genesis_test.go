@@ -3,6 +3,8 @@ package types_test
 import (
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
@@ -129,3 +131,170 @@ func TestGenesisValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisGuardrailValidation(t *testing.T) {
+	cp := slinkytypes.NewCurrencyPair("AA", "BB")
+	cpgs := []types.CurrencyPairGenesis{{CurrencyPair: cp, Id: 0}}
+	guardrail := types.NewPriceGuardrail(100_000, 0, false)
+
+	tcs := []struct {
+		name       string
+		guardrails []types.CurrencyPairGuardrail
+		halted     []slinkytypes.CurrencyPair
+		expectPass bool
+	}{
+		{
+			"if the guardrails and halted currency-pairs are valid - pass",
+			[]types.CurrencyPairGuardrail{{CurrencyPair: cp, Guardrail: guardrail}},
+			[]slinkytypes.CurrencyPair{cp},
+			true,
+		},
+		{
+			"if a guardrail is invalid - fail",
+			[]types.CurrencyPairGuardrail{{CurrencyPair: cp, Guardrail: types.NewPriceGuardrail(types.PPMPrecision+1, 0, false)}},
+			nil,
+			false,
+		},
+		{
+			"if a guardrail is for an unknown currency-pair - fail",
+			[]types.CurrencyPairGuardrail{{CurrencyPair: slinkytypes.NewCurrencyPair("CC", "DD"), Guardrail: guardrail}},
+			nil,
+			false,
+		},
+		{
+			"if a guardrail is repeated - fail",
+			[]types.CurrencyPairGuardrail{{CurrencyPair: cp, Guardrail: guardrail}, {CurrencyPair: cp, Guardrail: guardrail}},
+			nil,
+			false,
+		},
+		{
+			"if a halted currency-pair is unknown - fail",
+			nil,
+			[]slinkytypes.CurrencyPair{slinkytypes.NewCurrencyPair("CC", "DD")},
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := types.NewGenesisStateWithParams(cpgs, 1, types.DefaultParams())
+			gs.PriceGuardrails = tc.guardrails
+			gs.HaltedCurrencyPairs = tc.halted
+
+			err := gs.Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestGenesisPerformanceValidation(t *testing.T) {
+	validator := sdk.ConsAddress("validator")
+	performance := types.NewValidatorPerformance(validator, 1)
+
+	tcs := []struct {
+		name         string
+		window       *types.PerformanceWindow
+		performances []types.ValidatorPerformance
+		expectPass   bool
+	}{
+		{
+			"if the performance window and performances are valid - pass",
+			&types.PerformanceWindow{Window: 2, StartHeight: 10},
+			[]types.ValidatorPerformance{performance, types.NewValidatorPerformance(validator, 2)},
+			true,
+		},
+		{
+			"if the performance window is 0 - fail",
+			&types.PerformanceWindow{Window: 0},
+			nil,
+			false,
+		},
+		{
+			"if there are performances but no performance window - fail",
+			nil,
+			[]types.ValidatorPerformance{performance},
+			false,
+		},
+		{
+			"if a performance is for a future window - fail",
+			&types.PerformanceWindow{Window: 1},
+			[]types.ValidatorPerformance{types.NewValidatorPerformance(validator, 2)},
+			false,
+		},
+		{
+			"if a performance is repeated - fail",
+			&types.PerformanceWindow{Window: 1},
+			[]types.ValidatorPerformance{performance, performance},
+			false,
+		},
+		{
+			"if a performance is invalid - fail",
+			&types.PerformanceWindow{Window: 1},
+			[]types.ValidatorPerformance{{Validator: validator.String(), Window: 1}},
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := types.DefaultGenesisState()
+			gs.PerformanceWindow = tc.window
+			gs.ValidatorPerformances = tc.performances
+
+			err := gs.Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestGenesisOracleKeyValidation(t *testing.T) {
+	key, err := types.NewOracleKey(sdk.ConsAddress("validator1"), ed25519.GenPrivKey().PubKey(), 10)
+	require.NoError(t, err)
+
+	other, err := types.NewOracleKey(sdk.ConsAddress("validator2"), ed25519.GenPrivKey().PubKey(), 10)
+	require.NoError(t, err)
+
+	tcs := []struct {
+		name       string
+		keys       []types.OracleKey
+		expectPass bool
+	}{
+		{
+			"if the oracle keys are valid - pass",
+			[]types.OracleKey{key, other},
+			true,
+		},
+		{
+			"if an oracle key is invalid - fail",
+			[]types.OracleKey{{Validator: key.Validator}},
+			false,
+		},
+		{
+			"if an oracle key is repeated - fail",
+			[]types.OracleKey{key, key},
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := types.DefaultGenesisState()
+			gs.OracleKeys = tc.keys
+
+			err := gs.Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
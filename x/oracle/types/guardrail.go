@@ -0,0 +1,108 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// PPMPrecision is the number of parts-per-million in a unit.
+const PPMPrecision = 1_000_000
+
+// NewPriceGuardrail returns a new PriceGuardrail.
+func NewPriceGuardrail(maxPriceChangePpm uint64, maxPriceAge time.Duration, clamp bool) PriceGuardrail {
+	return PriceGuardrail{
+		MaxPriceChangePpm: maxPriceChangePpm,
+		MaxPriceAge:       maxPriceAge,
+		Clamp:             clamp,
+	}
+}
+
+// WithQuorum returns the guardrail with the given quorum: the minimum voting power, in parts-per-million
+// of the total bonded stake, and the minimum number of validators that must report a price for the price
+// to be updated.
+func (g PriceGuardrail) WithQuorum(minVotingPowerPpm, minValidators uint64) PriceGuardrail {
+	g.MinVotingPowerPpm = minVotingPowerPpm
+	g.MinValidators = minValidators
+	return g
+}
+
+// ValidateBasic validates that the max price change and the min voting power are at most 100%, and that
+// the max price age is non-negative.
+func (g *PriceGuardrail) ValidateBasic() error {
+	if g.MaxPriceChangePpm > PPMPrecision {
+		return fmt.Errorf("max price change must be at most %d ppm; got %d", PPMPrecision, g.MaxPriceChangePpm)
+	}
+
+	if g.MaxPriceAge < 0 {
+		return fmt.Errorf("max price age cannot be negative: %s", g.MaxPriceAge)
+	}
+
+	if g.MinVotingPowerPpm > PPMPrecision {
+		return fmt.Errorf("min voting power must be at most %d ppm; got %d", PPMPrecision, g.MinVotingPowerPpm)
+	}
+
+	return nil
+}
+
+// IsEmpty returns true if the guardrail does not restrict price updates in any way.
+func (g *PriceGuardrail) IsEmpty() bool {
+	return g.MaxPriceChangePpm == 0 && g.MaxPriceAge == 0 && g.MinVotingPowerPpm == 0 && g.MinValidators == 0
+}
+
+// IsStale returns true if the given latest price (nil if there is none) is older than the
+// guardrail's max price age at the given time. Prices are never stale if no max price age is set.
+func (g *PriceGuardrail) IsStale(latest *QuotePrice, now time.Time) bool {
+	if g.MaxPriceAge == 0 {
+		return false
+	}
+
+	if latest == nil {
+		return true
+	}
+
+	return now.Sub(latest.BlockTimestamp) > g.MaxPriceAge
+}
+
+// CheckPriceChange checks the next price against the previous price. The maximum allowed change is
+// MaxPriceChangePpm of the previous price for every block since the previous price was written. It
+// returns the price that may be written to state, and whether the change exceeded the maximum. If
+// the change exceeded the maximum and the guardrail clamps, the returned price is the previous price
+// moved by the maximum allowed change towards the next price.
+func (g *PriceGuardrail) CheckPriceChange(previous, next QuotePrice) (math.Int, bool) {
+	if g.MaxPriceChangePpm == 0 || !previous.Price.IsPositive() {
+		return next.Price, false
+	}
+
+	blocks := uint64(1)
+	if next.BlockHeight > previous.BlockHeight {
+		blocks = next.BlockHeight - previous.BlockHeight
+	}
+
+	// maxChange = previous * ppm * blocks / 1_000_000
+	maxChange := previous.Price.
+		Mul(math.NewIntFromUint64(g.MaxPriceChangePpm)).
+		Mul(math.NewIntFromUint64(blocks)).
+		QuoRaw(PPMPrecision)
+
+	change := next.Price.Sub(previous.Price)
+	if change.Abs().LTE(maxChange) {
+		return next.Price, false
+	}
+
+	if change.IsNegative() {
+		return previous.Price.Sub(maxChange), true
+	}
+
+	return previous.Price.Add(maxChange), true
+}
+
+// ValidateBasic validates the CurrencyPair and the guardrail.
+func (g *CurrencyPairGuardrail) ValidateBasic() error {
+	if err := g.CurrencyPair.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return g.Guardrail.ValidateBasic()
+}
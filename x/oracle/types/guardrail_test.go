@@ -0,0 +1,135 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zoguxprotocol/slinky/x/oracle/types"
+)
+
+func TestPriceGuardrailValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		guardrail types.PriceGuardrail
+		expectErr bool
+	}{
+		{
+			name:      "valid empty guardrail",
+			guardrail: types.PriceGuardrail{},
+			expectErr: false,
+		},
+		{
+			name:      "valid guardrail",
+			guardrail: types.NewPriceGuardrail(50_000, time.Minute, true),
+			expectErr: false,
+		},
+		{
+			name:      "invalid max price change",
+			guardrail: types.NewPriceGuardrail(types.PPMPrecision+1, time.Minute, false),
+			expectErr: true,
+		},
+		{
+			name:      "invalid max price age",
+			guardrail: types.NewPriceGuardrail(50_000, -time.Minute, false),
+			expectErr: true,
+		},
+		{
+			name:      "valid quorum",
+			guardrail: types.PriceGuardrail{}.WithQuorum(types.PPMPrecision, 5),
+			expectErr: false,
+		},
+		{
+			name:      "invalid min voting power",
+			guardrail: types.PriceGuardrail{}.WithQuorum(types.PPMPrecision+1, 0),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.guardrail.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPriceGuardrailIsEmpty(t *testing.T) {
+	require.True(t, (&types.PriceGuardrail{}).IsEmpty())
+	require.False(t, (&types.PriceGuardrail{MaxPriceChangePpm: 1}).IsEmpty())
+	require.False(t, (&types.PriceGuardrail{MaxPriceAge: time.Second}).IsEmpty())
+	require.False(t, (&types.PriceGuardrail{MinVotingPowerPpm: 1}).IsEmpty())
+	require.False(t, (&types.PriceGuardrail{MinValidators: 1}).IsEmpty())
+}
+
+func TestPriceGuardrailCheckPriceChange(t *testing.T) {
+	previous := types.QuotePrice{Price: math.NewInt(1_000), BlockHeight: 10}
+
+	testCases := []struct {
+		name          string
+		guardrail     types.PriceGuardrail
+		next          types.QuotePrice
+		expectedPrice int64
+		breached      bool
+	}{
+		{
+			name:          "no max price change",
+			guardrail:     types.NewPriceGuardrail(0, time.Minute, false),
+			next:          types.QuotePrice{Price: math.NewInt(5_000), BlockHeight: 11},
+			expectedPrice: 5_000,
+		},
+		{
+			name:          "change within the max price change",
+			guardrail:     types.NewPriceGuardrail(100_000, 0, false),
+			next:          types.QuotePrice{Price: math.NewInt(1_100), BlockHeight: 11},
+			expectedPrice: 1_100,
+		},
+		{
+			name:          "increase beyond the max price change is clamped",
+			guardrail:     types.NewPriceGuardrail(100_000, 0, true),
+			next:          types.QuotePrice{Price: math.NewInt(2_000), BlockHeight: 11},
+			expectedPrice: 1_100,
+			breached:      true,
+		},
+		{
+			name:          "decrease beyond the max price change is clamped",
+			guardrail:     types.NewPriceGuardrail(100_000, 0, true),
+			next:          types.QuotePrice{Price: math.NewInt(500), BlockHeight: 11},
+			expectedPrice: 900,
+			breached:      true,
+		},
+		{
+			name:          "max price change scales with the blocks since the previous price",
+			guardrail:     types.NewPriceGuardrail(100_000, 0, false),
+			next:          types.QuotePrice{Price: math.NewInt(1_300), BlockHeight: 13},
+			expectedPrice: 1_300,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			price, breached := tc.guardrail.CheckPriceChange(previous, tc.next)
+			require.Equal(t, tc.breached, breached)
+			require.Equal(t, math.NewInt(tc.expectedPrice), price)
+		})
+	}
+}
+
+func TestPriceGuardrailIsStale(t *testing.T) {
+	now := time.Unix(1_000, 0)
+	guardrail := types.NewPriceGuardrail(0, time.Minute, false)
+
+	require.True(t, guardrail.IsStale(nil, now))
+	require.False(t, guardrail.IsStale(&types.QuotePrice{BlockTimestamp: now.Add(-time.Minute)}, now))
+	require.True(t, guardrail.IsStale(&types.QuotePrice{BlockTimestamp: now.Add(-2 * time.Minute)}, now))
+
+	// prices are never stale without a max price age
+	require.False(t, (&types.PriceGuardrail{}).IsStale(nil, now))
+}
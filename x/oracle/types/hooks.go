@@ -0,0 +1,38 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// ValidatorPerformanceHooks is the interface that defines the hooks that can be integrated by other
+// modules to act on the oracle performance of validators, e.g. to slash or reward them.
+type ValidatorPerformanceHooks interface {
+	// AfterPerformanceWindowCompleted is called after a performance window is completed, with the
+	// performances of all validators over the window.
+	AfterPerformanceWindowCompleted(ctx sdk.Context, window uint64, performances []ValidatorPerformance) error
+}
+
+var _ ValidatorPerformanceHooks = MultiValidatorPerformanceHooks{}
+
+// MultiValidatorPerformanceHooks defines an array of ValidatorPerformanceHooks which can be executed
+// in sequence.
+type MultiValidatorPerformanceHooks []ValidatorPerformanceHooks
+
+// AfterPerformanceWindowCompleted calls all AfterPerformanceWindowCompleted hooks registered to the
+// MultiValidatorPerformanceHooks.
+func (mh MultiValidatorPerformanceHooks) AfterPerformanceWindowCompleted(ctx sdk.Context, window uint64, performances []ValidatorPerformance) error {
+	for i := range mh {
+		if err := mh[i].AfterPerformanceWindowCompleted(ctx, window, performances); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var _ ValidatorPerformanceHooks = &NoopValidatorPerformanceHooks{}
+
+// NoopValidatorPerformanceHooks defines validator performance hooks that are a no-op.
+type NoopValidatorPerformanceHooks struct{}
+
+func (n *NoopValidatorPerformanceHooks) AfterPerformanceWindowCompleted(_ sdk.Context, _ uint64, _ []ValidatorPerformance) error {
+	return nil
+}
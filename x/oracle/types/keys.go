@@ -33,6 +33,37 @@ var (
 	// NumCPsKeyPrefix is the key-prefix under which the number CPs is stored.
 	NumCPsKeyPrefix = collections.NewPrefix(5)
 
+	// ParamsKeyPrefix is the key-prefix under which the module Params are stored.
+	ParamsKeyPrefix = collections.NewPrefix(6)
+
+	// PriceHistoryKeyPrefix is the key-prefix under which the price history of each
+	// currency-pair is stored, keyed by (currency-pair ID, nonce).
+	PriceHistoryKeyPrefix = collections.NewPrefix(7)
+
+	// PriceGuardrailKeyPrefix is the key-prefix under which the price guardrail of each
+	// currency-pair is stored.
+	PriceGuardrailKeyPrefix = collections.NewPrefix(8)
+
+	// HaltedCurrencyPairKeyPrefix is the key-prefix under which the currency-pairs whose
+	// latest price update was rejected by their guardrail are stored.
+	HaltedCurrencyPairKeyPrefix = collections.NewPrefix(9)
+
+	// PerformanceWindowKeyPrefix is the key-prefix under which the current validator performance
+	// window is stored.
+	PerformanceWindowKeyPrefix = collections.NewPrefix(10)
+
+	// ValidatorPerformanceKeyPrefix is the key-prefix under which the validator performances are
+	// stored, keyed by (window, validator consensus address).
+	ValidatorPerformanceKeyPrefix = collections.NewPrefix(11)
+
+	// OracleKeyKeyPrefix is the key-prefix under which the oracle keys of the validators are
+	// stored, keyed by validator consensus address.
+	OracleKeyKeyPrefix = collections.NewPrefix(12)
+
+	// PrunedPriceHistoryLengthKeyPrefix is the key-prefix under which the price history length
+	// that the price history of every currency-pair was last pruned to is stored.
+	PrunedPriceHistoryLengthKeyPrefix = collections.NewPrefix(13)
+
 	// CounterCodec is the collections.KeyCodec value used for the counter values.
 	CounterCodec = codec.KeyToValueCodec[uint64](codec.NewUint64Key[uint64]())
 )
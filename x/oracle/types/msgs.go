@@ -1,6 +1,10 @@
 package types
 
 import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
@@ -9,6 +13,11 @@ import (
 var (
 	_ sdk.Msg = &MsgAddCurrencyPairs{}
 	_ sdk.Msg = &MsgRemoveCurrencyPairs{}
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetPriceGuardrails{}
+	_ sdk.Msg = &MsgSetOracleKey{}
+
+	_ codectypes.UnpackInterfacesMessage = &MsgSetOracleKey{}
 )
 
 // NewMsgAddCurrencyPairs returns a new message from a set of currency-pairs and an authority.
@@ -65,3 +74,92 @@ func (m *MsgRemoveCurrencyPairs) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgUpdateParams returns a new message to update the x/oracle module's Params.
+func NewMsgUpdateParams(authority string, params Params) MsgUpdateParams {
+	return MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// ValidateBasic determines whether the information in the message is valid, specifically
+// whether the authority is a valid acc-address, and that the Params are valid.
+func (m *MsgUpdateParams) ValidateBasic() error {
+	// validate authority address
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return err
+	}
+
+	return m.Params.ValidateBasic()
+}
+
+// NewMsgSetPriceGuardrails returns a new message to set the price guardrails of a set of currency-pairs.
+func NewMsgSetPriceGuardrails(authority string, guardrails []CurrencyPairGuardrail) MsgSetPriceGuardrails {
+	return MsgSetPriceGuardrails{
+		Authority:  authority,
+		Guardrails: guardrails,
+	}
+}
+
+// ValidateBasic determines whether the information in the message is valid, specifically
+// whether the authority is a valid acc-address, and that each guardrail is valid and refers
+// to a distinct CurrencyPair.
+func (m *MsgSetPriceGuardrails) ValidateBasic() error {
+	// validate authority address
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return err
+	}
+
+	cps := make(map[string]struct{})
+	for _, g := range m.Guardrails {
+		if err := g.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if _, ok := cps[g.CurrencyPair.String()]; ok {
+			return fmt.Errorf("repeated currency-pair: %v", g.CurrencyPair.String())
+		}
+
+		cps[g.CurrencyPair.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+// NewMsgSetOracleKey returns a new message to register the oracle key of a validator.
+func NewMsgSetOracleKey(validator sdk.ValAddress, pubKey cryptotypes.PubKey) (*MsgSetOracleKey, error) {
+	pkAny, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgSetOracleKey{
+		ValidatorAddress: validator.String(),
+		PubKey:           pkAny,
+	}, nil
+}
+
+// ValidateBasic determines whether the information in the message is valid, specifically
+// whether the validator address is a valid val-address, and that the oracle key is a public key.
+func (m *MsgSetOracleKey) ValidateBasic() error {
+	// validate validator address
+	_, err := sdk.ValAddressFromBech32(m.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	if _, err := unpackPubKey(m.PubKey); err != nil {
+		return fmt.Errorf("invalid oracle key: %w", err)
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces.
+func (m *MsgSetOracleKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pk cryptotypes.PubKey
+	return unpacker.UnpackAny(m.PubKey, &pk)
+}
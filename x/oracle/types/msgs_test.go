@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
@@ -105,3 +106,46 @@ func TestValidateBasicMsgRemoveCurrencyPairs(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateBasicMsgSetOracleKey(t *testing.T) {
+	valid, err := types.NewMsgSetOracleKey(sdk.ValAddress("validator"), ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+
+	tcs := []struct {
+		name       string
+		msg        types.MsgSetOracleKey
+		expectPass bool
+	}{
+		{
+			"if the validator is not a val-address - fail",
+			types.MsgSetOracleKey{
+				ValidatorAddress: sdk.AccAddress("validator").String(),
+				PubKey:           valid.PubKey,
+			},
+			false,
+		},
+		{
+			"if the oracle key is missing - fail",
+			types.MsgSetOracleKey{
+				ValidatorAddress: valid.ValidatorAddress,
+			},
+			false,
+		},
+		{
+			"if the validator + oracle key are valid - pass",
+			*valid,
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.expectPass {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ codectypes.UnpackInterfacesMessage = &OracleKey{}
+	_ codectypes.UnpackInterfacesMessage = &GenesisState{}
+	_ codectypes.UnpackInterfacesMessage = &GetOracleKeyResponse{}
+)
+
+// NewOracleKey returns the OracleKey of a validator, registered at the given height.
+func NewOracleKey(validator sdk.ConsAddress, pubKey cryptotypes.PubKey, height int64) (OracleKey, error) {
+	pkAny, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return OracleKey{}, err
+	}
+
+	return OracleKey{
+		Validator: validator.String(),
+		PubKey:    pkAny,
+		Height:    height,
+	}, nil
+}
+
+// OraclePubKey returns the current public oracle key of the validator.
+func (k *OracleKey) OraclePubKey() (cryptotypes.PubKey, error) {
+	return unpackPubKey(k.PubKey)
+}
+
+// PreviousOraclePubKey returns the public oracle key replaced by the current key, or nil if the
+// current key is the first key registered by the validator.
+func (k *OracleKey) PreviousOraclePubKey() (cryptotypes.PubKey, error) {
+	if k.PreviousPubKey == nil {
+		return nil, nil
+	}
+
+	return unpackPubKey(k.PreviousPubKey)
+}
+
+// PubKeyForHeight returns the public oracle key the vote extensions created at the given height
+// are signed with. The vote extensions created up to (and including) the height the current key
+// was registered at are signed with the previous key, as the registration only takes effect in the
+// following block. If there is no previous key, nil is returned for these heights.
+func (k *OracleKey) PubKeyForHeight(height int64) (cryptotypes.PubKey, error) {
+	if height > k.Height {
+		return k.OraclePubKey()
+	}
+
+	return k.PreviousOraclePubKey()
+}
+
+// ValidateBasic performs stateless validation of the OracleKey.
+func (k *OracleKey) ValidateBasic() error {
+	if _, err := sdk.ConsAddressFromBech32(k.Validator); err != nil {
+		return fmt.Errorf("invalid validator address %s: %w", k.Validator, err)
+	}
+
+	if k.PubKey == nil {
+		return fmt.Errorf("missing oracle key for validator %s", k.Validator)
+	}
+
+	if _, err := k.OraclePubKey(); err != nil {
+		return fmt.Errorf("invalid oracle key for validator %s: %w", k.Validator, err)
+	}
+
+	if _, err := k.PreviousOraclePubKey(); err != nil {
+		return fmt.Errorf("invalid previous oracle key for validator %s: %w", k.Validator, err)
+	}
+
+	if k.Height < 0 {
+		return fmt.Errorf("invalid height for the oracle key of validator %s: %d", k.Validator, k.Height)
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces.
+func (k *OracleKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pk cryptotypes.PubKey
+	if err := unpacker.UnpackAny(k.PubKey, &pk); err != nil {
+		return err
+	}
+
+	var previous cryptotypes.PubKey
+	return unpacker.UnpackAny(k.PreviousPubKey, &previous)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces.
+func (gs *GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for i := range gs.OracleKeys {
+		if err := gs.OracleKeys[i].UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces.
+func (r *GetOracleKeyResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return r.OracleKey.UnpackInterfaces(unpacker)
+}
+
+// unpackPubKey returns the cached public key of an Any. The Any must have been unpacked by the
+// codec, or created with NewAnyWithValue.
+func unpackPubKey(pkAny *codectypes.Any) (cryptotypes.PubKey, error) {
+	if pkAny == nil {
+		return nil, fmt.Errorf("missing public key")
+	}
+
+	pk, ok := pkAny.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, fmt.Errorf("expected %T, got %T", (cryptotypes.PubKey)(nil), pkAny.GetCachedValue())
+	}
+
+	return pk, nil
+}
@@ -0,0 +1,111 @@
+package types_test
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zoguxprotocol/slinky/x/oracle/types"
+)
+
+func TestOracleKeyPubKeyForHeight(t *testing.T) {
+	validator := sdk.ConsAddress("validator")
+	previous := ed25519.GenPrivKey().PubKey()
+	current := ed25519.GenPrivKey().PubKey()
+
+	t.Run("the first key is only used after the height it was registered at", func(t *testing.T) {
+		key, err := types.NewOracleKey(validator, current, 10)
+		require.NoError(t, err)
+
+		pk, err := key.PubKeyForHeight(10)
+		require.NoError(t, err)
+		require.Nil(t, pk)
+
+		pk, err = key.PubKeyForHeight(11)
+		require.NoError(t, err)
+		require.True(t, current.Equals(pk))
+	})
+
+	t.Run("the previous key is used up to the height the current key was registered at", func(t *testing.T) {
+		key, err := types.NewOracleKey(validator, current, 10)
+		require.NoError(t, err)
+		key.PreviousPubKey, err = codectypes.NewAnyWithValue(previous)
+		require.NoError(t, err)
+
+		pk, err := key.PubKeyForHeight(10)
+		require.NoError(t, err)
+		require.True(t, previous.Equals(pk))
+
+		pk, err = key.PubKeyForHeight(11)
+		require.NoError(t, err)
+		require.True(t, current.Equals(pk))
+	})
+}
+
+func TestOracleKeyValidateBasic(t *testing.T) {
+	key, err := types.NewOracleKey(sdk.ConsAddress("validator"), ed25519.GenPrivKey().PubKey(), 10)
+	require.NoError(t, err)
+
+	tcs := []struct {
+		name       string
+		key        func() types.OracleKey
+		expectPass bool
+	}{
+		{
+			"if the oracle key is valid - pass",
+			func() types.OracleKey { return key },
+			true,
+		},
+		{
+			"if the validator is not a cons-address - fail",
+			func() types.OracleKey {
+				k := key
+				k.Validator = sdk.AccAddress("validator").String()
+				return k
+			},
+			false,
+		},
+		{
+			"if the public key is missing - fail",
+			func() types.OracleKey {
+				k := key
+				k.PubKey = nil
+				return k
+			},
+			false,
+		},
+		{
+			"if the public key is not a public key - fail",
+			func() types.OracleKey {
+				k := key
+				k.PubKey = &codectypes.Any{TypeUrl: "/cosmos.crypto.ed25519.PubKey", Value: []byte("invalid")}
+				return k
+			},
+			false,
+		},
+		{
+			"if the height is negative - fail",
+			func() types.OracleKey {
+				k := key
+				k.Height = -1
+				return k
+			},
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			k := tc.key()
+			err := k.ValidateBasic()
+			if !tc.expectPass {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
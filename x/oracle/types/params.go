@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// DefaultPriceHistoryLength is the default number of most recent prices stored per
+	// currency-pair.
+	DefaultPriceHistoryLength = 100
+
+	// MaxPriceHistoryLength is the maximum number of most recent prices that can be stored
+	// per currency-pair.
+	MaxPriceHistoryLength = 10_000
+
+	// DefaultPerformanceHistory is the default number of completed validator performance
+	// windows retained in state.
+	DefaultPerformanceHistory = 10
+
+	// MaxPerformanceHistory is the maximum number of completed validator performance windows
+	// that can be retained in state.
+	MaxPerformanceHistory = 1_000
+)
+
+// DefaultParams returns default oracle parameters.
+func DefaultParams() Params {
+	return NewParamsWithPerformanceTracking(DefaultPriceHistoryLength, 0, DefaultPerformanceHistory)
+}
+
+// NewParams returns a new Params instance.
+func NewParams(priceHistoryLength uint64) Params {
+	return Params{
+		PriceHistoryLength: priceHistoryLength,
+	}
+}
+
+// NewParamsWithPerformanceTracking returns a new Params instance that tracks the oracle performance
+// of validators over windows of the given number of blocks.
+func NewParamsWithPerformanceTracking(priceHistoryLength, performanceWindow, performanceHistory uint64) Params {
+	return Params{
+		PriceHistoryLength: priceHistoryLength,
+		PerformanceWindow:  performanceWindow,
+		PerformanceHistory: performanceHistory,
+	}
+}
+
+// PerformanceTrackingEnabled returns true if the oracle performance of validators is tracked.
+func (p *Params) PerformanceTrackingEnabled() bool {
+	return p.PerformanceWindow > 0
+}
+
+// ValidateBasic performs stateless validation of the Params.
+func (p *Params) ValidateBasic() error {
+	if p.PriceHistoryLength > MaxPriceHistoryLength {
+		return fmt.Errorf("price history length must be at most %d; got %d", MaxPriceHistoryLength, p.PriceHistoryLength)
+	}
+
+	if p.PerformanceHistory > MaxPerformanceHistory {
+		return fmt.Errorf("performance history must be at most %d; got %d", MaxPerformanceHistory, p.PerformanceHistory)
+	}
+
+	return nil
+}
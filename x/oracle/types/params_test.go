@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zoguxprotocol/slinky/x/oracle/types"
+)
+
+func TestParamsValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		params    types.Params
+		expectErr bool
+	}{
+		{
+			name:      "valid default params",
+			params:    types.DefaultParams(),
+			expectErr: false,
+		},
+		{
+			name:      "valid disabled price history",
+			params:    types.NewParams(0),
+			expectErr: false,
+		},
+		{
+			name:      "valid max price history length",
+			params:    types.NewParams(types.MaxPriceHistoryLength),
+			expectErr: false,
+		},
+		{
+			name:      "invalid price history length",
+			params:    types.NewParams(types.MaxPriceHistoryLength + 1),
+			expectErr: true,
+		},
+		{
+			name:      "valid performance tracking",
+			params:    types.NewParamsWithPerformanceTracking(types.DefaultPriceHistoryLength, 100, types.MaxPerformanceHistory),
+			expectErr: false,
+		},
+		{
+			name:      "invalid performance history",
+			params:    types.NewParamsWithPerformanceTracking(types.DefaultPriceHistoryLength, 100, types.MaxPerformanceHistory+1),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
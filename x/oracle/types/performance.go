@@ -0,0 +1,125 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/zoguxprotocol/slinky/pkg/types"
+)
+
+// DeviationBucketBounds are the inclusive upper bounds, in basis points, of the buckets of the
+// deviation histogram of a ValidatorPerformance. The histogram has one more bucket, for the
+// deviations larger than the last bound.
+var DeviationBucketBounds = []uint64{0, 1, 2, 5, 10, 20, 50, 100, 200, 500, 1000}
+
+// ValidatorReport is the set of prices a validator reported in the vote extension included in a
+// block.
+type ValidatorReport struct {
+	// Validator is the consensus address of the validator.
+	Validator sdk.ConsAddress
+
+	// Absent is true if the validator did not vote for the block.
+	Absent bool
+
+	// Prices are the prices the validator reported.
+	Prices map[slinkytypes.CurrencyPair]*big.Int
+}
+
+// NewValidatorPerformance returns an empty ValidatorPerformance for the given validator and window.
+func NewValidatorPerformance(validator sdk.ConsAddress, window uint64) ValidatorPerformance {
+	return ValidatorPerformance{
+		Validator:          validator.String(),
+		Window:             window,
+		DeviationHistogram: make([]uint64, len(DeviationBucketBounds)+1),
+	}
+}
+
+// AddDeviation records a reported price deviating from the committed price by the given number of
+// basis points.
+func (vp *ValidatorPerformance) AddDeviation(bps uint64) {
+	if len(vp.DeviationHistogram) != len(DeviationBucketBounds)+1 {
+		vp.DeviationHistogram = make([]uint64, len(DeviationBucketBounds)+1)
+	}
+
+	bucket := len(DeviationBucketBounds)
+	for i, bound := range DeviationBucketBounds {
+		if bps <= bound {
+			bucket = i
+			break
+		}
+	}
+
+	vp.DeviationHistogram[bucket]++
+}
+
+// MedianDeviationBps returns the upper bound of the histogram bucket that contains the median
+// deviation of the validator's prices, in basis points. If the median is larger than the last
+// bound, the last bound is returned. If the validator did not report any price, 0 is returned.
+func (vp *ValidatorPerformance) MedianDeviationBps() uint64 {
+	var total uint64
+	for _, count := range vp.DeviationHistogram {
+		total += count
+	}
+	if total == 0 {
+		return 0
+	}
+
+	var seen uint64
+	for i, count := range vp.DeviationHistogram {
+		seen += count
+		if 2*seen >= total {
+			return DeviationBucketBounds[min(i, len(DeviationBucketBounds)-1)]
+		}
+	}
+
+	return DeviationBucketBounds[len(DeviationBucketBounds)-1]
+}
+
+// ValidateBasic performs stateless validation of the ValidatorPerformance.
+func (vp *ValidatorPerformance) ValidateBasic() error {
+	if _, err := sdk.ConsAddressFromBech32(vp.Validator); err != nil {
+		return fmt.Errorf("invalid validator address %s: %w", vp.Validator, err)
+	}
+
+	if vp.Window == 0 {
+		return fmt.Errorf("invalid window for validator %s: windows start at 1", vp.Validator)
+	}
+
+	if vp.AbsentVotes > vp.Blocks {
+		return fmt.Errorf("invalid performance for validator %s: absent votes %d exceed blocks %d", vp.Validator, vp.AbsentVotes, vp.Blocks)
+	}
+
+	if vp.MissedReports > vp.Reports {
+		return fmt.Errorf("invalid performance for validator %s: missed reports %d exceed reports %d", vp.Validator, vp.MissedReports, vp.Reports)
+	}
+
+	if len(vp.DeviationHistogram) != len(DeviationBucketBounds)+1 {
+		return fmt.Errorf(
+			"invalid deviation histogram for validator %s: expected %d buckets, got %d",
+			vp.Validator, len(DeviationBucketBounds)+1, len(vp.DeviationHistogram),
+		)
+	}
+
+	return nil
+}
+
+// DeviationBps returns the absolute deviation of a price from a reference price, in basis points of
+// the reference price, rounded up. The reference price must be positive.
+func DeviationBps(price, reference *big.Int) uint64 {
+	diff := new(big.Int).Sub(price, reference)
+	diff.Abs(diff)
+	diff.Mul(diff, big.NewInt(10_000))
+
+	bps, rem := new(big.Int).QuoRem(diff, reference, new(big.Int))
+	if rem.Sign() != 0 {
+		bps.Add(bps, big.NewInt(1))
+	}
+
+	if !bps.IsUint64() {
+		return ^uint64(0)
+	}
+
+	return bps.Uint64()
+}
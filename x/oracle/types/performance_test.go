@@ -0,0 +1,126 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zoguxprotocol/slinky/x/oracle/types"
+)
+
+func TestDeviationBps(t *testing.T) {
+	testCases := []struct {
+		name      string
+		price     int64
+		reference int64
+		expected  uint64
+	}{
+		{
+			name:      "equal prices",
+			price:     100,
+			reference: 100,
+			expected:  0,
+		},
+		{
+			name:      "higher price",
+			price:     101,
+			reference: 100,
+			expected:  100,
+		},
+		{
+			name:      "lower price",
+			price:     99,
+			reference: 100,
+			expected:  100,
+		},
+		{
+			name:      "fractional deviations are rounded up",
+			price:     1_000_001,
+			reference: 1_000_000,
+			expected:  1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, types.DeviationBps(big.NewInt(tc.price), big.NewInt(tc.reference)))
+		})
+	}
+}
+
+func TestValidatorPerformanceDeviationHistogram(t *testing.T) {
+	vp := types.NewValidatorPerformance(sdk.ConsAddress("validator"), 1)
+	require.Equal(t, uint64(0), vp.MedianDeviationBps())
+
+	for _, bps := range []uint64{0, 3, 4, 40, 5000} {
+		vp.AddDeviation(bps)
+	}
+
+	require.Equal(t, []uint64{1, 0, 0, 2, 0, 0, 1, 0, 0, 0, 0, 1}, vp.DeviationHistogram)
+	require.Equal(t, uint64(5), vp.MedianDeviationBps())
+	require.NoError(t, vp.ValidateBasic())
+
+	// medians in the last bucket are reported as the last bound
+	for i := 0; i < 5; i++ {
+		vp.AddDeviation(10_000)
+	}
+	require.Equal(t, uint64(1000), vp.MedianDeviationBps())
+}
+
+func TestValidatorPerformanceValidateBasic(t *testing.T) {
+	validator := sdk.ConsAddress("validator")
+
+	testCases := []struct {
+		name      string
+		modify    func(vp *types.ValidatorPerformance)
+		expectErr bool
+	}{
+		{
+			name:      "valid performance",
+			modify:    func(_ *types.ValidatorPerformance) {},
+			expectErr: false,
+		},
+		{
+			name:      "invalid validator",
+			modify:    func(vp *types.ValidatorPerformance) { vp.Validator = "invalid" },
+			expectErr: true,
+		},
+		{
+			name:      "invalid window",
+			modify:    func(vp *types.ValidatorPerformance) { vp.Window = 0 },
+			expectErr: true,
+		},
+		{
+			name:      "more absent votes than blocks",
+			modify:    func(vp *types.ValidatorPerformance) { vp.AbsentVotes = 1 },
+			expectErr: true,
+		},
+		{
+			name:      "more missed reports than reports",
+			modify:    func(vp *types.ValidatorPerformance) { vp.MissedReports = 1 },
+			expectErr: true,
+		},
+		{
+			name:      "invalid histogram",
+			modify:    func(vp *types.ValidatorPerformance) { vp.DeviationHistogram = nil },
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			vp := types.NewValidatorPerformance(validator, 1)
+			tc.modify(&vp)
+
+			err := vp.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -225,46 +226,353 @@ func (m *MsgRemoveCurrencyPairsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRemoveCurrencyPairsResponse proto.InternalMessageInfo
 
+// MsgUpdateParams defines the Msg/UpdateParams request type. It contains the
+// new parameters for the x/oracle module.
+type MsgUpdateParams struct {
+	// authority is the address of the account that is authorized to update the
+	// x/oracle's Params
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the new parameters for the x/oracle module.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cd987ee4c7d5236, []int{4}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the Msg/UpdateParams response type.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cd987ee4c7d5236, []int{5}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgSetPriceGuardrails defines the Msg/SetPriceGuardrails request type. It
+// sets the price guardrails of the given CurrencyPairs. An empty guardrail
+// removes the guardrail of its CurrencyPair.
+type MsgSetPriceGuardrails struct {
+	// authority is the address of the account that is authorized to update the
+	// x/oracle's price guardrails
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// guardrails are the price guardrails to set.
+	Guardrails []CurrencyPairGuardrail `protobuf:"bytes,2,rep,name=guardrails,proto3" json:"guardrails"`
+}
+
+func (m *MsgSetPriceGuardrails) Reset()         { *m = MsgSetPriceGuardrails{} }
+func (m *MsgSetPriceGuardrails) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPriceGuardrails) ProtoMessage()    {}
+func (*MsgSetPriceGuardrails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cd987ee4c7d5236, []int{6}
+}
+func (m *MsgSetPriceGuardrails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPriceGuardrails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPriceGuardrails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPriceGuardrails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPriceGuardrails.Merge(m, src)
+}
+func (m *MsgSetPriceGuardrails) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPriceGuardrails) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPriceGuardrails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPriceGuardrails proto.InternalMessageInfo
+
+func (m *MsgSetPriceGuardrails) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetPriceGuardrails) GetGuardrails() []CurrencyPairGuardrail {
+	if m != nil {
+		return m.Guardrails
+	}
+	return nil
+}
+
+// MsgSetPriceGuardrailsResponse defines the Msg/SetPriceGuardrails response
+// type.
+type MsgSetPriceGuardrailsResponse struct {
+}
+
+func (m *MsgSetPriceGuardrailsResponse) Reset()         { *m = MsgSetPriceGuardrailsResponse{} }
+func (m *MsgSetPriceGuardrailsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPriceGuardrailsResponse) ProtoMessage()    {}
+func (*MsgSetPriceGuardrailsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cd987ee4c7d5236, []int{7}
+}
+func (m *MsgSetPriceGuardrailsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPriceGuardrailsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPriceGuardrailsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPriceGuardrailsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPriceGuardrailsResponse.Merge(m, src)
+}
+func (m *MsgSetPriceGuardrailsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPriceGuardrailsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPriceGuardrailsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPriceGuardrailsResponse proto.InternalMessageInfo
+
+// MsgSetOracleKey defines the Msg/SetOracleKey request type. It registers the
+// oracle key of a validator, replacing its current oracle key if any.
+type MsgSetOracleKey struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// pub_key is the public oracle key of the validator.
+	PubKey *types1.Any `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+}
+
+func (m *MsgSetOracleKey) Reset()         { *m = MsgSetOracleKey{} }
+func (m *MsgSetOracleKey) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOracleKey) ProtoMessage()    {}
+func (*MsgSetOracleKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cd987ee4c7d5236, []int{8}
+}
+func (m *MsgSetOracleKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetOracleKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOracleKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetOracleKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOracleKey.Merge(m, src)
+}
+func (m *MsgSetOracleKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOracleKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOracleKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetOracleKey proto.InternalMessageInfo
+
+func (m *MsgSetOracleKey) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgSetOracleKey) GetPubKey() *types1.Any {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+// MsgSetOracleKeyResponse defines the Msg/SetOracleKey response type.
+type MsgSetOracleKeyResponse struct {
+}
+
+func (m *MsgSetOracleKeyResponse) Reset()         { *m = MsgSetOracleKeyResponse{} }
+func (m *MsgSetOracleKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOracleKeyResponse) ProtoMessage()    {}
+func (*MsgSetOracleKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cd987ee4c7d5236, []int{9}
+}
+func (m *MsgSetOracleKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetOracleKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOracleKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetOracleKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOracleKeyResponse.Merge(m, src)
+}
+func (m *MsgSetOracleKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOracleKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOracleKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetOracleKeyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAddCurrencyPairs)(nil), "slinky.oracle.v1.MsgAddCurrencyPairs")
 	proto.RegisterType((*MsgAddCurrencyPairsResponse)(nil), "slinky.oracle.v1.MsgAddCurrencyPairsResponse")
 	proto.RegisterType((*MsgRemoveCurrencyPairs)(nil), "slinky.oracle.v1.MsgRemoveCurrencyPairs")
 	proto.RegisterType((*MsgRemoveCurrencyPairsResponse)(nil), "slinky.oracle.v1.MsgRemoveCurrencyPairsResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "slinky.oracle.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "slinky.oracle.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetPriceGuardrails)(nil), "slinky.oracle.v1.MsgSetPriceGuardrails")
+	proto.RegisterType((*MsgSetPriceGuardrailsResponse)(nil), "slinky.oracle.v1.MsgSetPriceGuardrailsResponse")
+	proto.RegisterType((*MsgSetOracleKey)(nil), "slinky.oracle.v1.MsgSetOracleKey")
+	proto.RegisterType((*MsgSetOracleKeyResponse)(nil), "slinky.oracle.v1.MsgSetOracleKeyResponse")
 }
 
 func init() { proto.RegisterFile("slinky/oracle/v1/tx.proto", fileDescriptor_1cd987ee4c7d5236) }
 
 var fileDescriptor_1cd987ee4c7d5236 = []byte{
-	// 458 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2c, 0xce, 0xc9, 0xcc,
-	0xcb, 0xae, 0xd4, 0xcf, 0x2f, 0x4a, 0x4c, 0xce, 0x49, 0xd5, 0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0xd0,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0x48, 0xe9, 0x41, 0xa4, 0xf4, 0xca, 0x0c, 0xa5,
-	0xe4, 0x30, 0x14, 0xa7, 0xa7, 0xe6, 0xa5, 0x16, 0x67, 0x16, 0x43, 0x74, 0x48, 0x49, 0x26, 0xe7,
-	0x17, 0xe7, 0xe6, 0x17, 0xc7, 0x83, 0x79, 0xfa, 0x10, 0x0e, 0x54, 0x4a, 0x1c, 0xc2, 0xd3, 0xcf,
-	0x2d, 0x4e, 0x07, 0xe9, 0xcb, 0x2d, 0x4e, 0x87, 0x4a, 0x08, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb,
-	0x83, 0x49, 0xa8, 0x90, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0xc4, 0x0c, 0x10, 0x0b, 0x2a, 0xaa, 0x0c,
-	0xb5, 0xbc, 0xa4, 0xb2, 0x20, 0xb5, 0x18, 0x64, 0x46, 0x72, 0x69, 0x51, 0x51, 0x6a, 0x5e, 0x72,
-	0x65, 0x7c, 0x41, 0x62, 0x66, 0x11, 0x44, 0x91, 0xd2, 0x55, 0x46, 0x2e, 0x61, 0xdf, 0xe2, 0x74,
-	0xc7, 0x94, 0x14, 0x67, 0xa8, 0x6c, 0x40, 0x62, 0x66, 0x51, 0xb1, 0x90, 0x19, 0x17, 0x67, 0x62,
-	0x69, 0x49, 0x46, 0x7e, 0x51, 0x66, 0x49, 0xa5, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xc4,
-	0xa5, 0x2d, 0xba, 0x22, 0x50, 0x37, 0x3a, 0xa6, 0xa4, 0x14, 0xa5, 0x16, 0x17, 0x07, 0x97, 0x14,
-	0x65, 0xe6, 0xa5, 0x07, 0x21, 0x94, 0x0a, 0x79, 0x71, 0xf1, 0xa1, 0x58, 0x53, 0x2c, 0xc1, 0xa4,
-	0xc0, 0xac, 0xc1, 0x6d, 0x24, 0xab, 0x07, 0x0d, 0x1c, 0xb0, 0x6b, 0xf4, 0xca, 0x0c, 0xf5, 0x90,
-	0xed, 0x73, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x88, 0x37, 0x19, 0xd9, 0x0d, 0x56, 0x56, 0x2f,
-	0x16, 0xc8, 0x33, 0x34, 0x3d, 0xdf, 0xa0, 0x85, 0x30, 0xbf, 0xeb, 0xf9, 0x06, 0x2d, 0x98, 0xbf,
-	0x2a, 0x60, 0xc1, 0x8a, 0xc5, 0xfd, 0x4a, 0xb2, 0x5c, 0xd2, 0x58, 0x84, 0x83, 0x52, 0x8b, 0x0b,
-	0xf2, 0xf3, 0x8a, 0x53, 0x95, 0x76, 0x30, 0x72, 0x89, 0xf9, 0x16, 0xa7, 0x07, 0xa5, 0xe6, 0xe6,
-	0x97, 0xa5, 0x52, 0xc7, 0xe7, 0x5a, 0x5c, 0x82, 0x28, 0x3e, 0x8f, 0xcf, 0x4c, 0x81, 0x78, 0x9e,
-	0x33, 0x88, 0x1f, 0xd9, 0x5f, 0x9e, 0x29, 0x24, 0xf9, 0x2c, 0x38, 0xb5, 0x04, 0xd5, 0x67, 0x0a,
-	0x5c, 0x72, 0xd8, 0x5d, 0x0e, 0xf3, 0x9c, 0xd1, 0x4b, 0x46, 0x2e, 0x66, 0xdf, 0xe2, 0x74, 0xa1,
-	0x0c, 0x2e, 0x01, 0x8c, 0x78, 0x55, 0xd5, 0x43, 0x4f, 0xa4, 0x7a, 0x58, 0xc2, 0x49, 0x4a, 0x97,
-	0x28, 0x65, 0x30, 0x1b, 0x85, 0x0a, 0xb9, 0x84, 0xb1, 0x05, 0xa5, 0x06, 0x56, 0x53, 0xb0, 0xa8,
-	0x94, 0x32, 0x20, 0x56, 0x25, 0xcc, 0x4a, 0x29, 0xd6, 0x86, 0xe7, 0x1b, 0xb4, 0x18, 0x9d, 0x3c,
-	0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5,
-	0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x2f, 0x3d, 0xb3, 0x24, 0xa3,
-	0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xbf, 0x2a, 0x3f, 0xbd, 0xb4, 0x02, 0x9c, 0xe0, 0x93, 0xf3,
-	0x73, 0xf4, 0xd1, 0x43, 0x19, 0x9c, 0x24, 0x93, 0xd8, 0xc0, 0xf2, 0xc6, 0x80, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xf2, 0x7b, 0xa2, 0x0f, 0xe1, 0x03, 0x00, 0x00,
+	// 747 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xc1, 0x4f, 0x13, 0x4f,
+	0x14, 0xc7, 0xbb, 0xc0, 0x8f, 0x5f, 0x18, 0x54, 0x60, 0x41, 0x29, 0x6b, 0xba, 0x2d, 0x35, 0x84,
+	0xd2, 0x84, 0x5d, 0xc1, 0x84, 0x43, 0x63, 0x4c, 0xa8, 0x07, 0x54, 0x52, 0x6d, 0x4a, 0xf4, 0x60,
+	0x4c, 0x9a, 0xe9, 0xee, 0x38, 0x6c, 0x68, 0x77, 0xd6, 0x99, 0xdd, 0x86, 0xf5, 0x64, 0x3c, 0x7a,
+	0xf2, 0xe2, 0xdd, 0x3f, 0x81, 0x03, 0x07, 0xfd, 0x0f, 0x88, 0x27, 0x42, 0x62, 0xe2, 0xc1, 0x18,
+	0x03, 0x07, 0x8c, 0x7f, 0x85, 0x61, 0x77, 0x76, 0xd9, 0x76, 0x87, 0x80, 0xc4, 0x4b, 0xdb, 0x99,
+	0xf7, 0xdd, 0xef, 0xbc, 0xcf, 0x7b, 0x6f, 0xa7, 0x60, 0x86, 0xb5, 0x2d, 0x7b, 0xcb, 0xd7, 0x09,
+	0x85, 0x46, 0x1b, 0xe9, 0xdd, 0x25, 0xdd, 0xdd, 0xd6, 0x1c, 0x4a, 0x5c, 0x22, 0x8f, 0x87, 0x21,
+	0x2d, 0x0c, 0x69, 0xdd, 0x25, 0x45, 0x4d, 0x89, 0x31, 0xb2, 0x11, 0xb3, 0x58, 0xf8, 0x84, 0x32,
+	0x63, 0x10, 0xd6, 0x21, 0xac, 0x19, 0xac, 0xf4, 0x70, 0xc1, 0x43, 0xd3, 0xe1, 0x4a, 0xef, 0x30,
+	0x7c, 0xf2, 0x5c, 0x87, 0x61, 0x1e, 0x98, 0x80, 0x1d, 0xcb, 0x26, 0x7a, 0xf0, 0xc9, 0xb7, 0xa6,
+	0x30, 0xc1, 0x24, 0xf4, 0x38, 0xf9, 0x15, 0x99, 0x63, 0x42, 0x70, 0x1b, 0xe9, 0xc1, 0xaa, 0xe5,
+	0xbd, 0xd4, 0xa1, 0xed, 0xf3, 0xd0, 0x2d, 0x9e, 0x97, 0xeb, 0x3b, 0x88, 0x9d, 0xd8, 0x1b, 0x1e,
+	0xa5, 0xc8, 0x36, 0xfc, 0xa6, 0x03, 0x2d, 0xca, 0x45, 0xb9, 0x54, 0xf2, 0x0e, 0xa4, 0xb0, 0xc3,
+	0x13, 0x2c, 0x7e, 0x95, 0xc0, 0x64, 0x8d, 0xe1, 0x55, 0xd3, 0xbc, 0xcf, 0x1f, 0xae, 0x43, 0x8b,
+	0x32, 0x79, 0x05, 0x8c, 0x40, 0xcf, 0xdd, 0x24, 0xd4, 0x72, 0xfd, 0xac, 0x54, 0x90, 0x4a, 0x23,
+	0xd5, 0xec, 0xc1, 0xee, 0xe2, 0x14, 0xa7, 0x5b, 0x35, 0x4d, 0x8a, 0x18, 0xdb, 0x70, 0xa9, 0x65,
+	0xe3, 0xc6, 0xa9, 0x54, 0x7e, 0x04, 0xae, 0xf5, 0x64, 0xc1, 0xb2, 0x03, 0x85, 0xc1, 0xd2, 0xe8,
+	0x72, 0x4e, 0xe3, 0x65, 0x0d, 0x92, 0xd5, 0xba, 0x4b, 0x5a, 0xf2, 0xbc, 0xea, 0xd0, 0xde, 0x8f,
+	0x7c, 0xa6, 0x71, 0xd5, 0x48, 0xe6, 0x50, 0xa9, 0xfc, 0xfa, 0x98, 0xcf, 0xbc, 0x3d, 0xde, 0x29,
+	0x9f, 0xfa, 0xbf, 0x3b, 0xde, 0x29, 0x47, 0xd8, 0xdb, 0x11, 0x93, 0x20, 0xff, 0x62, 0x0e, 0xdc,
+	0x14, 0x6c, 0x37, 0x10, 0x73, 0x88, 0xcd, 0x50, 0xf1, 0x93, 0x04, 0x6e, 0xd4, 0x18, 0x6e, 0xa0,
+	0x0e, 0xe9, 0xa2, 0x7f, 0x43, 0x5e, 0x06, 0x13, 0x3d, 0xe4, 0x4d, 0xcb, 0x0c, 0xe1, 0x47, 0x1a,
+	0x63, 0x49, 0xae, 0x87, 0xe6, 0x5f, 0x91, 0x6d, 0x20, 0xb7, 0x97, 0xac, 0x00, 0x54, 0x71, 0xe6,
+	0x31, 0xdc, 0x67, 0x09, 0x8c, 0xd5, 0x18, 0x7e, 0xea, 0x98, 0xd0, 0x45, 0xf5, 0xa0, 0xdb, 0x97,
+	0xa6, 0x5a, 0x01, 0xc3, 0xe1, 0xbc, 0x64, 0x07, 0x0a, 0x52, 0x69, 0x74, 0x39, 0xab, 0xf5, 0xbf,
+	0x1e, 0x5a, 0x78, 0x02, 0x6f, 0x21, 0x57, 0x57, 0x56, 0xc4, 0x84, 0x79, 0x01, 0x61, 0x32, 0xcf,
+	0xe2, 0x0c, 0x98, 0xee, 0xdb, 0x8a, 0xb1, 0xbe, 0x4b, 0xe0, 0x7a, 0x58, 0x90, 0x3a, 0xb5, 0x0c,
+	0xb4, 0xe6, 0x41, 0x6a, 0x52, 0x68, 0xb5, 0x2f, 0x0f, 0x57, 0x03, 0x00, 0xc7, 0x2e, 0x7c, 0x50,
+	0xe7, 0xd3, 0x80, 0xc9, 0x2a, 0xc7, 0xa7, 0x72, 0xde, 0x84, 0x41, 0xe5, 0xae, 0x98, 0x79, 0x4e,
+	0xdc, 0xd5, 0x3e, 0x88, 0x62, 0x1e, 0xe4, 0x84, 0x81, 0x98, 0xff, 0x77, 0xd8, 0xd6, 0x0d, 0xe4,
+	0x3e, 0x09, 0x7c, 0xd6, 0x91, 0x2f, 0x3f, 0x06, 0x13, 0x5d, 0xd8, 0xb6, 0x4c, 0xe8, 0x12, 0xda,
+	0x84, 0x21, 0x27, 0xaf, 0xc0, 0xec, 0xc1, 0xee, 0x62, 0x8e, 0x57, 0xe0, 0x59, 0xa4, 0xe9, 0x2d,
+	0xc5, 0x78, 0xb7, 0x6f, 0x5f, 0x5e, 0x03, 0xff, 0x3b, 0x5e, 0xab, 0xb9, 0x85, 0x7c, 0xde, 0xef,
+	0x29, 0x2d, 0xbc, 0x7f, 0xb4, 0xe8, 0xfe, 0xd1, 0x56, 0x6d, 0xbf, 0x9a, 0xfd, 0x72, 0x5a, 0x5d,
+	0x83, 0xfa, 0x8e, 0x4b, 0xb4, 0xba, 0xd7, 0x5a, 0x47, 0x7e, 0x63, 0xd8, 0x09, 0xbe, 0x2b, 0xf7,
+	0xa2, 0x5a, 0xa4, 0xf3, 0x3b, 0x6b, 0x0e, 0x92, 0x60, 0x7c, 0x0e, 0x92, 0x5b, 0x51, 0x1d, 0x96,
+	0x3f, 0x0c, 0x81, 0xc1, 0x1a, 0xc3, 0xf2, 0x26, 0x18, 0x4f, 0x5d, 0x5b, 0x73, 0xe9, 0xee, 0x09,
+	0xae, 0x01, 0x65, 0xf1, 0x42, 0xb2, 0xe8, 0x44, 0xf9, 0x15, 0x98, 0x14, 0xdd, 0x14, 0x25, 0xa1,
+	0x8b, 0x40, 0xa9, 0xdc, 0xbe, 0xa8, 0x32, 0x3e, 0xf2, 0x05, 0xb8, 0xd2, 0xf3, 0xfe, 0xce, 0x0a,
+	0x1d, 0x92, 0x12, 0x65, 0xe1, 0x5c, 0x49, 0xec, 0x6e, 0x03, 0x59, 0xf0, 0x1a, 0xcd, 0x0b, 0x0d,
+	0xd2, 0x42, 0x45, 0xbf, 0xa0, 0x30, 0x49, 0xd3, 0x33, 0xb6, 0xb3, 0x67, 0x19, 0xc4, 0x12, 0x65,
+	0xe1, 0x5c, 0x49, 0xe4, 0xae, 0xfc, 0xf7, 0xe6, 0x78, 0xa7, 0x2c, 0x55, 0x1f, 0xec, 0x1d, 0xaa,
+	0xd2, 0xfe, 0xa1, 0x2a, 0xfd, 0x3c, 0x54, 0xa5, 0xf7, 0x47, 0x6a, 0x66, 0xff, 0x48, 0xcd, 0x7c,
+	0x3b, 0x52, 0x33, 0xcf, 0x35, 0x6c, 0xb9, 0x9b, 0x5e, 0x4b, 0x33, 0x48, 0x47, 0x7f, 0x4d, 0xb0,
+	0xb7, 0x1d, 0x0c, 0xb3, 0x41, 0xda, 0x7a, 0xff, 0x18, 0x06, 0xff, 0x4e, 0xad, 0xe1, 0x20, 0x7e,
+	0xe7, 0xcf, 0x00, 0xc1, 0xf5, 0xb0, 0x3e, 0x26, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -287,6 +595,16 @@ type MsgClient interface {
 	// given set of currency-pairs from the module's state. Thus these
 	// CurrencyPairs will no longer have price-data available from this module.
 	RemoveCurrencyPairs(ctx context.Context, in *MsgRemoveCurrencyPairs, opts ...grpc.CallOption) (*MsgRemoveCurrencyPairsResponse, error)
+	// UpdateParams defines a method for updating the x/oracle module
+	// parameters. It can only be executed by the module authority.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetPriceGuardrails defines a method for setting the price guardrails of a
+	// set of CurrencyPairs. It can only be executed by the module authority.
+	SetPriceGuardrails(ctx context.Context, in *MsgSetPriceGuardrails, opts ...grpc.CallOption) (*MsgSetPriceGuardrailsResponse, error)
+	// SetOracleKey defines a method for a validator to register the key it
+	// signs the prices of its vote extensions with. It can only be executed by
+	// the operator of the validator.
+	SetOracleKey(ctx context.Context, in *MsgSetOracleKey, opts ...grpc.CallOption) (*MsgSetOracleKeyResponse, error)
 }
 
 type msgClient struct {
@@ -315,6 +633,33 @@ func (c *msgClient) RemoveCurrencyPairs(ctx context.Context, in *MsgRemoveCurren
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/slinky.oracle.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPriceGuardrails(ctx context.Context, in *MsgSetPriceGuardrails, opts ...grpc.CallOption) (*MsgSetPriceGuardrailsResponse, error) {
+	out := new(MsgSetPriceGuardrailsResponse)
+	err := c.cc.Invoke(ctx, "/slinky.oracle.v1.Msg/SetPriceGuardrails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetOracleKey(ctx context.Context, in *MsgSetOracleKey, opts ...grpc.CallOption) (*MsgSetOracleKeyResponse, error) {
+	out := new(MsgSetOracleKeyResponse)
+	err := c.cc.Invoke(ctx, "/slinky.oracle.v1.Msg/SetOracleKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AddCurrencyPairs will be used only by governance to update the set of
@@ -325,6 +670,16 @@ type MsgServer interface {
 	// given set of currency-pairs from the module's state. Thus these
 	// CurrencyPairs will no longer have price-data available from this module.
 	RemoveCurrencyPairs(context.Context, *MsgRemoveCurrencyPairs) (*MsgRemoveCurrencyPairsResponse, error)
+	// UpdateParams defines a method for updating the x/oracle module
+	// parameters. It can only be executed by the module authority.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetPriceGuardrails defines a method for setting the price guardrails of a
+	// set of CurrencyPairs. It can only be executed by the module authority.
+	SetPriceGuardrails(context.Context, *MsgSetPriceGuardrails) (*MsgSetPriceGuardrailsResponse, error)
+	// SetOracleKey defines a method for a validator to register the key it
+	// signs the prices of its vote extensions with. It can only be executed by
+	// the operator of the validator.
+	SetOracleKey(context.Context, *MsgSetOracleKey) (*MsgSetOracleKeyResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -337,6 +692,15 @@ func (*UnimplementedMsgServer) AddCurrencyPairs(ctx context.Context, req *MsgAdd
 func (*UnimplementedMsgServer) RemoveCurrencyPairs(ctx context.Context, req *MsgRemoveCurrencyPairs) (*MsgRemoveCurrencyPairsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveCurrencyPairs not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) SetPriceGuardrails(ctx context.Context, req *MsgSetPriceGuardrails) (*MsgSetPriceGuardrailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPriceGuardrails not implemented")
+}
+func (*UnimplementedMsgServer) SetOracleKey(ctx context.Context, req *MsgSetOracleKey) (*MsgSetOracleKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOracleKey not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -378,6 +742,60 @@ func _Msg_RemoveCurrencyPairs_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.oracle.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPriceGuardrails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPriceGuardrails)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPriceGuardrails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.oracle.v1.Msg/SetPriceGuardrails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPriceGuardrails(ctx, req.(*MsgSetPriceGuardrails))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetOracleKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetOracleKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetOracleKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.oracle.v1.Msg/SetOracleKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetOracleKey(ctx, req.(*MsgSetOracleKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "slinky.oracle.v1.Msg",
@@ -391,6 +809,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveCurrencyPairs",
 			Handler:    _Msg_RemoveCurrencyPairs_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "SetPriceGuardrails",
+			Handler:    _Msg_SetPriceGuardrails_Handler,
+		},
+		{
+			MethodName: "SetOracleKey",
+			Handler:    _Msg_SetOracleKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "slinky/oracle/v1/tx.proto",
@@ -525,26 +955,221 @@ func (m *MsgRemoveCurrencyPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgAddCurrencyPairs) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPriceGuardrails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPriceGuardrails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPriceGuardrails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Guardrails) > 0 {
+		for iNdEx := len(m.Guardrails) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Guardrails[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPriceGuardrailsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPriceGuardrailsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPriceGuardrailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetOracleKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetOracleKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOracleKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PubKey != nil {
+		{
+			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetOracleKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetOracleKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOracleKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddCurrencyPairs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	if len(m.CurrencyPairs) > 0 {
 		for _, e := range m.CurrencyPairs {
@@ -559,46 +1184,454 @@ func (m *MsgAddCurrencyPairsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveCurrencyPairs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CurrencyPairIds) > 0 {
+		for _, s := range m.CurrencyPairIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRemoveCurrencyPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPriceGuardrails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Guardrails) > 0 {
+		for _, e := range m.Guardrails {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetPriceGuardrailsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetOracleKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetOracleKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddCurrencyPairs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddCurrencyPairs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddCurrencyPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPairs = append(m.CurrencyPairs, types.CurrencyPair{})
+			if err := m.CurrencyPairs[len(m.CurrencyPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddCurrencyPairsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddCurrencyPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveCurrencyPairs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveCurrencyPairs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveCurrencyPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPairIds = append(m.CurrencyPairIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgRemoveCurrencyPairs) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if len(m.CurrencyPairIds) > 0 {
-		for _, s := range m.CurrencyPairIds {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+func (m *MsgRemoveCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveCurrencyPairsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveCurrencyPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgRemoveCurrencyPairsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgAddCurrencyPairs) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -621,10 +1654,10 @@ func (m *MsgAddCurrencyPairs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddCurrencyPairs: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddCurrencyPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -661,7 +1694,7 @@ func (m *MsgAddCurrencyPairs) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -688,8 +1721,7 @@ func (m *MsgAddCurrencyPairs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrencyPairs = append(m.CurrencyPairs, types.CurrencyPair{})
-			if err := m.CurrencyPairs[len(m.CurrencyPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -714,7 +1746,7 @@ func (m *MsgAddCurrencyPairs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -737,10 +1769,10 @@ func (m *MsgAddCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddCurrencyPairsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddCurrencyPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -764,7 +1796,7 @@ func (m *MsgAddCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveCurrencyPairs) Unmarshal(dAtA []byte) error {
+func (m *MsgSetPriceGuardrails) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -787,10 +1819,10 @@ func (m *MsgRemoveCurrencyPairs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveCurrencyPairs: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetPriceGuardrails: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveCurrencyPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetPriceGuardrails: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -827,7 +1859,141 @@ func (m *MsgRemoveCurrencyPairs) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardrails", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardrails = append(m.Guardrails, CurrencyPairGuardrail{})
+			if err := m.Guardrails[len(m.Guardrails)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPriceGuardrailsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPriceGuardrailsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPriceGuardrailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetOracleKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetOracleKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetOracleKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -855,7 +2021,43 @@ func (m *MsgRemoveCurrencyPairs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrencyPairIds = append(m.CurrencyPairIds, string(dAtA[iNdEx:postIndex]))
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &types1.Any{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -878,7 +2080,7 @@ func (m *MsgRemoveCurrencyPairs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetOracleKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -901,10 +2103,10 @@ func (m *MsgRemoveCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveCurrencyPairsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetOracleKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveCurrencyPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetOracleKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default: